@@ -158,7 +158,8 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 
 	// add block gas meter
 	var gasMeter sdk.GasMeter
-	if maxGas := app.getMaximumBlockGas(app.deliverState.ctx); maxGas > 0 {
+	maxGas := app.getMaximumBlockGas(app.deliverState.ctx)
+	if maxGas > 0 {
 		gasMeter = sdk.NewGasMeter(maxGas)
 	} else {
 		gasMeter = sdk.NewInfiniteGasMeter()
@@ -170,6 +171,10 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		WithBlockGasMeter(gasMeter).
 		WithHeaderHash(req.Hash).
 		WithConsensusParams(app.GetConsensusParams(app.deliverState.ctx))
+	app.deliverState.ctx = app.loadGasConfigs(app.deliverState.ctx)
+	if maxGas > 0 {
+		app.deliverState.ctx = app.loadPriorityLanes(app.deliverState.ctx)
+	}
 
 	// we also set block gas meter to checkState in case the application needs to
 	// verify gas consumption during (Re)CheckTx
@@ -261,8 +266,16 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 // Otherwise, the ResponseDeliverTx will contain releveant error information.
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
-func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliverTx) {
-	gInfo := sdk.GasInfo{}
+func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, req.Tx)
+	return app.finalizeDeliverTx(req, gInfo, result, anteEvents, err)
+}
+
+// finalizeDeliverTx returns the response to a delivered tx given the result of its
+// execution, and reports it to the telemetry and the ABCI listeners.
+func (app *BaseApp) finalizeDeliverTx(
+	req abci.RequestDeliverTx, gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error,
+) (res abci.ResponseDeliverTx) {
 	resultStr := "successful"
 
 	defer func() {
@@ -280,7 +293,6 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, req.Tx)
 	if err != nil {
 		resultStr = "failed"
 		return sdkerrors.ResponseDeliverTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, sdk.MarkEventsToIndex(anteEvents, app.indexEvents), app.trace)
@@ -640,6 +652,18 @@ func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, e
 	}
 
 	cacheMS, err := qms.CacheMultiStoreWithVersion(height)
+	if err != nil && app.archive != nil && app.archive.HasVersion(height) {
+		// the archive holds no merkle proofs
+		if prove {
+			return sdk.Context{},
+				sdkerrors.Wrapf(
+					sdkerrors.ErrInvalidRequest,
+					"cannot query with proof at height %d served by the archive store", height,
+				)
+		}
+
+		cacheMS, err = app.archive.CacheMultiStoreWithVersion(height)
+	}
 	if err != nil {
 		return sdk.Context{},
 			sdkerrors.Wrapf(
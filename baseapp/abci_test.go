@@ -167,6 +167,53 @@ func TestBaseAppCreateQueryContext(t *testing.T) {
 	}
 }
 
+type mockArchiveStore struct {
+	version int64
+	ms      sdk.CacheMultiStore
+}
+
+func (as *mockArchiveStore) HasVersion(version int64) bool {
+	return version == as.version
+}
+
+func (as *mockArchiveStore) CacheMultiStoreWithVersion(version int64) (sdk.CacheMultiStore, error) {
+	return as.ms, nil
+}
+
+func TestBaseAppCreateQueryContextArchive(t *testing.T) {
+	key := sdk.NewKVStoreKey("main")
+	app := NewBaseApp(t.Name(), defaultLogger(), dbm.NewMemDB(), nil, SetPruning(pruningtypes.NewPruningOptions(pruningtypes.PruningEverything)))
+	archive := &mockArchiveStore{}
+	app.SetArchiveStore(archive)
+	app.MountStores(key)
+	require.NoError(t, app.LoadLatestVersion())
+
+	for height := int64(1); height <= 12; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		app.Commit()
+	}
+
+	// height 1 is pruned
+	_, err := app.createQueryContext(1, false)
+	require.Error(t, err)
+
+	archived := app.cms.CacheMultiStore()
+	archived.GetKVStore(key).Set([]byte("key"), []byte("archived"))
+	archive.version, archive.ms = 1, archived
+
+	ctx, err := app.createQueryContext(1, false)
+	require.NoError(t, err)
+	require.Equal(t, []byte("archived"), ctx.KVStore(key).Get([]byte("key")))
+
+	_, err = app.createQueryContext(1, true)
+	require.Error(t, err)
+
+	// heights kept by the multistore are not served by the archive
+	ctx, err = app.createQueryContext(12, false)
+	require.NoError(t, err)
+	require.Nil(t, ctx.KVStore(key).Get([]byte("key")))
+}
+
 type paramStore struct {
 	db *dbm.MemDB
 }
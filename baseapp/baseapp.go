@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -18,6 +19,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 )
 
@@ -52,6 +54,14 @@ type BaseApp struct { // nolint: maligned
 	anteHandler sdk.AnteHandler // ante handler for fee and auth
 	postHandler sdk.AnteHandler // post handler, optional, e.g. for tips
 
+	mempool    mempool.Mempool // application side mempool, kept in sync by CheckTx and DeliverTx
+	mempoolMtx sync.Mutex      // guards the mempool against the workers of DeliverTxBatch
+
+	parallelTxWorkers int // number of workers executing the txs of DeliverTxBatch in parallel
+
+	gasConfigLoader    GasConfigLoader    // loads the store gas configs of every block, optional
+	priorityLaneLoader PriorityLaneLoader // loads the priority lanes of every block, optional
+
 	appStore
 	baseappVersions
 	peerFilters
@@ -117,6 +127,7 @@ type appStore struct {
 	db          dbm.DB               // common DB backend
 	cms         sdk.CommitMultiStore // Main (uncached) state
 	qms         sdk.MultiStore       // Optional alternative state provider for query service
+	archive     ArchiveStore         // Optional state provider for queries at heights pruned from cms
 	storeLoader StoreLoader          // function to handle store loading, may be overridden with SetStoreLoader()
 
 	// an inter-block write-through cache provided to the context during deliverState
@@ -181,6 +192,7 @@ func NewBaseApp(
 			msgServiceRouter: NewMsgServiceRouter(),
 		},
 		txDecoder: txDecoder,
+		mempool:   mempool.NoOpMempool{},
 	}
 
 	for _, option := range options {
@@ -430,6 +442,7 @@ func (app *BaseApp) setCheckState(header tmproto.Header) {
 		ms:  ms,
 		ctx: sdk.NewContext(ms, header, true, app.logger).WithMinGasPrices(app.minGasPrices),
 	}
+	app.checkState.ctx = app.loadGasConfigs(app.checkState.ctx)
 }
 
 // setDeliverState sets the BaseApp's deliverState with a branched multi-store
@@ -623,12 +636,16 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes)
+}
+
+// runTxWithContext processes a transaction like runTx within the given Context.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -645,12 +662,21 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		gInfo = sdk.GasInfo{GasWanted: gasWanted, GasUsed: ctx.GasMeter().GasConsumed()}
 	}()
 
+	// the block gas meter reserves gas for the priority lanes, if any, and lane is the
+	// lane of the tx
+	reserving, _ := ctx.BlockGasMeter().(*reservingGasMeter)
+	lane := -1
+
 	blockGasConsumed := false
 	// consumeBlockGas makes sure block gas is consumed at most once. It must happen after
 	// tx processing, and must be execute even if tx processing fails. Hence we use trick with `defer`
 	consumeBlockGas := func() {
 		if !blockGasConsumed {
 			blockGasConsumed = true
+			if reserving != nil {
+				reserving.consumeTxGas(lane, ctx.GasMeter().GasConsumedToLimit())
+				return
+			}
 			ctx.BlockGasMeter().ConsumeGas(
 				ctx.GasMeter().GasConsumedToLimit(), "block gas meter",
 			)
@@ -671,6 +697,21 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		return sdk.GasInfo{}, nil, nil, 0, err
 	}
 
+	// only run the tx if there is block gas remaining for its lane
+	if mode == runTxModeDeliver && reserving != nil {
+		lane = reserving.laneOf(tx)
+		if reserving.gasRemainingFor(lane) == 0 {
+			blockGasConsumed = true
+			return gInfo, nil, nil, 0, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
+		}
+	}
+
+	// A tx included in a block leaves the mempool whatever its result. It is executed
+	// even if it is unknown to the mempool, e.g. because it was proposed by another node.
+	if mode == runTxModeDeliver {
+		app.removeFromMempool(ctx, tx)
+	}
+
 	msgs := tx.GetMsgs()
 	if err := validateBasicTxMsgs(msgs); err != nil {
 		return sdk.GasInfo{}, nil, nil, 0, err
@@ -709,6 +750,10 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		gasWanted = ctx.GasMeter().Limit()
 
 		if err != nil {
+			// a pending tx which became invalid after a commit leaves the mempool
+			if mode == runTxModeReCheck {
+				app.removeFromMempool(ctx, tx)
+			}
 			return gInfo, nil, nil, 0, err
 		}
 
@@ -717,6 +762,12 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		anteEvents = events.ToABCIEvents()
 	}
 
+	if mode == runTxModeCheck {
+		if err := app.mempool.Insert(ctx, tx); err != nil {
+			return gInfo, nil, anteEvents, priority, err
+		}
+	}
+
 	// Create a new Context based off of the existing Context with a MultiStore branch
 	// in case message processing fails. At this point, the MultiStore
 	// is a branch of a branch.
@@ -760,6 +811,16 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	return gInfo, result, anteEvents, priority, err
 }
 
+// removeFromMempool removes the tx from the application side mempool, if present.
+func (app *BaseApp) removeFromMempool(ctx sdk.Context, tx sdk.Tx) {
+	app.mempoolMtx.Lock()
+	defer app.mempoolMtx.Unlock()
+
+	if err := app.mempool.Remove(tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+		ctx.Logger().Error("failed to remove tx from mempool", "err", err)
+	}
+}
+
 // runMsgs iterates through a list of messages and executes them with the provided
 // Context and execution mode. Messages will only be executed during simulation
 // and DeliverTx. An error is returned if any single message fails or if a
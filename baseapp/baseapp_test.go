@@ -492,6 +492,9 @@ func TestBaseAppOptionSeal(t *testing.T) {
 	require.Panics(t, func() {
 		app.SetAnteHandler(nil)
 	})
+	require.Panics(t, func() {
+		app.SetGasConfigLoader(nil)
+	})
 	require.Panics(t, func() {
 		app.SetAddrPeerFilter(nil)
 	})
@@ -506,6 +509,40 @@ func TestBaseAppOptionSeal(t *testing.T) {
 	})
 }
 
+func TestGasConfigLoader(t *testing.T) {
+	gasKey := []byte("gas-key")
+	loaderOpt := func(bapp *BaseApp) {
+		bapp.SetGasConfigLoader(func(ctx sdk.Context) (kv, transient storetypes.GasConfig) {
+			kv, transient = storetypes.KVGasConfig(), storetypes.TransientGasConfig()
+			if bz := ctx.KVStore(capKey1).Get(gasKey); bz != nil {
+				kv.WriteCostFlat = binary.BigEndian.Uint64(bz)
+			}
+			return kv, transient
+		})
+	}
+
+	app := setupBaseApp(t, loaderOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	header := tmproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	require.Equal(t, storetypes.KVGasConfig(), app.deliverState.ctx.KVGasConfig())
+	app.deliverState.ctx.KVStore(capKey1).Set(gasKey, sdk.Uint64ToBigEndian(1000))
+
+	// the gas configs of the block do not change until the next block
+	require.Equal(t, storetypes.KVGasConfig(), app.deliverState.ctx.KVGasConfig())
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	require.Equal(t, uint64(1000), app.checkState.ctx.KVGasConfig().WriteCostFlat)
+	require.Equal(t, storetypes.TransientGasConfig(), app.checkState.ctx.TransientKVGasConfig())
+
+	header = tmproto.Header{Height: 2}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	require.Equal(t, uint64(1000), app.deliverState.ctx.KVGasConfig().WriteCostFlat)
+	require.Equal(t, storetypes.TransientGasConfig(), app.deliverState.ctx.TransientKVGasConfig())
+}
+
 func TestSetMinGasPrices(t *testing.T) {
 	minGasPrices := sdk.DecCoins{sdk.NewInt64DecCoin("stake", 5000)}
 	app := newBaseApp(t.Name(), SetMinGasPrices(minGasPrices.String()))
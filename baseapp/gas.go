@@ -0,0 +1,23 @@
+package baseapp
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GasConfigLoader loads the gas configs of the KVStores and transient stores from the
+// state of the given context. It is called when the BaseApp sets the context of a
+// block, for DeliverTx at the start of a block and for CheckTx on Commit, and the
+// gas configs apply to every tx executed on that context.
+type GasConfigLoader func(ctx sdk.Context) (kv, transient storetypes.GasConfig)
+
+// loadGasConfigs returns the context with the gas configs loaded by the gas config
+// loader. The loader does not consume the gas of the context.
+func (app *BaseApp) loadGasConfigs(ctx sdk.Context) sdk.Context {
+	if app.gasConfigLoader == nil {
+		return ctx
+	}
+
+	kv, transient := app.gasConfigLoader(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+	return ctx.WithKVGasConfig(kv).WithTransientKVGasConfig(transient)
+}
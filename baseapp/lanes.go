@@ -0,0 +1,107 @@
+package baseapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// PriorityLane is a lane of the txs whose Msgs all have one of its type URLs, e.g. IBC
+// client updates or governance votes. The block gas reserved for a lane can only be
+// used by the txs of the lane, which use it before the unreserved block gas.
+type PriorityLane struct {
+	Name        string
+	MsgTypeURLs []string
+	ReservedGas uint64
+}
+
+// PriorityLaneLoader loads the priority lanes from the state of the given context. It
+// is called at the start of every block, and the block gas reserved for the lanes
+// applies to the txs delivered in the block.
+type PriorityLaneLoader func(ctx sdk.Context) []PriorityLane
+
+// loadPriorityLanes returns the context with a block gas meter reserving the gas of
+// the priority lanes loaded by the priority lane loader. It must only be called with
+// a block gas limit.
+func (app *BaseApp) loadPriorityLanes(ctx sdk.Context) sdk.Context {
+	if app.priorityLaneLoader == nil {
+		return ctx
+	}
+
+	var lanes []*reservedLane
+	for _, lane := range app.priorityLaneLoader(ctx.WithGasMeter(sdk.NewInfiniteGasMeter())) {
+		if lane.ReservedGas > 0 {
+			lanes = append(lanes, &reservedLane{match: mempool.MatchMsgTypeURLs(lane.MsgTypeURLs...), reserved: lane.ReservedGas})
+		}
+	}
+	if len(lanes) == 0 {
+		return ctx
+	}
+
+	return ctx.WithBlockGasMeter(&reservingGasMeter{GasMeter: ctx.BlockGasMeter(), lanes: lanes})
+}
+
+// reservedLane is a priority lane with reserved block gas.
+type reservedLane struct {
+	match    func(sdk.Tx) bool
+	reserved uint64
+	used     uint64
+}
+
+// unused returns the reserved gas not used yet by the txs of the lane.
+func (l *reservedLane) unused() uint64 {
+	if l.used >= l.reserved {
+		return 0
+	}
+	return l.reserved - l.used
+}
+
+// reservingGasMeter is a block gas meter which reserves block gas for the txs of
+// priority lanes. The txs are identified by the index of their lane, or by -1 for the
+// txs of no lane.
+type reservingGasMeter struct {
+	sdk.GasMeter
+	lanes []*reservedLane
+}
+
+// laneOf returns the index of the first lane matching the tx, or -1.
+func (m *reservingGasMeter) laneOf(tx sdk.Tx) int {
+	for i, lane := range m.lanes {
+		if lane.match(tx) {
+			return i
+		}
+	}
+	return -1
+}
+
+// gasRemainingFor returns the block gas remaining for a tx of the given lane, which
+// excludes the unused gas reserved for the other lanes.
+func (m *reservingGasMeter) gasRemainingFor(lane int) uint64 {
+	remaining := m.GasRemaining()
+	for i, l := range m.lanes {
+		if i == lane {
+			continue
+		}
+		if unused := l.unused(); unused < remaining {
+			remaining -= unused
+		} else {
+			return 0
+		}
+	}
+	return remaining
+}
+
+// consumeTxGas consumes the gas of a tx of the given lane. Like ConsumeGas, it panics
+// with an ErrorOutOfGas, after consuming the gas, if the gas exceeds the block gas
+// remaining for the lane.
+func (m *reservingGasMeter) consumeTxGas(lane int, amount uint64) {
+	remaining := m.gasRemainingFor(lane)
+
+	m.ConsumeGas(amount, "block gas meter")
+	if lane >= 0 {
+		m.lanes[lane].used += amount
+	}
+
+	if amount > remaining {
+		panic(sdk.ErrorOutOfGas{Descriptor: "reserved block gas"})
+	}
+}
@@ -0,0 +1,103 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	gasscheduletypes "github.com/cosmos/cosmos-sdk/x/gasschedule/types"
+)
+
+func TestPriorityLaneReservedGas(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	genesisApp := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, "", 0, encCfg, simapp.EmptyAppOptions{})
+	stateBytes, err := tmjson.MarshalIndent(simapp.GenesisStateWithSingleValidator(t, genesisApp), "", " ")
+	require.NoError(t, err)
+
+	// the multi-sends have a lane with half of the block gas reserved
+	newApp := func() *simapp.SimApp {
+		app := newParallelTestApp(t, encCfg, stateBytes, 100000, false, parallelTestAccounts)
+		ctx := app.NewContext(false, tmproto.Header{})
+		schedule := app.GasScheduleKeeper.GetGasSchedule(ctx)
+		schedule.PriorityLanes = []gasscheduletypes.PriorityLane{{
+			Name:        "multisend",
+			MsgTypeURLs: []string{sdk.MsgTypeURL(&banktypes.MsgMultiSend{})},
+			ReservedGas: 50000,
+		}}
+		require.NoError(t, app.GasScheduleKeeper.SetGasSchedule(ctx, schedule))
+		return app
+	}
+	sequential, parallel := newApp(), newApp()
+
+	ctx := sequential.NewContext(false, tmproto.Header{})
+	privs := make([]*secp256k1.PrivKey, 2)
+	addrs := make([]sdk.AccAddress, 2)
+	accNums := make([]uint64, 2)
+	for i := range privs {
+		privs[i] = secp256k1.GenPrivKeyFromSecret([]byte{byte(i)})
+		addrs[i] = sdk.AccAddress(privs[i].PubKey().Address())
+		accNums[i] = sequential.AccountKeeper.GetAccount(ctx, addrs[i]).GetAccountNumber()
+	}
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+	newTx := func(from int, seq uint64, msg sdk.Msg) []byte {
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msg))
+		txBuilder.SetGasLimit(200000)
+		_, txBytes, err := createTestTx(encCfg.TxConfig, txBuilder, []cryptotypes.PrivKey{privs[from]}, []uint64{accNums[from]}, []uint64{seq}, ctx.ChainID())
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	// the sends run out of the unreserved block gas, while the multi-sends can still
+	// use the reserved block gas
+	var reqs []abci.RequestDeliverTx
+	for seq := uint64(0); seq < 8; seq++ {
+		reqs = append(reqs, abci.RequestDeliverTx{Tx: newTx(0, seq, banktypes.NewMsgSend(addrs[0], addrs[1], coins))})
+	}
+	for seq := uint64(0); seq < 2; seq++ {
+		msg := banktypes.NewMsgMultiSend([]banktypes.Input{banktypes.NewInput(addrs[1], coins)}, []banktypes.Output{banktypes.NewOutput(addrs[0], coins)})
+		reqs = append(reqs, abci.RequestDeliverTx{Tx: newTx(1, seq, msg)})
+	}
+
+	header := tmproto.Header{Height: 1}
+	sequential.BeginBlock(abci.RequestBeginBlock{Header: header})
+	parallel.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	res := make([]abci.ResponseDeliverTx, 0, len(reqs))
+	for _, req := range reqs {
+		res = append(res, sequential.DeliverTx(req))
+	}
+
+	var sends, outOfGas int
+	for _, r := range res[:8] {
+		switch r.Code {
+		case 0:
+			sends++
+		case sdkerrors.ErrOutOfGas.ABCICode():
+			outOfGas++
+		default:
+			t.Fatalf("unexpected response %v", r)
+		}
+	}
+	require.Positive(t, sends)
+	require.Positive(t, outOfGas)
+	for _, r := range res[8:] {
+		require.Zero(t, r.Code, r.Log)
+	}
+
+	// the parallel execution reserves the same block gas
+	require.Equal(t, res, parallel.DeliverTxBatch(reqs))
+	require.Equal(t, sequential.Commit(), parallel.Commit())
+}
@@ -0,0 +1,109 @@
+package baseapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// counterMempool is a mempool of txTest transactions identified by their counter.
+type counterMempool struct {
+	txs        map[int64]sdk.Tx
+	priorities map[int64]int64
+}
+
+var _ mempool.Mempool = (*counterMempool)(nil)
+
+func newCounterMempool() *counterMempool {
+	return &counterMempool{txs: make(map[int64]sdk.Tx), priorities: make(map[int64]int64)}
+}
+
+func (mp *counterMempool) Insert(goCtx context.Context, tx sdk.Tx) error {
+	counter := tx.(txTest).Counter
+	mp.txs[counter] = tx
+	mp.priorities[counter] = sdk.UnwrapSDKContext(goCtx).Priority()
+	return nil
+}
+
+func (mp *counterMempool) Select(context.Context, [][]byte) mempool.Iterator { return nil }
+func (mp *counterMempool) CountTx() int                                      { return len(mp.txs) }
+
+func (mp *counterMempool) Remove(tx sdk.Tx) error {
+	counter := tx.(txTest).Counter
+	if _, ok := mp.txs[counter]; !ok {
+		return mempool.ErrTxNotFound
+	}
+	delete(mp.txs, counter)
+	return nil
+}
+
+func TestMempoolSync(t *testing.T) {
+	mp := newCounterMempool()
+
+	// the ante handler rejects the txs with an invalid counter
+	invalid := make(map[int64]bool)
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			if txTest := tx.(txTest); txTest.FailOnAnte || invalid[txTest.Counter] {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+			}
+			return ctx.WithPriority(testTxPriority), nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, []byte("deliver-key"))))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, SetMempool(mp))
+	require.Equal(t, mp, app.Mempool())
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	// txs passing CheckTx are inserted with their priority
+	var txs [][]byte
+	for i := int64(0); i < 3; i++ {
+		txBytes, err := cdc.Marshal(newTxCounter(i, i))
+		require.NoError(t, err)
+		require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: txBytes}).IsOK())
+		txs = append(txs, txBytes)
+	}
+	require.Equal(t, 3, mp.CountTx())
+	require.Equal(t, int64(testTxPriority), mp.priorities[0])
+
+	// txs failing CheckTx are not inserted
+	failTx := newTxCounter(3, 3)
+	failTx.setFailOnAnte(true)
+	failTxBytes, err := cdc.Marshal(failTx)
+	require.NoError(t, err)
+	require.False(t, app.CheckTx(abci.RequestCheckTx{Tx: failTxBytes}).IsOK())
+	require.Equal(t, 3, mp.CountTx())
+
+	// delivered txs are removed, including txs unknown to the mempool
+	unknownTxBytes, err := cdc.Marshal(newTxCounter(0, 0))
+	require.NoError(t, err)
+	delete(mp.txs, 0)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	require.True(t, app.DeliverTx(abci.RequestDeliverTx{Tx: unknownTxBytes}).IsOK())
+	require.True(t, app.DeliverTx(abci.RequestDeliverTx{Tx: txs[1]}).IsOK())
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+	require.Equal(t, 1, mp.CountTx())
+
+	// pending txs failing ReCheckTx are removed
+	require.True(t, app.CheckTx(abci.RequestCheckTx{Tx: txs[2], Type: abci.CheckTxType_Recheck}).IsOK())
+	require.Equal(t, 1, mp.CountTx())
+	invalid[2] = true
+	require.False(t, app.CheckTx(abci.RequestCheckTx{Tx: txs[2], Type: abci.CheckTxType_Recheck}).IsOK())
+	require.Equal(t, 0, mp.CountTx())
+}
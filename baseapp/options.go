@@ -12,6 +12,7 @@ import (
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 // File for storing in-package BaseApp optional functions,
@@ -80,6 +81,17 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetMempool returns an option that sets the application side mempool of the BaseApp.
+func SetMempool(mempool mempool.Mempool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetMempool(mempool) }
+}
+
+// SetParallelTxWorkers returns an option that sets the number of workers executing
+// the txs of DeliverTxBatch in parallel.
+func SetParallelTxWorkers(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetParallelTxWorkers(workers) }
+}
+
 // SetSnapshot sets the snapshot store.
 func SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOptions) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshot(snapshotStore, opts) }
@@ -163,6 +175,26 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	app.anteHandler = ah
 }
 
+// SetGasConfigLoader sets the loader of the store gas configs, which lets the state
+// define the gas costs of the store operations.
+func (app *BaseApp) SetGasConfigLoader(loader GasConfigLoader) {
+	if app.sealed {
+		panic("SetGasConfigLoader() on sealed BaseApp")
+	}
+
+	app.gasConfigLoader = loader
+}
+
+// SetPriorityLaneLoader sets the loader of the priority lanes, which lets the state
+// reserve block gas for the txs of the lanes.
+func (app *BaseApp) SetPriorityLaneLoader(loader PriorityLaneLoader) {
+	if app.sealed {
+		panic("SetPriorityLaneLoader() on sealed BaseApp")
+	}
+
+	app.priorityLaneLoader = loader
+}
+
 func (app *BaseApp) SetPostHandler(ph sdk.AnteHandler) {
 	if app.sealed {
 		panic("SetPostHandler() on sealed BaseApp")
@@ -171,6 +203,31 @@ func (app *BaseApp) SetPostHandler(ph sdk.AnteHandler) {
 	app.postHandler = ph
 }
 
+// SetMempool sets the application side mempool of the BaseApp.
+func (app *BaseApp) SetMempool(mempool mempool.Mempool) {
+	if app.sealed {
+		panic("SetMempool() on sealed BaseApp")
+	}
+
+	app.mempool = mempool
+}
+
+// Mempool returns the application side mempool of the BaseApp.
+func (app *BaseApp) Mempool() mempool.Mempool {
+	return app.mempool
+}
+
+// SetParallelTxWorkers sets the number of workers executing the txs of DeliverTxBatch
+// in parallel. The txs are executed sequentially with less than two workers, which is
+// the default.
+func (app *BaseApp) SetParallelTxWorkers(workers int) {
+	if app.sealed {
+		panic("SetParallelTxWorkers() on sealed BaseApp")
+	}
+
+	app.parallelTxWorkers = workers
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
@@ -258,3 +315,12 @@ func (app *BaseApp) SetQueryMultiStore(ms sdk.MultiStore) {
 	}
 	app.qms = ms
 }
+
+// SetArchiveStore sets an ArchiveStore serving the queries at heights which
+// are no longer kept by the CommitMultiStore, e.g. because of pruning.
+func (app *BaseApp) SetArchiveStore(as ArchiveStore) {
+	if app.sealed {
+		panic("SetArchiveStore() on sealed BaseApp")
+	}
+	app.archive = as
+}
@@ -0,0 +1,299 @@
+package baseapp
+
+import (
+	"sort"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/multiversion"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// txExecution is the result of the speculative execution of a tx.
+type txExecution struct {
+	gInfo      sdk.GasInfo
+	result     *sdk.Result
+	anteEvents []abci.Event
+	err        error
+
+	// blockGas is the gas the tx consumes from the block gas meter.
+	blockGas uint64
+	// paramsGas is the gas consumed by reading the consensus params, which DeliverTx
+	// charges to the gas meter of the block context.
+	paramsGas uint64
+	// sequential is set if the gas info of the tx depends on the preceding txs,
+	// see initialGasMeter.
+	sequential bool
+	views      []*multiversion.VersionIndexedStore
+}
+
+// validate returns true if the values read by the tx are still up to date.
+func (e *txExecution) validate() bool {
+	for _, view := range e.views {
+		if !view.Validate() {
+			return false
+		}
+	}
+	return true
+}
+
+// readsAny returns true if the tx read one of the given keys of the stores.
+func (e *txExecution) readsAny(keys []map[string]struct{}) bool {
+	for i, view := range e.views {
+		if view.ReadsAny(keys[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// addWrites adds the keys written by the tx to the given keys of the stores.
+func (e *txExecution) addWrites(keys []map[string]struct{}) {
+	for i, view := range e.views {
+		for key := range view.WriteSet() {
+			keys[i][key] = struct{}{}
+		}
+	}
+}
+
+// initialGasMeter is the gas meter a tx starts with during parallel execution. The
+// AnteHandler replaces it with the gas meter of the tx. Otherwise, the tx would be
+// metered by the gas meter of the block context when executed sequentially, and it
+// is marked as used to execute the tx sequentially.
+type initialGasMeter struct {
+	sdk.GasMeter
+	used bool
+}
+
+// GasConsumedToLimit implements sdk.GasMeter.
+func (m *initialGasMeter) GasConsumedToLimit() sdk.Gas {
+	m.used = true
+	return m.GasMeter.GasConsumedToLimit()
+}
+
+// DeliverTxBatch executes the txs of a block like a sequence of DeliverTx calls and
+// returns their responses in the same order.
+//
+// With parallel execution enabled by SetParallelTxWorkers, the txs are executed
+// optimistically in parallel. Every tx runs against a multi-version view of the block
+// state, below its cached stores, which records the values read and written by the tx.
+// The executed txs are validated in block order and a tx is executed again when a
+// preceding tx changed a value it read, see executeTxsParallel. The results are then
+// committed in block order and match the results of sequential execution. The remaining txs are executed
+// sequentially once the block gas limit is reached or a tx fails before the
+// AnteHandler sets its gas meter.
+//
+// Since a tx may be executed several times, parallel execution requires the Msg
+// handlers and the AnteHandler to keep their state in the stores. Store tracing is
+// not supported during parallel execution. The txs only run in parallel as long as
+// they do not depend on each other, hence the state every tx updates, e.g. the fees
+// collected in the block, must be kept per tx and aggregated at the end of the block.
+//
+// The node delivers the txs of a block with DeliverTxBatch when parallel-tx-workers
+// is set to two or more in app.toml. Its ABCI client then buffers the DeliverTx
+// requests of Tendermint and delivers them as a batch before EndBlock.
+func (app *BaseApp) DeliverTxBatch(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx {
+	res := make([]abci.ResponseDeliverTx, 0, len(reqs))
+
+	keys := app.parallelStoreKeys()
+	if app.parallelTxWorkers < 2 || app.anteHandler == nil || len(reqs) < 2 || len(keys) == 0 {
+		for _, req := range reqs {
+			res = append(res, app.DeliverTx(req))
+		}
+		return res
+	}
+
+	stores := make([]*multiversion.Store, len(keys))
+	for i, key := range keys {
+		stores[i] = multiversion.NewStore(app.deliverState.ms.GetKVStore(key))
+	}
+	execs := app.executeTxsParallel(reqs, keys, stores)
+
+	blockGasMeter := app.deliverState.ctx.BlockGasMeter()
+	reserving, _ := blockGasMeter.(*reservingGasMeter)
+	for i, req := range reqs {
+		exec := execs[i]
+		remaining, lane := blockGasMeter.GasRemaining(), -1
+		if reserving != nil {
+			lane = app.txLane(reserving, req.Tx)
+			remaining = reserving.gasRemainingFor(lane)
+		}
+		if exec.sequential || blockGasMeter.IsOutOfGas() || remaining == 0 || exec.blockGas > remaining {
+			for _, req := range reqs[i:] {
+				res = append(res, app.DeliverTx(req))
+			}
+			return res
+		}
+
+		// DeliverTx reads the consensus params with the gas meter of the block context
+		app.deliverState.ctx.GasMeter().ConsumeGas(exec.paramsGas, "consensus params")
+
+		for _, mvs := range stores {
+			mvs.Write(i)
+		}
+		if reserving != nil {
+			reserving.consumeTxGas(lane, exec.blockGas)
+		} else {
+			blockGasMeter.ConsumeGas(exec.blockGas, "block gas meter")
+		}
+
+		res = append(res, app.finalizeDeliverTx(req, exec.gInfo, exec.result, exec.anteEvents, exec.err))
+	}
+
+	return res
+}
+
+// txLane returns the index of the priority lane of the tx, or -1 if it has none or
+// cannot be decoded.
+func (app *BaseApp) txLane(reserving *reservingGasMeter, txBytes []byte) int {
+	tx, err := app.txDecoder(txBytes)
+	if err != nil {
+		return -1
+	}
+	return reserving.laneOf(tx)
+}
+
+// parallelStoreKeys returns the keys of the stores mounted on the BaseApp, sorted by
+// name, or nil if the CommitMultiStore does not expose them.
+func (app *BaseApp) parallelStoreKeys() []storetypes.StoreKey {
+	cms, ok := app.cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		return nil
+	}
+
+	keysByName := cms.StoreKeysByName()
+	keys := make([]storetypes.StoreKey, 0, len(keysByName))
+	for _, key := range keysByName {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name() < keys[j].Name() })
+
+	return keys
+}
+
+// executeTxsParallel executes the txs in parallel, then executes the invalid txs
+// again in rounds until every tx is valid. Every tx is validated after the first
+// execution, since the txs read the writes of the txs executed concurrently. After
+// that, a tx may only become invalid if it read a key written, before or after, by a
+// preceding tx executed again, so the next round only executes again the txs reading
+// such a key whose values changed. The first tx executed again in a round is valid
+// afterwards, as the txs before it are valid and were not executed again, so that
+// every round makes progress, and the txs which do not depend on each other are only
+// executed once.
+func (app *BaseApp) executeTxsParallel(
+	reqs []abci.RequestDeliverTx, keys []storetypes.StoreKey, stores []*multiversion.Store,
+) []*txExecution {
+	execs := make([]*txExecution, len(reqs))
+
+	all := make([]int, len(reqs))
+	for i := range all {
+		all[i] = i
+	}
+	app.executeTxs(reqs, all, keys, stores, execs)
+
+	var pending []int
+	for i, exec := range execs {
+		if !exec.validate() {
+			pending = append(pending, i)
+		}
+	}
+
+	for len(pending) > 0 {
+		prev := make(map[int]*txExecution, len(pending))
+		for _, i := range pending {
+			prev[i] = execs[i]
+		}
+		app.executeTxs(reqs, pending, keys, stores, execs)
+
+		// the keys written by the txs executed again before the tx being validated
+		written := make([]map[string]struct{}, len(stores))
+		for i := range written {
+			written[i] = make(map[string]struct{})
+		}
+
+		var next []int
+		for i := pending[0]; i < len(reqs); i++ {
+			if i > pending[0] && execs[i].readsAny(written) && !execs[i].validate() {
+				next = append(next, i)
+			}
+			if exec, ok := prev[i]; ok {
+				exec.addWrites(written)
+				execs[i].addWrites(written)
+			}
+		}
+		pending = next
+	}
+
+	return execs
+}
+
+// executeTxs executes the txs at the given indexes on parallel workers.
+func (app *BaseApp) executeTxs(
+	reqs []abci.RequestDeliverTx, indexes []int, keys []storetypes.StoreKey, stores []*multiversion.Store, execs []*txExecution,
+) {
+	queue := make(chan int, len(indexes))
+	for _, i := range indexes {
+		queue <- i
+	}
+	close(queue)
+
+	var wg sync.WaitGroup
+	for w := 0; w < app.parallelTxWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				execs[i] = app.executeTx(i, reqs[i], keys, stores)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// executeTx executes the tx at index against the multi-version stores, and sets the
+// writes of the tx to the stores.
+func (app *BaseApp) executeTx(
+	index int, req abci.RequestDeliverTx, keys []storetypes.StoreKey, stores []*multiversion.Store,
+) *txExecution {
+	exec := &txExecution{views: make([]*multiversion.VersionIndexedStore, len(stores))}
+
+	views := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(keys))
+	for i, key := range keys {
+		exec.views[i] = stores[i].VersionIndexed(index)
+		views[key] = exec.views[i]
+	}
+	ms := cachemulti.NewStore(dbm.NewMemDB(), views, nil, nil, nil)
+
+	ctx := app.deliverState.ctx.
+		WithMultiStore(ms).
+		WithTxBytes(req.Tx).
+		WithVoteInfos(app.voteInfos).
+		WithEventManager(sdk.NewEventManager())
+
+	paramsGasMeter := sdk.NewInfiniteGasMeter()
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx.WithGasMeter(paramsGasMeter)))
+	exec.paramsGas = paramsGasMeter.GasConsumed()
+
+	// the block gas meter of the tx only records the gas the tx consumes, which is
+	// charged to the block gas meter when the tx is committed
+	gasMeter := &initialGasMeter{GasMeter: sdk.NewInfiniteGasMeter()}
+	blockGasMeter := sdk.NewInfiniteGasMeter()
+	ctx = ctx.WithGasMeter(gasMeter).WithBlockGasMeter(blockGasMeter)
+
+	exec.gInfo, exec.result, exec.anteEvents, _, exec.err = app.runTxWithContext(ctx, runTxModeDeliver, req.Tx)
+	exec.blockGas = blockGasMeter.GasConsumed()
+	exec.sequential = gasMeter.used
+
+	ms.Write()
+	for i, view := range exec.views {
+		stores[i].SetWriteSet(index, view.WriteSet())
+	}
+
+	return exec
+}
@@ -0,0 +1,208 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+const parallelTestAccounts = 6
+
+// parallelTestBlock builds a block of bank sends between the given number of accounts
+// from the fuzzer input. Three bytes describe a tx: the sender, the recipient and the
+// amount, fee and sequence of the tx. Txs may fail for lack of funds or a wrong
+// sequence, and some are not decodable.
+func parallelTestBlock(t testing.TB, app *simapp.SimApp, encCfg params.EncodingConfig, accounts int, data []byte) [][]byte {
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	privs := make([]cryptotypes.PrivKey, accounts)
+	accNums := make([]uint64, accounts)
+	seqs := make([]uint64, accounts)
+	for i := range privs {
+		privs[i] = secp256k1.GenPrivKeyFromSecret([]byte{byte(i)})
+		addr := sdk.AccAddress(privs[i].PubKey().Address())
+		accNums[i] = app.AccountKeeper.GetAccount(ctx, addr).GetAccountNumber()
+	}
+
+	var txs [][]byte
+	for ; len(data) >= 3; data = data[3:] {
+		if data[0] == 0xff {
+			txs = append(txs, data[:3])
+			continue
+		}
+
+		from, to := int(data[0])%accounts, int(data[1])%accounts
+		amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(data[2]&0x3f)*10))
+		msg := banktypes.NewMsgSend(privs[from].PubKey().Address().Bytes(), privs[to].PubKey().Address().Bytes(), amount)
+
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msg))
+		txBuilder.SetGasLimit(200000)
+		if data[2]&0x40 != 0 {
+			txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+		}
+
+		seq := seqs[from]
+		if data[2]&0x80 != 0 {
+			// a wrong sequence fails the AnteHandler
+			seq++
+		} else {
+			seqs[from]++
+		}
+
+		_, txBytes, err := createTestTx(encCfg.TxConfig, txBuilder, []cryptotypes.PrivKey{privs[from]}, []uint64{accNums[from]}, []uint64{seq}, ctx.ChainID())
+		require.NoError(t, err)
+		txs = append(txs, txBytes)
+	}
+
+	return txs
+}
+
+// newParallelTestApp returns a SimApp initialized with the given genesis, with the
+// given number of test accounts funded. The txs pay a base fee if baseFee is set.
+func newParallelTestApp(
+	t testing.TB, encCfg params.EncodingConfig, stateBytes []byte, maxGas int64, baseFee bool, accounts int,
+) *simapp.SimApp {
+	app := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, "", 0, encCfg, simapp.EmptyAppOptions{}, baseapp.SetParallelTxWorkers(4))
+
+	consensusParams := *simapp.DefaultConsensusParams
+	consensusParams.Block = &abci.BlockParams{MaxBytes: consensusParams.Block.MaxBytes, MaxGas: maxGas}
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: &consensusParams,
+		AppStateBytes:   stateBytes,
+	})
+
+	ctx := app.NewContext(false, tmproto.Header{})
+	if baseFee {
+		// a base fee of 1stake for the gas limit of the test txs
+		app.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDecWithPrec(5, 6))
+	}
+	for i := 0; i < accounts; i++ {
+		addr := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte{byte(i)}).PubKey().Address())
+		coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins))
+	}
+
+	return app
+}
+
+// FuzzDeliverTxBatch checks that executing a block with DeliverTxBatch in parallel
+// gives the same responses and app hash as executing it with DeliverTx.
+func FuzzDeliverTxBatch(f *testing.F) {
+	// The first byte selects the block gas limit and the base fee, the next bytes
+	// describe the txs.
+	// disjoint sends
+	f.Add([]byte{0, 0, 1, 0x05, 2, 3, 0x05, 4, 5, 0x05, 1, 0, 0x05, 3, 2, 0x05, 5, 4, 0x05})
+	// disjoint sends paying fees and base fees
+	f.Add([]byte{3, 0, 1, 0x45, 2, 3, 0x45, 4, 5, 0x45, 1, 0, 0x45, 3, 2, 0x45, 5, 4, 0x45})
+	// sends of a single sender paying fees
+	f.Add([]byte{1, 0, 1, 0x45, 0, 2, 0x45, 0, 3, 0x45, 0, 4, 0x45, 0, 5, 0x45, 0, 1, 0x45})
+	// chained sends, spending more than the balance or with a wrong sequence
+	f.Add([]byte{0, 0, 1, 0x3f, 1, 2, 0x3f, 2, 3, 0x3f, 3, 4, 0xbf, 4, 5, 0x3f, 0, 5, 0x3f, 3, 4, 0x3f, 5, 0, 0x01})
+	// undecodable and invalid txs
+	f.Add([]byte{1, 0, 1, 0x01, 0xff, 0, 0, 1, 2, 0x02, 2, 3, 0x00, 3, 4, 0x03, 4, 5, 0x04})
+	// txs reaching the block gas limit
+	f.Add([]byte{2, 0, 1, 0x41, 1, 2, 0x41, 2, 3, 0x41, 3, 4, 0x41, 4, 5, 0x41, 5, 0, 0x41, 0, 2, 0x41, 1, 3, 0x41})
+
+	encCfg := simapp.MakeTestEncodingConfig()
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) == 0 || len(data) > 3*32 {
+			t.Skip()
+		}
+		maxGas := []int64{-1, 2000000, 400000}[int(data[0])%3]
+		baseFee := data[0]/3%2 == 1
+
+		genesisApp := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, "", 0, encCfg, simapp.EmptyAppOptions{})
+		stateBytes, err := tmjson.MarshalIndent(simapp.GenesisStateWithSingleValidator(t, genesisApp), "", " ")
+		require.NoError(t, err)
+
+		sequential := newParallelTestApp(t, encCfg, stateBytes, maxGas, baseFee, parallelTestAccounts)
+		parallel := newParallelTestApp(t, encCfg, stateBytes, maxGas, baseFee, parallelTestAccounts)
+		txs := parallelTestBlock(t, sequential, encCfg, parallelTestAccounts, data[1:])
+
+		header := tmproto.Header{Height: 1}
+		sequential.BeginBlock(abci.RequestBeginBlock{Header: header})
+		parallel.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		expected := make([]abci.ResponseDeliverTx, 0, len(txs))
+		reqs := make([]abci.RequestDeliverTx, 0, len(txs))
+		for _, tx := range txs {
+			expected = append(expected, sequential.DeliverTx(abci.RequestDeliverTx{Tx: tx}))
+			reqs = append(reqs, abci.RequestDeliverTx{Tx: tx})
+		}
+		require.Equal(t, expected, parallel.DeliverTxBatch(reqs))
+
+		require.Equal(t, sequential.EndBlock(abci.RequestEndBlock{Height: 1}), parallel.EndBlock(abci.RequestEndBlock{Height: 1}))
+		require.Equal(t, sequential.Commit(), parallel.Commit())
+	})
+}
+
+// BenchmarkDeliverTxBatch measures the execution of a block of sends between distinct
+// accounts, which pay fees and base fees, with DeliverTx and with DeliverTxBatch.
+func BenchmarkDeliverTxBatch(b *testing.B) {
+	const txs = 100
+
+	encCfg := simapp.MakeTestEncodingConfig()
+	genesisApp := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, "", 0, encCfg, simapp.EmptyAppOptions{})
+	stateBytes, err := tmjson.MarshalIndent(simapp.GenesisStateWithSingleValidator(b, genesisApp), "", " ")
+	require.NoError(b, err)
+
+	data := make([]byte, 0, 3*txs)
+	for i := 0; i < txs; i++ {
+		data = append(data, byte(i), byte(txs+i), 0x41)
+	}
+	reqs := make([]abci.RequestDeliverTx, 0, txs)
+	for _, tx := range parallelTestBlock(b, newParallelTestApp(b, encCfg, stateBytes, -1, true, 2*txs), encCfg, 2*txs, data) {
+		reqs = append(reqs, abci.RequestDeliverTx{Tx: tx})
+	}
+
+	for _, bc := range []struct {
+		name    string
+		deliver func(app *simapp.SimApp) []abci.ResponseDeliverTx
+	}{
+		{"DeliverTx", func(app *simapp.SimApp) []abci.ResponseDeliverTx {
+			res := make([]abci.ResponseDeliverTx, 0, len(reqs))
+			for _, req := range reqs {
+				res = append(res, app.DeliverTx(req))
+			}
+			return res
+		}},
+		{"DeliverTxBatch", func(app *simapp.SimApp) []abci.ResponseDeliverTx {
+			return app.DeliverTxBatch(reqs)
+		}},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				b.StopTimer()
+				app := newParallelTestApp(b, encCfg, stateBytes, -1, true, 2*txs)
+				app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+				b.StartTimer()
+
+				res := bc.deliver(app)
+
+				b.StopTimer()
+				for _, r := range res {
+					require.Zero(b, r.Code, r.Log)
+				}
+				b.StartTimer()
+			}
+		})
+	}
+}
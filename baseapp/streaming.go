@@ -35,3 +35,13 @@ type StreamingService interface {
 	// Closer interface
 	io.Closer
 }
+
+// ArchiveStore is a store keeping the state at past heights, which serves the
+// queries at heights pruned from the CommitMultiStore.
+type ArchiveStore interface {
+	// HasVersion returns true if the state at the given height is kept.
+	HasVersion(version int64) bool
+	// CacheMultiStoreWithVersion returns a read-only CacheMultiStore of the
+	// state at the given height.
+	CacheMultiStoreWithVersion(version int64) (store.CacheMultiStore, error)
+}
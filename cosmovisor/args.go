@@ -27,6 +27,12 @@ const (
 	EnvDataBackupPath       = "DAEMON_DATA_BACKUP_DIR"
 	EnvInterval             = "DAEMON_POLL_INTERVAL"
 	EnvPreupgradeMaxRetries = "DAEMON_PREUPGRADE_MAX_RETRIES"
+	EnvPreDownload          = "DAEMON_PREDOWNLOAD_BINARIES"
+	EnvPreDownloadInterval  = "DAEMON_PREDOWNLOAD_INTERVAL"
+	EnvGRPCAddress          = "DAEMON_GRPC_ADDRESS"
+	EnvRollbackMaxFailures  = "DAEMON_ROLLBACK_MAX_FAILURES"
+	EnvRollbackWindow       = "DAEMON_ROLLBACK_WINDOW"
+	EnvRestartBackoff       = "DAEMON_RESTART_BACKOFF"
 )
 
 const (
@@ -39,6 +45,21 @@ const (
 // must be the same as x/upgrade/types.UpgradeInfoFilename
 const defaultFilename = "upgrade-info.json"
 
+const (
+	// stagingStatusFilename is the file in the cosmovisor root that records pre-download progress.
+	stagingStatusFilename = "staging-status.json"
+)
+
+// DefaultPreDownloadInterval is how often scheduled plans are checked when pre-download is enabled.
+const DefaultPreDownloadInterval = time.Minute
+
+const (
+	// DefaultRollbackWindow is the window in which failed starts after an upgrade are counted.
+	DefaultRollbackWindow = 10 * time.Minute
+	// DefaultRestartBackoff is the delay before the first restart of a failed app.
+	DefaultRestartBackoff = time.Second
+)
+
 // Config is the information passed in to control the daemon
 type Config struct {
 	Home                  string
@@ -49,6 +70,12 @@ type Config struct {
 	UnsafeSkipBackup      bool
 	DataBackupPath        string
 	PreupgradeMaxRetries  int
+	PreDownloadBinaries   bool
+	PreDownloadInterval   time.Duration
+	GRPCAddress           string
+	RollbackMaxFailures   int
+	RollbackWindow        time.Duration
+	RestartBackoff        time.Duration
 
 	// currently running upgrade
 	currentUpgrade upgradetypes.Plan
@@ -85,6 +112,11 @@ func (cfg *Config) UpgradeInfoFilePath() string {
 	return filepath.Join(cfg.Home, "data", defaultFilename)
 }
 
+// StagingStatusFilePath is the file recording the staging status of pre-downloaded upgrades.
+func (cfg *Config) StagingStatusFilePath() string {
+	return filepath.Join(cfg.Root(), stagingStatusFilename)
+}
+
 // SymLinkToGenesis creates a symbolic link from "./current" to the genesis directory.
 func (cfg *Config) SymLinkToGenesis() (string, error) {
 	genesis := filepath.Join(cfg.Root(), genesisDir)
@@ -133,6 +165,7 @@ func GetConfigFromEnv() (*Config, error) {
 		Home:           os.Getenv(EnvHome),
 		Name:           os.Getenv(EnvName),
 		DataBackupPath: os.Getenv(EnvDataBackupPath),
+		GRPCAddress:    os.Getenv(EnvGRPCAddress),
 	}
 
 	if cfg.DataBackupPath == "" {
@@ -149,24 +182,21 @@ func GetConfigFromEnv() (*Config, error) {
 	if cfg.UnsafeSkipBackup, err = booleanOption(EnvSkipBackup, false); err != nil {
 		errs = append(errs, err)
 	}
+	if cfg.PreDownloadBinaries, err = booleanOption(EnvPreDownload, false); err != nil {
+		errs = append(errs, err)
+	}
 
-	interval := os.Getenv(EnvInterval)
-	if interval != "" {
-		var intervalUInt uint64
-		intervalUInt, err = strconv.ParseUint(interval, 10, 32)
-		if err == nil {
-			cfg.PollInterval = time.Millisecond * time.Duration(intervalUInt)
-		} else {
-			cfg.PollInterval, err = time.ParseDuration(interval)
-		}
-		switch {
-		case err != nil:
-			errs = append(errs, fmt.Errorf("invalid %s: could not parse \"%s\" into either a duration or uint (milliseconds)", EnvInterval, interval))
-		case cfg.PollInterval <= 0:
-			errs = append(errs, fmt.Errorf("invalid %s: must be greater than 0", EnvInterval))
-		}
-	} else {
-		cfg.PollInterval = 300 * time.Millisecond
+	if cfg.PollInterval, err = durationOption(EnvInterval, 300*time.Millisecond); err != nil {
+		errs = append(errs, err)
+	}
+	if cfg.PreDownloadInterval, err = durationOption(EnvPreDownloadInterval, DefaultPreDownloadInterval); err != nil {
+		errs = append(errs, err)
+	}
+	if cfg.RollbackWindow, err = durationOption(EnvRollbackWindow, DefaultRollbackWindow); err != nil {
+		errs = append(errs, err)
+	}
+	if cfg.RestartBackoff, err = durationOption(EnvRestartBackoff, DefaultRestartBackoff); err != nil {
+		errs = append(errs, err)
 	}
 
 	envPreupgradeMaxRetriesVal := os.Getenv(EnvPreupgradeMaxRetries)
@@ -174,6 +204,11 @@ func GetConfigFromEnv() (*Config, error) {
 		errs = append(errs, fmt.Errorf("%s could not be parsed to int: %w", EnvPreupgradeMaxRetries, err))
 	}
 
+	envRollbackMaxFailuresVal := os.Getenv(EnvRollbackMaxFailures)
+	if cfg.RollbackMaxFailures, err = strconv.Atoi(envRollbackMaxFailuresVal); err != nil && envRollbackMaxFailuresVal != "" {
+		errs = append(errs, fmt.Errorf("%s could not be parsed to int: %w", EnvRollbackMaxFailures, err))
+	}
+
 	errs = append(errs, cfg.validate()...)
 
 	if len(errs) > 0 {
@@ -219,6 +254,20 @@ func (cfg *Config) validate() []error {
 		}
 	}
 
+	if cfg.PreDownloadBinaries && !cfg.AllowDownloadBinaries {
+		errs = append(errs, fmt.Errorf("%s requires %s to be true", EnvPreDownload, EnvDownloadBin))
+	}
+	if cfg.PreDownloadBinaries && cfg.GRPCAddress == "" {
+		errs = append(errs, fmt.Errorf("%s requires %s to be set", EnvPreDownload, EnvGRPCAddress))
+	}
+
+	switch {
+	case cfg.RollbackMaxFailures < 0:
+		errs = append(errs, fmt.Errorf("%s must not be negative", EnvRollbackMaxFailures))
+	case cfg.RollbackMaxFailures > 0 && cfg.UnsafeSkipBackup:
+		errs = append(errs, fmt.Errorf("%s requires data backups, %s must not be true", EnvRollbackMaxFailures, EnvSkipBackup))
+	}
+
 	// check the DataBackupPath
 	if cfg.UnsafeSkipBackup == true {
 		return errs
@@ -320,6 +369,30 @@ func booleanOption(name string, defaultVal bool) (bool, error) {
 	return false, fmt.Errorf("env variable %q must have a boolean value (\"true\" or \"false\"), got %q", name, p)
 }
 
+// durationOption parses an env option given either as a duration or as an uint (milliseconds).
+// The duration must be positive.
+func durationOption(name string, defaultVal time.Duration) (time.Duration, error) {
+	val := os.Getenv(name)
+	if val == "" {
+		return defaultVal, nil
+	}
+
+	var d time.Duration
+	ms, err := strconv.ParseUint(val, 10, 32)
+	if err == nil {
+		d = time.Millisecond * time.Duration(ms)
+	} else {
+		d, err = time.ParseDuration(val)
+	}
+	switch {
+	case err != nil:
+		return 0, fmt.Errorf("invalid %s: could not parse \"%s\" into either a duration or uint (milliseconds)", name, val)
+	case d <= 0:
+		return 0, fmt.Errorf("invalid %s: must be greater than 0", name)
+	}
+	return d, nil
+}
+
 // DetailString returns a multi-line string with details about this config.
 func (cfg Config) DetailString() string {
 	configEntries := []struct{ name, value string }{
@@ -331,6 +404,12 @@ func (cfg Config) DetailString() string {
 		{EnvSkipBackup, fmt.Sprintf("%t", cfg.UnsafeSkipBackup)},
 		{EnvDataBackupPath, cfg.DataBackupPath},
 		{EnvPreupgradeMaxRetries, fmt.Sprintf("%d", cfg.PreupgradeMaxRetries)},
+		{EnvPreDownload, fmt.Sprintf("%t", cfg.PreDownloadBinaries)},
+		{EnvPreDownloadInterval, fmt.Sprintf("%s", cfg.PreDownloadInterval)},
+		{EnvGRPCAddress, cfg.GRPCAddress},
+		{EnvRollbackMaxFailures, fmt.Sprintf("%d", cfg.RollbackMaxFailures)},
+		{EnvRollbackWindow, fmt.Sprintf("%s", cfg.RollbackWindow)},
+		{EnvRestartBackoff, fmt.Sprintf("%s", cfg.RestartBackoff)},
 	}
 	derivedEntries := []struct{ name, value string }{
 		{"Root Dir", cfg.Root()},
@@ -338,6 +417,7 @@ func (cfg Config) DetailString() string {
 		{"Genesis Bin", cfg.GenesisBin()},
 		{"Monitored File", cfg.UpgradeInfoFilePath()},
 		{"Data Backup Dir", cfg.DataBackupPath},
+		{"Staging Status File", cfg.StagingStatusFilePath()},
 	}
 
 	var sb strings.Builder
@@ -365,6 +365,9 @@ func (s *argsTestSuite) TestGetConfigFromEnv() {
 			UnsafeSkipBackup:      skipBackup,
 			DataBackupPath:        dataBackupPath,
 			PreupgradeMaxRetries:  preupgradeMaxRetries,
+			PreDownloadInterval:   DefaultPreDownloadInterval,
+			RollbackWindow:        DefaultRollbackWindow,
+			RestartBackoff:        DefaultRestartBackoff,
 		}
 	}
 
@@ -552,6 +555,119 @@ func (s *argsTestSuite) TestGetConfigFromEnv() {
 	}
 }
 
+func (s *argsTestSuite) TestGetConfigFromEnvPreDownload() {
+	initialEnv := s.clearEnv()
+	defer s.setEnv(nil, initialEnv)
+
+	absPath, err := filepath.Abs(filepath.Join("testdata", "validate"))
+	s.Require().NoError(err)
+	s.setEnv(s.T(), &cosmovisorEnv{Home: absPath, Name: "testname", SkipBackup: "true"})
+
+	preDownloadEnv := []string{EnvPreDownload, EnvPreDownloadInterval, EnvGRPCAddress}
+	for _, name := range preDownloadEnv {
+		initial, ok := os.LookupEnv(name)
+		if ok {
+			defer os.Setenv(name, initial)
+		} else {
+			defer os.Unsetenv(name)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		downloadBin string
+		preDownload string
+		interval    string
+		grpcAddress string
+		expPre      bool
+		expInterval time.Duration
+		expErr      bool
+	}{
+		{"not set", "", "", "", "", false, DefaultPreDownloadInterval, false},
+		{"enabled", "true", "true", "30s", "localhost:9090", true, 30 * time.Second, false},
+		{"enabled with ms interval", "true", "true", "1500", "localhost:9090", true, 1500 * time.Millisecond, false},
+		{"enabled without grpc address", "true", "true", "", "", false, 0, true},
+		{"enabled without download", "false", "true", "", "", false, 0, true},
+		{"bad value", "true", "bad", "", "localhost:9090", false, 0, true},
+		{"bad interval", "true", "true", "-1m", "localhost:9090", false, 0, true},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			require.NoError(t, os.Setenv(EnvDownloadBin, tc.downloadBin))
+			require.NoError(t, os.Setenv(EnvPreDownload, tc.preDownload))
+			require.NoError(t, os.Setenv(EnvPreDownloadInterval, tc.interval))
+			require.NoError(t, os.Setenv(EnvGRPCAddress, tc.grpcAddress))
+
+			cfg, err := GetConfigFromEnv()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expPre, cfg.PreDownloadBinaries)
+			require.Equal(t, tc.expInterval, cfg.PreDownloadInterval)
+			require.Equal(t, tc.grpcAddress, cfg.GRPCAddress)
+		})
+	}
+}
+
+func (s *argsTestSuite) TestGetConfigFromEnvRollback() {
+	initialEnv := s.clearEnv()
+	defer s.setEnv(nil, initialEnv)
+
+	absPath, err := filepath.Abs(filepath.Join("testdata", "validate"))
+	s.Require().NoError(err)
+
+	for _, name := range []string{EnvRollbackMaxFailures, EnvRollbackWindow, EnvRestartBackoff} {
+		initial, ok := os.LookupEnv(name)
+		if ok {
+			defer os.Setenv(name, initial)
+		} else {
+			defer os.Unsetenv(name)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		skipBackup  string
+		maxFailures string
+		window      string
+		backoff     string
+		expFailures int
+		expWindow   time.Duration
+		expBackoff  time.Duration
+		expErr      bool
+	}{
+		{"not set", "", "", "", "", 0, DefaultRollbackWindow, DefaultRestartBackoff, false},
+		{"enabled", "", "3", "5m", "2s", 3, 5 * time.Minute, 2 * time.Second, false},
+		{"enabled without backup", "true", "3", "", "", 0, 0, 0, true},
+		{"negative failures", "", "-1", "", "", 0, 0, 0, true},
+		{"bad failures", "", "bad", "", "", 0, 0, 0, true},
+		{"bad window", "", "3", "0", "", 0, 0, 0, true},
+		{"bad backoff", "", "3", "", "bad", 0, 0, 0, true},
+	}
+
+	for _, tc := range tests {
+		s.T().Run(tc.name, func(t *testing.T) {
+			s.setEnv(t, &cosmovisorEnv{Home: absPath, Name: "testname", SkipBackup: tc.skipBackup})
+			require.NoError(t, os.Setenv(EnvRollbackMaxFailures, tc.maxFailures))
+			require.NoError(t, os.Setenv(EnvRollbackWindow, tc.window))
+			require.NoError(t, os.Setenv(EnvRestartBackoff, tc.backoff))
+
+			cfg, err := GetConfigFromEnv()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expFailures, cfg.RollbackMaxFailures)
+			require.Equal(t, tc.expWindow, cfg.RollbackWindow)
+			require.Equal(t, tc.expBackoff, cfg.RestartBackoff)
+		})
+	}
+}
+
 func (s *argsTestSuite) TestLogConfigOrError() {
 	cfg := &Config{
 		Home:                  "/no/place/like/it",
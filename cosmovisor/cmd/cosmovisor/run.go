@@ -1,6 +1,8 @@
 package main
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/cosmovisor"
 	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
@@ -40,9 +42,20 @@ func Run(logger *zerolog.Logger, args []string, options ...RunOption) error {
 	}
 
 	doUpgrade, err := launcher.Run(args, runCfg.StdOut, runCfg.StdErr)
-	// if RestartAfterUpgrade, we launch after a successful upgrade (only condition LaunchProcess returns nil)
-	for cfg.RestartAfterUpgrade && err == nil && doUpgrade {
-		logger.Info().Str("app", cfg.Name).Msg("upgrade detected, relaunching")
+	for {
+		if err != nil {
+			// restart with a backoff if the app failed shortly after an upgrade, or roll the upgrade back
+			var backoff time.Duration
+			if backoff, err = launcher.HandleFailure(err); err != nil {
+				return err
+			}
+			time.Sleep(backoff)
+		} else if cfg.RestartAfterUpgrade && doUpgrade {
+			// if RestartAfterUpgrade, we launch after a successful upgrade (only condition LaunchProcess returns nil)
+			logger.Info().Str("app", cfg.Name).Msg("upgrade detected, relaunching")
+		} else {
+			break
+		}
 		doUpgrade, err = launcher.Run(args, runCfg.StdOut, runCfg.StdErr)
 	}
 	if doUpgrade && err == nil {
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/cosmovisor"
+)
+
+func init() {
+	statusCmd.Flags().StringP(OutputFlag, "o", "text", "Output format (text|json)")
+	rootCmd.AddCommand(statusCmd)
+}
+
+var statusCmd = &cobra.Command{
+	Use:          "status",
+	Short:        "Prints the current upgrade and the staging status of pre-downloaded upgrade binaries.",
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := cosmovisor.GetConfigFromEnv()
+		if err != nil {
+			return err
+		}
+
+		output, err := cmd.Flags().GetString(OutputFlag)
+		if err != nil {
+			return err
+		}
+
+		return printStatus(cmd.OutOrStdout(), cfg, output == "json")
+	},
+}
+
+// statusOutput is the status of cosmovisor as printed by the status command.
+type statusOutput struct {
+	CurrentUpgrade string                     `json:"current_upgrade"`
+	CurrentBin     string                     `json:"current_bin"`
+	PreDownload    bool                       `json:"pre_download"`
+	Staging        []cosmovisor.StagingStatus `json:"staging"`
+}
+
+func printStatus(w io.Writer, cfg *cosmovisor.Config, asJSON bool) error {
+	staging, err := cosmovisor.ReadStagingStatus(cfg)
+	if err != nil {
+		return err
+	}
+
+	bin, err := cfg.CurrentBin()
+	if err != nil {
+		return err
+	}
+
+	out := statusOutput{
+		CurrentUpgrade: "genesis",
+		CurrentBin:     bin,
+		PreDownload:    cfg.PreDownloadBinaries,
+		Staging:        staging,
+	}
+	if u, err := cfg.UpgradeInfo(); err == nil {
+		out.CurrentUpgrade = u.Name
+	}
+
+	if asJSON {
+		bz, err := json.Marshal(out)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(bz))
+		return err
+	}
+
+	fmt.Fprintf(w, "current upgrade: %s\n", out.CurrentUpgrade)
+	fmt.Fprintf(w, "current binary: %s\n", out.CurrentBin)
+	fmt.Fprintf(w, "pre-download: %t\n", out.PreDownload)
+	if len(out.Staging) == 0 {
+		fmt.Fprintln(w, "staged upgrades: none")
+		return nil
+	}
+
+	fmt.Fprintln(w, "staged upgrades:")
+	for _, s := range out.Staging {
+		fmt.Fprintf(w, "  %s (height %d): %s, updated %s\n", s.Name, s.Height, s.State, s.UpdatedAt.Format(time.RFC3339))
+		if s.Error != "" {
+			fmt.Fprintf(w, "    error: %s\n", s.Error)
+		}
+	}
+	return nil
+}
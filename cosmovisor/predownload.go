@@ -0,0 +1,396 @@
+package cosmovisor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/cosmos/cosmos-sdk/x/upgrade/plan"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// StagingState describes how far the binary of a scheduled upgrade has been prepared.
+type StagingState string
+
+const (
+	// StagingPending is set while the binary of a scheduled upgrade is being downloaded.
+	StagingPending StagingState = "pending"
+	// StagingStaged is set once the binary is verified and placed in the upgrade dir.
+	StagingStaged StagingState = "staged"
+	// StagingFailed is set when the binary could not be downloaded or verified. It is retried on the next poll.
+	StagingFailed StagingState = "failed"
+)
+
+// StagingStatus is the staging status of a single scheduled upgrade.
+type StagingStatus struct {
+	Name      string       `json:"name"`
+	Height    int64        `json:"height"`
+	State     StagingState `json:"state"`
+	URL       string       `json:"url,omitempty"`
+	Error     string       `json:"error,omitempty"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+// ReadStagingStatus returns the recorded staging status of all known upgrades, ordered by height.
+// An empty list is returned if nothing has been staged yet.
+func ReadStagingStatus(cfg *Config) ([]StagingStatus, error) {
+	statuses, err := readStagingStatusFile(cfg.StagingStatusFilePath())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]StagingStatus, 0, len(statuses))
+	for _, s := range statuses {
+		res = append(res, s)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Height != res[j].Height {
+			return res[i].Height < res[j].Height
+		}
+		return res[i].Name < res[j].Name
+	})
+	return res, nil
+}
+
+func readStagingStatusFile(filename string) (map[string]StagingStatus, error) {
+	statuses := make(map[string]StagingStatus)
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return statuses, nil
+		}
+		return nil, fmt.Errorf("failed to read %q: %w", filename, err)
+	}
+	if err := json.Unmarshal(bz, &statuses); err != nil {
+		return nil, fmt.Errorf("failed to parse %q: %w", filename, err)
+	}
+	return statuses, nil
+}
+
+func writeStagingStatusFile(filename string, statuses map[string]StagingStatus) error {
+	bz, err := json.MarshalIndent(statuses, "", "  ")
+	if err != nil {
+		return err
+	}
+	// write to a temporary file first so that readers never see a partial file
+	tmp := filename + ".tmp"
+	if err := os.WriteFile(tmp, bz, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filename)
+}
+
+// planSource provides the upgrade plans which are scheduled but not applied yet.
+type planSource interface {
+	ScheduledPlans(ctx context.Context) ([]upgradetypes.Plan, error)
+}
+
+// upgradePlansMethod is the full name of the Query/UpgradePlans gRPC method of the
+// x/upgrade module.
+const upgradePlansMethod = "/cosmos.upgrade.v1beta1.Query/UpgradePlans"
+
+// grpcPlanSource queries all the scheduled plans of a running node with UpgradePlans,
+// or only the CurrentPlan of nodes without UpgradePlans.
+type grpcPlanSource struct {
+	address string
+}
+
+func (s grpcPlanSource) ScheduledPlans(ctx context.Context) ([]upgradetypes.Plan, error) {
+	conn, err := grpc.DialContext(ctx, s.address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial %s: %w", s.address, err)
+	}
+	defer conn.Close()
+
+	plansRes := new(queryUpgradePlansResponse)
+	err = conn.Invoke(ctx, upgradePlansMethod, new(queryUpgradePlansRequest), plansRes)
+	if err == nil {
+		return plansRes.Plans, nil
+	}
+	if status.Code(err) != codes.Unimplemented {
+		return nil, fmt.Errorf("failed to query upgrade plans: %w", err)
+	}
+
+	res, err := upgradetypes.NewQueryClient(conn).CurrentPlan(ctx, &upgradetypes.QueryCurrentPlanRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query current plan: %w", err)
+	}
+	if res.Plan == nil {
+		return nil, nil
+	}
+	return []upgradetypes.Plan{*res.Plan}, nil
+}
+
+// queryUpgradePlansRequest is the request of the Query/UpgradePlans gRPC method, which
+// the x/upgrade/types version required by cosmovisor does not have yet.
+type queryUpgradePlansRequest struct{}
+
+func (*queryUpgradePlansRequest) Reset()                   {}
+func (*queryUpgradePlansRequest) String() string           { return "QueryUpgradePlansRequest" }
+func (*queryUpgradePlansRequest) ProtoMessage()            {}
+func (*queryUpgradePlansRequest) Marshal() ([]byte, error) { return nil, nil }
+func (*queryUpgradePlansRequest) Unmarshal([]byte) error   { return nil }
+
+// queryUpgradePlansResponse is the response of the Query/UpgradePlans gRPC method,
+// whose field 1 holds the scheduled plans ordered by height.
+type queryUpgradePlansResponse struct {
+	Plans []upgradetypes.Plan
+}
+
+func (m *queryUpgradePlansResponse) Reset()         { *m = queryUpgradePlansResponse{} }
+func (m *queryUpgradePlansResponse) String() string { return fmt.Sprintf("%v", m.Plans) }
+func (*queryUpgradePlansResponse) ProtoMessage()    {}
+
+func (m *queryUpgradePlansResponse) Marshal() ([]byte, error) {
+	var bz []byte
+	for i := range m.Plans {
+		plan, err := m.Plans[i].Marshal()
+		if err != nil {
+			return nil, err
+		}
+		bz = protowire.AppendTag(bz, 1, protowire.BytesType)
+		bz = protowire.AppendBytes(bz, plan)
+	}
+	return bz, nil
+}
+
+func (m *queryUpgradePlansResponse) Unmarshal(bz []byte) error {
+	m.Plans = nil
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		bz = bz[n:]
+
+		if num != 1 || typ != protowire.BytesType {
+			if n = protowire.ConsumeFieldValue(num, typ, bz); n < 0 {
+				return protowire.ParseError(n)
+			}
+			bz = bz[n:]
+			continue
+		}
+
+		v, n := protowire.ConsumeBytes(bz)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		bz = bz[n:]
+
+		var p upgradetypes.Plan
+		if err := p.Unmarshal(v); err != nil {
+			return err
+		}
+		m.Plans = append(m.Plans, p)
+	}
+	return nil
+}
+
+// preDownloader watches for scheduled upgrade plans and stages their binaries under
+// upgrades/<name> before the upgrade height is reached, so that DoUpgrade only needs
+// to switch the current link.
+type preDownloader struct {
+	logger   *zerolog.Logger
+	cfg      *Config
+	source   planSource
+	interval time.Duration
+
+	mu sync.Mutex
+}
+
+func newPreDownloader(logger *zerolog.Logger, cfg *Config) *preDownloader {
+	return &preDownloader{
+		logger:   logger,
+		cfg:      cfg,
+		source:   grpcPlanSource{address: cfg.GRPCAddress},
+		interval: cfg.PreDownloadInterval,
+	}
+}
+
+// Start polls the plan sources in the background until the returned stop function is called.
+func (pd *preDownloader) Start() (stop func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(pd.interval)
+		defer ticker.Stop()
+
+		for {
+			pd.Poll(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// Poll collects the scheduled plans from the node and stages every plan which is not
+// staged yet. Status entries of plans that are neither scheduled nor staged anymore are dropped.
+func (pd *preDownloader) Poll(ctx context.Context) {
+	pd.mu.Lock()
+	defer pd.mu.Unlock()
+
+	ps, err := pd.source.ScheduledPlans(ctx)
+	if err != nil {
+		// the node might not be serving gRPC yet, try again on the next poll
+		pd.logger.Debug().Err(err).Msg("failed to load scheduled upgrade plans")
+		return
+	}
+
+	plans := make(map[string]upgradetypes.Plan)
+	for _, p := range ps {
+		if p.Name != "" {
+			plans[p.Name] = p
+		}
+	}
+
+	filename := pd.cfg.StagingStatusFilePath()
+	statuses, err := readStagingStatusFile(filename)
+	if err != nil {
+		pd.logger.Error().Err(err).Msg("failed to read staging status")
+		return
+	}
+
+	for name, s := range statuses {
+		if _, ok := plans[name]; !ok && s.State != StagingStaged {
+			delete(statuses, name)
+		}
+	}
+
+	names := make([]string, 0, len(plans))
+	for name := range plans {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if ctx.Err() != nil {
+			break
+		}
+		if s, ok := statuses[name]; ok && s.State == StagingStaged && EnsureBinary(pd.cfg.UpgradeBin(name)) == nil {
+			continue
+		}
+
+		p := plans[name]
+		statuses[name] = StagingStatus{Name: p.Name, Height: p.Height, State: StagingPending, UpdatedAt: time.Now().UTC()}
+		if err := writeStagingStatusFile(filename, statuses); err != nil {
+			pd.logger.Error().Err(err).Msg("failed to write staging status")
+		}
+
+		statuses[name] = pd.stage(p)
+	}
+
+	if err := writeStagingStatusFile(filename, statuses); err != nil {
+		pd.logger.Error().Err(err).Msg("failed to write staging status")
+	}
+}
+
+// stage downloads the binary of the given plan into its upgrade dir.
+func (pd *preDownloader) stage(p upgradetypes.Plan) StagingStatus {
+	status := StagingStatus{Name: p.Name, Height: p.Height, State: StagingStaged}
+
+	url, err := pd.stageBinary(p)
+	status.URL = url
+	status.UpdatedAt = time.Now().UTC()
+	if err != nil {
+		status.State = StagingFailed
+		status.Error = err.Error()
+		pd.logger.Error().Err(err).Str("upgrade", p.Name).Int64("height", p.Height).Msg("failed to stage upgrade binary")
+		return status
+	}
+
+	pd.logger.Info().Str("upgrade", p.Name).Int64("height", p.Height).Str("path", pd.cfg.UpgradeBin(p.Name)).Msg("upgrade binary staged")
+	return status
+}
+
+func (pd *preDownloader) stageBinary(p upgradetypes.Plan) (string, error) {
+	if err := EnsureBinary(pd.cfg.UpgradeBin(p.Name)); err == nil {
+		// the binary was put in place manually or by a previous run
+		return "", nil
+	}
+
+	upgradeDir := pd.cfg.UpgradeDir(p.Name)
+	if _, err := os.Stat(upgradeDir); !os.IsNotExist(err) {
+		return "", errors.New("upgrade dir already exists, won't overwrite")
+	}
+
+	url, err := GetBinaryURLWithChecksum(p)
+	if err != nil {
+		return "", err
+	}
+
+	pd.logger.Info().Str("upgrade", p.Name).Str("url", url).Msg("pre-downloading upgrade binary")
+
+	// download into a temporary dir next to the upgrade dir and move it in place only once
+	// the checksum is verified, so that DoUpgrade never sees a partial download.
+	if err := os.MkdirAll(pd.cfg.BaseUpgradeDir(), 0o755); err != nil {
+		return url, err
+	}
+	tmpDir, err := os.MkdirTemp(pd.cfg.BaseUpgradeDir(), ".staging-")
+	if err != nil {
+		return url, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	if err := plan.DownloadUpgrade(tmpDir, url, pd.cfg.Name); err != nil {
+		return url, fmt.Errorf("cannot download binary: %w", err)
+	}
+	if err := os.Rename(tmpDir, upgradeDir); err != nil {
+		return url, err
+	}
+	return url, EnsureBinary(pd.cfg.UpgradeBin(p.Name))
+}
+
+// GetBinaryURLWithChecksum returns the download URL of the binary for this os/arch from the plan
+// info. Unlike GetDownloadURL, the URL must carry a checksum so the binary can be verified.
+func GetBinaryURLWithChecksum(p upgradetypes.Plan) (string, error) {
+	doc := strings.TrimSpace(p.Info)
+
+	var info plan.Info
+	if !strings.HasPrefix(doc, "{") {
+		// the info is a reference to a file with the binaries map
+		ref, err := plan.ParseInfo(doc)
+		if err != nil {
+			return "", fmt.Errorf("cannot parse plan info: %w", err)
+		}
+		info = *ref
+	} else if err := json.Unmarshal([]byte(doc), &info); err != nil {
+		return "", fmt.Errorf("cannot parse plan info: %w", err)
+	}
+
+	// this also ensures every url carries a checksum
+	if err := info.Binaries.ValidateBasic(); err != nil {
+		return "", err
+	}
+
+	url, ok := info.Binaries[OSArch()]
+	if !ok {
+		url, ok = info.Binaries["any"]
+	}
+	if !ok {
+		return "", fmt.Errorf("cannot find binary for os/arch: neither %s, nor any", OSArch())
+	}
+	return url, nil
+}
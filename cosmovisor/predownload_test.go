@@ -0,0 +1,221 @@
+package cosmovisor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+const (
+	rawBinaryChecksum = "sha256:e6bc7851600a2a9917f7bf88eb7bdee1ec162c671101485690b4deb089077b0d"
+	badChecksum       = "sha256:73e2bd6cbb99261733caf137015d5cc58e3f96248d8b01da68be8564989dd906"
+)
+
+func binariesInfo(t *testing.T, checksum string) string {
+	src, err := filepath.Abs(filepath.Join("testdata", "repo", "raw_binary", "autod"))
+	require.NoError(t, err)
+	return fmt.Sprintf(`{"binaries":{"any":"%s?checksum=%s"}}`, src, checksum)
+}
+
+func newPreDownloadTestConfig(t *testing.T, grpcAddress string) *Config {
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "data"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(home, rootName), 0o755))
+	return &Config{
+		Home: home, Name: "autod", AllowDownloadBinaries: true, PreDownloadBinaries: true,
+		PreDownloadInterval: DefaultPreDownloadInterval, GRPCAddress: grpcAddress,
+	}
+}
+
+// upgradeQueryServer serves the scheduled plans with the x/upgrade Query service,
+// with UpgradePlans or, like older nodes, only CurrentPlan.
+type upgradeQueryServer struct {
+	upgradetypes.UnimplementedQueryServer
+
+	withUpgradePlans bool
+
+	mu    sync.Mutex
+	plans []upgradetypes.Plan
+}
+
+// startUpgradeQueryServer starts an upgradeQueryServer and returns its address.
+func startUpgradeQueryServer(t *testing.T, withUpgradePlans bool) (*upgradeQueryServer, string) {
+	srv := &upgradeQueryServer{withUpgradePlans: withUpgradePlans}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcSrv := grpc.NewServer(grpc.UnknownServiceHandler(srv.handleUnknown))
+	upgradetypes.RegisterQueryServer(grpcSrv, srv)
+	go grpcSrv.Serve(lis) //nolint:errcheck
+	t.Cleanup(grpcSrv.Stop)
+
+	return srv, lis.Addr().String()
+}
+
+func (s *upgradeQueryServer) setPlans(plans ...upgradetypes.Plan) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.plans = plans
+	sort.Slice(s.plans, func(i, j int) bool { return s.plans[i].Height < s.plans[j].Height })
+}
+
+func (s *upgradeQueryServer) CurrentPlan(context.Context, *upgradetypes.QueryCurrentPlanRequest) (*upgradetypes.QueryCurrentPlanResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.plans) == 0 {
+		return &upgradetypes.QueryCurrentPlanResponse{}, nil
+	}
+	return &upgradetypes.QueryCurrentPlanResponse{Plan: &s.plans[0]}, nil
+}
+
+// handleUnknown serves UpgradePlans, which the Query service of the x/upgrade/types
+// version required by cosmovisor does not have.
+func (s *upgradeQueryServer) handleUnknown(_ interface{}, stream grpc.ServerStream) error {
+	if method, _ := grpc.MethodFromServerStream(stream); !s.withUpgradePlans || method != upgradePlansMethod {
+		return status.Error(codes.Unimplemented, "unknown method")
+	}
+	if err := stream.RecvMsg(new(queryUpgradePlansRequest)); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return stream.SendMsg(&queryUpgradePlansResponse{Plans: s.plans})
+}
+
+func TestGRPCPlanSource(t *testing.T) {
+	plans := []upgradetypes.Plan{
+		{Name: "first", Height: 100, Info: "first info"},
+		{Name: "second", Height: 200, Info: "second info"},
+	}
+
+	for _, withUpgradePlans := range []bool{true, false} {
+		t.Run(fmt.Sprintf("with UpgradePlans %t", withUpgradePlans), func(t *testing.T) {
+			srv, addr := startUpgradeQueryServer(t, withUpgradePlans)
+			src := grpcPlanSource{address: addr}
+
+			res, err := src.ScheduledPlans(context.Background())
+			require.NoError(t, err)
+			require.Empty(t, res)
+
+			srv.setPlans(plans...)
+			res, err = src.ScheduledPlans(context.Background())
+			require.NoError(t, err)
+			if withUpgradePlans {
+				require.Equal(t, plans, res)
+			} else {
+				// only the current plan is known
+				require.Equal(t, plans[:1], res)
+			}
+		})
+	}
+
+	// the node is not running
+	_, err := grpcPlanSource{address: "127.0.0.1:1"}.ScheduledPlans(context.Background())
+	require.Error(t, err)
+}
+
+func TestPreDownloaderStagesScheduledPlans(t *testing.T) {
+	srv, addr := startUpgradeQueryServer(t, true)
+	cfg := newPreDownloadTestConfig(t, addr)
+	logger := zerolog.Nop()
+	pd := newPreDownloader(&logger, cfg)
+
+	// nothing scheduled yet
+	pd.Poll(context.Background())
+	statuses, err := ReadStagingStatus(cfg)
+	require.NoError(t, err)
+	require.Empty(t, statuses)
+
+	srv.setPlans(
+		upgradetypes.Plan{Name: "bad-checksum", Height: 200, Info: binariesInfo(t, badChecksum)},
+		upgradetypes.Plan{Name: "good", Height: 100, Info: binariesInfo(t, rawBinaryChecksum)},
+		upgradetypes.Plan{Name: "no-checksum", Height: 300, Info: `{"binaries":{"any":"https://example.com/autod"}}`},
+	)
+	pd.Poll(context.Background())
+
+	statuses, err = ReadStagingStatus(cfg)
+	require.NoError(t, err)
+	require.Len(t, statuses, 3)
+
+	require.Equal(t, "good", statuses[0].Name)
+	require.Equal(t, StagingStaged, statuses[0].State)
+	require.NoError(t, EnsureBinary(cfg.UpgradeBin("good")))
+
+	require.Equal(t, "bad-checksum", statuses[1].Name)
+	require.Equal(t, StagingFailed, statuses[1].State)
+	require.Contains(t, statuses[1].Error, "Checksums did not match")
+	_, err = os.Stat(cfg.UpgradeDir("bad-checksum"))
+	require.True(t, os.IsNotExist(err), "a failed download must not leave an upgrade dir behind")
+
+	require.Equal(t, "no-checksum", statuses[2].Name)
+	require.Equal(t, StagingFailed, statuses[2].State)
+
+	// the staged binary is picked up by DoUpgrade without downloading it again
+	require.NoError(t, DoUpgrade(&logger, cfg, upgradetypes.Plan{Name: "good", Height: 100}))
+	bin, err := cfg.CurrentBin()
+	require.NoError(t, err)
+	require.Equal(t, cfg.UpgradeBin("good"), bin)
+
+	// cancelled plans are forgotten unless they were staged
+	srv.setPlans()
+	pd.Poll(context.Background())
+	statuses, err = ReadStagingStatus(cfg)
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	require.Equal(t, "good", statuses[0].Name)
+}
+
+func TestGetBinaryURLWithChecksum(t *testing.T) {
+	tests := map[string]struct {
+		info   string
+		expURL string
+		expErr string
+	}{
+		"any binary": {
+			info:   `{"binaries":{"any":"https://example.com/autod?checksum=sha256:abcd"}}`,
+			expURL: "https://example.com/autod?checksum=sha256:abcd",
+		},
+		"os/arch binary": {
+			info:   fmt.Sprintf(`{"binaries":{"%s":"https://example.com/autod?checksum=sha256:abcd"}}`, OSArch()),
+			expURL: "https://example.com/autod?checksum=sha256:abcd",
+		},
+		"missing checksum": {
+			info:   `{"binaries":{"any":"https://example.com/autod"}}`,
+			expErr: "missing checksum",
+		},
+		"no matching binary": {
+			info:   `{"binaries":{"plan9/mips":"https://example.com/autod?checksum=sha256:abcd"}}`,
+			expErr: "cannot find binary",
+		},
+		"invalid json": {
+			info:   `{"binaries":`,
+			expErr: "cannot parse plan info",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			url, err := GetBinaryURLWithChecksum(upgradetypes.Plan{Name: "test", Info: tc.info})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expURL, url)
+		})
+	}
+}
@@ -23,6 +23,8 @@ type Launcher struct {
 	logger *zerolog.Logger
 	cfg    *Config
 	fw     *fileWatcher
+	pd     *preDownloader
+	rb     *rollbackState
 }
 
 func NewLauncher(logger *zerolog.Logger, cfg *Config) (Launcher, error) {
@@ -31,7 +33,12 @@ func NewLauncher(logger *zerolog.Logger, cfg *Config) (Launcher, error) {
 		return Launcher{}, err
 	}
 
-	return Launcher{logger: logger, cfg: cfg, fw: fw}, nil
+	var pd *preDownloader
+	if cfg.PreDownloadBinaries {
+		pd = newPreDownloader(logger, cfg)
+	}
+
+	return Launcher{logger: logger, cfg: cfg, fw: fw, pd: pd, rb: &rollbackState{}}, nil
 }
 
 // Run launches the app in a subprocess and returns when the subprocess (app)
@@ -54,6 +61,15 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 	if err := cmd.Start(); err != nil {
 		return false, fmt.Errorf("launching process %s %s failed: %w", bin, strings.Join(args, " "), err)
 	}
+	l.rb.mu.Lock()
+	l.rb.lastStart = time.Now()
+	l.rb.mu.Unlock()
+
+	if l.pd != nil {
+		// stage binaries of scheduled upgrades while the app is running
+		stop := l.pd.Start()
+		defer stop()
+	}
 
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGQUIT, syscall.SIGTERM)
@@ -69,8 +85,12 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 		return false, err
 	}
 
+	// the new upgrade supersedes the one which could have been rolled back
+	l.forgetUpgrade()
+
+	var backupDir string
 	if !IsSkipUpgradeHeight(args, l.fw.currentInfo) {
-		if err := l.doBackup(); err != nil {
+		if backupDir, err = l.doBackup(); err != nil {
 			return false, err
 		}
 
@@ -79,7 +99,16 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 		}
 	}
 
-	return true, DoUpgrade(l.logger, l.cfg, l.fw.currentInfo)
+	previousDir, err := l.cfg.currentDir()
+	if err != nil {
+		return false, fmt.Errorf("error reading current symlink: %w", err)
+	}
+	if err := DoUpgrade(l.logger, l.cfg, l.fw.currentInfo); err != nil {
+		return true, err
+	}
+	l.recordUpgrade(l.fw.currentInfo, previousDir, backupDir)
+
+	return true, nil
 }
 
 // WaitForUpgradeOrExit checks upgrade plan file created by the app.
@@ -120,23 +149,25 @@ func (l Launcher) WaitForUpgradeOrExit(cmd *exec.Cmd) (bool, error) {
 	return true, nil
 }
 
-func (l Launcher) doBackup() error {
+// doBackup copies the data directory to the backup dir and returns the backup location.
+// It returns an empty string if backups are disabled.
+func (l Launcher) doBackup() (string, error) {
 	// take backup if `UNSAFE_SKIP_BACKUP` is not set.
 	if !l.cfg.UnsafeSkipBackup {
 		// check if upgrade-info.json is not empty.
 		var uInfo upgradetypes.Plan
 		upgradeInfoFile, err := os.ReadFile(filepath.Join(l.cfg.Home, "data", "upgrade-info.json"))
 		if err != nil {
-			return fmt.Errorf("error while reading upgrade-info.json: %w", err)
+			return "", fmt.Errorf("error while reading upgrade-info.json: %w", err)
 		}
 
 		err = json.Unmarshal(upgradeInfoFile, &uInfo)
 		if err != nil {
-			return err
+			return "", err
 		}
 
 		if uInfo.Name == "" {
-			return fmt.Errorf("upgrade-info.json is empty")
+			return "", fmt.Errorf("upgrade-info.json is empty")
 		}
 
 		// a destination directory, Format YYYY-MM-DD
@@ -150,15 +181,16 @@ func (l Launcher) doBackup() error {
 		err = copy.Copy(filepath.Join(l.cfg.Home, "data"), dst)
 
 		if err != nil {
-			return fmt.Errorf("error while taking data backup: %w", err)
+			return "", fmt.Errorf("error while taking data backup: %w", err)
 		}
 
 		// backup is done, lets check endtime to calculate total time taken for backup process
 		et := time.Now()
 		l.logger.Info().Str("backup saved at", dst).Time("backup completion time", et).TimeDiff("time taken to complete backup", et, st).Msg("backup completed")
+		return dst, nil
 	}
 
-	return nil
+	return "", nil
 }
 
 // doPreUpgrade runs the pre-upgrade command defined by the application and handles respective error codes
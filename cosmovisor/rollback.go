@@ -0,0 +1,194 @@
+package cosmovisor
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/otiai10/copy"
+
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// maxRestartBackoff caps the exponential backoff between restarts of a failing app.
+const maxRestartBackoff = time.Minute
+
+// upgradeRecord holds what is needed to undo the most recent upgrade.
+type upgradeRecord struct {
+	plan        upgradetypes.Plan
+	previousDir string
+	backupDir   string
+}
+
+// rollbackState tracks the app starts since the most recent upgrade. It is shared by
+// all copies of a Launcher.
+type rollbackState struct {
+	mu        sync.Mutex
+	upgrade   *upgradeRecord
+	lastStart time.Time
+	failures  []time.Time
+}
+
+// RollbackReport describes a rollback performed after the upgraded app failed to start.
+type RollbackReport struct {
+	Upgrade        string
+	UpgradeHeight  int64
+	Failures       int
+	Window         time.Duration
+	LastError      error
+	RestoredBinary string
+	RestoredData   string
+	FailedData     string
+}
+
+// String returns a multi-line report for the operator.
+func (r RollbackReport) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("upgrade %q (height %d) was rolled back after %d failed starts within %s\n", r.Upgrade, r.UpgradeHeight, r.Failures, r.Window))
+	sb.WriteString(fmt.Sprintf("  last error: %v\n", r.LastError))
+	sb.WriteString(fmt.Sprintf("  current binary restored to: %s\n", r.RestoredBinary))
+	sb.WriteString(fmt.Sprintf("  data restored from backup: %s\n", r.RestoredData))
+	sb.WriteString(fmt.Sprintf("  data of the failed upgrade moved to: %s\n", r.FailedData))
+	sb.WriteString("the restored data still contains the upgrade-info.json of this upgrade, fix the upgrade binary before starting cosmovisor again")
+	return sb.String()
+}
+
+// RollbackError is returned by HandleFailure once the most recent upgrade was rolled back.
+type RollbackError struct {
+	Report RollbackReport
+}
+
+func (e *RollbackError) Error() string {
+	return e.Report.String()
+}
+
+// recordUpgrade remembers the previous binary and the data backup of an upgrade, so that the
+// upgrade can be rolled back if the new binary keeps failing.
+func (l Launcher) recordUpgrade(plan upgradetypes.Plan, previousDir, backupDir string) {
+	l.rb.mu.Lock()
+	defer l.rb.mu.Unlock()
+
+	l.rb.upgrade = &upgradeRecord{
+		plan:        plan,
+		previousDir: previousDir,
+		backupDir:   backupDir,
+	}
+	l.rb.failures = nil
+}
+
+func (l Launcher) forgetUpgrade() {
+	l.rb.mu.Lock()
+	defer l.rb.mu.Unlock()
+
+	l.rb.upgrade = nil
+	l.rb.failures = nil
+}
+
+// HandleFailure applies the rollback policy after the app exited with the given error.
+// If the policy is disabled, or the app did not fail shortly after an upgrade, the error is
+// returned unchanged. Otherwise the failure is counted and either the delay before the next
+// restart is returned, or, once DAEMON_ROLLBACK_MAX_FAILURES failures happened within
+// DAEMON_ROLLBACK_WINDOW, the upgrade is rolled back and a *RollbackError is returned.
+func (l Launcher) HandleFailure(runErr error) (time.Duration, error) {
+	if l.cfg.RollbackMaxFailures <= 0 {
+		return 0, runErr
+	}
+
+	l.rb.mu.Lock()
+	defer l.rb.mu.Unlock()
+
+	if l.rb.upgrade == nil {
+		return 0, runErr
+	}
+
+	now := time.Now()
+	if now.Sub(l.rb.lastStart) >= l.cfg.RollbackWindow {
+		// the upgraded app ran fine for the whole window, this failure is not caused by the upgrade
+		l.rb.upgrade = nil
+		l.rb.failures = nil
+		return 0, runErr
+	}
+
+	failures := []time.Time{now}
+	for _, t := range l.rb.failures {
+		if now.Sub(t) < l.cfg.RollbackWindow {
+			failures = append(failures, t)
+		}
+	}
+	l.rb.failures = failures
+
+	if len(failures) < l.cfg.RollbackMaxFailures {
+		backoff := l.cfg.RestartBackoff << (len(failures) - 1)
+		if backoff > maxRestartBackoff || backoff <= 0 {
+			backoff = maxRestartBackoff
+		}
+		l.logger.Error().Err(runErr).Str("upgrade", l.rb.upgrade.plan.Name).Int("failures", len(failures)).
+			Dur("backoff", backoff).Msg("upgraded app failed, restarting")
+		return backoff, nil
+	}
+
+	report, err := l.rollback(l.rb.upgrade)
+	if err != nil {
+		return 0, fmt.Errorf("upgrade %q failed %d times and could not be rolled back: %w (app error: %v)",
+			l.rb.upgrade.plan.Name, len(failures), err, runErr)
+	}
+	report.Failures = len(failures)
+	report.Window = l.cfg.RollbackWindow
+	report.LastError = runErr
+
+	l.rb.upgrade = nil
+	l.rb.failures = nil
+
+	l.logger.Error().Msg(report.String())
+	return 0, &RollbackError{Report: report}
+}
+
+// rollback restores the data backup taken before the upgrade and points the current link
+// back to the previous binary. The data written by the failed upgrade is kept next to the
+// data directory for inspection.
+func (l Launcher) rollback(u *upgradeRecord) (RollbackReport, error) {
+	report := RollbackReport{
+		Upgrade:       u.plan.Name,
+		UpgradeHeight: u.plan.Height,
+	}
+
+	if u.backupDir == "" {
+		return report, fmt.Errorf("no data backup was taken before the upgrade")
+	}
+	if _, err := os.Stat(u.backupDir); err != nil {
+		return report, fmt.Errorf("data backup is not available: %w", err)
+	}
+
+	dataDir := filepath.Join(l.cfg.Home, "data")
+	failedDir := filepath.Join(l.cfg.Home, fmt.Sprintf("data-failed-%s-%d", url.PathEscape(u.plan.Name), time.Now().Unix()))
+	if err := os.Rename(dataDir, failedDir); err != nil {
+		return report, fmt.Errorf("error while moving data of the failed upgrade: %w", err)
+	}
+	if err := copy.Copy(u.backupDir, dataDir); err != nil {
+		return report, fmt.Errorf("error while restoring data backup: %w", err)
+	}
+	report.RestoredData = u.backupDir
+	report.FailedData = failedDir
+
+	link := filepath.Join(l.cfg.Root(), currentLink)
+	if err := os.Remove(link); err != nil && !os.IsNotExist(err) {
+		return report, err
+	}
+	if err := os.Symlink(u.previousDir, link); err != nil {
+		return report, fmt.Errorf("creating current symlink: %w", err)
+	}
+	// force the upgrade info to be read again from the restored link
+	l.cfg.currentUpgrade = upgradetypes.Plan{}
+	report.RestoredBinary = filepath.Join(u.previousDir, "bin", l.cfg.Name)
+
+	return report, nil
+}
+
+// currentDir returns the directory the current link points to.
+func (cfg *Config) currentDir() (string, error) {
+	return os.Readlink(filepath.Join(cfg.Root(), currentLink))
+}
@@ -0,0 +1,115 @@
+package cosmovisor
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// newRollbackTestLauncher creates a home dir where the upgrade "v2" was just applied on top
+// of genesis, with a data backup taken before the upgrade.
+func newRollbackTestLauncher(t *testing.T, maxFailures int) (Launcher, string) {
+	home := t.TempDir()
+	cfg := &Config{
+		Home:                home,
+		Name:                "dummyd",
+		DataBackupPath:      home,
+		RollbackMaxFailures: maxFailures,
+		RollbackWindow:      time.Minute,
+		RestartBackoff:      time.Second,
+	}
+
+	for _, bin := range []string{cfg.GenesisBin(), cfg.UpgradeBin("v2")} {
+		require.NoError(t, os.MkdirAll(filepath.Dir(bin), 0o755))
+		require.NoError(t, os.WriteFile(bin, []byte("#!/bin/sh\n"), 0o755))
+	}
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "data"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "data", "state"), []byte("upgraded"), 0o600))
+
+	backupDir := filepath.Join(home, "data-backup")
+	require.NoError(t, os.MkdirAll(backupDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(backupDir, "state"), []byte("original"), 0o600))
+
+	_, err := cfg.SymLinkToGenesis()
+	require.NoError(t, err)
+	previousDir, err := cfg.currentDir()
+	require.NoError(t, err)
+	plan := upgradetypes.Plan{Name: "v2", Height: 100}
+	require.NoError(t, cfg.SetCurrentUpgrade(plan))
+
+	logger := zerolog.Nop()
+	l := Launcher{logger: &logger, cfg: cfg, rb: &rollbackState{}}
+	l.recordUpgrade(plan, previousDir, backupDir)
+	l.rb.lastStart = time.Now()
+	return l, backupDir
+}
+
+func TestHandleFailureRollsBackUpgrade(t *testing.T) {
+	l, backupDir := newRollbackTestLauncher(t, 3)
+	runErr := errors.New("exit status 2")
+
+	// restarts back off exponentially until the maximum number of failures is reached
+	backoff, err := l.HandleFailure(runErr)
+	require.NoError(t, err)
+	require.Equal(t, time.Second, backoff)
+
+	backoff, err = l.HandleFailure(runErr)
+	require.NoError(t, err)
+	require.Equal(t, 2*time.Second, backoff)
+
+	_, err = l.HandleFailure(runErr)
+	var rollbackErr *RollbackError
+	require.ErrorAs(t, err, &rollbackErr)
+	require.Equal(t, "v2", rollbackErr.Report.Upgrade)
+	require.Equal(t, 3, rollbackErr.Report.Failures)
+	require.Equal(t, runErr, rollbackErr.Report.LastError)
+	require.Equal(t, backupDir, rollbackErr.Report.RestoredData)
+
+	// the current link points to the previous binary again
+	bin, err := l.cfg.CurrentBin()
+	require.NoError(t, err)
+	require.Equal(t, l.cfg.GenesisBin(), bin)
+
+	// the data backup was restored and the failed data kept aside
+	bz, err := os.ReadFile(filepath.Join(l.cfg.Home, "data", "state"))
+	require.NoError(t, err)
+	require.Equal(t, "original", string(bz))
+	bz, err = os.ReadFile(filepath.Join(rollbackErr.Report.FailedData, "state"))
+	require.NoError(t, err)
+	require.Equal(t, "upgraded", string(bz))
+
+	// once rolled back, further failures are returned as they are
+	_, err = l.HandleFailure(runErr)
+	require.Equal(t, runErr, err)
+}
+
+func TestHandleFailureWithoutRollback(t *testing.T) {
+	runErr := errors.New("exit status 2")
+
+	// the policy is disabled
+	l, _ := newRollbackTestLauncher(t, 0)
+	_, err := l.HandleFailure(runErr)
+	require.Equal(t, runErr, err)
+
+	// the upgraded app ran for longer than the window
+	l, _ = newRollbackTestLauncher(t, 3)
+	l.rb.lastStart = time.Now().Add(-2 * time.Minute)
+	_, err = l.HandleFailure(runErr)
+	require.Equal(t, runErr, err)
+	bin, err := l.cfg.CurrentBin()
+	require.NoError(t, err)
+	require.Equal(t, l.cfg.UpgradeBin("v2"), bin)
+
+	// no upgrade happened in this session
+	l, _ = newRollbackTestLauncher(t, 3)
+	l.forgetUpgrade()
+	_, err = l.HandleFailure(runErr)
+	require.Equal(t, runErr, err)
+}
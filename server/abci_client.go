@@ -0,0 +1,140 @@
+package server
+
+import (
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmsync "github.com/tendermint/tendermint/libs/sync"
+	"github.com/tendermint/tendermint/proxy"
+)
+
+// deliverTxBatcher is implemented by the applications executing the txs of a block as
+// a batch, e.g. by embedding a BaseApp.
+type deliverTxBatcher interface {
+	DeliverTxBatch(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx
+}
+
+// newClientCreator returns the creator of the local ABCI clients of the app. With two
+// parallel tx workers or more, the clients deliver the txs of a block as a batch
+// through BaseApp.DeliverTxBatch, which executes them in parallel.
+func newClientCreator(app abci.Application, parallelTxWorkers int) proxy.ClientCreator {
+	batcher, ok := app.(deliverTxBatcher)
+	if !ok || parallelTxWorkers < 2 {
+		return proxy.NewLocalClientCreator(app)
+	}
+
+	return &batchClientCreator{
+		mtx:     new(tmsync.Mutex),
+		app:     app,
+		batcher: batcher,
+	}
+}
+
+// batchClientCreator creates local ABCI clients delivering the txs of a block as a
+// batch. The clients share a mutex, like the clients of proxy.NewLocalClientCreator.
+type batchClientCreator struct {
+	mtx     *tmsync.Mutex
+	app     abci.Application
+	batcher deliverTxBatcher
+}
+
+// NewABCIClient implements proxy.ClientCreator.
+func (c *batchClientCreator) NewABCIClient() (abcicli.Client, error) {
+	return &batchClient{
+		Client:  abcicli.NewLocalClient(c.mtx, c.app),
+		mtx:     c.mtx,
+		batcher: c.batcher,
+	}, nil
+}
+
+// batchClient is a local ABCI client which buffers the DeliverTx requests of a block
+// and delivers them as a batch before EndBlock. Tendermint sends the txs of a block
+// with DeliverTxAsync, and collects their responses with the response callback until
+// EndBlock returns, hence the responses are passed to the callback in block order
+// before EndBlock, like the responses of a socket client.
+type batchClient struct {
+	abcicli.Client
+
+	mtx     *tmsync.Mutex
+	batcher deliverTxBatcher
+
+	callback abcicli.Callback
+	reqs     []abci.RequestDeliverTx
+	reqRes   []*abcicli.ReqRes
+}
+
+// SetResponseCallback implements abcicli.Client.
+func (c *batchClient) SetResponseCallback(cb abcicli.Callback) {
+	c.Client.SetResponseCallback(cb)
+
+	c.mtx.Lock()
+	c.callback = cb
+	c.mtx.Unlock()
+}
+
+// DeliverTxAsync implements abcicli.Client. It buffers the request, which is delivered
+// before the next EndBlock or Flush.
+func (c *batchClient) DeliverTxAsync(req abci.RequestDeliverTx) *abcicli.ReqRes {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	reqRes := abcicli.NewReqRes(abci.ToRequestDeliverTx(req))
+	c.reqs = append(c.reqs, req)
+	c.reqRes = append(c.reqRes, reqRes)
+
+	return reqRes
+}
+
+// DeliverTxSync implements abcicli.Client.
+func (c *batchClient) DeliverTxSync(req abci.RequestDeliverTx) (*abci.ResponseDeliverTx, error) {
+	reqRes := c.DeliverTxAsync(req)
+	c.deliverTxs()
+	return reqRes.Response.GetDeliverTx(), nil
+}
+
+// EndBlockAsync implements abcicli.Client.
+func (c *batchClient) EndBlockAsync(req abci.RequestEndBlock) *abcicli.ReqRes {
+	c.deliverTxs()
+	return c.Client.EndBlockAsync(req)
+}
+
+// EndBlockSync implements abcicli.Client.
+func (c *batchClient) EndBlockSync(req abci.RequestEndBlock) (*abci.ResponseEndBlock, error) {
+	c.deliverTxs()
+	return c.Client.EndBlockSync(req)
+}
+
+// FlushAsync implements abcicli.Client.
+func (c *batchClient) FlushAsync() *abcicli.ReqRes {
+	c.deliverTxs()
+	return c.Client.FlushAsync()
+}
+
+// FlushSync implements abcicli.Client.
+func (c *batchClient) FlushSync() error {
+	c.deliverTxs()
+	return c.Client.FlushSync()
+}
+
+// deliverTxs delivers the buffered txs as a batch, and passes their responses in block
+// order to the response callback and to the callbacks of their requests.
+func (c *batchClient) deliverTxs() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if len(c.reqs) == 0 {
+		return
+	}
+
+	responses := c.batcher.DeliverTxBatch(c.reqs)
+	for i, res := range responses {
+		reqRes := c.reqRes[i]
+		reqRes.Response = abci.ToResponseDeliverTx(res)
+		if c.callback != nil {
+			c.callback(reqRes.Request, reqRes.Response)
+		}
+		reqRes.InvokeCallback()
+		reqRes.Done()
+	}
+
+	c.reqs, c.reqRes = nil, nil
+}
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// batchApp records the DeliverTx calls, the DeliverTxBatch calls and the number of
+// responses received by the response callback when EndBlock is called.
+type batchApp struct {
+	abci.BaseApplication
+
+	delivered []string
+	batches   [][]string
+	responses int
+	endBlock  int
+}
+
+func (app *batchApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	app.delivered = append(app.delivered, string(req.Tx))
+	return abci.ResponseDeliverTx{Data: req.Tx}
+}
+
+func (app *batchApp) DeliverTxBatch(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx {
+	var batch []string
+	res := make([]abci.ResponseDeliverTx, len(reqs))
+	for i, req := range reqs {
+		batch = append(batch, string(req.Tx))
+		res[i] = abci.ResponseDeliverTx{Data: req.Tx}
+	}
+	app.batches = append(app.batches, batch)
+	return res
+}
+
+func (app *batchApp) EndBlock(abci.RequestEndBlock) abci.ResponseEndBlock {
+	app.endBlock = app.responses
+	return abci.ResponseEndBlock{}
+}
+
+func newTestClient(t *testing.T, app *batchApp, parallelTxWorkers int) (abcicli.Client, *[]string) {
+	client, err := newClientCreator(app, parallelTxWorkers).NewABCIClient()
+	require.NoError(t, err)
+
+	var responses []string
+	client.SetResponseCallback(func(_ *abci.Request, res *abci.Response) {
+		if r, ok := res.Value.(*abci.Response_DeliverTx); ok {
+			responses = append(responses, string(r.DeliverTx.Data))
+			app.responses++
+		}
+	})
+
+	return client, &responses
+}
+
+func TestBatchClient(t *testing.T) {
+	app := &batchApp{}
+	client, responses := newTestClient(t, app, 2)
+
+	// the txs are delivered as a batch before EndBlock
+	var reqRes []*abcicli.ReqRes
+	for _, tx := range []string{"a", "b", "c"} {
+		reqRes = append(reqRes, client.DeliverTxAsync(abci.RequestDeliverTx{Tx: []byte(tx)}))
+	}
+	require.Empty(t, app.batches)
+	require.Empty(t, *responses)
+
+	_, err := client.EndBlockSync(abci.RequestEndBlock{})
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"a", "b", "c"}}, app.batches)
+	require.Equal(t, []string{"a", "b", "c"}, *responses)
+	require.Equal(t, 3, app.endBlock)
+	require.Empty(t, app.delivered)
+
+	for i, tx := range []string{"a", "b", "c"} {
+		reqRes[i].Wait()
+		require.Equal(t, tx, string(reqRes[i].Response.GetDeliverTx().Data))
+	}
+
+	// a flush delivers the buffered txs
+	client.DeliverTxAsync(abci.RequestDeliverTx{Tx: []byte("d")})
+	require.NoError(t, client.FlushSync())
+	require.Equal(t, [][]string{{"a", "b", "c"}, {"d"}}, app.batches)
+
+	res, err := client.DeliverTxSync(abci.RequestDeliverTx{Tx: []byte("e")})
+	require.NoError(t, err)
+	require.Equal(t, "e", string(res.Data))
+	require.Equal(t, []string{"a", "b", "c", "d", "e"}, *responses)
+}
+
+func TestBatchClientDisabled(t *testing.T) {
+	app := &batchApp{}
+	client, responses := newTestClient(t, app, 1)
+
+	// the txs are delivered one by one
+	client.DeliverTxAsync(abci.RequestDeliverTx{Tx: []byte("a")})
+	require.Equal(t, []string{"a"}, app.delivered)
+	require.Equal(t, []string{"a"}, *responses)
+
+	_, err := client.EndBlockSync(abci.RequestEndBlock{})
+	require.NoError(t, err)
+	require.Empty(t, app.batches)
+}
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/archive"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// NewArchiveCmd creates a command to manage the archive store.
+func NewArchiveCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive",
+		Short: "Manage the archive store serving historical queries",
+	}
+
+	cmd.AddCommand(NewArchiveBackfillCmd(appCreator, defaultNodeHome))
+	return cmd
+}
+
+// NewArchiveBackfillCmd creates a command to backfill the archive store with the
+// state kept by the application.
+func NewArchiveBackfillCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backfill <from-height> [to-height]",
+		Short: "Backfill the archive store with the application state at the given heights",
+		Long: `
+Backfill writes the application state at each height from from-height to to-height,
+the latest height by default, to the archive store configured in app.toml. The
+heights must not be pruned from the application state. If the archive is not empty,
+from-height must follow its latest height. The node must be stopped.
+
+Backfill the archive up to the latest height before enabling it, or after the node
+stopped because the archive fell behind.
+`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := GetServerContextFromCmd(cmd)
+			home := ctx.Config.RootDir
+
+			fromHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid from-height: %w", err)
+			}
+
+			db, err := openDB(home, GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				return err
+			}
+
+			// the archive is opened by the command, not by the application
+			ctx.Viper.Set(archive.OptArchiveEnable, false)
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+			defer app.Close()
+
+			cms, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("unsupported commit multistore %T", app.CommitMultiStore())
+			}
+
+			toHeight := cms.LatestVersion()
+			if len(args) > 1 {
+				if toHeight, err = strconv.ParseInt(args[1], 10, 64); err != nil {
+					return fmt.Errorf("invalid to-height: %w", err)
+				}
+			}
+
+			var keys []storetypes.StoreKey
+			for _, key := range cms.StoreKeysByName() {
+				if cms.GetCommitKVStore(key).GetStoreType() == storetypes.StoreTypeIAVL {
+					keys = append(keys, key)
+				}
+			}
+
+			dir := cast.ToString(ctx.Viper.Get(archive.OptArchiveDir))
+			if !filepath.IsAbs(dir) {
+				dir = filepath.Join(home, dir)
+			}
+
+			store, err := archive.OpenStore(dir, keys)
+			if err != nil {
+				return err
+			}
+			defer store.Close()
+
+			if err := archive.Backfill(cms, store, fromHeight, toHeight); err != nil {
+				return err
+			}
+
+			fmt.Printf("Backfilled the archive store from height %d to %d\n", fromHeight, toHeight)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
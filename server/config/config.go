@@ -9,6 +9,7 @@ import (
 
 	clientflags "github.com/cosmos/cosmos-sdk/client/flags"
 	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -36,6 +37,14 @@ const (
 
 	// FileStreamer defines the store streaming type for file streaming.
 	FileStreamer = "file"
+
+	// GRPCStreamer defines the store streaming type for streaming to a gRPC
+	// consumer.
+	GRPCStreamer = "grpc"
+
+	// AppendLogStreamer defines the store streaming type for streaming to a
+	// segmented append-only log.
+	AppendLogStreamer = "appendlog"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -94,6 +103,10 @@ type BaseConfig struct {
 	// IAVLLazyLoading enable/disable the lazy loading of iavl store.
 	IAVLLazyLoading bool `mapstructure:"iavl-lazy-loading"`
 
+	// ParallelTxWorkers defines the number of workers executing the txs of a block in
+	// parallel. The txs are executed sequentially with less than two workers.
+	ParallelTxWorkers int `mapstructure:"parallel-tx-workers"`
+
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the Tendermint config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
@@ -200,6 +213,10 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotCompression sets the compression of state sync snapshots, "zstd" or
+	// "lz4" for format 3 snapshots. Empty or "zlib" takes format 2 snapshots.
+	SnapshotCompression string `mapstructure:"snapshot-compression"`
 }
 
 type (
@@ -209,11 +226,24 @@ type (
 		Streamers []string `mapstructure:"streamers"`
 	}
 
+	// ArchiveConfig defines the configuration of the archive store serving the
+	// queries at heights pruned from the application state.
+	ArchiveConfig struct {
+		// Enable enables writing the state changes of every block to the archive
+		// and serving historical queries from it.
+		Enable bool `mapstructure:"enable"`
+		// Dir is the directory of the archive database, relative to the node
+		// home directory if not absolute.
+		Dir string `mapstructure:"dir"`
+	}
+
 	// StreamersConfig defines concrete state streaming configuration options. These
 	// fields are required to be set when state streaming is enabled via a non-empty
 	// list defined by 'StoreConfig.Streamers'.
 	StreamersConfig struct {
-		File FileStreamerConfig `mapstructure:"file"`
+		File      FileStreamerConfig      `mapstructure:"file"`
+		GRPC      GRPCStreamerConfig      `mapstructure:"grpc"`
+		AppendLog AppendLogStreamerConfig `mapstructure:"appendlog"`
 	}
 
 	// FileStreamerConfig defines the file streaming configuration options.
@@ -231,6 +261,38 @@ type (
 		// the commit, but don't lose data in face of system crash.
 		Fsync bool `mapstructure:"fsync"`
 	}
+
+	// GRPCStreamerConfig defines the gRPC streaming configuration options.
+	GRPCStreamerConfig struct {
+		Keys []string `mapstructure:"keys"`
+		// Address is the address of the consumer implementing the
+		// ABCIListenerService.
+		Address string `mapstructure:"address"`
+		// StopNodeOnError specifies if propagate the errors of the consumer to the
+		// consensus state machine, it's necessary to deliver every block.
+		StopNodeOnError bool `mapstructure:"stop-node-on-error"`
+		// Timeout is the timeout of each call to the consumer (in milliseconds).
+		Timeout uint `mapstructure:"timeout"`
+	}
+
+	// AppendLogStreamerConfig defines the append-only log streaming configuration
+	// options.
+	AppendLogStreamerConfig struct {
+		Keys []string `mapstructure:"keys"`
+		Dir  string   `mapstructure:"dir"`
+		// SegmentSize is the size in bytes after which a new log segment is
+		// started.
+		SegmentSize int64 `mapstructure:"segment-size"`
+		// RetainBlocks is the number of recent blocks to keep in the log, 0 to
+		// keep all blocks. Whole segments are removed.
+		RetainBlocks int64 `mapstructure:"retain-blocks"`
+		// Fsync specifies if syncing every block to disk, it slows down the
+		// commit, but don't lose data in face of system crash.
+		Fsync bool `mapstructure:"fsync"`
+		// StopNodeOnError specifies if propagate the log errors to the consensus
+		// state machine, it's necessary for every block to be in the log.
+		StopNodeOnError bool `mapstructure:"stop-node-on-error"`
+	}
 )
 
 // Config defines the server's top level configuration
@@ -246,6 +308,7 @@ type Config struct {
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
 	Store     StoreConfig      `mapstructure:"store"`
 	Streamers StreamersConfig  `mapstructure:"streamers"`
+	Archive   ArchiveConfig    `mapstructure:"archive"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -289,6 +352,7 @@ func DefaultConfig() *Config {
 			IAVLCacheSize:       781250, // 50 MB
 			IAVLDisableFastNode: false,
 			IAVLLazyLoading:     false,
+			ParallelTxWorkers:   0,
 			AppDBBackend:        "",
 		},
 		Telemetry: telemetry.Config{
@@ -325,8 +389,9 @@ func DefaultConfig() *Config {
 			Address: DefaultGRPCWebAddress,
 		},
 		StateSync: StateSyncConfig{
-			SnapshotInterval:   0,
-			SnapshotKeepRecent: 2,
+			SnapshotInterval:    0,
+			SnapshotKeepRecent:  2,
+			SnapshotCompression: "zstd",
 		},
 		Store: StoreConfig{
 			Streamers: []string{},
@@ -341,6 +406,24 @@ func DefaultConfig() *Config {
 				// in face of system crash.
 				Fsync: false,
 			},
+			GRPC: GRPCStreamerConfig{
+				Keys:            []string{"*"},
+				Address:         "",
+				StopNodeOnError: true,
+				Timeout:         5000,
+			},
+			AppendLog: AppendLogStreamerConfig{
+				Keys:            []string{"*"},
+				Dir:             "data/appendlog",
+				SegmentSize:     64 << 20,
+				RetainBlocks:    0,
+				Fsync:           false,
+				StopNodeOnError: true,
+			},
+		},
+		Archive: ArchiveConfig{
+			Enable: false,
+			Dir:    "data/archive.db",
 		},
 	}
 }
@@ -364,6 +447,9 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	if _, err := snapshottypes.ParseCompression(c.StateSync.SnapshotCompression); err != nil {
+		return sdkerrors.ErrAppConfig.Wrap(err.Error())
+	}
 
 	return nil
 }
@@ -82,6 +82,11 @@ iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 # Default is false.
 iavl-lazy-loading = {{ .BaseConfig.IAVLLazyLoading }}
 
+# EXPERIMENTAL: ParallelTxWorkers defines the number of workers executing the txs of a
+# block optimistically in parallel. The results match sequential execution.
+# Default is 0, i.e. the txs are executed sequentially with less than two workers.
+parallel-tx-workers = {{ .BaseConfig.ParallelTxWorkers }}
+
 # AppDBBackend defines the database backend type to use for the application and snapshots DBs.
 # An empty string indicates that a fallback will be used.
 # First fallback is the deprecated compile-time types.DBBackend value.
@@ -240,6 +245,11 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
+# snapshot-compression specifies the compression of the snapshots: "zstd" or "lz4" take format 3
+# snapshots, generated and restored in parallel, "zlib" or "" take format 2 snapshots, which nodes
+# running older versions can restore.
+snapshot-compression = "{{ .StateSync.SnapshotCompression }}"
+
 ###############################################################################
 ###                         Store / State Streaming                         ###
 ###############################################################################
@@ -262,6 +272,51 @@ stop-node-on-error = "{{ .Streamers.File.StopNodeOnError }}"
 
 # fsync specifies if call fsync after writing the files.
 fsync = "{{ .Streamers.File.Fsync }}"
+
+[streamers.grpc]
+keys = [{{ range .Streamers.GRPC.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# address of the consumer implementing the ABCIListenerService, e.g. "localhost:9191".
+address = "{{ .Streamers.GRPC.Address }}"
+
+# stop-node-on-error specifies if propagate the errors of the consumer to consensus state machine.
+stop-node-on-error = "{{ .Streamers.GRPC.StopNodeOnError }}"
+
+# timeout is the timeout of each call to the consumer (in milliseconds). Once a call times out,
+# the remaining messages of the block are skipped, unless stop-node-on-error is set.
+timeout = {{ .Streamers.GRPC.Timeout }}
+
+[streamers.appendlog]
+keys = [{{ range .Streamers.AppendLog.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# dir is the directory of the log, relative to the node home directory if not absolute.
+dir = "{{ .Streamers.AppendLog.Dir }}"
+
+# segment-size is the size in bytes after which a new log segment is started.
+segment-size = {{ .Streamers.AppendLog.SegmentSize }}
+
+# retain-blocks is the number of recent blocks to keep in the log (0 to keep all).
+retain-blocks = {{ .Streamers.AppendLog.RetainBlocks }}
+
+# fsync specifies if call fsync after appending each block.
+fsync = "{{ .Streamers.AppendLog.Fsync }}"
+
+# stop-node-on-error specifies if propagate the log errors to consensus state machine.
+stop-node-on-error = "{{ .Streamers.AppendLog.StopNodeOnError }}"
+
+###############################################################################
+###                            Archive Store                                ###
+###############################################################################
+
+[archive]
+
+# enable writes the state changes of every block to a separate archive database,
+# which serves the queries at heights pruned from the application state.
+# The archive must be backfilled up to the latest height before it is enabled.
+enable = {{ .Archive.Enable }}
+
+# dir is the directory of the archive database, relative to the node home directory if not absolute.
+dir = "{{ .Archive.Dir }}"
 `
 
 var configTemplate *template.Template
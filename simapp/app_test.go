@@ -17,6 +17,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
@@ -26,6 +27,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/gasschedule"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	group "github.com/cosmos/cosmos-sdk/x/group/module"
@@ -128,8 +131,13 @@ func TestRunMigrations(t *testing.T) {
 			false, "", true, "no migration found for module bank from version 2 to version 3: not found", 0,
 		},
 		{
-			"can register 2->3 migration handler for x/bank, can run migration",
+			"can register 2->3 migration handler for x/bank, cannot run migration",
 			"bank", 2,
+			false, "", true, "no migration found for module bank from version 3 to version 4: not found", 0,
+		},
+		{
+			"can register 3->4 migration handler for x/bank, can run migration",
+			"bank", 3,
 			false, "", false, "", 1,
 		},
 		{
@@ -171,23 +179,26 @@ func TestRunMigrations(t *testing.T) {
 			_, err = app.mm.RunMigrations(
 				app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()}), app.configurator,
 				module.VersionMap{
-					"bank":         1,
-					"auth":         auth.AppModule{}.ConsensusVersion(),
-					"authz":        authzmodule.AppModule{}.ConsensusVersion(),
-					"staking":      staking.AppModule{}.ConsensusVersion(),
-					"mint":         mint.AppModule{}.ConsensusVersion(),
-					"distribution": distribution.AppModule{}.ConsensusVersion(),
-					"slashing":     slashing.AppModule{}.ConsensusVersion(),
-					"gov":          gov.AppModule{}.ConsensusVersion(),
-					"group":        group.AppModule{}.ConsensusVersion(),
-					"params":       params.AppModule{}.ConsensusVersion(),
-					"upgrade":      upgrade.AppModule{}.ConsensusVersion(),
-					"vesting":      vesting.AppModule{}.ConsensusVersion(),
-					"feegrant":     feegrantmodule.AppModule{}.ConsensusVersion(),
-					"evidence":     evidence.AppModule{}.ConsensusVersion(),
-					"crisis":       crisis.AppModule{}.ConsensusVersion(),
-					"genutil":      genutil.AppModule{}.ConsensusVersion(),
-					"capability":   capability.AppModule{}.ConsensusVersion(),
+					"bank":          1,
+					"auth":          auth.AppModule{}.ConsensusVersion(),
+					"authz":         authzmodule.AppModule{}.ConsensusVersion(),
+					"staking":       staking.AppModule{}.ConsensusVersion(),
+					"mint":          mint.AppModule{}.ConsensusVersion(),
+					"distribution":  distribution.AppModule{}.ConsensusVersion(),
+					"slashing":      slashing.AppModule{}.ConsensusVersion(),
+					"gov":           gov.AppModule{}.ConsensusVersion(),
+					"group":         group.AppModule{}.ConsensusVersion(),
+					"params":        params.AppModule{}.ConsensusVersion(),
+					"upgrade":       upgrade.AppModule{}.ConsensusVersion(),
+					"vesting":       vesting.AppModule{}.ConsensusVersion(),
+					"feegrant":      feegrantmodule.AppModule{}.ConsensusVersion(),
+					"evidence":      evidence.AppModule{}.ConsensusVersion(),
+					"crisis":        crisis.AppModule{}.ConsensusVersion(),
+					"genutil":       genutil.AppModule{}.ConsensusVersion(),
+					"capability":    capability.AppModule{}.ConsensusVersion(),
+					"gasschedule":   gasschedule.AppModule{}.ConsensusVersion(),
+					"feemarket":     feemarket.AppModule{}.ConsensusVersion(),
+					"authenticator": authenticator.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingcli "github.com/cosmos/cosmos-sdk/x/auth/vesting/client/cli"
 	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
@@ -25,6 +26,9 @@ const (
 	flagVestingEnd   = "vesting-end-time"
 	flagVestingAmt   = "vesting-amount"
 	flagAppendMode   = "append"
+	flagFunder       = "vesting-funder"
+	flagLockupFile   = "lockup-periods-file"
+	flagVestingFile  = "vesting-periods-file"
 )
 
 // AddGenesisAccountCmd returns add-genesis-account cobra Command.
@@ -36,6 +40,8 @@ func AddGenesisAccountCmd(defaultNodeHome string) *cobra.Command {
 the account address or key name and a list of initial coins. If a key name is given,
 the address will be looked up in the local Keybase. The list of initial tokens must
 contain valid denominations. Accounts may optionally be supplied with vesting parameters.
+A clawback vesting account is created when a funder is given, with its lockup and vesting
+schedules read from JSON files in the format accepted by create-clawback-vesting-account.
 `,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -92,7 +98,16 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 			balances := banktypes.Balance{Address: addr.String(), Coins: coins.Sort()}
 			baseAccount := authtypes.NewBaseAccount(addr, nil, 0, 0)
 
-			if !vestingAmt.IsZero() {
+			funderStr, _ := cmd.Flags().GetString(flagFunder)
+			lockupFile, _ := cmd.Flags().GetString(flagLockupFile)
+			vestingFile, _ := cmd.Flags().GetString(flagVestingFile)
+
+			if funderStr != "" {
+				genAccount, err = clawbackGenesisAccount(baseAccount, balances.Coins, funderStr, lockupFile, vestingFile)
+				if err != nil {
+					return err
+				}
+			} else if !vestingAmt.IsZero() {
 				baseVestingAccount := authvesting.NewBaseVestingAccount(baseAccount, vestingAmt.Sort(), vestingEnd)
 
 				if (balances.Coins.IsZero() && !baseVestingAccount.OriginalVesting.IsZero()) ||
@@ -193,8 +208,54 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
 	cmd.Flags().Int64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
 	cmd.Flags().Int64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	cmd.Flags().String(flagFunder, "", "funder address of clawback vesting accounts")
+	cmd.Flags().String(flagLockupFile, "", "path to the lockup schedule of clawback vesting accounts")
+	cmd.Flags().String(flagVestingFile, "", "path to the vesting schedule of clawback vesting accounts")
 	cmd.Flags().Bool(flagAppendMode, false, "append the coins to an account already in the genesis.json file")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// clawbackGenesisAccount builds a clawback vesting account whose schedules are
+// read from the given files. At least one schedule must be provided.
+func clawbackGenesisAccount(baseAccount *authtypes.BaseAccount, balance sdk.Coins, funderStr, lockupFile, vestingFile string) (authtypes.GenesisAccount, error) {
+	funder, err := sdk.AccAddressFromBech32(funderStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse funder address: %w", err)
+	}
+
+	if lockupFile == "" && vestingFile == "" {
+		return nil, errors.New("clawback vesting accounts require a lockup or a vesting schedule")
+	}
+
+	var (
+		startTime                     int64
+		lockupPeriods, vestingPeriods []authvesting.Period
+	)
+	if lockupFile != "" {
+		if startTime, lockupPeriods, err = vestingcli.ReadScheduleFile(lockupFile); err != nil {
+			return nil, fmt.Errorf("failed to read lockup schedule: %w", err)
+		}
+	}
+	if vestingFile != "" {
+		var vestingStart int64
+		if vestingStart, vestingPeriods, err = vestingcli.ReadScheduleFile(vestingFile); err != nil {
+			return nil, fmt.Errorf("failed to read vesting schedule: %w", err)
+		}
+		if lockupFile != "" && vestingStart != startTime {
+			return nil, errors.New("lockup and vesting schedules must have the same start time")
+		}
+		startTime = vestingStart
+	}
+
+	vestingAmt := authvesting.Periods(vestingPeriods).TotalAmount()
+	if len(vestingPeriods) == 0 {
+		vestingAmt = authvesting.Periods(lockupPeriods).TotalAmount()
+	}
+	if vestingAmt.IsZero() || !vestingAmt.IsAllLTE(balance) {
+		return nil, errors.New("vesting amount cannot be greater than total amount")
+	}
+
+	return authvesting.NewClawbackVestingAccount(baseAccount, funder, vestingAmt.Sort(), startTime, lockupPeriods, vestingPeriods), nil
+}
@@ -145,7 +145,7 @@ func Setup(t *testing.T, isCheckTx bool) *SimApp {
 	return app
 }
 
-func genesisStateWithValSet(t *testing.T,
+func genesisStateWithValSet(t testing.TB,
 	app *SimApp, genesisState GenesisState,
 	valSet *tmtypes.ValidatorSet, genAccs []authtypes.GenesisAccount,
 	balances ...banktypes.Balance,
@@ -275,7 +275,7 @@ func SetupWithGenesisAccounts(t *testing.T, genAccs []authtypes.GenesisAccount,
 
 // GenesisStateWithSingleValidator initializes GenesisState with a single validator and genesis accounts
 // that also act as delegators.
-func GenesisStateWithSingleValidator(t *testing.T, app *SimApp) GenesisState {
+func GenesisStateWithSingleValidator(t testing.TB, app *SimApp) GenesisState {
 	t.Helper()
 
 	privVal := mock.NewPV()
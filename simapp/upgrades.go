@@ -4,20 +4,61 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
-	"github.com/cosmos/cosmos-sdk/x/group"
-	"github.com/cosmos/cosmos-sdk/x/nft"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	circuittypes "github.com/cosmos/cosmos-sdk/x/circuit/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	gasscheduletypes "github.com/cosmos/cosmos-sdk/x/gasschedule/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
-// UpgradeName defines the on-chain upgrade name for the sample simap upgrade from v045 to v046.
+// UpgradeName defines the on-chain upgrade name for the sample simap upgrade from v046 to v047.
 //
 // NOTE: This upgrade defines a reference implementation of what an upgrade could look like
-// when an application is migrating from Cosmos SDK version v0.45.x to v0.46.x.
-const UpgradeName = "v045-to-v046"
+// when an application is migrating from Cosmos SDK version v0.46.x to v0.47.x.
+const UpgradeName = "v046-to-v047"
 
 func (app SimApp) RegisterUpgradeHandlers() {
 	app.UpgradeKeeper.SetUpgradeHandler(UpgradeName,
 		func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			// Modules no longer register their key tables on the x/params
+			// subspaces, so register them here for the parameter migrations.
+			for _, subspace := range app.ParamsKeeper.GetSubspaces() {
+				var keyTable paramstypes.KeyTable
+				switch subspace.Name() {
+				case authtypes.ModuleName:
+					keyTable = authtypes.ParamKeyTable()
+				case banktypes.ModuleName:
+					keyTable = banktypes.ParamKeyTable()
+				case stakingtypes.ModuleName:
+					keyTable = stakingtypes.ParamKeyTable()
+				case minttypes.ModuleName:
+					keyTable = minttypes.ParamKeyTable()
+				case distrtypes.ModuleName:
+					keyTable = distrtypes.ParamKeyTable()
+				case slashingtypes.ModuleName:
+					keyTable = slashingtypes.ParamKeyTable()
+				case govtypes.ModuleName:
+					keyTable = govv1.ParamKeyTable()
+				case crisistypes.ModuleName:
+					keyTable = crisistypes.ParamKeyTable()
+				default:
+					continue
+				}
+
+				if !subspace.HasKeyTable() {
+					subspace.WithKeyTable(keyTable)
+				}
+			}
+
 			return app.mm.RunMigrations(ctx, app.configurator, fromVM)
 		})
 
@@ -29,8 +70,10 @@ func (app SimApp) RegisterUpgradeHandlers() {
 	if upgradeInfo.Name == UpgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
 		storeUpgrades := storetypes.StoreUpgrades{
 			Added: []string{
-				group.ModuleName,
-				nft.ModuleName,
+				crisistypes.StoreKey,
+				circuittypes.StoreKey,
+				gasscheduletypes.StoreKey,
+				feemarkettypes.StoreKey,
 			},
 		}
 
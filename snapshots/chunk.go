@@ -55,14 +55,18 @@ func (w *ChunkWriter) Close() error {
 	return nil
 }
 
-// CloseWithError closes the writer and sends an error to the reader.
+// CloseWithError closes the writer and sends an error to the reader. If no chunk was written yet,
+// the error is sent in a new chunk.
 func (w *ChunkWriter) CloseWithError(err error) {
 	if !w.closed {
 		w.closed = true
-		close(w.ch)
-		if w.pipe != nil {
-			_ = w.pipe.CloseWithError(err) // CloseWithError always returns nil
+		if w.pipe == nil {
+			pr, pw := io.Pipe()
+			w.ch <- pr
+			w.pipe = pw
 		}
+		close(w.ch)
+		_ = w.pipe.CloseWithError(err) // CloseWithError always returns nil
 	}
 }
 
@@ -166,7 +170,7 @@ func DrainChunks(chunks <-chan io.ReadCloser) {
 
 // ValidRestoreHeight will check height is valid for snapshot restore or not
 func ValidRestoreHeight(format uint32, height uint64) error {
-	if format != snapshottypes.CurrentFormat {
+	if format != snapshottypes.CurrentFormat && format != snapshottypes.SectionsFormat {
 		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
 	}
 
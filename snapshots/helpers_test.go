@@ -7,6 +7,8 @@ import (
 	"crypto/sha256"
 	"errors"
 	"io"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -158,6 +160,64 @@ func (m *mockSnapshotter) SetSnapshotInterval(snapshotInterval uint64) {
 	m.snapshotInterval = snapshotInterval
 }
 
+// mockStoreSnapshotter is a mockSnapshotter whose stores are snapshotted as extension payloads.
+type mockStoreSnapshotter struct {
+	mockSnapshotter
+
+	mtx       sync.Mutex
+	stores    map[string][][]byte
+	finalized bool
+}
+
+func (m *mockStoreSnapshotter) SnapshotStoreNames(height uint64) ([]string, error) {
+	names := make([]string, 0, len(m.stores))
+	for name := range m.stores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (m *mockStoreSnapshotter) SnapshotStore(height uint64, name string, protoWriter protoio.Writer) error {
+	for _, item := range m.stores[name] {
+		if err := snapshottypes.WriteExtensionItem(protoWriter, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockStoreSnapshotter) RestoreStore(height uint64, name string, protoReader protoio.Reader) error {
+	items := [][]byte{}
+	for {
+		item := &snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(item)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+		payload := item.GetExtensionPayload()
+		if payload == nil {
+			return errors.New("invalid snapshot item")
+		}
+		items = append(items, payload.Payload)
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.stores == nil {
+		m.stores = make(map[string][][]byte)
+	}
+	m.stores[name] = items
+	return nil
+}
+
+func (m *mockStoreSnapshotter) FinalizeRestore(height uint64) error {
+	m.finalized = true
+	return nil
+}
+
 // setupBusyManager creates a manager with an empty store that is busy creating a snapshot at height 1.
 // The snapshot will complete when the returned closer is called.
 func setupBusyManager(t *testing.T) *snapshots.Manager {
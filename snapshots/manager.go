@@ -8,9 +8,11 @@ import (
 	"io"
 	"math"
 	"os"
+	"runtime"
 	"sort"
 	"sync"
 
+	protoio "github.com/gogo/protobuf/io"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
@@ -70,6 +72,10 @@ const (
 
 var ErrOptsZeroSnapshotInterval = errors.New("snaphot-interval must not be 0")
 
+// snapshotConcurrency is the number of sections of a SectionsFormat snapshot generated or restored
+// at the same time.
+var snapshotConcurrency = runtime.NumCPU()
+
 // NewManager creates a new manager.
 func NewManager(store *Store, opts types.SnapshotOptions, multistore types.Snapshotter, extensions map[string]types.ExtensionSnapshotter, logger log.Logger) *Manager {
 	if extensions == nil {
@@ -178,6 +184,10 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	if m.opts.Compression != types.CompressionUnspecified {
+		return m.createSections(height)
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
 	go m.createSnapshot(height, ch)
@@ -202,10 +212,71 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		streamWriter.CloseWithError(err)
 		return
 	}
+	if err := m.snapshotExtensions(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+	}
+}
+
+// createSections takes a SectionsFormat snapshot, with a section per store of the multistore and
+// a last one for the extension snapshotters. At most snapshotConcurrency sections are generated at
+// the same time.
+func (m *Manager) createSections(height uint64) (*types.Snapshot, error) {
+	multistore, ok := m.multistore.(types.StoreSnapshotter)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownFormat, "multistore doesn't support format %v", types.SectionsFormat)
+	}
+
+	names, err := multistore.SnapshotStoreNames(height)
+	if err != nil {
+		return nil, err
+	}
+
+	sem := make(chan struct{}, snapshotConcurrency)
+	sections := make([]Section, 0, len(names)+1)
+	for _, name := range names {
+		name := name
+		ch := make(chan io.ReadCloser)
+		sections = append(sections, Section{Store: name, Chunks: ch})
+		go m.createSection(ch, sem, func(protoWriter protoio.Writer) error {
+			return multistore.SnapshotStore(height, name, protoWriter)
+		})
+	}
+
+	ch := make(chan io.ReadCloser)
+	sections = append(sections, Section{Chunks: ch})
+	go m.createSection(ch, sem, func(protoWriter protoio.Writer) error {
+		return m.snapshotExtensions(height, protoWriter)
+	})
+
+	return m.store.SaveSections(height, m.opts.Compression, sections)
+}
+
+// createSection generates the chunks of a section of a SectionsFormat snapshot, written to the
+// channel.
+func (m *Manager) createSection(ch chan<- io.ReadCloser, sem chan struct{}, snapshot func(protoio.Writer) error) {
+	sem <- struct{}{}
+	defer func() { <-sem }()
+
+	streamWriter, err := NewSectionStreamWriter(ch, m.opts.Compression)
+	if err != nil {
+		return
+	}
+
+	if err := snapshot(streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+	if err := streamWriter.Close(); err != nil {
+		streamWriter.CloseWithError(err)
+	}
+}
+
+// snapshotExtensions writes the metadata and the items of each extension snapshotter.
+func (m *Manager) snapshotExtensions(height uint64, protoWriter protoio.Writer) error {
 	for _, name := range m.sortedExtensionNames() {
 		extension := m.extensions[name]
 		// write extension metadata
-		err := streamWriter.WriteMsg(&types.SnapshotItem{
+		err := protoWriter.WriteMsg(&types.SnapshotItem{
 			Item: &types.SnapshotItem_Extension{
 				Extension: &types.SnapshotExtensionMeta{
 					Name:   name,
@@ -214,14 +285,13 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 			},
 		})
 		if err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
-		if err := extension.Snapshot(height, streamWriter); err != nil {
-			streamWriter.CloseWithError(err)
-			return
+		if err := extension.Snapshot(height, protoWriter); err != nil {
+			return err
 		}
 	}
+	return nil
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
@@ -269,7 +339,13 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	switch snapshot.Format {
+	case types.CurrentFormat:
+	case types.SectionsFormat:
+		if err := m.validateSections(snapshot); err != nil {
+			return err
+		}
+	default:
 		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
 	if snapshot.Height == 0 {
@@ -294,10 +370,13 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 		return sdkerrors.Wrapf(err, "failed to create snapshot directory %q", dir)
 	}
 
-	chChunks := m.loadChunkStream(snapshot.Height, snapshot.Format, chChunkIDs)
-
 	go func() {
-		err := m.doRestoreSnapshot(snapshot, chChunks)
+		var err error
+		if snapshot.Format == types.SectionsFormat {
+			err = m.doRestoreSections(snapshot, chChunkIDs)
+		} else {
+			err = m.doRestoreSnapshot(snapshot, m.loadChunkStream(snapshot.Height, snapshot.Format, chChunkIDs))
+		}
 		chDone <- restoreDone{
 			complete: err == nil,
 			err:      err,
@@ -347,6 +426,166 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 	if err != nil {
 		return sdkerrors.Wrap(err, "multistore restore")
 	}
+	return m.restoreExtensions(snapshot.Height, next, streamReader)
+}
+
+// validateSections checks the metadata of a SectionsFormat snapshot against its hash, and that it
+// can be restored.
+func (m *Manager) validateSections(snapshot types.Snapshot) error {
+	if _, ok := m.multistore.(types.StoreSnapshotter); !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "multistore doesn't support format %v", snapshot.Format)
+	}
+	metadata := snapshot.Metadata
+	if metadata.Compression != types.CompressionZstd && metadata.Compression != types.CompressionLZ4 {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot compression %v", metadata.Compression)
+	}
+
+	hash, err := metadataHash(metadata)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(hash, snapshot.Hash) {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot hash %X doesn't match metadata hash %X", snapshot.Hash, hash)
+	}
+
+	if len(metadata.Sections) == 0 || metadata.Sections[len(metadata.Sections)-1].Store != "" {
+		return sdkerrors.Wrap(types.ErrInvalidMetadata, "snapshot doesn't end with the extensions section")
+	}
+	chunks := uint32(0)
+	stores := make(map[string]bool, len(metadata.Sections))
+	for _, section := range metadata.Sections[:len(metadata.Sections)-1] {
+		if section.Store == "" || stores[section.Store] {
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata, "invalid snapshot section for store %q", section.Store)
+		}
+		stores[section.Store] = true
+		chunks += section.Chunks
+	}
+	if chunks+metadata.Sections[len(metadata.Sections)-1].Chunks != snapshot.Chunks {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot sections have %v chunks, but %v chunks",
+			chunks+metadata.Sections[len(metadata.Sections)-1].Chunks, snapshot.Chunks)
+	}
+
+	return nil
+}
+
+// doRestoreSections do the heavy work of a SectionsFormat snapshot restoration. The chunks are
+// dispatched to their sections as they arrive, the stores are restored concurrently, then the
+// extension snapshotters once the multistore is restored.
+func (m *Manager) doRestoreSections(snapshot types.Snapshot, chChunkIDs <-chan uint32) error {
+	dir := m.store.pathSnapshot(snapshot.Height, snapshot.Format)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return sdkerrors.Wrapf(err, "failed to create snapshot directory %q", dir)
+	}
+
+	multistore := m.multistore.(types.StoreSnapshotter)
+	sections := snapshot.Metadata.Sections
+	chSections := make([]chan uint32, len(sections))
+	for i, section := range sections {
+		// the dispatch never blocks, so a section waiting for its chunks can't hold back the others
+		chSections[i] = make(chan uint32, section.Chunks)
+	}
+
+	aborted := make(chan struct{})
+	go func() {
+		defer func() {
+			for _, ch := range chSections {
+				close(ch)
+			}
+		}()
+
+		i, end := 0, sections[0].Chunks
+		for {
+			select {
+			case chunkID, ok := <-chChunkIDs:
+				if !ok {
+					return
+				}
+				for chunkID >= end && i < len(sections)-1 {
+					i++
+					end += sections[i].Chunks
+				}
+				chSections[i] <- chunkID
+
+			case <-aborted:
+				// the chunks still given to the restore are dropped
+				go func() {
+					for range chChunkIDs {
+					}
+				}()
+				return
+			}
+		}
+	}()
+
+	var (
+		wg        sync.WaitGroup
+		abortOnce sync.Once
+		abortErr  error
+	)
+	abort := func(err error) {
+		abortOnce.Do(func() {
+			abortErr = err
+			close(aborted)
+		})
+	}
+	sem := make(chan struct{}, snapshotConcurrency)
+loop:
+	for i, section := range sections[:len(sections)-1] {
+		sem <- struct{}{}
+		select {
+		case <-aborted:
+			<-sem
+			break loop
+		default:
+		}
+
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			err := m.restoreSection(snapshot, chSections[i], func(protoReader protoio.Reader) error {
+				return multistore.RestoreStore(snapshot.Height, name, protoReader)
+			})
+			if err != nil {
+				abort(sdkerrors.Wrapf(err, "store %s restore", name))
+			}
+		}(i, section.Store)
+	}
+	wg.Wait()
+	if abortErr != nil {
+		return abortErr
+	}
+
+	if err := multistore.FinalizeRestore(snapshot.Height); err != nil {
+		return sdkerrors.Wrap(err, "multistore restore")
+	}
+
+	return m.restoreSection(snapshot, chSections[len(sections)-1], func(protoReader protoio.Reader) error {
+		var next types.SnapshotItem
+		if err := protoReader.ReadMsg(&next); err != nil && err != io.EOF {
+			return sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+		return m.restoreExtensions(snapshot.Height, next, protoReader)
+	})
+}
+
+// restoreSection restores a section of a SectionsFormat snapshot from its chunks.
+func (m *Manager) restoreSection(snapshot types.Snapshot, chunkIDs <-chan uint32, restore func(protoio.Reader) error) error {
+	chChunks := m.loadChunkStream(snapshot.Height, snapshot.Format, chunkIDs)
+	streamReader, err := NewSectionStreamReader(chChunks, snapshot.Metadata.Compression)
+	if err != nil {
+		DrainChunks(chChunks)
+		return err
+	}
+	defer streamReader.Close()
+
+	return restore(streamReader)
+}
+
+// restoreExtensions restores the extension snapshotters, starting from the given snapshot item.
+func (m *Manager) restoreExtensions(height uint64, next types.SnapshotItem, protoReader protoio.Reader) error {
+	var err error
 	for {
 		if next.Item == nil {
 			// end of stream
@@ -363,7 +602,7 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		if !IsFormatSupported(extension, metadata.Format) {
 			return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v for extension %s", metadata.Format, metadata.Name)
 		}
-		next, err = extension.Restore(snapshot.Height, metadata.Format, streamReader)
+		next, err = extension.Restore(height, metadata.Format, protoReader)
 		if err != nil {
 			return sdkerrors.Wrapf(err, "extension %s restore", metadata.Name)
 		}
@@ -437,6 +676,10 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 
 // RestoreLocalSnapshot restores app state from a local snapshot.
 func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
+	if format == types.SectionsFormat {
+		return m.restoreLocalSections(height)
+	}
+
 	snapshot, ch, err := m.store.Load(height, format)
 	if err != nil {
 		return err
@@ -458,6 +701,38 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 	return m.doRestoreSnapshot(*snapshot, ch)
 }
 
+// restoreLocalSections restores app state from a local SectionsFormat snapshot.
+func (m *Manager) restoreLocalSections(height uint64) error {
+	snapshot, err := m.store.Get(height, types.SectionsFormat)
+	if err != nil {
+		return err
+	}
+
+	if snapshot == nil {
+		return fmt.Errorf("snapshot doesn't exist, height: %d, format: %d", height, types.SectionsFormat)
+	}
+	if err := m.validateSections(*snapshot); err != nil {
+		return err
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	err = m.beginLocked(opRestore)
+	if err != nil {
+		return err
+	}
+	defer m.endLocked()
+
+	chunkIDs := make(chan uint32, snapshot.Chunks)
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunkIDs <- i
+	}
+	close(chunkIDs)
+
+	return m.doRestoreSections(*snapshot, chunkIDs)
+}
+
 // sortedExtensionNames sort extension names for deterministic iteration.
 func (m *Manager) sortedExtensionNames() []string {
 	names := make([]string, 0, len(m.extensions))
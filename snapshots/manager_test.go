@@ -235,3 +235,72 @@ func TestManager_Restore(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestManager_TakeAndRestoreSections(t *testing.T) {
+	for _, compression := range []types.Compression{types.CompressionZstd, types.CompressionLZ4} {
+		compression := compression
+		t.Run(compression.String(), func(t *testing.T) {
+			stores := map[string][][]byte{
+				"a": {{1, 2, 3}, {4, 5, 6}},
+				"b": {},
+				"c": {{7, 8, 9}},
+			}
+			source := &mockStoreSnapshotter{
+				mockSnapshotter: mockSnapshotter{prunedHeights: make(map[int64]struct{})},
+				stores:          stores,
+			}
+			sectionOpts := opts
+			sectionOpts.Compression = compression
+			manager := snapshots.NewManager(setupStore(t), sectionOpts, source, nil, log.NewNopLogger())
+
+			snapshot, err := manager.Create(5)
+			require.NoError(t, err)
+			require.Equal(t, types.SectionsFormat, snapshot.Format)
+			require.Equal(t, compression, snapshot.Metadata.Compression)
+			require.Len(t, snapshot.Metadata.Sections, 4)
+			require.Equal(t, "a", snapshot.Metadata.Sections[0].Store)
+			require.Equal(t, "", snapshot.Metadata.Sections[3].Store)
+			require.Len(t, snapshot.Metadata.ChunkHashes, int(snapshot.Chunks))
+
+			chunks := make([][]byte, snapshot.Chunks)
+			for i := range chunks {
+				chunks[i], err = manager.LoadChunk(snapshot.Height, snapshot.Format, uint32(i))
+				require.NoError(t, err)
+			}
+
+			target := &mockStoreSnapshotter{mockSnapshotter: mockSnapshotter{prunedHeights: make(map[int64]struct{})}}
+			manager = snapshots.NewManager(setupStore(t), sectionOpts, target, nil, log.NewNopLogger())
+
+			// the metadata must match the snapshot hash
+			tampered := *snapshot
+			tampered.Metadata.Sections = append([]types.SnapshotSection{}, snapshot.Metadata.Sections...)
+			tampered.Metadata.Sections[0].Store = "d"
+			err = manager.Restore(tampered)
+			require.ErrorIs(t, err, types.ErrInvalidMetadata)
+
+			// snapshots of a multistore restoring stores independently only
+			err = snapshots.NewManager(setupStore(t), sectionOpts, &mockSnapshotter{}, nil, log.NewNopLogger()).Restore(*snapshot)
+			require.ErrorIs(t, err, types.ErrUnknownFormat)
+
+			require.NoError(t, manager.Restore(*snapshot))
+
+			// bad chunks are rejected before being applied
+			_, err = manager.RestoreChunk([]byte{9, 9, 9})
+			require.ErrorIs(t, err, types.ErrChunkHashMismatch)
+
+			for i, chunk := range chunks {
+				done, err := manager.RestoreChunk(chunk)
+				require.NoError(t, err)
+				require.Equal(t, i == len(chunks)-1, done)
+			}
+			require.Equal(t, stores, target.stores)
+			require.True(t, target.finalized)
+
+			// the restored snapshot can be restored locally
+			target.stores, target.finalized = nil, false
+			require.NoError(t, manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+			require.Equal(t, stores, target.stores)
+			require.True(t, target.finalized)
+		})
+	}
+}
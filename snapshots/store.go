@@ -278,6 +278,145 @@ func (s *Store) Save(
 	return snapshot, s.saveSnapshot(snapshot)
 }
 
+// Section is a section of a SectionsFormat snapshot being saved, the chunks of the snapshot items
+// of a store or, without store name, of the extension snapshotters.
+type Section struct {
+	Store  string
+	Chunks <-chan io.ReadCloser
+}
+
+// SaveSections saves a SectionsFormat snapshot to disk, returning it. The chunks of the sections
+// are saved concurrently, then numbered in the order of the sections. The snapshot hash is the
+// hash of its metadata, which lists the sections and the hashes of their chunks.
+func (s *Store) SaveSections(
+	height uint64, compression types.Compression, sections []Section,
+) (*types.Snapshot, error) {
+	defer func() {
+		for _, section := range sections {
+			DrainChunks(section.Chunks)
+		}
+	}()
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "snapshot height cannot be 0")
+	}
+
+	s.mtx.Lock()
+	saving := s.saving[height]
+	s.saving[height] = true
+	s.mtx.Unlock()
+	if saving {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrConflict,
+			"a snapshot for height %v is already being saved", height)
+	}
+	defer func() {
+		s.mtx.Lock()
+		delete(s.saving, height)
+		s.mtx.Unlock()
+	}()
+
+	format := types.SectionsFormat
+	exists, err := s.db.Has(encodeKey(height, format))
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrConflict,
+			"snapshot already exists for height %v format %v", height, format)
+	}
+
+	// The chunks of each section are saved in their own directory, until the sections are
+	// complete and the chunks can be numbered.
+	dir := s.pathSnapshot(height, format)
+	sectionsDir := filepath.Join(dir, "sections")
+	defer os.RemoveAll(sectionsDir)
+
+	chunkHashes := make([][][]byte, len(sections))
+	errs := make([]error, len(sections))
+	var wg sync.WaitGroup
+	for i, section := range sections {
+		wg.Add(1)
+		go func(i int, section Section) {
+			defer wg.Done()
+			defer DrainChunks(section.Chunks)
+
+			sectionDir := filepath.Join(sectionsDir, strconv.Itoa(i))
+			if err := os.MkdirAll(sectionDir, 0o755); err != nil {
+				errs[i] = sdkerrors.Wrapf(err, "failed to create snapshot directory %q", sectionDir)
+				return
+			}
+
+			chunkHasher := sha256.New()
+			for chunkBody := range section.Chunks {
+				path := filepath.Join(sectionDir, strconv.Itoa(len(chunkHashes[i])))
+				if err := saveChunkFile(chunkBody, path, chunkHasher); err != nil {
+					errs[i] = sdkerrors.Wrapf(err, "section %d chunk %d", i, len(chunkHashes[i]))
+					return
+				}
+				chunkHashes[i] = append(chunkHashes[i], chunkHasher.Sum(nil))
+			}
+		}(i, section)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	snapshot := &types.Snapshot{
+		Height: height,
+		Format: format,
+		Metadata: types.Metadata{
+			ChunkHashes: [][]byte{},
+			Sections:    make([]types.SnapshotSection, len(sections)),
+			Compression: compression,
+		},
+	}
+	for i, section := range sections {
+		for j, hash := range chunkHashes[i] {
+			path := filepath.Join(sectionsDir, strconv.Itoa(i), strconv.Itoa(j))
+			if err := os.Rename(path, s.PathChunk(height, format, snapshot.Chunks)); err != nil {
+				return nil, sdkerrors.Wrapf(err, "failed to move snapshot chunk file %q", path)
+			}
+			snapshot.Metadata.ChunkHashes = append(snapshot.Metadata.ChunkHashes, hash)
+			snapshot.Chunks++
+		}
+		snapshot.Metadata.Sections[i] = types.SnapshotSection{
+			Store:  section.Store,
+			Chunks: uint32(len(chunkHashes[i])),
+		}
+	}
+
+	snapshot.Hash, err = metadataHash(snapshot.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, s.saveSnapshot(snapshot)
+}
+
+// saveChunkFile saves the given chunkBody to the given path, writing it to the reset hasher too.
+func saveChunkFile(chunkBody io.ReadCloser, path string, hasher hash.Hash) error {
+	defer chunkBody.Close()
+
+	chunkFile, err := os.Create(path)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "failed to create snapshot chunk file %q", path)
+	}
+	defer chunkFile.Close()
+
+	hasher.Reset()
+	if _, err := io.Copy(io.MultiWriter(chunkFile, hasher), chunkBody); err != nil {
+		return sdkerrors.Wrap(err, "failed to generate snapshot chunk")
+	}
+
+	if err := chunkFile.Close(); err != nil {
+		return sdkerrors.Wrap(err, "failed to close snapshot chunk file")
+	}
+
+	return sdkerrors.Wrap(chunkBody.Close(), "failed to close snapshot chunk body")
+}
+
 // saveChunk saves the given chunkBody with the given index to its appropriate path on disk.
 // The hash of the chunk is appended to the snapshot's metadata,
 // and the overall snapshot hash is updated with the chunk content too.
@@ -339,6 +478,19 @@ func (s *Store) PathChunk(height uint64, format, chunk uint32) string {
 	return filepath.Join(s.pathSnapshot(height, format), strconv.FormatUint(uint64(chunk), 10))
 }
 
+// metadataHash returns the hash of a SectionsFormat snapshot, the SHA-256 hash of its encoded
+// metadata. As the metadata lists the hashes of the chunks, the hash of the snapshot covers them
+// while the chunks can be verified one by one before being applied.
+func metadataHash(metadata types.Metadata) ([]byte, error) {
+	bz, err := proto.Marshal(&metadata)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to encode snapshot metadata")
+	}
+
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
+
 // decodeKey decodes a snapshot key.
 func decodeKey(k []byte) (uint64, uint32, error) {
 	if len(k) != 13 {
@@ -7,7 +7,10 @@ import (
 
 	protoio "github.com/gogo/protobuf/io"
 	"github.com/gogo/protobuf/proto"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
 
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -17,6 +20,8 @@ const (
 	snapshotBufferSize = int(snapshotChunkSize)
 	// Do not change compression level without new snapshot format (must be uniform across nodes)
 	snapshotCompressionLevel = 7
+	snapshotZstdLevel        = zstd.SpeedDefault
+	snapshotLZ4Level         = lz4.Fast
 )
 
 // StreamWriter set up a stream pipeline to serialize snapshot nodes:
@@ -24,7 +29,7 @@ const (
 type StreamWriter struct {
 	chunkWriter *ChunkWriter
 	bufWriter   *bufio.Writer
-	zWriter     *zlib.Writer
+	zWriter     io.WriteCloser
 	protoWriter protoio.WriteCloser
 }
 
@@ -46,6 +51,58 @@ func NewStreamWriter(ch chan<- io.ReadCloser) *StreamWriter {
 	}
 }
 
+// NewSectionStreamWriter set up a stream pipeline to serialize the snapshot items of a section
+// of a SectionsFormat snapshot:
+// Exported Items -> delimited Protobuf -> zstd or lz4 -> buffer -> chunkWriter -> chan io.ReadCloser
+func NewSectionStreamWriter(ch chan<- io.ReadCloser, compression types.Compression) (*StreamWriter, error) {
+	chunkWriter := NewChunkWriter(ch, snapshotChunkSize)
+	bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
+
+	var zWriter io.WriteCloser
+	switch compression {
+	case types.CompressionZstd:
+		// a single goroutine keeps the output identical across nodes
+		encoder, err := zstd.NewWriter(bufWriter,
+			zstd.WithEncoderLevel(snapshotZstdLevel),
+			zstd.WithEncoderConcurrency(1),
+			zstd.WithZeroFrames(true),
+		)
+		if err != nil {
+			err = sdkerrors.Wrap(err, "zstd failure")
+			chunkWriter.CloseWithError(err)
+			return nil, err
+		}
+		zWriter = encoder
+
+	case types.CompressionLZ4:
+		lz4Writer := lz4.NewWriter(bufWriter)
+		err := lz4Writer.Apply(lz4.CompressionLevelOption(snapshotLZ4Level), lz4.ConcurrencyOption(1))
+		if err == nil {
+			// writes the frame header, which is otherwise missing from empty sections
+			_, err = lz4Writer.Write(nil)
+		}
+		if err != nil {
+			err = sdkerrors.Wrap(err, "lz4 failure")
+			chunkWriter.CloseWithError(err)
+			return nil, err
+		}
+		zWriter = lz4Writer
+
+	default:
+		err := sdkerrors.Wrapf(types.ErrUnknownFormat, "compression %v", compression)
+		chunkWriter.CloseWithError(err)
+		return nil, err
+	}
+
+	// Unlike zlib, the compressors must be closed only once, by Close.
+	return &StreamWriter{
+		chunkWriter: chunkWriter,
+		bufWriter:   bufWriter,
+		zWriter:     zWriter,
+		protoWriter: protoio.NewDelimitedWriter(struct{ io.Writer }{zWriter}),
+	}, nil
+}
+
 // WriteMsg implements protoio.Write interface
 func (sw *StreamWriter) WriteMsg(msg proto.Message) error {
 	return sw.protoWriter.WriteMsg(msg)
@@ -96,6 +153,34 @@ func NewStreamReader(chunks <-chan io.ReadCloser) (*StreamReader, error) {
 	}, nil
 }
 
+// NewSectionStreamReader set up a restore stream pipeline for a section of a SectionsFormat snapshot
+// chan io.ReadCloser -> chunkReader -> zstd or lz4 -> delimited Protobuf -> ExportNode
+func NewSectionStreamReader(chunks <-chan io.ReadCloser, compression types.Compression) (*StreamReader, error) {
+	chunkReader := NewChunkReader(chunks)
+
+	var zReader io.ReadCloser
+	switch compression {
+	case types.CompressionZstd:
+		decoder, err := zstd.NewReader(chunkReader, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "zstd failure")
+		}
+		zReader = decoder.IOReadCloser()
+
+	case types.CompressionLZ4:
+		zReader = io.NopCloser(lz4.NewReader(chunkReader))
+
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrUnknownFormat, "compression %v", compression)
+	}
+
+	return &StreamReader{
+		chunkReader: chunkReader,
+		zReader:     zReader,
+		protoReader: protoio.NewDelimitedReader(zReader, snapshotMaxItemSize),
+	}, nil
+}
+
 // ReadMsg implements protoio.Reader interface
 func (sr *StreamReader) ReadMsg(msg proto.Message) error {
 	return sr.protoReader.ReadMsg(msg)
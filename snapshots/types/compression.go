@@ -0,0 +1,21 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseCompression parses the name of a snapshot compression, "zstd" or "lz4". The empty name and
+// "zlib" select the zlib compressed CurrentFormat, and are parsed as CompressionUnspecified.
+func ParseCompression(name string) (Compression, error) {
+	switch strings.ToLower(name) {
+	case "", "zlib":
+		return CompressionUnspecified, nil
+	case "zstd":
+		return CompressionZstd, nil
+	case "lz4":
+		return CompressionLZ4, nil
+	default:
+		return CompressionUnspecified, fmt.Errorf("unknown snapshot compression %q", name)
+	}
+}
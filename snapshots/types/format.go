@@ -4,3 +4,8 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 2
+
+// SectionsFormat is the format of snapshots made of a separately compressed section per store,
+// which are generated and restored in parallel. It is used instead of CurrentFormat when a
+// compression is set in the SnapshotOptions.
+const SectionsFormat uint32 = 3
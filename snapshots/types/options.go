@@ -8,6 +8,10 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// Compression defines the compression of the snapshot sections. If set, snapshots are taken
+	// in the SectionsFormat, otherwise in the zlib compressed CurrentFormat.
+	Compression Compression
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
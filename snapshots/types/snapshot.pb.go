@@ -23,6 +23,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Compression is the compression algorithm of the sections of a snapshot.
+type Compression int32
+
+const (
+	// COMPRESSION_UNSPECIFIED defines an unspecified compression, format 2 snapshots are zlib compressed.
+	CompressionUnspecified Compression = 0
+	// COMPRESSION_ZSTD defines the zstd compression.
+	CompressionZstd Compression = 1
+	// COMPRESSION_LZ4 defines the lz4 compression.
+	CompressionLZ4 Compression = 2
+)
+
+var Compression_name = map[int32]string{
+	0: "COMPRESSION_UNSPECIFIED",
+	1: "COMPRESSION_ZSTD",
+	2: "COMPRESSION_LZ4",
+}
+
+var Compression_value = map[string]int32{
+	"COMPRESSION_UNSPECIFIED": 0,
+	"COMPRESSION_ZSTD":        1,
+	"COMPRESSION_LZ4":         2,
+}
+
+func (x Compression) String() string {
+	return proto.EnumName(Compression_name, int32(x))
+}
+
+func (Compression) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{0}
+}
+
 // Snapshot contains Tendermint state sync snapshot info.
 type Snapshot struct {
 	Height   uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
@@ -103,6 +135,10 @@ func (m *Snapshot) GetMetadata() Metadata {
 // Metadata contains SDK-specific snapshot metadata.
 type Metadata struct {
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// sections lists the sections of a format 3 snapshot, in chunk order.
+	Sections []SnapshotSection `protobuf:"bytes,2,rep,name=sections,proto3" json:"sections"`
+	// compression is the compression algorithm of the sections of a format 3 snapshot.
+	Compression Compression `protobuf:"varint,3,opt,name=compression,proto3,enum=cosmos.base.snapshots.v1beta1.Compression" json:"compression,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -145,6 +181,77 @@ func (m *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (m *Metadata) GetSections() []SnapshotSection {
+	if m != nil {
+		return m.Sections
+	}
+	return nil
+}
+
+func (m *Metadata) GetCompression() Compression {
+	if m != nil {
+		return m.Compression
+	}
+	return CompressionUnspecified
+}
+
+// SnapshotSection is a section of a format 3 snapshot, a compressed stream of snapshot items
+// split into chunks, generated and restored independently of the other sections.
+type SnapshotSection struct {
+	// store is the name of the store whose items the section contains, empty for the section
+	// of the extension snapshotters.
+	Store string `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	// chunks is the number of chunks of the section.
+	Chunks uint32 `protobuf:"varint,2,opt,name=chunks,proto3" json:"chunks,omitempty"`
+}
+
+func (m *SnapshotSection) Reset()         { *m = SnapshotSection{} }
+func (m *SnapshotSection) String() string { return proto.CompactTextString(m) }
+func (*SnapshotSection) ProtoMessage()    {}
+func (*SnapshotSection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{2}
+}
+func (m *SnapshotSection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotSection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotSection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotSection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotSection.Merge(m, src)
+}
+func (m *SnapshotSection) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotSection) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotSection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotSection proto.InternalMessageInfo
+
+func (m *SnapshotSection) GetStore() string {
+	if m != nil {
+		return m.Store
+	}
+	return ""
+}
+
+func (m *SnapshotSection) GetChunks() uint32 {
+	if m != nil {
+		return m.Chunks
+	}
+	return 0
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 //
 // Since: cosmos-sdk 0.46
@@ -165,7 +272,7 @@ func (m *SnapshotItem) Reset()         { *m = SnapshotItem{} }
 func (m *SnapshotItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotItem) ProtoMessage()    {}
 func (*SnapshotItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{2}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{3}
 }
 func (m *SnapshotItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -298,7 +405,7 @@ func (m *SnapshotStoreItem) Reset()         { *m = SnapshotStoreItem{} }
 func (m *SnapshotStoreItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotStoreItem) ProtoMessage()    {}
 func (*SnapshotStoreItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{3}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{4}
 }
 func (m *SnapshotStoreItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +457,7 @@ func (m *SnapshotIAVLItem) Reset()         { *m = SnapshotIAVLItem{} }
 func (m *SnapshotIAVLItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotIAVLItem) ProtoMessage()    {}
 func (*SnapshotIAVLItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{4}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{5}
 }
 func (m *SnapshotIAVLItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -419,7 +526,7 @@ func (m *SnapshotExtensionMeta) Reset()         { *m = SnapshotExtensionMeta{} }
 func (m *SnapshotExtensionMeta) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionMeta) ProtoMessage()    {}
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{5}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{6}
 }
 func (m *SnapshotExtensionMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,7 +580,7 @@ func (m *SnapshotExtensionPayload) Reset()         { *m = SnapshotExtensionPaylo
 func (m *SnapshotExtensionPayload) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionPayload) ProtoMessage()    {}
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{6}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{7}
 }
 func (m *SnapshotExtensionPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,7 +628,7 @@ func (m *SnapshotKVItem) Reset()         { *m = SnapshotKVItem{} }
 func (m *SnapshotKVItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotKVItem) ProtoMessage()    {}
 func (*SnapshotKVItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{7}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{8}
 }
 func (m *SnapshotKVItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -575,7 +682,7 @@ func (m *SnapshotSchema) Reset()         { *m = SnapshotSchema{} }
 func (m *SnapshotSchema) String() string { return proto.CompactTextString(m) }
 func (*SnapshotSchema) ProtoMessage()    {}
 func (*SnapshotSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{8}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{9}
 }
 func (m *SnapshotSchema) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -612,8 +719,10 @@ func (m *SnapshotSchema) GetKeys() [][]byte {
 }
 
 func init() {
+	proto.RegisterEnum("cosmos.base.snapshots.v1beta1.Compression", Compression_name, Compression_value)
 	proto.RegisterType((*Snapshot)(nil), "cosmos.base.snapshots.v1beta1.Snapshot")
 	proto.RegisterType((*Metadata)(nil), "cosmos.base.snapshots.v1beta1.Metadata")
+	proto.RegisterType((*SnapshotSection)(nil), "cosmos.base.snapshots.v1beta1.SnapshotSection")
 	proto.RegisterType((*SnapshotItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotItem")
 	proto.RegisterType((*SnapshotStoreItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotStoreItem")
 	proto.RegisterType((*SnapshotIAVLItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem")
@@ -628,44 +737,54 @@ func init() {
 }
 
 var fileDescriptor_dd7a3c9b0a19e1ee = []byte{
-	// 581 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xb5, 0x13, 0xc7, 0xa4, 0x63, 0x83, 0xda, 0x55, 0x41, 0x16, 0x12, 0x6e, 0xb0, 0x90, 0x9a,
-	0x43, 0x6b, 0xd3, 0x50, 0x09, 0xae, 0x04, 0x41, 0x5d, 0x15, 0x04, 0xda, 0xa0, 0x1c, 0xb8, 0x54,
-	0x9b, 0x64, 0x1b, 0x47, 0x8e, 0xb3, 0x51, 0x76, 0x63, 0x91, 0x23, 0x7f, 0xc0, 0xaf, 0xf0, 0x17,
-	0x3d, 0xf6, 0xc8, 0x29, 0x42, 0xc9, 0x8f, 0xa0, 0x5d, 0xdb, 0x69, 0x29, 0x2d, 0xa4, 0xa7, 0xcc,
-	0x4c, 0xde, 0x7b, 0x9e, 0x9d, 0xb7, 0xb3, 0xb0, 0xd7, 0x65, 0x3c, 0x61, 0x3c, 0xe8, 0x10, 0x4e,
-	0x03, 0x3e, 0x22, 0x63, 0x1e, 0x31, 0xc1, 0x83, 0xf4, 0xa0, 0x43, 0x05, 0x39, 0x58, 0x55, 0xfc,
-	0xf1, 0x84, 0x09, 0x86, 0x9e, 0x64, 0x68, 0x5f, 0xa2, 0xfd, 0x15, 0xda, 0xcf, 0xd1, 0x8f, 0xb7,
-	0xfb, 0xac, 0xcf, 0x14, 0x32, 0x90, 0x51, 0x46, 0xf2, 0x7e, 0xe8, 0x50, 0x6d, 0xe5, 0x58, 0xf4,
-	0x08, 0xcc, 0x88, 0x0e, 0xfa, 0x91, 0x70, 0xf4, 0x9a, 0x5e, 0x37, 0x70, 0x9e, 0xc9, 0xfa, 0x19,
-	0x9b, 0x24, 0x44, 0x38, 0xa5, 0x9a, 0x5e, 0xbf, 0x8f, 0xf3, 0x4c, 0xd6, 0xbb, 0xd1, 0x74, 0x14,
-	0x73, 0xa7, 0x9c, 0xd5, 0xb3, 0x0c, 0x21, 0x30, 0x22, 0xc2, 0x23, 0xc7, 0xa8, 0xe9, 0x75, 0x1b,
-	0xab, 0x18, 0x1d, 0x43, 0x35, 0xa1, 0x82, 0xf4, 0x88, 0x20, 0x4e, 0xa5, 0xa6, 0xd7, 0xad, 0xc6,
-	0xae, 0xff, 0xcf, 0x86, 0xfd, 0x0f, 0x39, 0xbc, 0x69, 0x9c, 0xcf, 0x77, 0x34, 0xbc, 0xa2, 0x7b,
-	0xfb, 0x50, 0x2d, 0xfe, 0x43, 0x4f, 0xc1, 0x56, 0x1f, 0x3d, 0x95, 0x1f, 0xa1, 0xdc, 0xd1, 0x6b,
-	0xe5, 0xba, 0x8d, 0x2d, 0x55, 0x0b, 0x55, 0xc9, 0xfb, 0x66, 0x80, 0x5d, 0x1c, 0xf1, 0x58, 0xd0,
-	0x04, 0x85, 0x50, 0xe1, 0x82, 0x4d, 0xa8, 0x3a, 0xa5, 0xd5, 0x78, 0xfe, 0x9f, 0x3e, 0x0a, 0x6e,
-	0x4b, 0x72, 0xa4, 0x40, 0xa8, 0xe1, 0x4c, 0x00, 0x7d, 0x04, 0x63, 0x40, 0xd2, 0xa1, 0x1a, 0x8b,
-	0xd5, 0x08, 0xd6, 0x14, 0x3a, 0x7e, 0xdd, 0x7e, 0x2f, 0x75, 0x9a, 0xd5, 0xc5, 0x7c, 0xc7, 0x90,
-	0x59, 0xa8, 0x61, 0x25, 0x84, 0x3e, 0xc3, 0x06, 0xfd, 0x2a, 0xe8, 0x88, 0x0f, 0xd8, 0x48, 0x0d,
-	0xd5, 0x6a, 0x1c, 0xae, 0xa9, 0xfa, 0xb6, 0xe0, 0xc9, 0xd9, 0x84, 0x1a, 0xbe, 0x14, 0x42, 0x67,
-	0xb0, 0xb5, 0x4a, 0x4e, 0xc7, 0x64, 0x36, 0x64, 0xa4, 0xa7, 0xcc, 0xb1, 0x1a, 0x2f, 0xef, 0xaa,
-	0xfe, 0x29, 0xa3, 0x87, 0x1a, 0xde, 0xa4, 0xd7, 0x6a, 0xe8, 0x08, 0x4a, 0x71, 0x9a, 0xbb, 0xbb,
-	0xbf, 0xa6, 0xf0, 0x49, 0x5b, 0x8d, 0xc2, 0x5c, 0xcc, 0x77, 0x4a, 0x27, 0xed, 0x50, 0xc3, 0xa5,
-	0x38, 0x45, 0x47, 0x60, 0xf2, 0x6e, 0x44, 0x13, 0xe2, 0x98, 0x77, 0x12, 0x6b, 0x29, 0x52, 0xa8,
-	0xe1, 0x9c, 0xde, 0x34, 0xc1, 0x18, 0x08, 0x9a, 0x78, 0xbb, 0xb0, 0xf5, 0x97, 0x8d, 0xf2, 0x9a,
-	0x8e, 0x48, 0x92, 0x5d, 0x83, 0x0d, 0xac, 0x62, 0x6f, 0x08, 0x9b, 0xd7, 0x6d, 0x42, 0x9b, 0x50,
-	0x8e, 0xe9, 0x4c, 0xc1, 0x6c, 0x2c, 0x43, 0xb4, 0x0d, 0x95, 0x94, 0x0c, 0xa7, 0x54, 0x19, 0x6f,
-	0xe3, 0x2c, 0x41, 0x0e, 0xdc, 0x4b, 0xe9, 0x64, 0x65, 0x5d, 0x19, 0x17, 0xe9, 0x95, 0xc5, 0x92,
-	0x53, 0xaf, 0x14, 0x8b, 0xe5, 0xbd, 0x81, 0x87, 0x37, 0xda, 0x77, 0x53, 0x6b, 0xb7, 0x6d, 0xa1,
-	0x77, 0x08, 0xce, 0x6d, 0x2e, 0xc9, 0x96, 0x0a, 0xbf, 0xb3, 0xf6, 0x8b, 0xd4, 0x7b, 0x05, 0x0f,
-	0xfe, 0xb4, 0x60, 0xdd, 0x63, 0x7a, 0xcf, 0x2e, 0x99, 0xd9, 0xbc, 0x65, 0xb7, 0x31, 0x9d, 0x15,
-	0xcb, 0xa7, 0xe2, 0xe6, 0xbb, 0xf3, 0x85, 0xab, 0x5f, 0x2c, 0x5c, 0xfd, 0xd7, 0xc2, 0xd5, 0xbf,
-	0x2f, 0x5d, 0xed, 0x62, 0xe9, 0x6a, 0x3f, 0x97, 0xae, 0xf6, 0x65, 0xaf, 0x3f, 0x10, 0xd1, 0xb4,
-	0xe3, 0x77, 0x59, 0x12, 0xe4, 0x0f, 0x5c, 0xf6, 0xb3, 0xcf, 0x7b, 0xf1, 0x95, 0x67, 0x4e, 0xcc,
-	0xc6, 0x94, 0x77, 0x4c, 0xf5, 0x4e, 0xbd, 0xf8, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x70, 0xb0, 0xd0,
-	0xad, 0x0c, 0x05, 0x00, 0x00,
+	// 751 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x41, 0x4f, 0x22, 0x49,
+	0x14, 0xee, 0x86, 0x96, 0xc5, 0x6a, 0x16, 0xb1, 0xd6, 0x75, 0x3b, 0x24, 0x8b, 0xbd, 0x9d, 0x4d,
+	0x64, 0x8d, 0x36, 0x2b, 0x43, 0xe2, 0xdc, 0x26, 0x03, 0xa2, 0x4d, 0x44, 0x25, 0x85, 0x7a, 0xe0,
+	0x62, 0x1a, 0x28, 0xe9, 0x0e, 0x34, 0x4d, 0xa8, 0x92, 0x0c, 0xc7, 0xb9, 0x4d, 0x3c, 0xcd, 0x1f,
+	0x30, 0x99, 0x64, 0x7e, 0xc1, 0xfc, 0x0b, 0x2f, 0x93, 0x78, 0x9c, 0x93, 0x99, 0xe0, 0x1f, 0x99,
+	0x54, 0x75, 0x37, 0xb6, 0x8e, 0x8e, 0x78, 0xa2, 0xde, 0xe3, 0xfb, 0xbe, 0xf7, 0xea, 0x7d, 0xd5,
+	0x55, 0x60, 0xbd, 0xe5, 0x12, 0xc7, 0x25, 0xb9, 0xa6, 0x49, 0x70, 0x8e, 0xf4, 0xcd, 0x01, 0xb1,
+	0x5c, 0x4a, 0x72, 0xa3, 0xcd, 0x26, 0xa6, 0xe6, 0xe6, 0x34, 0xa3, 0x0f, 0x86, 0x2e, 0x75, 0xe1,
+	0xdf, 0x1e, 0x5a, 0x67, 0x68, 0x7d, 0x8a, 0xd6, 0x7d, 0x74, 0x7a, 0xa9, 0xe3, 0x76, 0x5c, 0x8e,
+	0xcc, 0xb1, 0x95, 0x47, 0xd2, 0xbe, 0x88, 0x20, 0x5e, 0xf7, 0xb1, 0x70, 0x19, 0xc4, 0x2c, 0x6c,
+	0x77, 0x2c, 0xaa, 0x88, 0xaa, 0x98, 0x95, 0x90, 0x1f, 0xb1, 0xfc, 0x99, 0x3b, 0x74, 0x4c, 0xaa,
+	0x44, 0x54, 0x31, 0xfb, 0x3b, 0xf2, 0x23, 0x96, 0x6f, 0x59, 0xe7, 0xfd, 0x2e, 0x51, 0xa2, 0x5e,
+	0xde, 0x8b, 0x20, 0x04, 0x92, 0x65, 0x12, 0x4b, 0x91, 0x54, 0x31, 0x9b, 0x40, 0x7c, 0x0d, 0x2b,
+	0x20, 0xee, 0x60, 0x6a, 0xb6, 0x4d, 0x6a, 0x2a, 0x73, 0xaa, 0x98, 0x95, 0xf3, 0xab, 0xfa, 0x2f,
+	0x1b, 0xd6, 0xf7, 0x7d, 0x78, 0x51, 0xba, 0xba, 0x59, 0x11, 0xd0, 0x94, 0xae, 0x7d, 0x15, 0x41,
+	0x3c, 0xf8, 0x13, 0xfe, 0x03, 0x12, 0xbc, 0xea, 0x29, 0xab, 0x82, 0x89, 0x22, 0xaa, 0xd1, 0x6c,
+	0x02, 0xc9, 0x3c, 0x67, 0xf0, 0x14, 0xac, 0x81, 0x38, 0xc1, 0x2d, 0x6a, 0xbb, 0x7d, 0xa2, 0x44,
+	0xd4, 0x68, 0x56, 0xce, 0xeb, 0xcf, 0x94, 0x0e, 0x26, 0x52, 0xf7, 0x68, 0x41, 0x07, 0x81, 0x0a,
+	0xac, 0x02, 0xb9, 0xe5, 0x3a, 0x83, 0x21, 0x26, 0xc4, 0x76, 0xfb, 0x7c, 0xf7, 0xc9, 0xfc, 0xda,
+	0x33, 0xa2, 0xa5, 0x3b, 0x06, 0x0a, 0xd3, 0xb5, 0x37, 0x60, 0xe1, 0x41, 0x41, 0xb8, 0x04, 0xe6,
+	0x08, 0x75, 0x87, 0x98, 0x1b, 0x31, 0x8f, 0xbc, 0x20, 0x34, 0xef, 0x48, 0x78, 0xde, 0xda, 0x7b,
+	0x09, 0x24, 0x02, 0x85, 0x0a, 0xc5, 0x0e, 0x34, 0xc2, 0x74, 0x39, 0xff, 0xff, 0xac, 0xdb, 0x65,
+	0x1c, 0x26, 0x60, 0x08, 0x41, 0xc9, 0x43, 0x20, 0xd9, 0xe6, 0xa8, 0xc7, 0x0b, 0xca, 0xf9, 0xdc,
+	0x8c, 0x42, 0x95, 0xb7, 0x27, 0x55, 0xa6, 0x53, 0x8c, 0x4f, 0x6e, 0x56, 0x24, 0x16, 0x19, 0x02,
+	0xe2, 0x42, 0xf0, 0x08, 0xcc, 0xe3, 0x77, 0x14, 0xf7, 0xa7, 0x83, 0x93, 0xf3, 0x85, 0x19, 0x55,
+	0xcb, 0x01, 0x8f, 0x99, 0x6f, 0x08, 0xe8, 0x4e, 0x08, 0x9e, 0x81, 0xc5, 0x69, 0x70, 0x3a, 0x30,
+	0xc7, 0x3d, 0xd7, 0x6c, 0xf3, 0xe3, 0x27, 0xe7, 0xb7, 0x5e, 0xaa, 0x5e, 0xf3, 0xe8, 0x86, 0x80,
+	0x52, 0xf8, 0x41, 0x0e, 0xee, 0x82, 0x48, 0x77, 0xe4, 0x9f, 0xdf, 0x8d, 0x19, 0x85, 0xf7, 0x4e,
+	0xf8, 0x28, 0x62, 0x93, 0x9b, 0x95, 0xc8, 0xde, 0x89, 0x21, 0xa0, 0x48, 0x77, 0x04, 0x77, 0x41,
+	0x8c, 0xb4, 0x2c, 0xec, 0x98, 0x4a, 0xec, 0x45, 0x62, 0x75, 0x4e, 0x32, 0x04, 0xe4, 0xd3, 0x8b,
+	0x31, 0x20, 0xd9, 0x14, 0x3b, 0xda, 0x2a, 0x58, 0xfc, 0xc9, 0x46, 0xf6, 0x21, 0xf6, 0x4d, 0x27,
+	0x38, 0x45, 0x7c, 0xad, 0xf5, 0x40, 0xea, 0xa1, 0x4d, 0x30, 0x05, 0xa2, 0x5d, 0x3c, 0xe6, 0xb0,
+	0x04, 0x62, 0x4b, 0x76, 0x00, 0x47, 0x66, 0xef, 0x1c, 0x73, 0xe3, 0x13, 0xc8, 0x0b, 0xa0, 0x02,
+	0x7e, 0x1b, 0xe1, 0xe1, 0xd4, 0xba, 0x28, 0x0a, 0xc2, 0xd0, 0xd5, 0xc1, 0xa6, 0x3e, 0x17, 0x5c,
+	0x1d, 0x5a, 0x09, 0xfc, 0xf9, 0xa8, 0x7d, 0x8f, 0xb5, 0xf6, 0xd4, 0x3d, 0xa3, 0x15, 0x80, 0xf2,
+	0x94, 0x4b, 0xac, 0xa5, 0xc0, 0x6f, 0xaf, 0xfd, 0x20, 0xd4, 0x5e, 0x83, 0xe4, 0x7d, 0x0b, 0x66,
+	0xdd, 0xa6, 0xf6, 0x2f, 0x48, 0xde, 0x9f, 0x37, 0xeb, 0xb6, 0x8b, 0xc7, 0xc1, 0xed, 0xc2, 0xd7,
+	0x6b, 0x9f, 0x44, 0x20, 0x87, 0xbe, 0x69, 0xb8, 0x05, 0xfe, 0x2a, 0x1d, 0xee, 0xd7, 0x50, 0xb9,
+	0x5e, 0xaf, 0x1c, 0x1e, 0x9c, 0x1e, 0x1f, 0xd4, 0x6b, 0xe5, 0x52, 0x65, 0xa7, 0x52, 0xde, 0x4e,
+	0x09, 0xe9, 0xf4, 0xc5, 0xa5, 0xba, 0x1c, 0x42, 0x1f, 0xf7, 0xc9, 0x00, 0xb7, 0xec, 0x33, 0x1b,
+	0xb7, 0xe1, 0x7f, 0x20, 0x15, 0x26, 0x36, 0xea, 0x47, 0xdb, 0x29, 0x31, 0xfd, 0xc7, 0xc5, 0xa5,
+	0xba, 0x10, 0x62, 0x34, 0x08, 0x6d, 0xc3, 0x55, 0xb0, 0x10, 0x86, 0x56, 0x1b, 0x85, 0x54, 0x24,
+	0x0d, 0x2f, 0x2e, 0xd5, 0x64, 0x08, 0x59, 0x6d, 0x14, 0xd2, 0xd2, 0x87, 0xcf, 0x19, 0xa1, 0xb8,
+	0x73, 0x35, 0xc9, 0x88, 0xd7, 0x93, 0x8c, 0xf8, 0x7d, 0x92, 0x11, 0x3f, 0xde, 0x66, 0x84, 0xeb,
+	0xdb, 0x8c, 0xf0, 0xed, 0x36, 0x23, 0x34, 0xd6, 0x3b, 0x36, 0xb5, 0xce, 0x9b, 0x7a, 0xcb, 0x75,
+	0x72, 0xfe, 0x2b, 0xe3, 0xfd, 0x6c, 0x90, 0x76, 0x37, 0xf4, 0xd6, 0xd0, 0xf1, 0x00, 0x93, 0x66,
+	0x8c, 0x3f, 0x16, 0xaf, 0x7e, 0x0c, 0x00, 0x15, 0x77, 0x41, 0x52, 0x91, 0x06, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -743,6 +862,25 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Compression != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Compression))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sections) > 0 {
+		for iNdEx := len(m.Sections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSnapshot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.ChunkHashes) > 0 {
 		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ChunkHashes[iNdEx])
@@ -755,6 +893,41 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SnapshotSection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotSection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotSection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Chunks != 0 {
+		i = encodeVarintSnapshot(dAtA, i, uint64(m.Chunks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Store) > 0 {
+		i -= len(m.Store)
+		copy(dAtA[i:], m.Store)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Store)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SnapshotItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1171,6 +1344,31 @@ func (m *Metadata) Size() (n int) {
 			n += 1 + l + sovSnapshot(uint64(l))
 		}
 	}
+	if len(m.Sections) > 0 {
+		for _, e := range m.Sections {
+			l = e.Size()
+			n += 1 + l + sovSnapshot(uint64(l))
+		}
+	}
+	if m.Compression != 0 {
+		n += 1 + sovSnapshot(uint64(m.Compression))
+	}
+	return n
+}
+
+func (m *SnapshotSection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Store)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	if m.Chunks != 0 {
+		n += 1 + sovSnapshot(uint64(m.Chunks))
+	}
 	return n
 }
 
@@ -1596,6 +1794,160 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
 			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sections = append(m.Sections, SnapshotSection{})
+			if err := m.Sections[len(m.Sections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= Compression(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotSection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotSection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotSection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Store = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunks", wireType)
+			}
+			m.Chunks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chunks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -28,6 +28,26 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// StoreSnapshotter is a Snapshotter whose stores can be snapshotted and restored independently of
+// each other, as required by the SectionsFormat. Its methods may be called concurrently for
+// different stores.
+type StoreSnapshotter interface {
+	Snapshotter
+
+	// SnapshotStoreNames returns the names of the stores to snapshot at the given height, in the
+	// order of the snapshot sections.
+	SnapshotStoreNames(height uint64) ([]string, error)
+
+	// SnapshotStore writes the snapshot items of the named store into the protobuf writer.
+	SnapshotStore(height uint64, name string, protoWriter protoio.Writer) error
+
+	// RestoreStore restores the named store from all the snapshot items of the protobuf reader.
+	RestoreStore(height uint64, name string, protoReader protoio.Reader) error
+
+	// FinalizeRestore completes the restoration once all the stores are restored.
+	FinalizeRestore(height uint64) error
+}
+
 // ExtensionSnapshotter is an extension Snapshotter that is appended to the snapshot stream.
 // ExtensionSnapshotter has an unique name and manages it's own internal formats.
 type ExtensionSnapshotter interface {
@@ -0,0 +1,167 @@
+package archive
+
+import (
+	"bytes"
+	"fmt"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/prefix"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// backfillBatchSize is the number of writes after which the backfill of a
+// height is committed, to bound the size of the database transactions.
+const backfillBatchSize = 10000
+
+// Backfill archives the state of the MultiStore at each height from fromHeight
+// to toHeight, which must still be kept by the MultiStore. If the archive is not
+// empty, fromHeight must follow its latest height. The first height of an empty
+// archive is written in full, every other height as the difference to the
+// previous one.
+func Backfill(ms types.MultiStore, store *Store, fromHeight, toHeight int64) error {
+	latest := store.LatestVersion()
+	switch {
+	case fromHeight <= 0 || toHeight < fromHeight:
+		return fmt.Errorf("invalid height range %d-%d", fromHeight, toHeight)
+	case latest > 0 && fromHeight != latest+1:
+		return fmt.Errorf("the latest archived height is %d, backfill must start at height %d", latest, latest+1)
+	}
+
+	for height := fromHeight; height <= toHeight; height++ {
+		cms, err := ms.CacheMultiStoreWithVersion(height)
+		if err != nil {
+			return err
+		}
+
+		if err := store.backfillHeight(cms, height, latest); err != nil {
+			return fmt.Errorf("failed to backfill height %d: %w", height, err)
+		}
+		latest = height
+	}
+
+	return nil
+}
+
+// backfillHeight writes the difference between the state of the MultiStore and
+// the archived state at the latest height, and saves it as the given height.
+func (s *Store) backfillHeight(ms types.MultiStore, height, latest int64) error {
+	w := &batchWriter{db: s.db, writer: s.db.Writer()}
+	defer func() { w.writer.Discard() }()
+
+	for _, key := range s.sortedKeys() {
+		var prev dbm.DBReader
+		if latest > 0 {
+			r, err := s.db.ReaderAt(uint64(latest))
+			if err != nil {
+				return err
+			}
+			defer r.Discard()
+
+			prev = prefix.NewPrefixReader(r, storePrefix(key.Name()))
+		}
+
+		if err := diffStore(ms.GetKVStore(key), prev, w, storePrefix(key.Name())); err != nil {
+			return err
+		}
+	}
+
+	if err := w.writer.Commit(); err != nil {
+		return err
+	}
+
+	return s.db.SaveVersion(uint64(height))
+}
+
+// diffStore writes the sets and deletes turning the previous state of a store
+// into its current state. A nil previous state is empty.
+func diffStore(current types.KVStore, prev dbm.DBReader, w *batchWriter, storePrefix []byte) error {
+	iter := current.Iterator(nil, nil)
+	defer iter.Close()
+
+	var (
+		prevIter  dbm.Iterator
+		prevValid bool
+	)
+	if prev != nil {
+		var err error
+		if prevIter, err = prev.Iterator(nil, nil); err != nil {
+			return err
+		}
+		defer prevIter.Close()
+
+		prevValid = prevIter.Next()
+	}
+
+	for ; iter.Valid(); iter.Next() {
+		key, value := iter.Key(), iter.Value()
+		for prevValid && bytes.Compare(prevIter.Key(), key) < 0 {
+			if err := w.delete(storePrefix, prevIter.Key()); err != nil {
+				return err
+			}
+			prevValid = prevIter.Next()
+		}
+
+		if prevValid && bytes.Equal(prevIter.Key(), key) {
+			if !bytes.Equal(prevIter.Value(), value) {
+				if err := w.set(storePrefix, key, value); err != nil {
+					return err
+				}
+			}
+			prevValid = prevIter.Next()
+			continue
+		}
+
+		if err := w.set(storePrefix, key, value); err != nil {
+			return err
+		}
+	}
+
+	for ; prevValid; prevValid = prevIter.Next() {
+		if err := w.delete(storePrefix, prevIter.Key()); err != nil {
+			return err
+		}
+	}
+
+	if prevIter != nil {
+		return prevIter.Error()
+	}
+
+	return nil
+}
+
+// batchWriter commits its writes every backfillBatchSize writes.
+type batchWriter struct {
+	db     dbm.DBConnection
+	writer dbm.DBWriter
+	count  int
+}
+
+func (w *batchWriter) set(storePrefix, key, value []byte) error {
+	if err := prefix.NewPrefixWriter(w.writer, storePrefix).Set(key, value); err != nil {
+		return err
+	}
+
+	return w.wrote()
+}
+
+func (w *batchWriter) delete(storePrefix, key []byte) error {
+	if err := prefix.NewPrefixWriter(w.writer, storePrefix).Delete(key); err != nil {
+		return err
+	}
+
+	return w.wrote()
+}
+
+func (w *batchWriter) wrote() error {
+	w.count++
+	if w.count < backfillBatchSize {
+		return nil
+	}
+
+	if err := w.writer.Commit(); err != nil {
+		return err
+	}
+
+	w.writer, w.count = w.db.Writer(), 0
+	return nil
+}
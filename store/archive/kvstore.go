@@ -0,0 +1,121 @@
+package archive
+
+import (
+	"io"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/prefix"
+	dbutil "github.com/cosmos/cosmos-sdk/internal/db"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.KVStore = &kvStore{}
+
+// kvStore is a read-only KVStore of a store at a version of the archive. Every
+// read opens a short-lived transaction, so the store needs not be closed.
+type kvStore struct {
+	db      dbm.DBConnection
+	version uint64
+	prefix  []byte
+}
+
+func (s *kvStore) reader() dbm.DBReader {
+	r, err := s.db.ReaderAt(s.version)
+	if err != nil {
+		panic(err)
+	}
+
+	return prefix.NewPrefixReader(r, s.prefix)
+}
+
+// Get implements KVStore.
+func (s *kvStore) Get(key []byte) []byte {
+	r := s.reader()
+	defer r.Discard()
+
+	value, err := r.Get(key)
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
+// Has implements KVStore.
+func (s *kvStore) Has(key []byte) bool {
+	r := s.reader()
+	defer r.Discard()
+
+	ok, err := r.Has(key)
+	if err != nil {
+		panic(err)
+	}
+
+	return ok
+}
+
+// Set implements KVStore. It panics as the archive is read-only.
+func (s *kvStore) Set(key, value []byte) {
+	panic("archive store is read-only")
+}
+
+// Delete implements KVStore. It panics as the archive is read-only.
+func (s *kvStore) Delete(key []byte) {
+	panic("archive store is read-only")
+}
+
+// Iterator implements KVStore.
+func (s *kvStore) Iterator(start, end []byte) types.Iterator {
+	r := s.reader()
+	iter, err := r.Iterator(start, end)
+	if err != nil {
+		r.Discard()
+		panic(err)
+	}
+
+	return &iterator{Iterator: dbutil.DBToStoreIterator(iter), reader: r}
+}
+
+// ReverseIterator implements KVStore.
+func (s *kvStore) ReverseIterator(start, end []byte) types.Iterator {
+	r := s.reader()
+	iter, err := r.ReverseIterator(start, end)
+	if err != nil {
+		r.Discard()
+		panic(err)
+	}
+
+	return &iterator{Iterator: dbutil.DBToStoreIterator(iter), reader: r}
+}
+
+// GetStoreType implements Store.
+func (s *kvStore) GetStoreType() types.StoreType {
+	return types.StoreTypeDB
+}
+
+// CacheWrap branches the underlying store.
+func (s *kvStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements KVStore.
+func (s *kvStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// iterator discards the transaction of the iterator when it is closed.
+type iterator struct {
+	types.Iterator
+	reader dbm.DBReader
+}
+
+func (it *iterator) Close() error {
+	err := it.Iterator.Close()
+	if discardErr := it.reader.Discard(); err == nil {
+		err = discardErr
+	}
+
+	return err
+}
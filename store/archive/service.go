@@ -0,0 +1,127 @@
+package archive
+
+import (
+	"context"
+	"path"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Archive option keys
+const (
+	OptArchiveEnable = "archive.enable"
+	OptArchiveDir    = "archive.dir"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// StreamingService is a StreamingService writing the state changes of each
+// block to an archive Store.
+type StreamingService struct {
+	storeListeners []*types.MemoryListener // a series of KVStore listeners for each archived KVStore
+	store          *Store
+
+	currentBlockNumber int64
+}
+
+// NewStreamingService returns a StreamingService writing to the given Store.
+func NewStreamingService(store *Store) *StreamingService {
+	keys := store.sortedKeys()
+	listeners := make([]*types.MemoryListener, len(keys))
+	for i, key := range keys {
+		listeners[i] = types.NewMemoryListener(key)
+	}
+
+	return &StreamingService{storeListeners: listeners, store: store}
+}
+
+// Listeners satisfies the StreamingService interface. It returns the
+// StreamingService's underlying WriteListeners. Use for registering the
+// underlying WriteListeners with the BaseApp.
+func (ass *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(ass.storeListeners))
+	for _, listener := range ass.storeListeners {
+		listeners[listener.StoreKey()] = []types.WriteListener{listener}
+	}
+
+	return listeners
+}
+
+// ListenBeginBlock satisfies the ABCIListener interface. It sets the current
+// block number.
+func (ass *StreamingService) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	ass.currentBlockNumber = req.Header.Height
+	return nil
+}
+
+// ListenDeliverTx satisfies the ABCIListener interface. It performs a no-op.
+func (ass *StreamingService) ListenDeliverTx(ctx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenEndBlock satisfies the ABCIListener interface. It performs a no-op.
+func (ass *StreamingService) ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenCommit satisfies the ABCIListener interface. It writes the state
+// changes of the block to the archive. Any error stops the node, as the archive
+// would otherwise serve a wrong state.
+func (ass *StreamingService) ListenCommit(ctx context.Context, res abci.ResponseCommit) error {
+	var changeSet []types.StoreKVPair
+	for _, listener := range ass.storeListeners {
+		changeSet = append(changeSet, listener.PopStateCache()...)
+	}
+
+	return ass.store.Commit(ass.currentBlockNumber, changeSet)
+}
+
+// Stream satisfies the StreamingService interface. It performs a no-op.
+func (ass *StreamingService) Stream(wg *sync.WaitGroup) error { return nil }
+
+// Close satisfies the StreamingService interface. It closes the archive Store.
+func (ass *StreamingService) Close() error { return ass.store.Close() }
+
+// LoadArchiveStore opens the archive Store of the given KVStores if it is
+// enabled by the AppOptions. It sets it as the archive store of the BaseApp and
+// registers the StreamingService writing to it. It returns nil if the archive
+// is disabled.
+func LoadArchiveStore(
+	bApp *baseapp.BaseApp,
+	appOpts serverTypes.AppOptions,
+	keys map[string]*types.KVStoreKey,
+) (*Store, error) {
+	if !cast.ToBool(appOpts.Get(OptArchiveEnable)) {
+		return nil, nil
+	}
+
+	homePath := cast.ToString(appOpts.Get(flags.FlagHome))
+	dir := cast.ToString(appOpts.Get(OptArchiveDir))
+
+	// relative path is based on node home directory.
+	if !path.IsAbs(dir) {
+		dir = path.Join(homePath, dir)
+	}
+
+	storeKeys := make([]types.StoreKey, 0, len(keys))
+	for _, key := range keys {
+		storeKeys = append(storeKeys, key)
+	}
+
+	store, err := OpenStore(dir, storeKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	bApp.SetArchiveStore(store)
+	bApp.SetStreamingService(NewStreamingService(store))
+	return store, nil
+}
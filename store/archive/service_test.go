@@ -0,0 +1,70 @@
+package archive_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	"github.com/cosmos/cosmos-sdk/store/archive"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// commitBlocks commits blocks setting the key "height" and deleting the key of
+// the previous height.
+func commitBlocks(t *testing.T, app *baseapp.BaseApp, key types.StoreKey, from, to int64) {
+	for height := from; height <= to; height++ {
+		header := tmproto.Header{Height: height}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		store := app.NewContext(false, header).KVStore(key)
+		store.Set([]byte("height"), sdk.Uint64ToBigEndian(uint64(height)))
+		store.Set(sdk.Uint64ToBigEndian(uint64(height)), []byte("set"))
+		store.Delete(sdk.Uint64ToBigEndian(uint64(height - 1)))
+
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+}
+
+func requireArchivedHeight(t *testing.T, store *archive.Store, key types.StoreKey, height int64) {
+	cms, err := store.CacheMultiStoreWithVersion(height)
+	require.NoError(t, err)
+
+	kvStore := cms.GetKVStore(key)
+	require.Equal(t, sdk.Uint64ToBigEndian(uint64(height)), kvStore.Get([]byte("height")))
+	require.Equal(t, []byte("set"), kvStore.Get(sdk.Uint64ToBigEndian(uint64(height))))
+	require.False(t, kvStore.Has(sdk.Uint64ToBigEndian(uint64(height-1))))
+}
+
+func TestStreamingServiceAndBackfill(t *testing.T) {
+	key := sdk.NewKVStoreKey("main")
+	app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB(), nil)
+	app.MountStores(key)
+	require.NoError(t, app.LoadLatestVersion())
+	commitBlocks(t, app, key, 1, 3)
+
+	// the archive is enabled at height 4, it must be backfilled first
+	store := archive.NewStore(memdb.NewDB(), []types.StoreKey{key})
+	app.SetStreamingService(archive.NewStreamingService(store))
+	require.Panics(t, func() { commitBlocks(t, app, key, 4, 4) })
+
+	require.Error(t, archive.Backfill(app.CommitMultiStore(), store, 0, 2))
+	require.NoError(t, archive.Backfill(app.CommitMultiStore(), store, 2, 3))
+	require.Error(t, archive.Backfill(app.CommitMultiStore(), store, 2, 3))
+	require.NoError(t, archive.Backfill(app.CommitMultiStore(), store, 4, 4))
+	require.False(t, store.HasVersion(1))
+
+	// the following heights are written by the streaming service
+	commitBlocks(t, app, key, 5, 6)
+	require.Equal(t, int64(6), store.LatestVersion())
+	for height := int64(2); height <= 6; height++ {
+		requireArchivedHeight(t, store, key, height)
+	}
+}
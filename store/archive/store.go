@@ -0,0 +1,148 @@
+package archive
+
+import (
+	"fmt"
+	"sort"
+
+	tmdb "github.com/tendermint/tm-db"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/badgerdb"
+	"github.com/cosmos/cosmos-sdk/db/prefix"
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Store archives the state of the KVStores of a CommitMultiStore in a separate
+// versioned database. Each version of the database holds the flat key/value
+// pairs of the stores at the block height of the version, so that historical
+// queries can be served from the archive while the CommitMultiStore prunes its
+// old versions.
+type Store struct {
+	db   dbm.DBConnection
+	keys map[string]types.StoreKey
+}
+
+// NewStore returns a Store archiving the given stores into db. The stores are
+// identified by the names of their keys in the database.
+func NewStore(db dbm.DBConnection, keys []types.StoreKey) *Store {
+	keysByName := make(map[string]types.StoreKey, len(keys))
+	for _, key := range keys {
+		keysByName[key.Name()] = key
+	}
+
+	return &Store{db: db, keys: keysByName}
+}
+
+// OpenStore opens a Store backed by a BadgerDB database in dir.
+func OpenStore(dir string, keys []types.StoreKey) (*Store, error) {
+	db, err := badgerdb.NewDB(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStore(db, keys), nil
+}
+
+// storePrefix returns the prefix of the keys of a store in the database.
+func storePrefix(name string) []byte {
+	return append([]byte{byte(len(name))}, name...)
+}
+
+// sortedKeys returns the store keys sorted by name.
+func (s *Store) sortedKeys() []types.StoreKey {
+	keys := make([]types.StoreKey, 0, len(s.keys))
+	for _, key := range s.keys {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name() < keys[j].Name() })
+	return keys
+}
+
+// LatestVersion returns the latest archived height, or 0 if the archive is
+// empty.
+func (s *Store) LatestVersion() int64 {
+	versions, err := s.db.Versions()
+	if err != nil {
+		panic(err)
+	}
+
+	return int64(versions.Last())
+}
+
+// HasVersion returns true if the state at the given height is archived.
+func (s *Store) HasVersion(version int64) bool {
+	if version <= 0 {
+		return false
+	}
+
+	versions, err := s.db.Versions()
+	if err != nil {
+		panic(err)
+	}
+
+	return versions.Exists(uint64(version))
+}
+
+// Commit writes the state changes of a block and saves them as the version of
+// the block height. Heights at or below the latest version were already
+// archived, e.g. before the node restarted and replayed them, and are skipped.
+// An error is returned if the previous height is not archived, as the state of
+// the keys not changed in the block would then be missing.
+func (s *Store) Commit(version int64, changeSet []types.StoreKVPair) error {
+	latest := s.LatestVersion()
+	if version <= latest {
+		return nil
+	}
+	if version != latest+1 {
+		return fmt.Errorf("cannot archive height %d, the latest archived height is %d; backfill the archive first", version, latest)
+	}
+
+	w := s.db.Writer()
+	for _, pair := range changeSet {
+		if _, ok := s.keys[pair.StoreKey]; !ok {
+			continue
+		}
+
+		pw := prefix.NewPrefixWriter(w, storePrefix(pair.StoreKey))
+		var err error
+		if pair.Delete {
+			err = pw.Delete(pair.Key)
+		} else {
+			err = pw.Set(pair.Key, pair.Value)
+		}
+		if err != nil {
+			w.Discard()
+			return err
+		}
+	}
+
+	if err := w.Commit(); err != nil {
+		return err
+	}
+
+	return s.db.SaveVersion(uint64(version))
+}
+
+// CacheMultiStoreWithVersion returns a read-only CacheMultiStore of the stores
+// at the given height. The CacheMultiStore is cache-wrapped, so writes are
+// allowed but must not be written.
+func (s *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	if !s.HasVersion(version) {
+		return nil, sdkerrors.Wrapf(dbm.ErrVersionDoesNotExist, "height %d is not archived", version)
+	}
+
+	stores := make(map[types.StoreKey]types.CacheWrapper, len(s.keys))
+	for name, key := range s.keys {
+		stores[key] = &kvStore{db: s.db, version: uint64(version), prefix: storePrefix(name)}
+	}
+
+	return cachemulti.NewStore(tmdb.NewMemDB(), stores, s.keys, nil, nil), nil
+}
+
+// Close closes the database of the archive.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
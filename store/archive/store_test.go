@@ -0,0 +1,79 @@
+package archive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestStore(t *testing.T) {
+	key1, key2 := sdk.NewKVStoreKey("store1"), sdk.NewKVStoreKey("store2")
+	store := NewStore(memdb.NewDB(), []types.StoreKey{key1, key2})
+	defer store.Close()
+
+	require.Equal(t, int64(0), store.LatestVersion())
+	require.False(t, store.HasVersion(1))
+	_, err := store.CacheMultiStoreWithVersion(1)
+	require.Error(t, err)
+
+	// the first height must be 1 or backfilled
+	require.Error(t, store.Commit(2, nil))
+
+	require.NoError(t, store.Commit(1, []types.StoreKVPair{
+		{StoreKey: key1.Name(), Key: []byte("a"), Value: []byte("1")},
+		{StoreKey: key1.Name(), Key: []byte("b"), Value: []byte("1")},
+		{StoreKey: key2.Name(), Key: []byte("a"), Value: []byte("2")},
+		{StoreKey: "unknown", Key: []byte("a"), Value: []byte("3")},
+	}))
+	require.NoError(t, store.Commit(2, []types.StoreKVPair{
+		{StoreKey: key1.Name(), Key: []byte("a"), Value: []byte("4")},
+		{StoreKey: key1.Name(), Key: []byte("b"), Delete: true},
+		{StoreKey: key1.Name(), Key: []byte("c"), Value: []byte("4")},
+	}))
+
+	// replayed heights are skipped and gaps are rejected
+	require.NoError(t, store.Commit(2, []types.StoreKVPair{
+		{StoreKey: key1.Name(), Key: []byte("a"), Value: []byte("5")},
+	}))
+	require.Error(t, store.Commit(4, nil))
+	require.Equal(t, int64(2), store.LatestVersion())
+
+	cms, err := store.CacheMultiStoreWithVersion(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), cms.GetKVStore(key1).Get([]byte("a")))
+	require.True(t, cms.GetKVStore(key1).Has([]byte("b")))
+	require.Equal(t, []byte("2"), cms.GetKVStore(key2).Get([]byte("a")))
+	require.Nil(t, cms.GetKVStore(key1).Get([]byte("c")))
+
+	cms, err = store.CacheMultiStoreWithVersion(2)
+	require.NoError(t, err)
+	kvStore := cms.GetKVStore(key1)
+	require.Equal(t, []byte("4"), kvStore.Get([]byte("a")))
+	require.False(t, kvStore.Has([]byte("b")))
+	require.Equal(t, []byte("2"), cms.GetKVStore(key2).Get([]byte("a")))
+
+	var keys []string
+	iter := kvStore.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	require.NoError(t, iter.Close())
+	require.Equal(t, []string{"a", "c"}, keys)
+
+	keys = nil
+	iter = kvStore.ReverseIterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	require.NoError(t, iter.Close())
+	require.Equal(t, []string{"c", "a"}, keys)
+
+	// writes stay in the cache
+	kvStore.Set([]byte("d"), []byte("6"))
+	require.Equal(t, []byte("6"), kvStore.Get([]byte("d")))
+	require.Panics(t, cms.Write)
+}
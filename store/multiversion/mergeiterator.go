@@ -0,0 +1,139 @@
+package multiversion
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// entryKV is a value overlaying the parent of a mergeIterator. A nil value marks
+// a deletion.
+type entryKV struct {
+	key   []byte
+	value []byte
+}
+
+// mergeIterator iterates over a parent iterator overlaid with a sorted list of
+// values, which take precedence over the values of the parent.
+type mergeIterator struct {
+	parent    types.Iterator
+	overlay   []entryKV
+	ascending bool
+
+	pos        int
+	fromParent bool
+	valid      bool
+	key, value []byte
+}
+
+var _ types.Iterator = (*mergeIterator)(nil)
+
+// newMergeIterator returns a mergeIterator over the parent and the overlay, which
+// must be sorted in ascending order of keys.
+func newMergeIterator(parent types.Iterator, overlay []entryKV, ascending bool) *mergeIterator {
+	if !ascending {
+		reversed := make([]entryKV, len(overlay))
+		for i, e := range overlay {
+			reversed[len(overlay)-1-i] = e
+		}
+		overlay = reversed
+	}
+
+	it := &mergeIterator{parent: parent, overlay: overlay, ascending: ascending}
+	it.skipUntilValid()
+	return it
+}
+
+// skipUntilValid moves the iterator to the next item which is not deleted.
+func (it *mergeIterator) skipUntilValid() {
+	for {
+		parentValid := it.parent.Valid()
+		if it.pos >= len(it.overlay) {
+			if it.valid = parentValid; parentValid {
+				it.setFromParent()
+			}
+			return
+		}
+
+		e := it.overlay[it.pos]
+		if parentValid {
+			cmp := bytes.Compare(it.parent.Key(), e.key)
+			if !it.ascending {
+				cmp = -cmp
+			}
+			if cmp < 0 {
+				it.valid = true
+				it.setFromParent()
+				return
+			}
+			if cmp == 0 {
+				// the parent value is overlaid
+				it.parent.Next()
+			}
+		}
+
+		if e.value == nil {
+			it.pos++
+			continue
+		}
+
+		it.valid, it.fromParent = true, false
+		it.key, it.value = e.key, e.value
+		return
+	}
+}
+
+func (it *mergeIterator) setFromParent() {
+	it.fromParent = true
+	it.key, it.value = it.parent.Key(), it.parent.Value()
+}
+
+// Domain implements types.Iterator.
+func (it *mergeIterator) Domain() (start, end []byte) {
+	return it.parent.Domain()
+}
+
+// Valid implements types.Iterator.
+func (it *mergeIterator) Valid() bool {
+	return it.valid
+}
+
+// Next implements types.Iterator.
+func (it *mergeIterator) Next() {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+
+	if it.fromParent {
+		it.parent.Next()
+	} else {
+		it.pos++
+	}
+	it.skipUntilValid()
+}
+
+// Key implements types.Iterator.
+func (it *mergeIterator) Key() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	return it.key
+}
+
+// Value implements types.Iterator.
+func (it *mergeIterator) Value() []byte {
+	if !it.valid {
+		panic("iterator is invalid")
+	}
+	return it.value
+}
+
+// Error implements types.Iterator.
+func (it *mergeIterator) Error() error {
+	return it.parent.Error()
+}
+
+// Close implements types.Iterator.
+func (it *mergeIterator) Close() error {
+	return it.parent.Close()
+}
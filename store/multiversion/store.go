@@ -0,0 +1,186 @@
+package multiversion
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/tidwall/btree"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// WriteSet holds the writes of a transaction by key. A nil value marks a deletion.
+type WriteSet map[string][]byte
+
+// entry is the value written to a key by the transaction at index. A nil value
+// marks a deletion.
+type entry struct {
+	index int
+	value []byte
+}
+
+// keyVersions holds the values written to a key by the transactions of a block,
+// sorted by transaction index.
+type keyVersions struct {
+	key     []byte
+	entries []entry
+}
+
+func byKeys(a, b *keyVersions) bool {
+	return bytes.Compare(a.key, b.key) < 0
+}
+
+// latest returns the value written by the last transaction before index.
+func (kv *keyVersions) latest(index int) (entry, bool) {
+	i := sort.Search(len(kv.entries), func(i int) bool { return kv.entries[i].index >= index })
+	if i == 0 {
+		return entry{}, false
+	}
+	return kv.entries[i-1], true
+}
+
+func (kv *keyVersions) set(index int, value []byte) {
+	i := sort.Search(len(kv.entries), func(i int) bool { return kv.entries[i].index >= index })
+	if i < len(kv.entries) && kv.entries[i].index == index {
+		kv.entries[i].value = value
+		return
+	}
+	kv.entries = append(kv.entries, entry{})
+	copy(kv.entries[i+1:], kv.entries[i:])
+	kv.entries[i] = entry{index: index, value: value}
+}
+
+func (kv *keyVersions) remove(index int) {
+	i := sort.Search(len(kv.entries), func(i int) bool { return kv.entries[i].index >= index })
+	if i < len(kv.entries) && kv.entries[i].index == index {
+		kv.entries = append(kv.entries[:i], kv.entries[i+1:]...)
+	}
+}
+
+// Store is a multi-version view of a KVStore, used to execute the transactions of
+// a block concurrently. Every transaction writes its changes as a version indexed
+// by its position in the block and reads the latest version written by a preceding
+// transaction, falling back to the parent store.
+//
+// The parent store is never written until Write is called, it must support
+// concurrent reads in the meantime.
+type Store struct {
+	parent types.KVStore
+
+	mtx      sync.RWMutex
+	versions *btree.BTreeG[*keyVersions]
+	writes   map[int]WriteSet
+}
+
+// NewStore returns a new multi-version Store on top of the given parent store.
+func NewStore(parent types.KVStore) *Store {
+	return &Store{
+		parent:   parent,
+		versions: btree.NewBTreeGOptions(byKeys, btree.Options{NoLocks: true}),
+		writes:   make(map[int]WriteSet),
+	}
+}
+
+// VersionIndexed returns a view of the store for the transaction at index which
+// records the reads and writes of the transaction.
+func (s *Store) VersionIndexed(index int) *VersionIndexedStore {
+	return newVersionIndexedStore(s, index)
+}
+
+// SetWriteSet sets the writes of the transaction at index, replacing the writes
+// of a previous execution of the transaction.
+func (s *Store) SetWriteSet(index int, writes WriteSet) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for key := range s.writes[index] {
+		if _, ok := writes[key]; ok {
+			continue
+		}
+		kv, found := s.versions.Get(&keyVersions{key: []byte(key)})
+		if !found {
+			continue
+		}
+		kv.remove(index)
+		if len(kv.entries) == 0 {
+			s.versions.Delete(kv)
+		}
+	}
+
+	for key, value := range writes {
+		kv, found := s.versions.Get(&keyVersions{key: []byte(key)})
+		if !found {
+			kv = &keyVersions{key: []byte(key)}
+			s.versions.Set(kv)
+		}
+		kv.set(index, value)
+	}
+
+	s.writes[index] = writes
+}
+
+// Write writes the writes of the transaction at index to the parent store.
+func (s *Store) Write(index int) {
+	s.mtx.RLock()
+	writes := s.writes[index]
+	s.mtx.RUnlock()
+
+	keys := make([]string, 0, len(writes))
+	for key := range writes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if value := writes[key]; value == nil {
+			s.parent.Delete([]byte(key))
+		} else {
+			s.parent.Set([]byte(key), value)
+		}
+	}
+}
+
+// get returns the value of key seen by the transaction at index.
+func (s *Store) get(index int, key []byte) []byte {
+	s.mtx.RLock()
+	kv, found := s.versions.Get(&keyVersions{key: key})
+	var (
+		e      entry
+		latest bool
+	)
+	if found {
+		e, latest = kv.latest(index)
+	}
+	s.mtx.RUnlock()
+
+	if latest {
+		return e.value
+	}
+	return s.parent.Get(key)
+}
+
+// iterator returns an iterator over the domain seen by the transaction at index.
+func (s *Store) iterator(index int, start, end []byte, ascending bool) types.Iterator {
+	var parent types.Iterator
+	if ascending {
+		parent = s.parent.Iterator(start, end)
+	} else {
+		parent = s.parent.ReverseIterator(start, end)
+	}
+
+	var overlay []entryKV
+	s.mtx.RLock()
+	s.versions.Ascend(&keyVersions{key: start}, func(kv *keyVersions) bool {
+		if end != nil && bytes.Compare(kv.key, end) >= 0 {
+			return false
+		}
+		if e, ok := kv.latest(index); ok {
+			overlay = append(overlay, entryKV{key: kv.key, value: e.value})
+		}
+		return true
+	})
+	s.mtx.RUnlock()
+
+	return newMergeIterator(parent, overlay, ascending)
+}
@@ -0,0 +1,162 @@
+package multiversion_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/multiversion"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newParent(pairs ...string) types.KVStore {
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	for i := 0; i < len(pairs); i += 2 {
+		parent.Set([]byte(pairs[i]), []byte(pairs[i+1]))
+	}
+	return parent
+}
+
+func collect(it types.Iterator) []string {
+	defer it.Close()
+	var res []string
+	for ; it.Valid(); it.Next() {
+		res = append(res, string(it.Key())+"="+string(it.Value()))
+	}
+	return res
+}
+
+func TestVersionIndexedStoreGet(t *testing.T) {
+	mvs := multiversion.NewStore(newParent("a", "1"))
+	mvs.SetWriteSet(1, multiversion.WriteSet{"a": []byte("2")})
+	mvs.SetWriteSet(3, multiversion.WriteSet{"a": nil})
+
+	require.Equal(t, []byte("1"), mvs.VersionIndexed(0).Get([]byte("a")))
+	require.Equal(t, []byte("1"), mvs.VersionIndexed(1).Get([]byte("a")))
+	require.Equal(t, []byte("2"), mvs.VersionIndexed(2).Get([]byte("a")))
+	require.Equal(t, []byte("2"), mvs.VersionIndexed(3).Get([]byte("a")))
+	require.Nil(t, mvs.VersionIndexed(4).Get([]byte("a")))
+	require.False(t, mvs.VersionIndexed(4).Has([]byte("a")))
+
+	// a new execution of a transaction replaces its previous writes
+	mvs.SetWriteSet(3, multiversion.WriteSet{"b": []byte("3")})
+	require.Equal(t, []byte("2"), mvs.VersionIndexed(4).Get([]byte("a")))
+	require.Equal(t, []byte("3"), mvs.VersionIndexed(4).Get([]byte("b")))
+
+	// the writes of the transaction itself are read back
+	view := mvs.VersionIndexed(2)
+	view.Set([]byte("a"), []byte("4"))
+	require.Equal(t, []byte("4"), view.Get([]byte("a")))
+	view.Delete([]byte("a"))
+	require.Nil(t, view.Get([]byte("a")))
+	require.Equal(t, multiversion.WriteSet{"a": nil}, view.WriteSet())
+}
+
+func TestVersionIndexedStoreValidate(t *testing.T) {
+	mvs := multiversion.NewStore(newParent("a", "1"))
+	mvs.SetWriteSet(0, multiversion.WriteSet{"a": []byte("2")})
+
+	view := mvs.VersionIndexed(2)
+	require.Equal(t, []byte("2"), view.Get([]byte("a")))
+	require.Nil(t, view.Get([]byte("b")))
+	require.True(t, view.Validate())
+
+	// writes of later transactions do not invalidate the reads
+	mvs.SetWriteSet(3, multiversion.WriteSet{"a": []byte("3"), "b": []byte("3")})
+	require.True(t, view.Validate())
+
+	// a preceding transaction writing a key read before invalidates the reads
+	mvs.SetWriteSet(1, multiversion.WriteSet{"b": []byte("1")})
+	require.False(t, view.Validate())
+	mvs.SetWriteSet(1, multiversion.WriteSet{})
+	require.True(t, view.Validate())
+
+	mvs.SetWriteSet(0, multiversion.WriteSet{"a": []byte("5")})
+	require.False(t, view.Validate())
+}
+
+func TestVersionIndexedStoreIterator(t *testing.T) {
+	mvs := multiversion.NewStore(newParent("a", "1", "b", "1", "c", "1"))
+	mvs.SetWriteSet(0, multiversion.WriteSet{"b": nil, "d": []byte("0")})
+	mvs.SetWriteSet(2, multiversion.WriteSet{"e": []byte("2")})
+
+	view := mvs.VersionIndexed(1)
+	require.Equal(t, []string{"a=1", "c=1", "d=0"}, collect(view.Iterator(nil, nil)))
+	require.Equal(t, []string{"d=0", "c=1", "a=1"}, collect(view.ReverseIterator(nil, nil)))
+	require.Equal(t, []string{"c=1"}, collect(view.Iterator([]byte("b"), []byte("d"))))
+
+	view.Set([]byte("b"), []byte("x"))
+	view.Delete([]byte("c"))
+	require.Equal(t, []string{"a=1", "b=x", "d=0"}, collect(view.Iterator(nil, nil)))
+	require.Equal(t, []string{"d=0", "b=x", "a=1"}, collect(view.ReverseIterator(nil, nil)))
+
+	require.Equal(t, []string{"a=1", "c=1", "d=0", "e=2"}, collect(mvs.VersionIndexed(3).Iterator(nil, nil)))
+}
+
+func TestVersionIndexedStoreValidateIteration(t *testing.T) {
+	mvs := multiversion.NewStore(newParent("a", "1", "c", "1"))
+
+	full := mvs.VersionIndexed(2)
+	require.Equal(t, []string{"a=1", "c=1"}, collect(full.Iterator(nil, nil)))
+
+	partial := mvs.VersionIndexed(2)
+	it := partial.Iterator(nil, nil)
+	require.Equal(t, []byte("a"), it.Key())
+	require.NoError(t, it.Close())
+
+	require.True(t, full.Validate())
+	require.True(t, partial.Validate())
+
+	// a key added by a preceding transaction after the items read by the transaction
+	mvs.SetWriteSet(0, multiversion.WriteSet{"d": []byte("0")})
+	require.False(t, full.Validate())
+	require.True(t, partial.Validate())
+
+	// a key added by a preceding transaction before the items read by the transaction
+	mvs.SetWriteSet(0, multiversion.WriteSet{"0": []byte("0")})
+	require.False(t, full.Validate())
+	require.False(t, partial.Validate())
+
+	mvs.SetWriteSet(0, multiversion.WriteSet{})
+	require.True(t, full.Validate())
+	require.True(t, partial.Validate())
+}
+
+func TestVersionIndexedStoreReadsAny(t *testing.T) {
+	mvs := multiversion.NewStore(newParent("a", "1", "c", "1", "e", "1"))
+
+	view := mvs.VersionIndexed(1)
+	view.Get([]byte("a"))
+	view.Set([]byte("b"), []byte("1"))
+	collect(view.Iterator([]byte("c"), []byte("e")))
+
+	keys := func(keys ...string) map[string]struct{} {
+		set := make(map[string]struct{}, len(keys))
+		for _, key := range keys {
+			set[key] = struct{}{}
+		}
+		return set
+	}
+	require.True(t, view.ReadsAny(keys("a")))
+	require.True(t, view.ReadsAny(keys("b", "d")))
+	require.True(t, view.ReadsAny(keys("c")))
+
+	// the keys written but not read, and the keys out of the iterated domain
+	require.False(t, view.ReadsAny(keys("b", "e", "f")))
+	require.False(t, view.ReadsAny(keys()))
+}
+
+func TestStoreWrite(t *testing.T) {
+	parent := newParent("a", "1", "b", "1")
+	mvs := multiversion.NewStore(parent)
+	mvs.SetWriteSet(0, multiversion.WriteSet{"a": []byte("0")})
+	mvs.SetWriteSet(1, multiversion.WriteSet{"a": nil, "c": []byte("1")})
+
+	mvs.Write(0)
+	require.Equal(t, []string{"a=0", "b=1"}, collect(parent.Iterator(nil, nil)))
+
+	mvs.Write(1)
+	require.Equal(t, []string{"b=1", "c=1"}, collect(parent.Iterator(nil, nil)))
+}
@@ -0,0 +1,221 @@
+package multiversion
+
+import (
+	"bytes"
+	"io"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// iterationRead records the items a transaction read through an iterator.
+type iterationRead struct {
+	start, end []byte
+	ascending  bool
+
+	items     []entryKV
+	exhausted bool
+}
+
+// VersionIndexedStore is the view of a multi-version Store for the transaction at
+// a given index. It records the values the transaction reads, so that they can be
+// validated against the writes of the preceding transactions, and keeps the writes
+// of the transaction until they are set with Store.SetWriteSet.
+//
+// A VersionIndexedStore is meant to be branched with a cachekv store for the
+// execution of a single transaction and must not be used concurrently.
+type VersionIndexedStore struct {
+	mvs   *Store
+	index int
+
+	reads      map[string][]byte
+	iterations []*iterationRead
+	writes     WriteSet
+}
+
+var _ types.KVStore = (*VersionIndexedStore)(nil)
+
+func newVersionIndexedStore(mvs *Store, index int) *VersionIndexedStore {
+	return &VersionIndexedStore{
+		mvs:    mvs,
+		index:  index,
+		reads:  make(map[string][]byte),
+		writes: make(WriteSet),
+	}
+}
+
+// Index returns the index of the transaction.
+func (s *VersionIndexedStore) Index() int {
+	return s.index
+}
+
+// WriteSet returns the writes of the transaction.
+func (s *VersionIndexedStore) WriteSet() WriteSet {
+	return s.writes
+}
+
+// Validate returns true if the values read by the transaction are still the values
+// written by the preceding transactions, i.e. executing the transaction again would
+// give the same result.
+func (s *VersionIndexedStore) Validate() bool {
+	for key, value := range s.reads {
+		if !equalValues(s.mvs.get(s.index, []byte(key)), value) {
+			return false
+		}
+	}
+
+	for _, r := range s.iterations {
+		if !s.validateIteration(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ReadsAny returns true if the transaction read one of the given keys, directly or
+// through an iterator over a domain containing the key. Only the transactions reading
+// a key written by a preceding transaction executed again need to be validated again.
+func (s *VersionIndexedStore) ReadsAny(keys map[string]struct{}) bool {
+	for key := range keys {
+		if _, ok := s.reads[key]; ok {
+			return true
+		}
+		for _, r := range s.iterations {
+			if r.contains([]byte(key)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// contains returns true if the key is in the domain of the iteration.
+func (r *iterationRead) contains(key []byte) bool {
+	return (r.start == nil || bytes.Compare(key, r.start) >= 0) && (r.end == nil || bytes.Compare(key, r.end) < 0)
+}
+
+func (s *VersionIndexedStore) validateIteration(r *iterationRead) bool {
+	it := s.mvs.iterator(s.index, r.start, r.end, r.ascending)
+	defer it.Close()
+
+	for _, item := range r.items {
+		if !it.Valid() || !bytes.Equal(it.Key(), item.key) || !equalValues(it.Value(), item.value) {
+			return false
+		}
+		it.Next()
+	}
+
+	return !r.exhausted || !it.Valid()
+}
+
+// GetStoreType implements Store.
+func (s *VersionIndexedStore) GetStoreType() types.StoreType {
+	return s.mvs.parent.GetStoreType()
+}
+
+// Get implements types.KVStore.
+func (s *VersionIndexedStore) Get(key []byte) []byte {
+	types.AssertValidKey(key)
+
+	if value, ok := s.writes[string(key)]; ok {
+		return value
+	}
+	if value, ok := s.reads[string(key)]; ok {
+		return value
+	}
+
+	value := s.mvs.get(s.index, key)
+	s.reads[string(key)] = value
+	return value
+}
+
+// Has implements types.KVStore.
+func (s *VersionIndexedStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+// Set implements types.KVStore.
+func (s *VersionIndexedStore) Set(key, value []byte) {
+	types.AssertValidKey(key)
+	types.AssertValidValue(value)
+	s.writes[string(key)] = value
+}
+
+// Delete implements types.KVStore.
+func (s *VersionIndexedStore) Delete(key []byte) {
+	types.AssertValidKey(key)
+	s.writes[string(key)] = nil
+}
+
+// Iterator implements types.KVStore.
+func (s *VersionIndexedStore) Iterator(start, end []byte) types.Iterator {
+	return s.iterator(start, end, true)
+}
+
+// ReverseIterator implements types.KVStore.
+func (s *VersionIndexedStore) ReverseIterator(start, end []byte) types.Iterator {
+	return s.iterator(start, end, false)
+}
+
+// iterator returns an iterator over the writes of the transaction overlaying the
+// recorded iteration of the domain seen by the transaction.
+func (s *VersionIndexedStore) iterator(start, end []byte, ascending bool) types.Iterator {
+	r := &iterationRead{start: start, end: end, ascending: ascending}
+	s.iterations = append(s.iterations, r)
+	parent := newRecordingIterator(s.mvs.iterator(s.index, start, end, ascending), r)
+
+	var overlay []entryKV
+	for key, value := range s.writes {
+		if k := []byte(key); r.contains(k) {
+			overlay = append(overlay, entryKV{key: k, value: value})
+		}
+	}
+	sort.Slice(overlay, func(i, j int) bool { return bytes.Compare(overlay[i].key, overlay[j].key) < 0 })
+
+	return newMergeIterator(parent, overlay, ascending)
+}
+
+// CacheWrap implements types.KVStore.
+func (s *VersionIndexedStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements types.KVStore.
+func (s *VersionIndexedStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// recordingIterator records every item its parent iterator moves to.
+type recordingIterator struct {
+	types.Iterator
+	read *iterationRead
+}
+
+func newRecordingIterator(parent types.Iterator, read *iterationRead) *recordingIterator {
+	it := &recordingIterator{Iterator: parent, read: read}
+	it.record()
+	return it
+}
+
+func (it *recordingIterator) record() {
+	if !it.Iterator.Valid() {
+		it.read.exhausted = true
+		return
+	}
+	it.read.items = append(it.read.items, entryKV{key: it.Iterator.Key(), value: it.Iterator.Value()})
+}
+
+// Next implements types.Iterator.
+func (it *recordingIterator) Next() {
+	it.Iterator.Next()
+	it.record()
+}
+
+// equalValues returns true if both values are equal, telling a missing value apart
+// from an empty one.
+func equalValues(a, b []byte) bool {
+	return (a == nil) == (b == nil) && bytes.Equal(a, b)
+}
@@ -0,0 +1,341 @@
+package appendlog
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const consumersDir = "consumers"
+
+var (
+	// ErrNoBlock is returned by Reader.Next when no committed block follows the
+	// current offset yet.
+	ErrNoBlock = errors.New("no committed block available")
+	// ErrOffsetOutOfRange is returned when seeking to an offset whose segment
+	// was removed by the retention or which follows the next block to commit.
+	ErrOffsetOutOfRange = errors.New("offset out of range")
+	// ErrHeightNotFound is returned when seeking to a height above the last
+	// committed block.
+	ErrHeightNotFound = errors.New("height not found")
+)
+
+// Block is a committed block read from the log.
+type Block struct {
+	Height int64
+	// Offset is the offset of the first record of the block and NextOffset the
+	// offset following its commit record.
+	Offset     uint64
+	NextOffset uint64
+	Metadata   types.BlockMetadata
+	ChangeSet  []types.StoreKVPair
+}
+
+// Reader reads the committed blocks of a log in order. It can be used while a
+// Writer appends to the log, in which case Next returns ErrNoBlock until the
+// next block is committed.
+type Reader struct {
+	dir      string
+	consumer string // name the offset is committed under, if any
+
+	offset   uint64 // offset of the next block to read
+	segment  *os.File
+	position int64 // position of the next block in the segment
+}
+
+// NewReader returns a Reader positioned at the first retained block of the log
+// in dir.
+func NewReader(dir string) (*Reader, error) {
+	r := &Reader{dir: dir}
+	bases, err := listSegments(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bases) > 0 {
+		r.offset = bases[0]
+	}
+
+	return r, nil
+}
+
+// OpenConsumer returns a Reader committing its offset under the given consumer
+// name. It is positioned at the last offset committed under that name, or at
+// the first retained block if none.
+func OpenConsumer(dir, consumer string) (*Reader, error) {
+	if consumer == "" || filepath.Base(consumer) != consumer {
+		return nil, fmt.Errorf("invalid consumer name: %q", consumer)
+	}
+
+	r, err := NewReader(dir)
+	if err != nil {
+		return nil, err
+	}
+	r.consumer = consumer
+
+	bz, err := os.ReadFile(r.offsetPath())
+	switch {
+	case os.IsNotExist(err):
+		return r, nil
+	case err != nil:
+		return nil, err
+	case len(bz) != 8:
+		return nil, fmt.Errorf("invalid offset file: %s", r.offsetPath())
+	}
+
+	if err := r.SeekOffset(binary.BigEndian.Uint64(bz)); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *Reader) offsetPath() string {
+	return filepath.Join(r.dir, consumersDir, r.consumer+".offset")
+}
+
+// Offset returns the offset of the next block to read.
+func (r *Reader) Offset() uint64 {
+	return r.offset
+}
+
+// Commit persists the offset of the next block to read under the consumer name
+// of the Reader, so that a Reader opened with OpenConsumer resumes from it.
+func (r *Reader) Commit() error {
+	if r.consumer == "" {
+		return errors.New("reader has no consumer name")
+	}
+
+	if err := os.MkdirAll(filepath.Join(r.dir, consumersDir), 0o755); err != nil {
+		return err
+	}
+
+	// write to a temporary file and rename it so that the offset is replaced
+	// atomically
+	tmp := r.offsetPath() + ".tmp"
+	if err := os.WriteFile(tmp, binary.BigEndian.AppendUint64(nil, r.offset), 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, r.offsetPath())
+}
+
+// SeekOffset positions the Reader at the first block starting at or after the
+// given offset.
+func (r *Reader) SeekOffset(offset uint64) error {
+	bases, err := listSegments(r.dir)
+	if err != nil {
+		return err
+	}
+	if len(bases) == 0 || offset < bases[0] {
+		return fmt.Errorf("%w: %d", ErrOffsetOutOfRange, offset)
+	}
+
+	for i, base := range bases {
+		if i+1 < len(bases) && bases[i+1] <= offset {
+			continue
+		}
+
+		entries, err := readIndex(r.dir, base)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			if entry.offset >= offset {
+				return r.seek(base, entry)
+			}
+		}
+	}
+
+	// the offset follows the last indexed block, position the Reader after it
+	last := bases[len(bases)-1]
+	entries, err := readIndex(r.dir, last)
+	if err != nil {
+		return err
+	}
+
+	if len(entries) == 0 {
+		err = r.seek(last, indexEntry{offset: last})
+	} else {
+		err = r.seek(last, entries[len(entries)-1])
+		if err == nil {
+			var block *Block
+			if block, r.position, err = r.readBlock(); err == nil {
+				r.offset = block.NextOffset
+			}
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if offset != r.offset {
+		r.close()
+		return fmt.Errorf("%w: %d", ErrOffsetOutOfRange, offset)
+	}
+
+	return nil
+}
+
+// SeekHeight positions the Reader at the first block at or above the given
+// height.
+func (r *Reader) SeekHeight(height int64) error {
+	bases, err := listSegments(r.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, base := range bases {
+		entries, err := readIndex(r.dir, base)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			if entry.height >= height {
+				return r.seek(base, entry)
+			}
+		}
+	}
+
+	return fmt.Errorf("%w: %d", ErrHeightNotFound, height)
+}
+
+func (r *Reader) seek(base uint64, entry indexEntry) error {
+	r.close()
+
+	segment, err := os.Open(segmentPath(r.dir, base))
+	if err != nil {
+		return err
+	}
+
+	r.segment, r.position, r.offset = segment, entry.position, entry.offset
+	return nil
+}
+
+// Next returns the next committed block and advances the Reader past it. It
+// returns ErrNoBlock if the next block is not committed yet.
+func (r *Reader) Next() (*Block, error) {
+	if r.segment == nil {
+		if err := r.openNextSegment(); err != nil {
+			return nil, err
+		}
+	}
+
+	block, end, err := r.readBlock()
+	if err == nil {
+		r.position, r.offset = end, block.NextOffset
+		return block, nil
+	}
+	if err != ErrNoBlock {
+		return nil, err
+	}
+
+	// the segment ends with the last committed block, the next block may be at
+	// the start of the next segment
+	if err := r.openNextSegment(); err != nil {
+		return nil, err
+	}
+
+	block, end, err = r.readBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	r.position, r.offset = end, block.NextOffset
+	return block, nil
+}
+
+// openNextSegment opens the segment starting at the offset of the next block,
+// or returns ErrNoBlock if it does not exist yet.
+func (r *Reader) openNextSegment() error {
+	segment, err := os.Open(segmentPath(r.dir, r.offset))
+	if os.IsNotExist(err) {
+		if r.segment != nil {
+			return ErrNoBlock
+		}
+
+		bases, err := listSegments(r.dir)
+		if err != nil {
+			return err
+		}
+		if len(bases) == 0 {
+			return ErrNoBlock
+		}
+
+		// the offset is not at the start of a segment
+		return r.SeekOffset(r.offset)
+	}
+	if err != nil {
+		return err
+	}
+
+	r.close()
+	r.segment, r.position = segment, 0
+	return nil
+}
+
+// readBlock reads the block at the current position. It returns ErrNoBlock if
+// the block is not completely written.
+func (r *Reader) readBlock() (*Block, int64, error) {
+	if r.segment == nil {
+		return nil, 0, ErrNoBlock
+	}
+
+	info, err := r.segment.Stat()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	block := &Block{Offset: r.offset}
+	for pos := r.position; ; {
+		rec, err := readRecord(r.segment, pos, info.Size())
+		if err == errIncompleteRecord {
+			return nil, 0, ErrNoBlock
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		pos += rec.size()
+
+		block.Height = rec.height
+		switch rec.typ {
+		case RecordMetadata:
+			if err := block.Metadata.Unmarshal(rec.payload); err != nil {
+				return nil, 0, err
+			}
+
+		case RecordKVPair:
+			var pair types.StoreKVPair
+			if err := pair.Unmarshal(rec.payload); err != nil {
+				return nil, 0, err
+			}
+			block.ChangeSet = append(block.ChangeSet, pair)
+
+		case RecordCommit:
+			block.NextOffset = rec.offset + 1
+			return block, pos, nil
+
+		default:
+			return nil, 0, fmt.Errorf("unknown record type %d at offset %d", rec.typ, rec.offset)
+		}
+	}
+}
+
+func (r *Reader) close() {
+	if r.segment != nil {
+		r.segment.Close()
+		r.segment = nil
+	}
+}
+
+// Close closes the Reader.
+func (r *Reader) Close() error {
+	r.close()
+	return nil
+}
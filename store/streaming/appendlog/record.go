@@ -0,0 +1,103 @@
+package appendlog
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// RecordType is the type of a record in the log.
+type RecordType byte
+
+const (
+	// RecordMetadata holds the protobuf encoded BlockMetadata of a block.
+	RecordMetadata RecordType = iota + 1
+	// RecordKVPair holds a protobuf encoded StoreKVPair.
+	RecordKVPair
+	// RecordCommit marks the end of a block, a block without a commit record is
+	// not visible to readers.
+	RecordCommit
+)
+
+const (
+	// recordHeaderSize is the size of the offset, height, type and payload
+	// length of a record.
+	recordHeaderSize = 8 + 8 + 1 + 4
+	// recordChecksumSize is the size of the CRC-32 checksum ending a record.
+	recordChecksumSize = 4
+)
+
+// errIncompleteRecord is returned when a record is truncated or its checksum
+// does not match, i.e. it has not been completely written.
+var errIncompleteRecord = fmt.Errorf("incomplete record")
+
+// record is the unit of the log. Its binary layout is:
+//
+//	offset (8) | height (8) | type (1) | payload length (4) | payload | crc32 (4)
+//
+// with big endian integers and the CRC-32 (IEEE) checksum of the preceding
+// bytes.
+type record struct {
+	offset  uint64
+	height  int64
+	typ     RecordType
+	payload []byte
+}
+
+// size returns the encoded size of the record.
+func (r record) size() int64 {
+	return int64(recordHeaderSize + len(r.payload) + recordChecksumSize)
+}
+
+// appendRecord appends the encoded record to buf.
+func appendRecord(buf []byte, r record) []byte {
+	start := len(buf)
+	buf = binary.BigEndian.AppendUint64(buf, r.offset)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(r.height))
+	buf = append(buf, byte(r.typ))
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(r.payload)))
+	buf = append(buf, r.payload...)
+	return binary.BigEndian.AppendUint32(buf, crc32.ChecksumIEEE(buf[start:]))
+}
+
+// readRecord reads the record at the given position of a segment of the given
+// size. It returns errIncompleteRecord if the record is truncated or corrupted.
+func readRecord(src io.ReaderAt, pos, size int64) (record, error) {
+	if pos+recordHeaderSize+recordChecksumSize > size {
+		return record{}, errIncompleteRecord
+	}
+
+	header := make([]byte, recordHeaderSize)
+	if _, err := src.ReadAt(header, pos); err != nil {
+		if err == io.EOF {
+			return record{}, errIncompleteRecord
+		}
+		return record{}, err
+	}
+
+	length := binary.BigEndian.Uint32(header[17:])
+	if pos+recordHeaderSize+int64(length)+recordChecksumSize > size {
+		return record{}, errIncompleteRecord
+	}
+
+	body := make([]byte, int64(length)+recordChecksumSize)
+	if _, err := src.ReadAt(body, pos+recordHeaderSize); err != nil {
+		if err == io.EOF {
+			return record{}, errIncompleteRecord
+		}
+		return record{}, err
+	}
+
+	checksum := crc32.Update(crc32.ChecksumIEEE(header), crc32.IEEETable, body[:length])
+	if checksum != binary.BigEndian.Uint32(body[length:]) {
+		return record{}, errIncompleteRecord
+	}
+
+	return record{
+		offset:  binary.BigEndian.Uint64(header),
+		height:  int64(binary.BigEndian.Uint64(header[8:])),
+		typ:     RecordType(header[16]),
+		payload: body[:length],
+	}, nil
+}
@@ -0,0 +1,127 @@
+package appendlog
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	segmentExt = ".log"
+	indexExt   = ".index"
+
+	// indexEntrySize is the size of the height, offset and position of a block
+	// in the index of a segment.
+	indexEntrySize = 8 + 8 + 8
+)
+
+// indexEntry locates a committed block in a segment.
+type indexEntry struct {
+	height   int64
+	offset   uint64 // offset of the first record of the block
+	position int64  // position of the first record of the block in the segment
+}
+
+func appendIndexEntry(buf []byte, e indexEntry) []byte {
+	buf = binary.BigEndian.AppendUint64(buf, uint64(e.height))
+	buf = binary.BigEndian.AppendUint64(buf, e.offset)
+	return binary.BigEndian.AppendUint64(buf, uint64(e.position))
+}
+
+// segmentPath returns the path of the segment starting at the given offset.
+func segmentPath(dir string, baseOffset uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%020d%s", baseOffset, segmentExt))
+}
+
+// indexPath returns the path of the index of the segment starting at the given
+// offset.
+func indexPath(dir string, baseOffset uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%020d%s", baseOffset, indexExt))
+}
+
+// listSegments returns the base offsets of the segments in dir in ascending
+// order.
+func listSegments(dir string) ([]uint64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var bases []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+
+		base, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		bases = append(bases, base)
+	}
+
+	sort.Slice(bases, func(i, j int) bool { return bases[i] < bases[j] })
+	return bases, nil
+}
+
+// readIndex returns the entries of the index of a segment. A trailing partial
+// entry is ignored.
+func readIndex(dir string, baseOffset uint64) ([]indexEntry, error) {
+	bz, err := os.ReadFile(indexPath(dir, baseOffset))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]indexEntry, 0, len(bz)/indexEntrySize)
+	for i := 0; i+indexEntrySize <= len(bz); i += indexEntrySize {
+		entries = append(entries, indexEntry{
+			height:   int64(binary.BigEndian.Uint64(bz[i:])),
+			offset:   binary.BigEndian.Uint64(bz[i+8:]),
+			position: int64(binary.BigEndian.Uint64(bz[i+16:])),
+		})
+	}
+
+	return entries, nil
+}
+
+// scanSegment reads the committed blocks of a segment. It returns their index
+// entries and the position and offset following the last commit record, any
+// data after which belongs to an incomplete block.
+func scanSegment(f *os.File, baseOffset uint64) (entries []indexEntry, end int64, nextOffset uint64, err error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	var (
+		pos        int64
+		blockStart *indexEntry
+	)
+	nextOffset = baseOffset
+	for {
+		rec, err := readRecord(f, pos, info.Size())
+		if err == errIncompleteRecord {
+			return entries, end, nextOffset, nil
+		}
+		if err != nil {
+			return nil, 0, 0, err
+		}
+
+		if blockStart == nil {
+			blockStart = &indexEntry{height: rec.height, offset: rec.offset, position: pos}
+		}
+		pos += rec.size()
+
+		if rec.typ == RecordCommit {
+			entries = append(entries, *blockStart)
+			blockStart = nil
+			end = pos
+			nextOffset = rec.offset + 1
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package appendlog
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// StreamingService is a concrete implementation of StreamingService that
+// appends the state changes and ABCI messages of each block to a segmented log.
+type StreamingService struct {
+	storeListeners []*types.MemoryListener // a series of KVStore listeners for each KVStore
+	log            *Writer
+
+	currentBlockNumber int64
+	blockMetadata      types.BlockMetadata
+
+	// retainBlocks is the number of recent blocks to keep in the log, 0 to keep
+	// all blocks.
+	retainBlocks int64
+
+	// stopNodeOnErr, if true, will panic and stop the node during ABCI Commit
+	// to ensure every block is in the log, otherwise, any errors are ignored
+	// which could yield data loss in the log.
+	stopNodeOnErr bool
+}
+
+// NewStreamingService returns a StreamingService appending to the log in dir.
+func NewStreamingService(
+	dir string,
+	storeKeys []types.StoreKey,
+	segmentSize, retainBlocks int64,
+	fsync, stopNodeOnErr bool,
+) (*StreamingService, error) {
+	// sort storeKeys for deterministic output
+	sort.SliceStable(storeKeys, func(i, j int) bool {
+		return storeKeys[i].Name() < storeKeys[j].Name()
+	})
+
+	listeners := make([]*types.MemoryListener, len(storeKeys))
+	for i, key := range storeKeys {
+		listeners[i] = types.NewMemoryListener(key)
+	}
+
+	log, err := OpenWriter(dir, segmentSize, fsync)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StreamingService{
+		storeListeners: listeners,
+		log:            log,
+		retainBlocks:   retainBlocks,
+		stopNodeOnErr:  stopNodeOnErr,
+	}, nil
+}
+
+// Listeners satisfies the StreamingService interface. It returns the
+// StreamingService's underlying WriteListeners. Use for registering the
+// underlying WriteListeners with the BaseApp.
+func (lss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(lss.storeListeners))
+	for _, listener := range lss.storeListeners {
+		listeners[listener.StoreKey()] = []types.WriteListener{listener}
+	}
+
+	return listeners
+}
+
+// ListenBeginBlock satisfies the ABCIListener interface. It sets the received
+// BeginBlock request, response and the current block number. Note, these are
+// not written to the log until ListenCommit is executed.
+func (lss *StreamingService) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	lss.blockMetadata = types.BlockMetadata{
+		RequestBeginBlock:  &req,
+		ResponseBeginBlock: &res,
+	}
+	lss.currentBlockNumber = req.Header.Height
+	return nil
+}
+
+// ListenDeliverTx satisfies the ABCIListener interface. It appends the received
+// DeliverTx request and response to the metadata of the block.
+func (lss *StreamingService) ListenDeliverTx(ctx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	lss.blockMetadata.DeliverTxs = append(lss.blockMetadata.DeliverTxs, &types.BlockMetadata_DeliverTx{
+		Request:  &req,
+		Response: &res,
+	})
+
+	return nil
+}
+
+// ListenEndBlock satisfies the ABCIListener interface. It sets the received
+// EndBlock request and response.
+func (lss *StreamingService) ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	lss.blockMetadata.RequestEndBlock = &req
+	lss.blockMetadata.ResponseEndBlock = &res
+	return nil
+}
+
+// ListenCommit satisfies the ABCIListener interface. It is executed during the
+// ABCI Commit request and appends the block with its commit marker to the log.
+// It will only return a non-nil error when stopNodeOnErr is set.
+func (lss *StreamingService) ListenCommit(ctx context.Context, res abci.ResponseCommit) error {
+	if err := lss.doListenCommit(res); err != nil {
+		if lss.stopNodeOnErr {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (lss *StreamingService) doListenCommit(res abci.ResponseCommit) error {
+	lss.blockMetadata.ResponseCommit = &res
+
+	var changeSet []types.StoreKVPair
+	for _, listener := range lss.storeListeners {
+		changeSet = append(changeSet, listener.PopStateCache()...)
+	}
+
+	if err := lss.log.AppendBlock(lss.currentBlockNumber, &lss.blockMetadata, changeSet); err != nil {
+		return err
+	}
+
+	if lss.retainBlocks > 0 {
+		return lss.log.Prune(lss.currentBlockNumber - lss.retainBlocks + 1)
+	}
+
+	return nil
+}
+
+// Stream satisfies the StreamingService interface. It performs a no-op.
+func (lss *StreamingService) Stream(wg *sync.WaitGroup) error { return nil }
+
+// Close satisfies the StreamingService interface. It closes the log.
+func (lss *StreamingService) Close() error { return lss.log.Close() }
@@ -0,0 +1,75 @@
+package appendlog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAppendLogStreamingService(t *testing.T) {
+	dir := t.TempDir()
+	mockStoreKey1 := sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 := sdk.NewKVStoreKey("mockStore2")
+
+	service, err := NewStreamingService(dir, []types.StoreKey{mockStoreKey2, mockStoreKey1}, 0, 1, false, true)
+	require.NoError(t, err)
+	listeners := service.Listeners()
+	require.Len(t, listeners, 2)
+
+	ctx := context.Background()
+	deliverTxReq := abci.RequestDeliverTx{Tx: []byte{1, 2, 3}}
+	deliverTxRes := abci.ResponseDeliverTx{Code: 1, Log: "mockLog"}
+	listenBlock := func(height int64) {
+		require.NoError(t, service.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{}))
+		listeners[mockStoreKey2][0].OnWrite(mockStoreKey2, []byte{2}, []byte{byte(height)}, false)
+		listeners[mockStoreKey1][0].OnWrite(mockStoreKey1, []byte{1}, nil, true)
+		require.NoError(t, service.ListenDeliverTx(ctx, deliverTxReq, deliverTxRes))
+		require.NoError(t, service.ListenEndBlock(ctx, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+		require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}))
+	}
+
+	listenBlock(1)
+
+	r, err := NewReader(dir)
+	require.NoError(t, err)
+	block, err := r.Next()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), block.Height)
+	require.Len(t, block.Metadata.DeliverTxs, 1)
+	require.Equal(t, deliverTxReq, *block.Metadata.DeliverTxs[0].Request)
+	require.Equal(t, deliverTxRes, *block.Metadata.DeliverTxs[0].Response)
+	require.Equal(t, abci.RequestEndBlock{Height: 1}, *block.Metadata.RequestEndBlock)
+
+	// the change set is grouped by the sorted store keys
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: mockStoreKey1.Name(), Key: []byte{1}, Delete: true},
+		{StoreKey: mockStoreKey2.Name(), Key: []byte{2}, Value: []byte{1}},
+	}, block.ChangeSet)
+
+	// the metadata of the previous block is reset
+	listenBlock(2)
+	block, err = r.Next()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), block.Height)
+	require.Len(t, block.Metadata.DeliverTxs, 1)
+	require.NoError(t, service.Close())
+
+	// a restarted node replaying a committed block does not write it again
+	service, err = NewStreamingService(dir, []types.StoreKey{mockStoreKey2, mockStoreKey1}, 0, 1, false, true)
+	require.NoError(t, err)
+	listeners = service.Listeners()
+	listenBlock(2)
+	listenBlock(3)
+	block, err = r.Next()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), block.Height)
+	_, err = r.Next()
+	require.ErrorIs(t, err, ErrNoBlock)
+	require.NoError(t, service.Close())
+}
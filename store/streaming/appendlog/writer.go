@@ -0,0 +1,280 @@
+package appendlog
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultSegmentSize is the default size after which a new segment is started.
+const DefaultSegmentSize = 64 << 20
+
+// segmentInfo holds the offset and the height range of a segment.
+type segmentInfo struct {
+	baseOffset  uint64
+	firstHeight int64 // 0 if the segment holds no block
+	lastHeight  int64
+}
+
+// Writer appends blocks to a log of segment files in a directory. Each block is
+// written as its metadata record, its state change records and a commit record,
+// and is indexed by height once committed. The data of a block interrupted by a
+// crash is truncated when the log is opened again.
+type Writer struct {
+	dir         string
+	segmentSize int64
+	fsync       bool
+
+	segments []segmentInfo // the last segment is the active one
+	log      *os.File
+	index    *os.File
+	size     int64 // size of the active segment
+
+	nextOffset uint64
+	lastHeight int64
+}
+
+// OpenWriter opens the log in dir for appending, creating it if needed. A new
+// segment is started once the active one reaches segmentSize bytes and, if
+// fsync is set, every block is synced to disk before it is indexed.
+func OpenWriter(dir string, segmentSize int64, fsync bool) (*Writer, error) {
+	if segmentSize <= 0 {
+		segmentSize = DefaultSegmentSize
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	bases, err := listSegments(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Writer{dir: dir, segmentSize: segmentSize, fsync: fsync}
+	if len(bases) == 0 {
+		return w, w.openSegment(0)
+	}
+
+	for _, base := range bases[:len(bases)-1] {
+		entries, err := readIndex(dir, base)
+		if err != nil {
+			return nil, err
+		}
+
+		w.segments = append(w.segments, newSegmentInfo(base, entries))
+	}
+
+	if err := w.recoverSegment(bases[len(bases)-1]); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func newSegmentInfo(base uint64, entries []indexEntry) segmentInfo {
+	info := segmentInfo{baseOffset: base}
+	if len(entries) > 0 {
+		info.firstHeight = entries[0].height
+		info.lastHeight = entries[len(entries)-1].height
+	}
+
+	return info
+}
+
+// recoverSegment opens the last segment for appending. It truncates the data
+// of an incomplete block and rebuilds the index from the committed blocks.
+func (w *Writer) recoverSegment(base uint64) error {
+	log, err := os.OpenFile(segmentPath(w.dir, base), os.O_RDWR, 0o600)
+	if err != nil {
+		return err
+	}
+
+	entries, end, nextOffset, err := scanSegment(log, base)
+	if err != nil {
+		log.Close()
+		return err
+	}
+
+	if err := log.Truncate(end); err != nil {
+		log.Close()
+		return sdkerrors.Wrapf(err, "truncate incomplete block failed: %s", log.Name())
+	}
+
+	var buf []byte
+	for _, entry := range entries {
+		buf = appendIndexEntry(buf, entry)
+	}
+
+	if err := os.WriteFile(indexPath(w.dir, base), buf, 0o600); err != nil {
+		log.Close()
+		return err
+	}
+
+	index, err := os.OpenFile(indexPath(w.dir, base), os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		log.Close()
+		return err
+	}
+
+	info := newSegmentInfo(base, entries)
+	w.segments = append(w.segments, info)
+	w.log, w.index, w.size, w.nextOffset = log, index, end, nextOffset
+	for i := len(w.segments) - 1; i >= 0 && w.lastHeight == 0; i-- {
+		w.lastHeight = w.segments[i].lastHeight
+	}
+
+	return nil
+}
+
+// openSegment creates a new active segment starting at the given offset.
+func (w *Writer) openSegment(base uint64) error {
+	log, err := os.OpenFile(segmentPath(w.dir, base), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+
+	index, err := os.OpenFile(indexPath(w.dir, base), os.O_WRONLY|os.O_CREATE|os.O_TRUNC|os.O_APPEND, 0o600)
+	if err != nil {
+		log.Close()
+		return err
+	}
+
+	w.segments = append(w.segments, segmentInfo{baseOffset: base})
+	w.log, w.index, w.size, w.nextOffset = log, index, 0, base
+	return nil
+}
+
+// LastHeight returns the height of the last committed block, or 0 if the log
+// is empty.
+func (w *Writer) LastHeight() int64 {
+	return w.lastHeight
+}
+
+// AppendBlock appends a block to the log and commits it. Blocks at or below the
+// last committed height were already written, e.g. before the node restarted
+// and replayed them, and are skipped so that every block is in the log exactly
+// once. An error is returned if the log is not empty and the block does not
+// follow the last committed block, as the blocks in between would be missing.
+func (w *Writer) AppendBlock(height int64, metadata *types.BlockMetadata, changeSet []types.StoreKVPair) error {
+	if height <= w.lastHeight {
+		return nil
+	}
+	if w.lastHeight > 0 && height != w.lastHeight+1 {
+		return fmt.Errorf("cannot append height %d, the last committed height is %d", height, w.lastHeight)
+	}
+
+	var (
+		buf    []byte
+		offset = w.nextOffset
+	)
+	appendPayload := func(typ RecordType, payload []byte) {
+		buf = appendRecord(buf, record{offset: offset, height: height, typ: typ, payload: payload})
+		offset++
+	}
+
+	bz, err := metadata.Marshal()
+	if err != nil {
+		return err
+	}
+	appendPayload(RecordMetadata, bz)
+
+	for i := range changeSet {
+		bz, err := changeSet[i].Marshal()
+		if err != nil {
+			return err
+		}
+		appendPayload(RecordKVPair, bz)
+	}
+	appendPayload(RecordCommit, nil)
+
+	if err := w.write(buf); err != nil {
+		return err
+	}
+
+	entry := indexEntry{height: height, offset: w.nextOffset, position: w.size}
+	if _, err := w.index.Write(appendIndexEntry(nil, entry)); err != nil {
+		return sdkerrors.Wrapf(err, "write index failed: %s", w.index.Name())
+	}
+
+	active := &w.segments[len(w.segments)-1]
+	if active.firstHeight == 0 {
+		active.firstHeight = height
+	}
+	active.lastHeight = height
+	w.size += int64(len(buf))
+	w.nextOffset = offset
+	w.lastHeight = height
+
+	if w.size >= w.segmentSize {
+		return w.roll()
+	}
+
+	return nil
+}
+
+// write writes the records of a block at the end of the active segment. On
+// failure, the segment is truncated so that the next block is appended after
+// the last committed one.
+func (w *Writer) write(buf []byte) error {
+	if _, err := w.log.WriteAt(buf, w.size); err != nil {
+		_ = w.log.Truncate(w.size)
+		return sdkerrors.Wrapf(err, "write block failed: %s", w.log.Name())
+	}
+
+	if w.fsync {
+		if err := w.log.Sync(); err != nil {
+			_ = w.log.Truncate(w.size)
+			return sdkerrors.Wrapf(err, "fsync failed: %s", w.log.Name())
+		}
+	}
+
+	return nil
+}
+
+// roll closes the active segment and starts a new one.
+func (w *Writer) roll() error {
+	if err := w.closeSegment(); err != nil {
+		return err
+	}
+
+	return w.openSegment(w.nextOffset)
+}
+
+func (w *Writer) closeSegment() error {
+	// the index of a closed segment is not rebuilt when the log is opened, so it
+	// is always synced
+	if err := w.index.Sync(); err != nil {
+		return err
+	}
+	if err := w.index.Close(); err != nil {
+		return err
+	}
+
+	return w.log.Close()
+}
+
+// Prune removes the segments whose blocks are all below retainHeight. The
+// active segment is never removed.
+func (w *Writer) Prune(retainHeight int64) error {
+	for len(w.segments) > 1 && w.segments[0].lastHeight < retainHeight {
+		base := w.segments[0].baseOffset
+		if err := os.Remove(segmentPath(w.dir, base)); err != nil {
+			return err
+		}
+		if err := os.Remove(indexPath(w.dir, base)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		w.segments = w.segments[1:]
+	}
+
+	return nil
+}
+
+// Close closes the active segment.
+func (w *Writer) Close() error {
+	return w.closeSegment()
+}
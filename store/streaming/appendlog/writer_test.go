@@ -0,0 +1,207 @@
+package appendlog
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func testBlock(height int64) (*types.BlockMetadata, []types.StoreKVPair) {
+	metadata := &types.BlockMetadata{
+		RequestBeginBlock: &abci.RequestBeginBlock{Header: tmproto.Header{Height: height}},
+		ResponseCommit:    &abci.ResponseCommit{Data: []byte{byte(height)}},
+	}
+	changeSet := []types.StoreKVPair{
+		{StoreKey: "store", Key: []byte{byte(height)}, Value: []byte{1}},
+		{StoreKey: "store", Key: []byte{byte(height), 1}, Delete: true},
+	}
+
+	return metadata, changeSet
+}
+
+func appendBlocks(t *testing.T, w *Writer, from, to int64) {
+	for height := from; height <= to; height++ {
+		metadata, changeSet := testBlock(height)
+		require.NoError(t, w.AppendBlock(height, metadata, changeSet))
+	}
+}
+
+func readHeights(t *testing.T, r *Reader) []int64 {
+	var heights []int64
+	for {
+		block, err := r.Next()
+		if err == ErrNoBlock {
+			return heights
+		}
+		require.NoError(t, err)
+		heights = append(heights, block.Height)
+	}
+}
+
+func TestWriterReader(t *testing.T) {
+	dir := t.TempDir()
+
+	r, err := NewReader(dir)
+	require.NoError(t, err)
+	_, err = r.Next()
+	require.ErrorIs(t, err, ErrNoBlock)
+
+	w, err := OpenWriter(dir, 0, true)
+	require.NoError(t, err)
+	appendBlocks(t, w, 1, 2)
+
+	block, err := r.Next()
+	require.NoError(t, err)
+	metadata, changeSet := testBlock(1)
+	require.Equal(t, int64(1), block.Height)
+	require.Equal(t, uint64(0), block.Offset)
+	require.Equal(t, uint64(4), block.NextOffset)
+	require.Equal(t, *metadata, block.Metadata)
+	require.Equal(t, changeSet, block.ChangeSet)
+
+	// blocks appended while reading become visible once committed
+	appendBlocks(t, w, 3, 3)
+	require.Equal(t, []int64{2, 3}, readHeights(t, r))
+	require.Equal(t, uint64(12), r.Offset())
+
+	// replayed blocks are skipped
+	appendBlocks(t, w, 2, 4)
+	require.Equal(t, []int64{4}, readHeights(t, r))
+	require.NoError(t, w.Close())
+	require.NoError(t, r.Close())
+}
+
+func TestWriterRejectsMissingBlocks(t *testing.T) {
+	w, err := OpenWriter(t.TempDir(), 0, false)
+	require.NoError(t, err)
+
+	// the first block of an empty log may start at any height
+	appendBlocks(t, w, 5, 6)
+
+	// but the following blocks must not leave a gap
+	metadata, changeSet := testBlock(8)
+	require.Error(t, w.AppendBlock(8, metadata, changeSet))
+	require.Equal(t, int64(6), w.LastHeight())
+	appendBlocks(t, w, 7, 8)
+	require.Equal(t, int64(8), w.LastHeight())
+	require.NoError(t, w.Close())
+}
+
+func TestWriterRecoversIncompleteBlock(t *testing.T) {
+	dir := t.TempDir()
+
+	w, err := OpenWriter(dir, 0, false)
+	require.NoError(t, err)
+	appendBlocks(t, w, 1, 2)
+	require.NoError(t, w.Close())
+
+	// simulate a crash in the middle of a block and of its index entry
+	metadata, _ := testBlock(3)
+	bz, err := metadata.Marshal()
+	require.NoError(t, err)
+	partial := appendRecord(nil, record{offset: 8, height: 3, typ: RecordMetadata, payload: bz})
+	appendToFile(t, segmentPath(dir, 0), partial[:len(partial)-1])
+	appendToFile(t, indexPath(dir, 0), []byte{0, 0, 0})
+
+	r, err := NewReader(dir)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, readHeights(t, r))
+
+	w, err = OpenWriter(dir, 0, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), w.LastHeight())
+	appendBlocks(t, w, 3, 3)
+	require.Equal(t, []int64{3}, readHeights(t, r))
+
+	entries, err := readIndex(dir, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.NoError(t, w.Close())
+}
+
+func TestSegmentsAndRetention(t *testing.T) {
+	dir := t.TempDir()
+
+	// every block starts a new segment
+	w, err := OpenWriter(dir, 1, false)
+	require.NoError(t, err)
+	appendBlocks(t, w, 1, 5)
+
+	bases, err := listSegments(dir)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 4, 8, 12, 16, 20}, bases)
+
+	r, err := NewReader(dir)
+	require.NoError(t, err)
+	require.NoError(t, r.SeekHeight(3))
+	require.Equal(t, []int64{3, 4, 5}, readHeights(t, r))
+	require.ErrorIs(t, r.SeekHeight(6), ErrHeightNotFound)
+
+	require.NoError(t, w.Prune(3))
+	bases, err = listSegments(dir)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{8, 12, 16, 20}, bases)
+	require.ErrorIs(t, r.SeekOffset(4), ErrOffsetOutOfRange)
+
+	require.NoError(t, r.SeekOffset(10))
+	require.Equal(t, []int64{4, 5}, readHeights(t, r))
+
+	// the writer resumes after the last segment
+	require.NoError(t, w.Close())
+	w, err = OpenWriter(dir, 1, false)
+	require.NoError(t, err)
+	appendBlocks(t, w, 6, 6)
+	require.Equal(t, []int64{6}, readHeights(t, r))
+	require.NoError(t, w.Close())
+}
+
+func TestConsumerOffsets(t *testing.T) {
+	dir := t.TempDir()
+
+	w, err := OpenWriter(dir, 0, false)
+	require.NoError(t, err)
+	appendBlocks(t, w, 1, 3)
+
+	_, err = OpenConsumer(dir, "../indexer")
+	require.Error(t, err)
+
+	r, err := OpenConsumer(dir, "indexer")
+	require.NoError(t, err)
+	block, err := r.Next()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), block.Height)
+	require.NoError(t, r.Commit())
+	require.NoError(t, r.Close())
+
+	// the consumer resumes after the committed block, also at the end of the
+	// log
+	r, err = OpenConsumer(dir, "indexer")
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 3}, readHeights(t, r))
+	require.NoError(t, r.Commit())
+	require.NoError(t, r.Close())
+
+	r, err = OpenConsumer(dir, "indexer")
+	require.NoError(t, err)
+	appendBlocks(t, w, 4, 4)
+	require.Equal(t, []int64{4}, readHeights(t, r))
+
+	// readers without a consumer name cannot commit
+	r, err = NewReader(dir)
+	require.NoError(t, err)
+	require.Error(t, r.Commit())
+	require.NoError(t, w.Close())
+}
+
+func appendToFile(t *testing.T, path string, bz []byte) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o600)
+	require.NoError(t, err)
+	_, err = f.Write(bz)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
@@ -6,12 +6,15 @@ import (
 	"path"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/appendlog"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/grpc"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -27,6 +30,8 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
+	GRPC
+	AppendLog
 )
 
 // Streaming option keys
@@ -37,6 +42,16 @@ const (
 	OptStreamersFileStopNodeOnError = "streamers.file.stop-node-on-error"
 	OptStreamersFileFsync           = "streamers.file.fsync"
 
+	OptStreamersGRPCAddress         = "streamers.grpc.address"
+	OptStreamersGRPCStopNodeOnError = "streamers.grpc.stop-node-on-error"
+	OptStreamersGRPCTimeout         = "streamers.grpc.timeout"
+
+	OptStreamersAppendLogDir             = "streamers.appendlog.dir"
+	OptStreamersAppendLogSegmentSize     = "streamers.appendlog.segment-size"
+	OptStreamersAppendLogRetainBlocks    = "streamers.appendlog.retain-blocks"
+	OptStreamersAppendLogFsync           = "streamers.appendlog.fsync"
+	OptStreamersAppendLogStopNodeOnError = "streamers.appendlog.stop-node-on-error"
+
 	OptStoreStreamers = "store.streamers"
 )
 
@@ -47,6 +62,12 @@ func ServiceTypeFromString(name string) ServiceType {
 	case "file", "f":
 		return File
 
+	case "grpc", "g":
+		return GRPC
+
+	case "appendlog", "log":
+		return AppendLog
+
 	default:
 		return Unknown
 	}
@@ -58,6 +79,12 @@ func (sst ServiceType) String() string {
 	case File:
 		return "file"
 
+	case GRPC:
+		return "grpc"
+
+	case AppendLog:
+		return "appendlog"
+
 	default:
 		return "unknown"
 	}
@@ -66,7 +93,9 @@ func (sst ServiceType) String() string {
 // ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to
 // streaming.ServiceConstructors types.
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
-	File: NewFileStreamingService,
+	File:      NewFileStreamingService,
+	GRPC:      NewGRPCStreamingService,
+	AppendLog: NewAppendLogStreamingService,
 }
 
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding
@@ -113,6 +142,42 @@ func NewFileStreamingService(
 	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller, outputMetadata, stopNodeOnErr, fsync)
 }
 
+// NewGRPCStreamingService is the streaming.ServiceConstructor function for
+// creating a GRPCStreamingService.
+func NewGRPCStreamingService(
+	opts serverTypes.AppOptions,
+	keys []types.StoreKey,
+	_ codec.BinaryCodec,
+) (baseapp.StreamingService, error) {
+	address := cast.ToString(opts.Get(OptStreamersGRPCAddress))
+	stopNodeOnErr := cast.ToBool(opts.Get(OptStreamersGRPCStopNodeOnError))
+	timeout := time.Duration(cast.ToUint(opts.Get(OptStreamersGRPCTimeout))) * time.Millisecond
+
+	return grpc.NewStreamingService(address, keys, stopNodeOnErr, timeout)
+}
+
+// NewAppendLogStreamingService is the streaming.ServiceConstructor function for
+// creating an AppendLogStreamingService.
+func NewAppendLogStreamingService(
+	opts serverTypes.AppOptions,
+	keys []types.StoreKey,
+	_ codec.BinaryCodec,
+) (baseapp.StreamingService, error) {
+	homePath := cast.ToString(opts.Get(flags.FlagHome))
+	logDir := cast.ToString(opts.Get(OptStreamersAppendLogDir))
+	segmentSize := cast.ToInt64(opts.Get(OptStreamersAppendLogSegmentSize))
+	retainBlocks := cast.ToInt64(opts.Get(OptStreamersAppendLogRetainBlocks))
+	fsync := cast.ToBool(opts.Get(OptStreamersAppendLogFsync))
+	stopNodeOnErr := cast.ToBool(opts.Get(OptStreamersAppendLogStopNodeOnError))
+
+	// relative path is based on node home directory.
+	if !path.IsAbs(logDir) {
+		logDir = path.Join(homePath, logDir)
+	}
+
+	return appendlog.NewStreamingService(logDir, keys, segmentSize, retainBlocks, fsync, stopNodeOnErr)
+}
+
 // LoadStreamingServices is a function for loading StreamingServices onto the
 // BaseApp using the provided AppOptions, codec, and keys. It returns the
 // WaitGroup and quit channel used to synchronize with the streaming services
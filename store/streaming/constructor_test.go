@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/store/streaming"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/grpc"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -25,6 +26,9 @@ func (f *fakeOptions) Get(key string) interface{} {
 		return "data/file_streamer"
 
 	}
+	if key == "streamers.grpc.address" {
+		return "localhost:9191"
+	}
 	return nil
 }
 
@@ -54,6 +58,20 @@ func TestStreamingServiceConstructor(t *testing.T) {
 	}
 }
 
+func TestGRPCStreamingServiceConstructor(t *testing.T) {
+	require.Equal(t, streaming.GRPC, streaming.ServiceTypeFromString("grpc"))
+	require.Equal(t, "grpc", streaming.GRPC.String())
+
+	constructor, err := streaming.NewServiceConstructor("grpc")
+	require.Nil(t, err)
+
+	serv, err := constructor(mockOptions, mockKeys, testMarshaller)
+	require.Nil(t, err)
+	require.IsType(t, &grpc.StreamingService{}, serv)
+	require.Len(t, serv.Listeners(), len(mockKeys))
+	require.Nil(t, serv.Close())
+}
+
 func TestLoadStreamingServices(t *testing.T) {
 	db := dbm.NewMemDB()
 	encCdc := simapp.MakeTestEncodingConfig()
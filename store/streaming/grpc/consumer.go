@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ ABCIListenerServiceServer = &Consumer{}
+
+// Block holds the ABCI messages and state changes of a block received by the
+// Consumer.
+type Block struct {
+	Metadata  types.BlockMetadata
+	ChangeSet []*types.StoreKVPair
+}
+
+// Consumer is a reference implementation of the ABCIListenerService which keeps
+// the blocks it receives in memory. It is meant for tests and as a starting
+// point for consumers of the grpc streaming service; it must be registered with
+// a gRPC server using RegisterABCIListenerServiceServer.
+type Consumer struct {
+	mtx     sync.Mutex
+	current Block
+	blocks  []Block
+}
+
+// NewConsumer returns an empty Consumer.
+func NewConsumer() *Consumer {
+	return &Consumer{}
+}
+
+// ListenBeginBlock implements the ABCIListenerService interface. It starts a new
+// block.
+func (c *Consumer) ListenBeginBlock(_ context.Context, req *ListenBeginBlockRequest) (*ListenBeginBlockResponse, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.current = Block{}
+	c.current.Metadata.RequestBeginBlock = req.Req
+	c.current.Metadata.ResponseBeginBlock = req.Res
+	return &ListenBeginBlockResponse{}, nil
+}
+
+// ListenDeliverTx implements the ABCIListenerService interface.
+func (c *Consumer) ListenDeliverTx(_ context.Context, req *ListenDeliverTxRequest) (*ListenDeliverTxResponse, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.current.Metadata.DeliverTxs = append(c.current.Metadata.DeliverTxs, &types.BlockMetadata_DeliverTx{
+		Request:  req.Req,
+		Response: req.Res,
+	})
+	return &ListenDeliverTxResponse{}, nil
+}
+
+// ListenEndBlock implements the ABCIListenerService interface.
+func (c *Consumer) ListenEndBlock(_ context.Context, req *ListenEndBlockRequest) (*ListenEndBlockResponse, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.current.Metadata.RequestEndBlock = req.Req
+	c.current.Metadata.ResponseEndBlock = req.Res
+	return &ListenEndBlockResponse{}, nil
+}
+
+// ListenCommit implements the ABCIListenerService interface. It completes the
+// current block.
+func (c *Consumer) ListenCommit(_ context.Context, req *ListenCommitRequest) (*ListenCommitResponse, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.current.Metadata.ResponseCommit = req.Res
+	c.current.ChangeSet = req.ChangeSet
+	c.blocks = append(c.blocks, c.current)
+	c.current = Block{}
+	return &ListenCommitResponse{}, nil
+}
+
+// Blocks returns the blocks committed so far.
+func (c *Consumer) Blocks() []Block {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return append([]Block(nil), c.blocks...)
+}
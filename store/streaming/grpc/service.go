@@ -0,0 +1,192 @@
+package grpc
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// DefaultTimeout is the default timeout of each call to the consumer.
+const DefaultTimeout = 5 * time.Second
+
+// StreamingService is a concrete implementation of StreamingService that pushes
+// state changes and ABCI messages to an out-of-process consumer implementing
+// the ABCIListenerService over gRPC.
+type StreamingService struct {
+	storeListeners []*types.MemoryListener // a series of KVStore listeners for each KVStore
+	address        string                  // address of the consumer
+	conn           *grpc.ClientConn
+	client         ABCIListenerServiceClient
+
+	currentBlockNumber int64
+	// skippedBlockNumber is the height of the last block whose remaining messages
+	// are skipped, as a call to the consumer timed out.
+	skippedBlockNumber int64
+
+	// timeout is the timeout of each call to the consumer, so that a hung consumer
+	// does not block the processing of the blocks.
+	timeout time.Duration
+
+	// stopNodeOnErr, if true, will return the errors of the consumer to the
+	// BaseApp, which panics and stops the node, to ensure every block is
+	// delivered, otherwise, any errors are ignored which could yield data loss
+	// in the streamed output.
+	stopNodeOnErr bool
+}
+
+// NewStreamingService returns a StreamingService connected to the consumer at
+// the given address. The connection is established lazily, so the consumer does
+// not need to be running when the node starts. A non-positive timeout defaults
+// to DefaultTimeout.
+func NewStreamingService(
+	address string,
+	storeKeys []types.StoreKey,
+	stopNodeOnErr bool,
+	timeout time.Duration,
+) (*StreamingService, error) {
+	if address == "" {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "grpc streaming service address cannot be empty")
+	}
+
+	// sort storeKeys for deterministic output
+	sort.SliceStable(storeKeys, func(i, j int) bool {
+		return storeKeys[i].Name() < storeKeys[j].Name()
+	})
+
+	listeners := make([]*types.MemoryListener, len(storeKeys))
+	for i, key := range storeKeys {
+		listeners[i] = types.NewMemoryListener(key)
+	}
+
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "dial grpc streaming consumer failed: %s", address)
+	}
+
+	return &StreamingService{
+		storeListeners: listeners,
+		address:        address,
+		conn:           conn,
+		client:         NewABCIListenerServiceClient(conn),
+		timeout:        timeout,
+		stopNodeOnErr:  stopNodeOnErr,
+	}, nil
+}
+
+// Listeners satisfies the StreamingService interface. It returns the
+// StreamingService's underlying WriteListeners. Use for registering the
+// underlying WriteListeners with the BaseApp.
+func (gss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(gss.storeListeners))
+	for _, listener := range gss.storeListeners {
+		listeners[listener.StoreKey()] = []types.WriteListener{listener}
+	}
+
+	return listeners
+}
+
+// ListenBeginBlock satisfies the ABCIListener interface. It sends the received
+// BeginBlock request and response to the consumer and sets the current block
+// number.
+func (gss *StreamingService) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	gss.currentBlockNumber = req.Header.Height
+
+	return gss.call(ctx, "ListenBeginBlock", func(ctx context.Context) error {
+		_, err := gss.client.ListenBeginBlock(ctx, &ListenBeginBlockRequest{Req: &req, Res: &res})
+		return err
+	})
+}
+
+// ListenDeliverTx satisfies the ABCIListener interface. It sends the received
+// DeliverTx request and response to the consumer.
+func (gss *StreamingService) ListenDeliverTx(ctx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	return gss.call(ctx, "ListenDeliverTx", func(ctx context.Context) error {
+		_, err := gss.client.ListenDeliverTx(ctx, &ListenDeliverTxRequest{
+			BlockHeight: gss.currentBlockNumber,
+			Req:         &req,
+			Res:         &res,
+		})
+		return err
+	})
+}
+
+// ListenEndBlock satisfies the ABCIListener interface. It sends the received
+// EndBlock request and response to the consumer.
+func (gss *StreamingService) ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return gss.call(ctx, "ListenEndBlock", func(ctx context.Context) error {
+		_, err := gss.client.ListenEndBlock(ctx, &ListenEndBlockRequest{Req: &req, Res: &res})
+		return err
+	})
+}
+
+// ListenCommit satisfies the ABCIListener interface. It sends the Commit
+// response together with the state changes of the block to the consumer. The
+// state changes are dropped even if they could not be delivered.
+func (gss *StreamingService) ListenCommit(ctx context.Context, res abci.ResponseCommit) error {
+	var changeSet []*types.StoreKVPair
+	for _, listener := range gss.storeListeners {
+		cache := listener.PopStateCache()
+		for i := range cache {
+			changeSet = append(changeSet, &cache[i])
+		}
+	}
+
+	return gss.call(ctx, "ListenCommit", func(ctx context.Context) error {
+		_, err := gss.client.ListenCommit(ctx, &ListenCommitRequest{
+			BlockHeight: gss.currentBlockNumber,
+			Res:         &res,
+			ChangeSet:   changeSet,
+		})
+		return err
+	})
+}
+
+// call calls the consumer with the timeout of the service. The error of the
+// consumer is wrapped if stopNodeOnErr is set and ignored otherwise, in which
+// case the remaining messages of the block are skipped once a call timed out.
+func (gss *StreamingService) call(ctx context.Context, method string, fn func(context.Context) error) error {
+	if !gss.stopNodeOnErr && gss.skippedBlockNumber == gss.currentBlockNumber {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gss.timeout)
+	defer cancel()
+
+	err := fn(ctx)
+	if err == nil {
+		return nil
+	}
+	if !gss.stopNodeOnErr {
+		if status.Code(err) == codes.DeadlineExceeded {
+			gss.skippedBlockNumber = gss.currentBlockNumber
+		}
+		return nil
+	}
+
+	return sdkerrors.Wrapf(err, "%s failed: %s", method, gss.address)
+}
+
+// Stream satisfies the StreamingService interface. It performs a no-op as the
+// messages are sent synchronously with the ABCI message processing.
+func (gss *StreamingService) Stream(wg *sync.WaitGroup) error { return nil }
+
+// Close satisfies the StreamingService interface. It closes the connection to
+// the consumer.
+func (gss *StreamingService) Close() error { return gss.conn.Close() }
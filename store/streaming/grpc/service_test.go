@@ -0,0 +1,172 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+
+	testBeginBlockReq = abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}}
+	testBeginBlockRes = abci.ResponseBeginBlock{Events: []abci.Event{{Type: "testEventType1"}}}
+	testDeliverTxReq  = abci.RequestDeliverTx{Tx: []byte{9, 8, 7}}
+	testDeliverTxRes  = abci.ResponseDeliverTx{Code: 1, Log: "mockLog", GasUsed: 2}
+	testEndBlockReq   = abci.RequestEndBlock{Height: 1}
+	testEndBlockRes   = abci.ResponseEndBlock{Events: []abci.Event{}}
+	testCommitRes     = abci.ResponseCommit{Data: []byte{1}}
+)
+
+// startConsumer serves a Consumer on a random local port and returns it with
+// its address.
+func startConsumer(t *testing.T) (*Consumer, string) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	consumer := NewConsumer()
+	server := grpc.NewServer()
+	RegisterABCIListenerServiceServer(server, consumer)
+	go server.Serve(lis) //nolint:errcheck
+	t.Cleanup(server.Stop)
+
+	return consumer, lis.Addr().String()
+}
+
+func TestGRPCStreamingService(t *testing.T) {
+	consumer, address := startConsumer(t)
+
+	_, err := NewStreamingService("", nil, false, 0)
+	require.Error(t, err)
+
+	service, err := NewStreamingService(address, []types.StoreKey{mockStoreKey2, mockStoreKey1}, true, 0)
+	require.NoError(t, err)
+	defer service.Close()
+
+	listeners := service.Listeners()
+	require.Len(t, listeners, 2)
+	listener1 := listeners[mockStoreKey1][0]
+	listener2 := listeners[mockStoreKey2][0]
+
+	ctx := context.Background()
+	require.NoError(t, service.ListenBeginBlock(ctx, testBeginBlockReq, testBeginBlockRes))
+	listener2.OnWrite(mockStoreKey2, []byte{2}, []byte{4}, false)
+	listener1.OnWrite(mockStoreKey1, []byte{1}, []byte{3}, false)
+	listener1.OnWrite(mockStoreKey1, []byte{3}, nil, true)
+	require.NoError(t, service.ListenDeliverTx(ctx, testDeliverTxReq, testDeliverTxRes))
+	require.NoError(t, service.ListenEndBlock(ctx, testEndBlockReq, testEndBlockRes))
+	require.NoError(t, service.ListenCommit(ctx, testCommitRes))
+
+	blocks := consumer.Blocks()
+	require.Len(t, blocks, 1)
+
+	metadata := blocks[0].Metadata
+	require.Equal(t, testBeginBlockReq, *metadata.RequestBeginBlock)
+	require.Equal(t, testBeginBlockRes, *metadata.ResponseBeginBlock)
+	require.Len(t, metadata.DeliverTxs, 1)
+	require.Equal(t, testDeliverTxReq, *metadata.DeliverTxs[0].Request)
+	require.Equal(t, testDeliverTxRes, *metadata.DeliverTxs[0].Response)
+	require.Equal(t, testEndBlockReq, *metadata.RequestEndBlock)
+	require.Equal(t, testCommitRes, *metadata.ResponseCommit)
+
+	// the change set is grouped by the sorted store keys
+	require.Equal(t, []*types.StoreKVPair{
+		{StoreKey: mockStoreKey1.Name(), Key: []byte{1}, Value: []byte{3}},
+		{StoreKey: mockStoreKey1.Name(), Key: []byte{3}, Delete: true},
+		{StoreKey: mockStoreKey2.Name(), Key: []byte{2}, Value: []byte{4}},
+	}, blocks[0].ChangeSet)
+
+	// the change set is reset after each commit
+	require.NoError(t, service.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 2}}, testBeginBlockRes))
+	require.NoError(t, service.ListenCommit(ctx, testCommitRes))
+	blocks = consumer.Blocks()
+	require.Len(t, blocks, 2)
+	require.Empty(t, blocks[1].ChangeSet)
+}
+
+func TestGRPCStreamingServiceStopNodeOnError(t *testing.T) {
+	// reserve an address no consumer listens on
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := lis.Addr().String()
+	require.NoError(t, lis.Close())
+
+	ctx := context.Background()
+	for _, stopNodeOnErr := range []bool{false, true} {
+		service, err := NewStreamingService(address, []types.StoreKey{mockStoreKey1}, stopNodeOnErr, 0)
+		require.NoError(t, err)
+
+		errs := []error{
+			service.ListenBeginBlock(ctx, testBeginBlockReq, testBeginBlockRes),
+			service.ListenDeliverTx(ctx, testDeliverTxReq, testDeliverTxRes),
+			service.ListenEndBlock(ctx, testEndBlockReq, testEndBlockRes),
+			service.ListenCommit(ctx, testCommitRes),
+		}
+		for _, err := range errs {
+			if stopNodeOnErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		}
+
+		require.NoError(t, service.Close())
+	}
+}
+
+// hungConsumer is a consumer which never answers the BeginBlock messages.
+type hungConsumer struct {
+	*Consumer
+	beginBlocks chan struct{}
+}
+
+func (c *hungConsumer) ListenBeginBlock(ctx context.Context, _ *ListenBeginBlockRequest) (*ListenBeginBlockResponse, error) {
+	c.beginBlocks <- struct{}{}
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestGRPCStreamingServiceTimeout(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	consumer := &hungConsumer{Consumer: NewConsumer(), beginBlocks: make(chan struct{}, 10)}
+	server := grpc.NewServer()
+	RegisterABCIListenerServiceServer(server, consumer)
+	go server.Serve(lis) //nolint:errcheck
+	t.Cleanup(server.Stop)
+
+	ctx := context.Background()
+	for _, stopNodeOnErr := range []bool{false, true} {
+		service, err := NewStreamingService(lis.Addr().String(), []types.StoreKey{mockStoreKey1}, stopNodeOnErr, 50*time.Millisecond)
+		require.NoError(t, err)
+
+		start := time.Now()
+		err = service.ListenBeginBlock(ctx, testBeginBlockReq, testBeginBlockRes)
+		require.Less(t, time.Since(start), 5*time.Second)
+		<-consumer.beginBlocks
+		if stopNodeOnErr {
+			require.Error(t, err)
+			require.NoError(t, service.Close())
+			continue
+		}
+		require.NoError(t, err)
+
+		// the remaining messages of the block are skipped
+		require.NoError(t, service.ListenDeliverTx(ctx, testDeliverTxReq, testDeliverTxRes))
+		require.NoError(t, service.ListenEndBlock(ctx, testEndBlockReq, testEndBlockRes))
+		require.NoError(t, service.ListenCommit(ctx, testCommitRes))
+		require.Empty(t, consumer.Blocks())
+		require.NoError(t, service.Close())
+	}
+}
@@ -2569,7 +2569,7 @@ type TestUpdatedTxBody struct {
 	Messages                     []*types.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	Memo                         string       `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
 	TimeoutHeight                int64        `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
-	SomeNewField                 uint64       `protobuf:"varint,4,opt,name=some_new_field,json=someNewField,proto3" json:"some_new_field,omitempty"`
+	SomeNewField                 uint64       `protobuf:"varint,6,opt,name=some_new_field,json=someNewField,proto3" json:"some_new_field,omitempty"`
 	SomeNewFieldNonCriticalField string       `protobuf:"bytes,1050,opt,name=some_new_field_non_critical_field,json=someNewFieldNonCriticalField,proto3" json:"some_new_field_non_critical_field,omitempty"`
 	ExtensionOptions             []*types.Any `protobuf:"bytes,1023,rep,name=extension_options,json=extensionOptions,proto3" json:"extension_options,omitempty"`
 	NonCriticalExtensionOptions  []*types.Any `protobuf:"bytes,2047,rep,name=non_critical_extension_options,json=nonCriticalExtensionOptions,proto3" json:"non_critical_extension_options,omitempty"`
@@ -2809,110 +2809,110 @@ func init() {
 func init() { proto.RegisterFile("unknonwnproto.proto", fileDescriptor_448ea787339d1228) }
 
 var fileDescriptor_448ea787339d1228 = []byte{
-	// 1644 bytes of a gzipped FileDescriptorProto
+	// 1637 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x6f, 0x1b, 0xc7,
-	0x15, 0xd7, 0x70, 0x49, 0x89, 0x7c, 0xa2, 0x69, 0x66, 0x6c, 0xb4, 0x1b, 0x3a, 0x66, 0x98, 0x85,
+	0x15, 0xd7, 0x70, 0x49, 0x8a, 0x7c, 0xa2, 0x69, 0x66, 0x6c, 0xb4, 0x1b, 0x3a, 0x66, 0x98, 0x85,
 	0xeb, 0xb0, 0x41, 0x43, 0x9a, 0x4b, 0x06, 0x28, 0x72, 0x32, 0xe9, 0x58, 0x95, 0x01, 0x57, 0x2e,
 	0xa6, 0x4e, 0x5a, 0xf8, 0x42, 0x2c, 0xb9, 0x43, 0x72, 0x21, 0x72, 0x46, 0xdd, 0x99, 0xb5, 0xc8,
 	0x5b, 0xd1, 0x1e, 0x7a, 0xcd, 0xa5, 0x28, 0xd0, 0x6f, 0xd0, 0x53, 0x91, 0x6f, 0xd0, 0xa3, 0x2f,
 	0x05, 0x7c, 0x29, 0x50, 0xa0, 0x40, 0x50, 0xd8, 0xd7, 0x7e, 0x83, 0xa2, 0x48, 0x31, 0xb3, 0x7f,
-	0xb8, 0x94, 0x44, 0x85, 0x52, 0xda, 0x18, 0x02, 0x72, 0x11, 0x67, 0xde, 0xfe, 0xe6, 0xcd, 0x7b,
-	0xbf, 0xf7, 0x67, 0x77, 0x46, 0x70, 0x23, 0x60, 0x87, 0x8c, 0xb3, 0x63, 0x76, 0xe4, 0x73, 0xc9,
-	0x1b, 0xfa, 0x2f, 0xce, 0x4b, 0x2a, 0xa4, 0xeb, 0x48, 0xa7, 0x72, 0x73, 0xcc, 0xc7, 0x5c, 0x0b,
-	0x9b, 0x6a, 0x14, 0x3e, 0xaf, 0xbc, 0x3d, 0xe6, 0x7c, 0x3c, 0xa5, 0x4d, 0x3d, 0x1b, 0x04, 0xa3,
-	0xa6, 0xc3, 0x16, 0xd1, 0xa3, 0xca, 0x90, 0x8b, 0x19, 0x17, 0x4d, 0x39, 0x6f, 0x3e, 0x6f, 0x0d,
-	0xa8, 0x74, 0x5a, 0x4d, 0x39, 0x0f, 0x9f, 0x59, 0x12, 0x0a, 0x0f, 0x02, 0x21, 0xf9, 0x8c, 0xfa,
-	0x2d, 0x5c, 0x82, 0x8c, 0xe7, 0x9a, 0xa8, 0x86, 0xea, 0x39, 0x92, 0xf1, 0x5c, 0x8c, 0x21, 0xcb,
-	0x9c, 0x19, 0x35, 0x33, 0x35, 0x54, 0x2f, 0x10, 0x3d, 0xc6, 0x3f, 0x84, 0xb2, 0x08, 0x06, 0x62,
-	0xe8, 0x7b, 0x47, 0xd2, 0xe3, 0xac, 0x3f, 0xa2, 0xd4, 0x34, 0x6a, 0xa8, 0x9e, 0x21, 0xd7, 0xd3,
-	0xf2, 0x3d, 0x4a, 0xb1, 0x09, 0x3b, 0x47, 0xce, 0x62, 0x46, 0x99, 0x34, 0x77, 0xb4, 0x86, 0x78,
-	0x6a, 0x7d, 0x91, 0x59, 0x6e, 0x6b, 0x9f, 0xda, 0xb6, 0x02, 0x79, 0x8f, 0xb9, 0x81, 0x90, 0xfe,
-	0x42, 0x6f, 0x9d, 0x23, 0xc9, 0x3c, 0x31, 0xc9, 0x48, 0x99, 0x74, 0x13, 0x72, 0x23, 0x7a, 0x4c,
-	0x7d, 0x33, 0xab, 0xed, 0x08, 0x27, 0xf8, 0x16, 0xe4, 0x7d, 0x2a, 0xa8, 0xff, 0x9c, 0xba, 0xe6,
-	0x1f, 0xf2, 0x35, 0x54, 0x37, 0x48, 0x22, 0xc0, 0x3f, 0x82, 0xec, 0xd0, 0x93, 0x0b, 0x73, 0xbb,
-	0x86, 0xea, 0x25, 0xdb, 0x6c, 0xc4, 0xe4, 0x36, 0x12, 0xab, 0x1a, 0x0f, 0x3c, 0xb9, 0x20, 0x1a,
-	0x85, 0x3f, 0x86, 0x6b, 0x33, 0x4f, 0x0c, 0xe9, 0x74, 0xea, 0x30, 0xca, 0x03, 0x61, 0x42, 0x0d,
-	0xd5, 0x77, 0xed, 0x9b, 0x8d, 0x90, 0xf3, 0x46, 0xcc, 0x79, 0xa3, 0xcb, 0x16, 0x64, 0x15, 0x6a,
-	0xfd, 0x04, 0xb2, 0x4a, 0x13, 0xce, 0x43, 0xf6, 0xb1, 0xc3, 0x45, 0x79, 0x0b, 0x97, 0x00, 0x1e,
-	0x73, 0xd1, 0x65, 0x63, 0x3a, 0xa5, 0xa2, 0x8c, 0x70, 0x11, 0xf2, 0x3f, 0x73, 0xa6, 0xbc, 0x3b,
-	0x95, 0xbc, 0x9c, 0xc1, 0x00, 0xdb, 0x3f, 0xe5, 0x62, 0xc8, 0x8f, 0xcb, 0x06, 0xde, 0x85, 0x9d,
-	0x03, 0xc7, 0xf3, 0xf9, 0xc0, 0x2b, 0x67, 0xad, 0x06, 0xe4, 0x0f, 0xa8, 0x90, 0xd4, 0xed, 0x74,
-	0x37, 0x09, 0x94, 0xf5, 0x37, 0x14, 0x2f, 0x68, 0x6f, 0xb4, 0x00, 0x5b, 0x90, 0x71, 0x3a, 0x66,
-	0xb6, 0x66, 0xd4, 0x77, 0x6d, 0xbc, 0x64, 0x24, 0xde, 0x94, 0x64, 0x9c, 0x0e, 0x6e, 0x43, 0xce,
-	0x63, 0x2e, 0x9d, 0x9b, 0x39, 0x0d, 0xbb, 0x7d, 0x12, 0xd6, 0xee, 0x36, 0x1e, 0xa9, 0xe7, 0x0f,
-	0x99, 0xf4, 0x17, 0x24, 0xc4, 0x56, 0x1e, 0x03, 0x2c, 0x85, 0xb8, 0x0c, 0xc6, 0x21, 0x5d, 0x68,
-	0x5b, 0x0c, 0xa2, 0x86, 0xb8, 0x0e, 0xb9, 0xe7, 0xce, 0x34, 0x08, 0xad, 0x39, 0x7b, 0xef, 0x10,
-	0xf0, 0x71, 0xe6, 0xc7, 0xc8, 0x7a, 0x16, 0xbb, 0x65, 0x6f, 0xe6, 0xd6, 0x07, 0xb0, 0xcd, 0x34,
-	0x5e, 0xe7, 0xcc, 0x19, 0xea, 0xdb, 0x5d, 0x12, 0x21, 0xac, 0xbd, 0x58, 0x77, 0xeb, 0xb4, 0xee,
-	0xa5, 0x9e, 0x35, 0x66, 0xda, 0x4b, 0x3d, 0xf7, 0x93, 0x58, 0xf5, 0x4e, 0xe9, 0x29, 0x83, 0xe1,
-	0x8c, 0x69, 0x94, 0xd8, 0x6a, 0x78, 0x56, 0x4e, 0x5b, 0x6e, 0x12, 0xbc, 0x4b, 0x6a, 0x50, 0xe1,
-	0x1c, 0xac, 0x0f, 0x67, 0x8f, 0x64, 0x06, 0x1d, 0x8b, 0x25, 0x5c, 0x9e, 0xb9, 0x8b, 0xaa, 0x6d,
-	0xb5, 0x0b, 0x22, 0x6a, 0xb8, 0x01, 0x93, 0xbd, 0x98, 0x01, 0x55, 0x93, 0x3e, 0x0f, 0x24, 0xd5,
-	0x35, 0x59, 0x20, 0xe1, 0xc4, 0xfa, 0x65, 0xc2, 0x6f, 0xef, 0x12, 0xfc, 0x2e, 0xb5, 0x47, 0x0c,
-	0x18, 0x09, 0x03, 0xd6, 0x6f, 0x52, 0x1d, 0xa5, 0xbd, 0x51, 0x5e, 0x94, 0x20, 0x23, 0x46, 0x51,
-	0xeb, 0xca, 0x88, 0x11, 0x7e, 0x07, 0x0a, 0x22, 0xf0, 0x87, 0x13, 0xc7, 0x1f, 0xd3, 0xa8, 0x93,
-	0x2c, 0x05, 0xb8, 0x06, 0xbb, 0x2e, 0x15, 0xd2, 0x63, 0x8e, 0xea, 0x6e, 0x66, 0x4e, 0x2b, 0x4a,
-	0x8b, 0xf0, 0x5d, 0x28, 0x0d, 0x7d, 0xea, 0x7a, 0xb2, 0x3f, 0x74, 0x7c, 0xb7, 0xcf, 0x78, 0xd8,
-	0xf4, 0xf6, 0xb7, 0x48, 0x31, 0x94, 0x3f, 0x70, 0x7c, 0xf7, 0x80, 0xe3, 0xdb, 0x50, 0x18, 0x4e,
-	0xe8, 0xaf, 0x02, 0xaa, 0x20, 0xf9, 0x08, 0x92, 0x0f, 0x45, 0x07, 0x1c, 0x37, 0x21, 0xcf, 0x7d,
-	0x6f, 0xec, 0x31, 0x67, 0x6a, 0x16, 0x34, 0x11, 0x37, 0x4e, 0x77, 0xa7, 0x16, 0x49, 0x40, 0xbd,
-	0x42, 0xd2, 0x65, 0xad, 0x7f, 0x65, 0xa0, 0xf8, 0x94, 0x0a, 0xf9, 0x19, 0xf5, 0x85, 0xc7, 0x59,
-	0x0b, 0x17, 0x01, 0xcd, 0xa3, 0x4a, 0x43, 0x73, 0x7c, 0x07, 0x90, 0x13, 0x91, 0xfb, 0xbd, 0xa5,
-	0xce, 0xf4, 0x02, 0x82, 0x1c, 0x85, 0x1a, 0x44, 0x01, 0x5e, 0x8b, 0x1a, 0x28, 0xd4, 0x30, 0x4a,
-	0xae, 0xb5, 0xa8, 0x21, 0xfe, 0x00, 0x90, 0x1b, 0xb5, 0x8a, 0x35, 0xa8, 0x5e, 0xf6, 0xc5, 0x97,
-	0xef, 0x6e, 0x11, 0xe4, 0xe2, 0x12, 0x20, 0xaa, 0xfb, 0x71, 0x6e, 0x7f, 0x8b, 0x20, 0x8a, 0xef,
-	0x02, 0x1a, 0x69, 0x0a, 0xd7, 0xae, 0x55, 0xb8, 0x11, 0xb6, 0x00, 0x8d, 0x35, 0x8f, 0xeb, 0x1a,
-	0x32, 0x1a, 0x2b, 0x6b, 0x27, 0x66, 0xe1, 0x7c, 0x6b, 0x27, 0xf8, 0x7d, 0x40, 0x87, 0x66, 0x71,
-	0x2d, 0xe7, 0xbd, 0xec, 0xcb, 0x2f, 0xdf, 0x45, 0x04, 0x1d, 0xf6, 0x72, 0x60, 0x88, 0x60, 0x66,
-	0xfd, 0xd6, 0x58, 0xa1, 0xdb, 0xbe, 0x28, 0xdd, 0xf6, 0x46, 0x74, 0xdb, 0x1b, 0xd1, 0x6d, 0x2b,
-	0xba, 0xef, 0x7c, 0x1d, 0xdd, 0xf6, 0xa5, 0x88, 0xb6, 0xdf, 0x14, 0xd1, 0xf8, 0x16, 0x14, 0x18,
-	0x3d, 0xee, 0x8f, 0x3c, 0x3a, 0x75, 0xcd, 0xb7, 0x6b, 0xa8, 0x9e, 0x25, 0x79, 0x46, 0x8f, 0xf7,
-	0xd4, 0x3c, 0x8e, 0xc2, 0xef, 0x57, 0xa3, 0xd0, 0xbe, 0x68, 0x14, 0xda, 0x1b, 0x45, 0xa1, 0xbd,
-	0x51, 0x14, 0xda, 0x1b, 0x45, 0xa1, 0x7d, 0xa9, 0x28, 0xb4, 0xdf, 0x58, 0x14, 0x3e, 0x04, 0xcc,
-	0x38, 0xeb, 0x0f, 0x7d, 0x4f, 0x7a, 0x43, 0x67, 0x1a, 0x85, 0xe3, 0x77, 0xba, 0x77, 0x91, 0x32,
-	0xe3, 0xec, 0x41, 0xf4, 0x64, 0x25, 0x2e, 0xff, 0xce, 0x40, 0x25, 0x6d, 0xfe, 0x63, 0xce, 0xe8,
-	0x13, 0x46, 0x9f, 0x8c, 0x3e, 0x53, 0xaf, 0xf2, 0x2b, 0x1a, 0xa5, 0x2b, 0xc3, 0xfe, 0x7f, 0xb6,
-	0xe1, 0xfb, 0x27, 0xd9, 0x3f, 0xd0, 0x6f, 0xab, 0xf1, 0x15, 0xa1, 0xbe, 0xb5, 0x2c, 0x88, 0xf7,
-	0xce, 0x46, 0xa5, 0x7c, 0xba, 0x22, 0xb5, 0x81, 0xef, 0xc3, 0xb6, 0xc7, 0x18, 0xf5, 0x5b, 0x66,
-	0x49, 0x2b, 0xaf, 0x7f, 0xad, 0x67, 0x8d, 0x47, 0x1a, 0x4f, 0xa2, 0x75, 0x89, 0x06, 0xdb, 0xbc,
-	0x7e, 0x21, 0x0d, 0x76, 0xa4, 0xc1, 0xae, 0xfc, 0x09, 0xc1, 0x76, 0xa8, 0x34, 0xf5, 0x9d, 0x64,
-	0xac, 0xfd, 0x4e, 0x7a, 0xa4, 0x3e, 0xf9, 0x19, 0xf5, 0xa3, 0xe8, 0xb7, 0x37, 0xb5, 0x38, 0xfc,
-	0xd1, 0x7f, 0x48, 0xa8, 0xa1, 0x72, 0x4f, 0x1d, 0x04, 0x62, 0x61, 0x6a, 0xf3, 0x42, 0xbc, 0xb9,
-	0x3e, 0x93, 0x45, 0x9b, 0xab, 0x71, 0xe5, 0xcf, 0xb1, 0xad, 0xf6, 0x29, 0xb8, 0x09, 0x3b, 0x43,
-	0x1e, 0xb0, 0xf8, 0x90, 0x58, 0x20, 0xf1, 0xf4, 0xb2, 0x16, 0xdb, 0xff, 0x0b, 0x8b, 0xe3, 0xfa,
-	0xfb, 0x6a, 0xb5, 0xfe, 0x3a, 0xdf, 0xd5, 0xdf, 0x15, 0xaa, 0xbf, 0xce, 0x37, 0xae, 0xbf, 0xce,
-	0xb7, 0x5c, 0x7f, 0x9d, 0x6f, 0x54, 0x7f, 0xc6, 0xda, 0xfa, 0xfb, 0xe2, 0xff, 0x56, 0x7f, 0x9d,
-	0x8d, 0xea, 0xcf, 0x3e, 0xb7, 0xfe, 0x6e, 0xa6, 0x2f, 0x0e, 0x8c, 0xe8, 0x92, 0x20, 0xae, 0xc0,
-	0xbf, 0x22, 0x28, 0xa5, 0xf6, 0xdb, 0xfb, 0xe4, 0x72, 0xc7, 0xa1, 0x37, 0x7e, 0x2c, 0x89, 0xfd,
-	0xf9, 0x07, 0x5a, 0xf9, 0x9e, 0xda, 0xfb, 0xa4, 0xf5, 0x0b, 0x4f, 0x4e, 0x1e, 0xce, 0xa5, 0xef,
-	0x74, 0xd9, 0xe2, 0x5b, 0xf5, 0xed, 0xce, 0xd2, 0xb7, 0x14, 0xae, 0xcb, 0x16, 0x89, 0x45, 0x17,
-	0xf6, 0xee, 0x29, 0x14, 0xd3, 0xeb, 0x71, 0x5d, 0x39, 0x80, 0xd6, 0xd3, 0x17, 0x77, 0x00, 0x47,
-	0x39, 0x1e, 0x76, 0x46, 0x43, 0x75, 0xc0, 0x62, 0xd8, 0x01, 0xf5, 0x6c, 0x68, 0xfd, 0x05, 0x41,
-	0x59, 0x6d, 0xf8, 0xe9, 0x91, 0xeb, 0x48, 0xea, 0x3e, 0x9d, 0x13, 0xe7, 0x18, 0xdf, 0x06, 0x18,
-	0x70, 0x77, 0xd1, 0x1f, 0x2c, 0x24, 0x15, 0x7a, 0x8f, 0x22, 0x29, 0x28, 0x49, 0x4f, 0x09, 0xf0,
-	0x5d, 0xb8, 0xee, 0x04, 0x72, 0xd2, 0xf7, 0xd8, 0x88, 0x47, 0x98, 0x8c, 0xc6, 0x5c, 0x53, 0xe2,
-	0x47, 0x6c, 0xc4, 0x43, 0x5c, 0x15, 0x40, 0x78, 0x63, 0xe6, 0xc8, 0xc0, 0xa7, 0xc2, 0x34, 0x6a,
-	0x46, 0xbd, 0x48, 0x52, 0x12, 0x5c, 0x85, 0xdd, 0xe4, 0xec, 0xd2, 0xff, 0x48, 0xdf, 0x18, 0x14,
-	0x49, 0x21, 0x3e, 0xbd, 0x7c, 0x84, 0x7f, 0x00, 0xa5, 0xe5, 0xf3, 0xd6, 0x3d, 0xbb, 0x63, 0xfe,
-	0x3a, 0xaf, 0x31, 0xc5, 0x18, 0xa3, 0x84, 0xd6, 0xe7, 0x06, 0xbc, 0xb5, 0xe2, 0x42, 0x8f, 0xbb,
-	0x0b, 0x7c, 0x0f, 0xf2, 0x33, 0x2a, 0x84, 0x33, 0xd6, 0x1e, 0x18, 0x6b, 0x93, 0x2c, 0x41, 0xa9,
-	0xea, 0x9e, 0xd1, 0x19, 0x8f, 0xab, 0x5b, 0x8d, 0x95, 0x09, 0xd2, 0x9b, 0x51, 0x1e, 0xc8, 0xfe,
-	0x84, 0x7a, 0xe3, 0x89, 0x8c, 0x78, 0xbc, 0x16, 0x49, 0xf7, 0xb5, 0x10, 0xdf, 0x81, 0x92, 0xe0,
-	0x33, 0xda, 0x5f, 0x1e, 0xc5, 0xb2, 0xfa, 0x28, 0x56, 0x54, 0xd2, 0x83, 0xc8, 0x58, 0xbc, 0x0f,
-	0xef, 0xad, 0xa2, 0xfa, 0x67, 0x34, 0xe6, 0x3f, 0x86, 0x8d, 0xf9, 0x9d, 0xf4, 0xca, 0x83, 0x93,
-	0x4d, 0xba, 0x07, 0x6f, 0xd1, 0xb9, 0xa4, 0x4c, 0xe5, 0x48, 0x9f, 0xeb, 0xeb, 0x64, 0x61, 0x7e,
-	0xb5, 0x73, 0x8e, 0x9b, 0xe5, 0x04, 0xff, 0x24, 0x84, 0xe3, 0x67, 0x50, 0x5d, 0xd9, 0xfe, 0x0c,
-	0x85, 0xd7, 0xcf, 0x51, 0x78, 0x2b, 0xf5, 0xe6, 0x78, 0x78, 0x42, 0xb7, 0xf5, 0x02, 0xc1, 0x8d,
-	0x54, 0x48, 0xba, 0x51, 0x5a, 0xe0, 0xfb, 0x50, 0x54, 0xf1, 0xa7, 0xbe, 0xce, 0x9d, 0x38, 0x30,
-	0xb7, 0x1b, 0xe1, 0xf5, 0x7b, 0x43, 0xce, 0x1b, 0xd1, 0xf5, 0x7b, 0xe3, 0xe7, 0x1a, 0xa6, 0x16,
-	0x91, 0x5d, 0x91, 0x8c, 0x05, 0xae, 0x2f, 0xef, 0xdc, 0x54, 0xd1, 0x9c, 0x5e, 0xb8, 0x47, 0x69,
-	0x78, 0x17, 0xb7, 0x92, 0x5d, 0x6d, 0x1d, 0xb7, 0x54, 0x76, 0xb5, 0x37, 0xcd, 0xae, 0xf7, 0xc3,
-	0xe4, 0x22, 0xf4, 0x88, 0x2a, 0x57, 0x3e, 0xf5, 0x98, 0xd4, 0xa9, 0xc2, 0x82, 0x59, 0x68, 0x7f,
-	0x96, 0xe8, 0x71, 0x6f, 0xff, 0xc5, 0xab, 0x2a, 0x7a, 0xf9, 0xaa, 0x8a, 0xfe, 0xf9, 0xaa, 0x8a,
-	0x3e, 0x7f, 0x5d, 0xdd, 0x7a, 0xf9, 0xba, 0xba, 0xf5, 0xf7, 0xd7, 0xd5, 0xad, 0x67, 0x8d, 0xb1,
-	0x27, 0x27, 0xc1, 0xa0, 0x31, 0xe4, 0xb3, 0x66, 0xf4, 0x8f, 0x86, 0xf0, 0xe7, 0x43, 0xe1, 0x1e,
-	0x36, 0x55, 0xdd, 0x07, 0xd2, 0x9b, 0x36, 0xe3, 0x06, 0x30, 0xd8, 0xd6, 0x44, 0xb7, 0xff, 0x1b,
-	0x00, 0x00, 0xff, 0xff, 0xa6, 0x69, 0x10, 0x33, 0xe6, 0x18, 0x00, 0x00,
+	0xb8, 0xb4, 0x44, 0x85, 0x56, 0xda, 0x18, 0x02, 0x72, 0x11, 0x67, 0xde, 0xfe, 0xe6, 0xbd, 0x37,
+	0xbf, 0xf7, 0x67, 0x77, 0x46, 0x70, 0x2d, 0x60, 0x47, 0x8c, 0xb3, 0x13, 0x76, 0xec, 0x73, 0xc9,
+	0x9b, 0xfa, 0x2f, 0x2e, 0x48, 0x2a, 0xa4, 0xeb, 0x48, 0xa7, 0x7a, 0x7d, 0xc2, 0x27, 0x5c, 0x0b,
+	0x5b, 0x6a, 0x14, 0x3e, 0xaf, 0xbe, 0x3d, 0xe1, 0x7c, 0x32, 0xa3, 0x2d, 0x3d, 0x1b, 0x06, 0xe3,
+	0x96, 0xc3, 0x96, 0xd1, 0xa3, 0xea, 0x88, 0x8b, 0x39, 0x17, 0x2d, 0xb9, 0x68, 0x3d, 0x6d, 0x0f,
+	0xa9, 0x74, 0xda, 0x2d, 0xb9, 0x08, 0x9f, 0x59, 0x12, 0x8a, 0xf7, 0x02, 0x21, 0xf9, 0x9c, 0xfa,
+	0x6d, 0x5c, 0x86, 0x8c, 0xe7, 0x9a, 0xa8, 0x8e, 0x1a, 0x39, 0x92, 0xf1, 0x5c, 0x8c, 0x21, 0xcb,
+	0x9c, 0x39, 0x35, 0x33, 0x75, 0xd4, 0x28, 0x12, 0x3d, 0xc6, 0x3f, 0x84, 0x8a, 0x08, 0x86, 0x62,
+	0xe4, 0x7b, 0xc7, 0xd2, 0xe3, 0x6c, 0x30, 0xa6, 0xd4, 0x34, 0xea, 0xa8, 0x91, 0x21, 0x57, 0xd3,
+	0xf2, 0x7d, 0x4a, 0xb1, 0x09, 0xbb, 0xc7, 0xce, 0x72, 0x4e, 0x99, 0x34, 0x77, 0xb5, 0x86, 0x78,
+	0x6a, 0x7d, 0x91, 0x59, 0x99, 0xb5, 0x4f, 0x99, 0xad, 0x42, 0xc1, 0x63, 0x6e, 0x20, 0xa4, 0xbf,
+	0xd4, 0xa6, 0x73, 0x24, 0x99, 0x27, 0x2e, 0x19, 0x29, 0x97, 0xae, 0x43, 0x6e, 0x4c, 0x4f, 0xa8,
+	0x6f, 0x66, 0xb5, 0x1f, 0xe1, 0x04, 0xdf, 0x80, 0x82, 0x4f, 0x05, 0xf5, 0x9f, 0x52, 0xd7, 0xfc,
+	0x43, 0xa1, 0x8e, 0x1a, 0x06, 0x49, 0x04, 0xf8, 0x47, 0x90, 0x1d, 0x79, 0x72, 0x69, 0xe6, 0xeb,
+	0xa8, 0x51, 0xb6, 0xcd, 0x66, 0x4c, 0x6e, 0x33, 0xf1, 0xaa, 0x79, 0xcf, 0x93, 0x4b, 0xa2, 0x51,
+	0xf8, 0x63, 0xb8, 0x32, 0xf7, 0xc4, 0x88, 0xce, 0x66, 0x0e, 0xa3, 0x3c, 0x10, 0x26, 0xd4, 0x51,
+	0x63, 0xcf, 0xbe, 0xde, 0x0c, 0x39, 0x6f, 0xc6, 0x9c, 0x37, 0x7b, 0x6c, 0x49, 0xd6, 0xa1, 0xd6,
+	0x4f, 0x20, 0xab, 0x34, 0xe1, 0x02, 0x64, 0x1f, 0x3a, 0x5c, 0x54, 0x76, 0x70, 0x19, 0xe0, 0x21,
+	0x17, 0x3d, 0x36, 0xa1, 0x33, 0x2a, 0x2a, 0x08, 0x97, 0xa0, 0xf0, 0x33, 0x67, 0xc6, 0x7b, 0x33,
+	0xc9, 0x2b, 0x19, 0x0c, 0x90, 0xff, 0x29, 0x17, 0x23, 0x7e, 0x52, 0x31, 0xf0, 0x1e, 0xec, 0x1e,
+	0x3a, 0x9e, 0xcf, 0x87, 0x5e, 0x25, 0x6b, 0x35, 0xa1, 0x70, 0x48, 0x85, 0xa4, 0x6e, 0xb7, 0xb7,
+	0x4d, 0xa0, 0xac, 0xbf, 0xa1, 0x78, 0x41, 0x67, 0xab, 0x05, 0xd8, 0x82, 0x8c, 0xd3, 0x35, 0xb3,
+	0x75, 0xa3, 0xb1, 0x67, 0xe3, 0x15, 0x23, 0xb1, 0x51, 0x92, 0x71, 0xba, 0xb8, 0x03, 0x39, 0x8f,
+	0xb9, 0x74, 0x61, 0xe6, 0x34, 0xec, 0xe6, 0xab, 0xb0, 0x4e, 0xaf, 0xf9, 0x40, 0x3d, 0xbf, 0xcf,
+	0xa4, 0xbf, 0x24, 0x21, 0xb6, 0xfa, 0x10, 0x60, 0x25, 0xc4, 0x15, 0x30, 0x8e, 0xe8, 0x52, 0xfb,
+	0x62, 0x10, 0x35, 0xc4, 0x0d, 0xc8, 0x3d, 0x75, 0x66, 0x41, 0xe8, 0xcd, 0xd9, 0xb6, 0x43, 0xc0,
+	0xc7, 0x99, 0x1f, 0x23, 0xeb, 0x49, 0xbc, 0x2d, 0x7b, 0xbb, 0x6d, 0x7d, 0x00, 0x79, 0xa6, 0xf1,
+	0xa6, 0x71, 0xb6, 0xfa, 0x4e, 0x8f, 0x44, 0x08, 0x6b, 0x3f, 0xd6, 0xdd, 0x3e, 0xad, 0x7b, 0xa5,
+	0x67, 0x83, 0x9b, 0xf6, 0x4a, 0xcf, 0xdd, 0x24, 0x56, 0xfd, 0x53, 0x7a, 0x2a, 0x60, 0x38, 0x13,
+	0x1a, 0x25, 0xb6, 0x1a, 0x9e, 0x95, 0xd3, 0x96, 0x9b, 0x04, 0xef, 0x82, 0x1a, 0x54, 0x38, 0x87,
+	0x9b, 0xc3, 0xd9, 0x27, 0x99, 0x61, 0xd7, 0x62, 0x09, 0x97, 0x67, 0x5a, 0x19, 0xd3, 0xd0, 0x0a,
+	0x22, 0x6a, 0xb8, 0x05, 0x93, 0xfd, 0x98, 0x01, 0x55, 0x93, 0x3e, 0x0f, 0x24, 0xd5, 0x35, 0x59,
+	0x24, 0xe1, 0xc4, 0xfa, 0x65, 0xc2, 0x6f, 0xff, 0x02, 0xfc, 0xae, 0xb4, 0x47, 0x0c, 0x18, 0x09,
+	0x03, 0xd6, 0x6f, 0x52, 0x1d, 0xa5, 0xb3, 0x55, 0x5e, 0x94, 0x21, 0x23, 0xc6, 0x51, 0xeb, 0xca,
+	0x88, 0x31, 0x7e, 0x07, 0x8a, 0x22, 0xf0, 0x47, 0x53, 0xc7, 0x9f, 0xd0, 0xa8, 0x93, 0xac, 0x04,
+	0xb8, 0x0e, 0x7b, 0x2e, 0x15, 0xd2, 0x63, 0x8e, 0xea, 0x6e, 0x66, 0x4e, 0x2b, 0x4a, 0x8b, 0xf0,
+	0x6d, 0x28, 0x8f, 0x7c, 0xea, 0x7a, 0x72, 0x30, 0x72, 0x7c, 0x77, 0xc0, 0x78, 0xd8, 0xf4, 0x0e,
+	0x76, 0x48, 0x29, 0x94, 0xdf, 0x73, 0x7c, 0xf7, 0x90, 0xe3, 0x9b, 0x50, 0x1c, 0x4d, 0xe9, 0xaf,
+	0x02, 0xaa, 0x20, 0x85, 0x08, 0x52, 0x08, 0x45, 0x87, 0x1c, 0xb7, 0xa0, 0xc0, 0x7d, 0x6f, 0xe2,
+	0x31, 0x67, 0x66, 0x16, 0x35, 0x11, 0xd7, 0x4e, 0x77, 0xa7, 0x36, 0x49, 0x40, 0xfd, 0x62, 0xd2,
+	0x65, 0xad, 0x7f, 0x65, 0xa0, 0xf4, 0x98, 0x0a, 0xf9, 0x19, 0xf5, 0x85, 0xc7, 0x59, 0x1b, 0x97,
+	0x00, 0x2d, 0xa2, 0x4a, 0x43, 0x0b, 0x7c, 0x0b, 0x90, 0x13, 0x91, 0xfb, 0xbd, 0x95, 0xce, 0xf4,
+	0x02, 0x82, 0x1c, 0x85, 0x1a, 0x9a, 0xc6, 0xf9, 0xa8, 0xa1, 0x42, 0x8d, 0xa2, 0xe4, 0xda, 0x88,
+	0x1a, 0xe1, 0x0f, 0x00, 0xb9, 0x66, 0xee, 0x3c, 0x54, 0x3f, 0xfb, 0xec, 0xcb, 0x77, 0x77, 0x08,
+	0x72, 0x71, 0x19, 0x10, 0xd5, 0xfd, 0x38, 0x77, 0xb0, 0x43, 0x10, 0xc5, 0xb7, 0x01, 0x8d, 0x35,
+	0x85, 0x1b, 0xd7, 0x2a, 0xdc, 0x18, 0x5b, 0x80, 0x26, 0x66, 0xe1, 0x9c, 0x86, 0x8c, 0x26, 0xca,
+	0xdb, 0xa9, 0x59, 0x3c, 0xdf, 0xdb, 0x29, 0x7e, 0x1f, 0xd0, 0x91, 0x59, 0xda, 0xc8, 0x79, 0x3f,
+	0xfb, 0xfc, 0xcb, 0x77, 0x11, 0x41, 0x47, 0xfd, 0x1c, 0x18, 0x22, 0x98, 0x5b, 0xbf, 0x35, 0xd6,
+	0xe8, 0xb6, 0x5f, 0x97, 0x6e, 0x7b, 0x2b, 0xba, 0xed, 0xad, 0xe8, 0xb6, 0x15, 0xdd, 0xb7, 0xbe,
+	0x8e, 0x6e, 0xfb, 0x42, 0x44, 0xdb, 0x6f, 0x8a, 0x68, 0x7c, 0x03, 0x8a, 0x8c, 0x9e, 0x0c, 0xc6,
+	0x1e, 0x9d, 0xb9, 0xe6, 0xdb, 0x75, 0xd4, 0xc8, 0x92, 0x02, 0xa3, 0x27, 0xfb, 0x6a, 0x1e, 0x47,
+	0xe1, 0xf7, 0xeb, 0x51, 0xe8, 0xbc, 0x6e, 0x14, 0x3a, 0x5b, 0x45, 0xa1, 0xb3, 0x55, 0x14, 0x3a,
+	0x5b, 0x45, 0xa1, 0x73, 0xa1, 0x28, 0x74, 0xde, 0x58, 0x14, 0x3e, 0x04, 0xcc, 0x38, 0x1b, 0x8c,
+	0x7c, 0x4f, 0x7a, 0x23, 0x67, 0x16, 0x85, 0xe3, 0x77, 0xba, 0x77, 0x91, 0x0a, 0xe3, 0xec, 0x5e,
+	0xf4, 0x64, 0x2d, 0x2e, 0xff, 0xce, 0x40, 0x35, 0xed, 0xfe, 0x43, 0xce, 0xe8, 0x23, 0x46, 0x1f,
+	0x8d, 0x3f, 0x53, 0xaf, 0xf2, 0x4b, 0x1a, 0xa5, 0x4b, 0xc3, 0xfe, 0x7f, 0xf2, 0xf0, 0xfd, 0x57,
+	0xd9, 0x3f, 0xd4, 0x6f, 0xab, 0xc9, 0x25, 0xa1, 0xbe, 0xbd, 0x2a, 0x88, 0xf7, 0xce, 0x46, 0xa5,
+	0xf6, 0x74, 0x49, 0x6a, 0x03, 0xdf, 0x85, 0xbc, 0xc7, 0x18, 0xf5, 0xdb, 0x66, 0x59, 0x2b, 0x6f,
+	0x7c, 0xed, 0xce, 0x9a, 0x0f, 0x34, 0x9e, 0x44, 0xeb, 0x12, 0x0d, 0xb6, 0x79, 0xf5, 0xb5, 0x34,
+	0xd8, 0x91, 0x06, 0xbb, 0xfa, 0x27, 0x04, 0xf9, 0x50, 0x69, 0xea, 0x3b, 0xc9, 0xd8, 0xf8, 0x9d,
+	0xf4, 0x40, 0x7d, 0xf2, 0x33, 0xea, 0x47, 0xd1, 0xef, 0x6c, 0xeb, 0x71, 0xf8, 0xa3, 0xff, 0x90,
+	0x50, 0x43, 0xf5, 0x0e, 0xc0, 0x4a, 0x98, 0x32, 0x5e, 0x8c, 0x8d, 0xeb, 0x33, 0x59, 0x64, 0x5c,
+	0x8d, 0xab, 0x7f, 0x8e, 0x7d, 0xb5, 0x4f, 0xc1, 0x4d, 0xd8, 0x1d, 0xf1, 0x80, 0xc5, 0x87, 0xc4,
+	0x22, 0x89, 0xa7, 0x17, 0xf5, 0xd8, 0xfe, 0x5f, 0x78, 0x1c, 0xd7, 0xdf, 0x57, 0xeb, 0xf5, 0xd7,
+	0xfd, 0xae, 0xfe, 0x2e, 0x51, 0xfd, 0x75, 0xbf, 0x71, 0xfd, 0x75, 0xbf, 0xe5, 0xfa, 0xeb, 0x7e,
+	0xa3, 0xfa, 0x33, 0x36, 0xd6, 0xdf, 0x17, 0xff, 0xb7, 0xfa, 0xeb, 0x6e, 0x55, 0x7f, 0xf6, 0xb9,
+	0xf5, 0x77, 0x3d, 0x7d, 0x71, 0x60, 0x44, 0x97, 0x04, 0x71, 0x05, 0xfe, 0x15, 0x41, 0x39, 0x65,
+	0x6f, 0xff, 0x93, 0x8b, 0x1d, 0x87, 0xde, 0xf8, 0xb1, 0x24, 0xde, 0xcf, 0x3f, 0xd0, 0xda, 0xf7,
+	0xd4, 0xfe, 0x27, 0xed, 0x5f, 0x78, 0x72, 0x7a, 0x7f, 0x21, 0x7d, 0xa7, 0xc7, 0x96, 0xdf, 0xea,
+	0xde, 0x6e, 0xad, 0xf6, 0x96, 0xc2, 0xf5, 0xd8, 0x32, 0xf1, 0xe8, 0xb5, 0x77, 0xf7, 0x18, 0x4a,
+	0xe9, 0xf5, 0xb8, 0xa1, 0x36, 0x80, 0x36, 0xd3, 0x17, 0x77, 0x00, 0x07, 0x97, 0xe2, 0xce, 0x68,
+	0xa8, 0x0e, 0x58, 0x0a, 0x3b, 0xa0, 0x9e, 0x8d, 0xac, 0xbf, 0x20, 0xa8, 0x28, 0x83, 0x9f, 0x1e,
+	0xbb, 0x8e, 0xa4, 0xee, 0xe3, 0x05, 0x71, 0x4e, 0xf0, 0x4d, 0x80, 0x21, 0x77, 0x97, 0x83, 0xe1,
+	0x52, 0x52, 0xa1, 0x6d, 0x94, 0x48, 0x51, 0x49, 0xfa, 0x4a, 0x80, 0x6f, 0xc3, 0x55, 0x27, 0x90,
+	0xd3, 0x81, 0xc7, 0xc6, 0x3c, 0xc2, 0x64, 0x34, 0xe6, 0x8a, 0x12, 0x3f, 0x60, 0x63, 0x1e, 0xe2,
+	0x6a, 0x00, 0xc2, 0x9b, 0x30, 0x47, 0x06, 0x3e, 0x15, 0xa6, 0x51, 0x37, 0x1a, 0x25, 0x92, 0x92,
+	0xe0, 0x1a, 0xec, 0x25, 0x67, 0x97, 0xc1, 0x47, 0xfa, 0xc6, 0xa0, 0x44, 0x8a, 0xf1, 0xe9, 0xe5,
+	0x23, 0xfc, 0x03, 0x28, 0xaf, 0x9e, 0xb7, 0xef, 0xd8, 0x5d, 0xf3, 0xd7, 0x05, 0x8d, 0x29, 0xc5,
+	0x18, 0x25, 0xb4, 0x3e, 0x37, 0xe0, 0xad, 0xb5, 0x2d, 0xf4, 0xb9, 0xbb, 0xc4, 0x77, 0xa0, 0x30,
+	0xa7, 0x42, 0x38, 0x13, 0xbd, 0x03, 0x63, 0x63, 0x92, 0x25, 0x28, 0x55, 0xdd, 0x73, 0x3a, 0xe7,
+	0x71, 0x75, 0xab, 0xb1, 0x72, 0x41, 0x7a, 0x73, 0xca, 0x03, 0x39, 0x98, 0x52, 0x6f, 0x32, 0x95,
+	0x11, 0x8f, 0x57, 0x22, 0xe9, 0x81, 0x16, 0xe2, 0x5b, 0x50, 0x16, 0x7c, 0x4e, 0x07, 0xab, 0xa3,
+	0x58, 0x5e, 0x1f, 0xc5, 0x4a, 0x4a, 0x7a, 0x18, 0x39, 0x8b, 0x0f, 0xe0, 0xbd, 0x75, 0xd4, 0xe0,
+	0x8c, 0xc6, 0xfc, 0xc7, 0xb0, 0x31, 0xbf, 0x93, 0x5e, 0x79, 0xf8, 0x6a, 0x93, 0xee, 0xc3, 0x5b,
+	0x74, 0x21, 0x29, 0x53, 0x39, 0x32, 0xe0, 0xfa, 0x3a, 0x59, 0x98, 0x5f, 0xed, 0x9e, 0xb3, 0xcd,
+	0x4a, 0x82, 0x7f, 0x14, 0xc2, 0xf1, 0x13, 0xa8, 0xad, 0x99, 0x3f, 0x43, 0xe1, 0xd5, 0x73, 0x14,
+	0xde, 0x48, 0xbd, 0x39, 0xee, 0xbf, 0xa2, 0xdb, 0x7a, 0x86, 0xe0, 0x5a, 0x2a, 0x24, 0xbd, 0x28,
+	0x2d, 0xf0, 0x5d, 0x28, 0xa9, 0xf8, 0x53, 0x5f, 0xe7, 0x4e, 0x1c, 0x98, 0x9b, 0xcd, 0xf0, 0xfa,
+	0xbd, 0x29, 0x17, 0xcd, 0xe8, 0xfa, 0xbd, 0xf9, 0x73, 0x0d, 0x53, 0x8b, 0xc8, 0x9e, 0x48, 0xc6,
+	0x02, 0x37, 0x56, 0x77, 0x6e, 0xaa, 0x68, 0x4e, 0x2f, 0xdc, 0xa7, 0x34, 0xbc, 0x8b, 0x5b, 0xcb,
+	0xae, 0x8e, 0x69, 0xac, 0x67, 0x57, 0x67, 0xdb, 0xec, 0x7a, 0x3f, 0x4c, 0x2e, 0x42, 0x8f, 0xa9,
+	0xda, 0xca, 0xa7, 0x1e, 0x93, 0x3a, 0x55, 0x58, 0x30, 0x0f, 0xfd, 0xcf, 0x12, 0x3d, 0xee, 0x1f,
+	0x3c, 0x7b, 0x51, 0x43, 0xcf, 0x5f, 0xd4, 0xd0, 0x3f, 0x5f, 0xd4, 0xd0, 0xe7, 0x2f, 0x6b, 0x3b,
+	0xcf, 0x5f, 0xd6, 0x76, 0xfe, 0xfe, 0xb2, 0xb6, 0xf3, 0xa4, 0x39, 0xf1, 0xe4, 0x34, 0x18, 0x36,
+	0x47, 0x7c, 0xde, 0x8a, 0xfe, 0xd1, 0x10, 0xfe, 0x7c, 0x28, 0xdc, 0xa3, 0x96, 0xaa, 0xfb, 0x40,
+	0x7a, 0xb3, 0x56, 0xdc, 0x00, 0x86, 0x79, 0x4d, 0x74, 0xe7, 0xbf, 0x03, 0x00, 0xf5, 0xc1, 0xe4,
+	0xd3, 0xe6, 0x18, 0x00, 0x00,
 }
 
 func (m *Customer1) Marshal() (dAtA []byte, err error) {
@@ -5252,7 +5252,7 @@ func (m *TestUpdatedTxBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	if m.SomeNewField != 0 {
 		i = encodeVarintUnknonwnproto(dAtA, i, uint64(m.SomeNewField))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x30
 	}
 	if m.TimeoutHeight != 0 {
 		i = encodeVarintUnknonwnproto(dAtA, i, uint64(m.TimeoutHeight))
@@ -12593,7 +12593,7 @@ func (m *TestUpdatedTxBody) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SomeNewField", wireType)
 			}
@@ -167,6 +167,10 @@ var (
 	// supplied.
 	ErrInvalidGasLimit = Register(RootCodespace, 41, "invalid gas limit")
 
+	// ErrTxTimeout defines an error for when a tx is rejected out due to an
+	// explicitly set timeout timestamp.
+	ErrTxTimeout = Register(RootCodespace, 42, "tx timeout")
+
 	// ErrPanic is only set when we recover from a panic, so we know to
 	// redact potentially sensitive system info
 	ErrPanic = errorsmod.ErrPanic
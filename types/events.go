@@ -237,6 +237,7 @@ const (
 	AttributeKeySignature       = "signature"
 	AttributeKeyFee             = "fee"
 	AttributeKeyFeePayer        = "fee_payer"
+	AttributeKeyFeeRefund       = "fee_refund"
 
 	EventTypeMessage = "message"
 
@@ -0,0 +1,140 @@
+package mempool
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Mempool = (*LaneMempool)(nil)
+
+// Lane is a part of a LaneMempool holding the transactions matched by Match.
+type Lane struct {
+	// Name is the name of the lane.
+	Name string
+
+	// Match returns whether the transaction belongs to the lane. It must only
+	// depend on the transaction.
+	Match func(sdk.Tx) bool
+
+	// Mempool holds and orders the transactions of the lane.
+	Mempool Mempool
+}
+
+// LaneMempool is a mempool which splits transactions into lanes, each with its own
+// mempool. A transaction goes into the first lane matching it, or into the default
+// lane if no lane matches. Select returns the transactions of each lane in order,
+// followed by the default lane, so that transactions of a lane never compete with
+// the transactions of other lanes, e.g. to keep governance or oracle transactions
+// out of the fee competition of the default lane.
+//
+// NOTE: the nonce order of a sender is only kept within each lane. A transaction of
+// a lane may be selected before a transaction of the same sender with a lower nonce
+// in a later lane, which then fails the sequence check of the AnteHandler.
+type LaneMempool struct {
+	lanes       []Lane
+	defaultLane Mempool
+}
+
+// NewLaneMempool creates a new LaneMempool with the given default lane and lanes.
+func NewLaneMempool(defaultLane Mempool, lanes ...Lane) *LaneMempool {
+	return &LaneMempool{lanes: lanes, defaultLane: defaultLane}
+}
+
+// Insert adds the transaction to the mempool of its lane.
+func (mp *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	return mp.laneFor(tx).Insert(ctx, tx)
+}
+
+// Select returns an iterator over the transactions of all lanes, lane by lane.
+func (mp *LaneMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	iters := make([]Iterator, 0, len(mp.lanes)+1)
+	for _, lane := range mp.lanes {
+		iters = append(iters, lane.Mempool.Select(ctx, txs))
+	}
+	iters = append(iters, mp.defaultLane.Select(ctx, txs))
+
+	return newChainIterator(iters)
+}
+
+// CountTx returns the number of transactions in all lanes.
+func (mp *LaneMempool) CountTx() int {
+	n := mp.defaultLane.CountTx()
+	for _, lane := range mp.lanes {
+		n += lane.Mempool.CountTx()
+	}
+	return n
+}
+
+// Remove removes the transaction from the mempool of its lane.
+func (mp *LaneMempool) Remove(tx sdk.Tx) error {
+	return mp.laneFor(tx).Remove(tx)
+}
+
+// LaneCountTx returns the number of transactions in the lane with the given name,
+// or in the default lane if no lane has that name.
+func (mp *LaneMempool) LaneCountTx(name string) int {
+	for _, lane := range mp.lanes {
+		if lane.Name == name {
+			return lane.Mempool.CountTx()
+		}
+	}
+	return mp.defaultLane.CountTx()
+}
+
+func (mp *LaneMempool) laneFor(tx sdk.Tx) Mempool {
+	for _, lane := range mp.lanes {
+		if lane.Match(tx) {
+			return lane.Mempool
+		}
+	}
+	return mp.defaultLane
+}
+
+// MatchMsgTypeURLs returns a lane matcher accepting the transactions whose messages
+// all have one of the given type URLs.
+func MatchMsgTypeURLs(typeURLs ...string) func(sdk.Tx) bool {
+	allowed := make(map[string]bool, len(typeURLs))
+	for _, url := range typeURLs {
+		allowed[url] = true
+	}
+
+	return func(tx sdk.Tx) bool {
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return false
+		}
+		for _, msg := range msgs {
+			if !allowed[sdk.MsgTypeURL(msg)] {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// chainIterator iterates over the transactions of several iterators in order.
+type chainIterator struct {
+	cur  Iterator
+	rest []Iterator
+}
+
+func newChainIterator(iters []Iterator) Iterator {
+	for i, iter := range iters {
+		if iter != nil {
+			return &chainIterator{cur: iter, rest: iters[i+1:]}
+		}
+	}
+	return nil
+}
+
+func (i *chainIterator) Next() Iterator {
+	if next := i.cur.Next(); next != nil {
+		return &chainIterator{cur: next, rest: i.rest}
+	}
+	return newChainIterator(i.rest)
+}
+
+func (i *chainIterator) Tx() sdk.Tx {
+	return i.cur.Tx()
+}
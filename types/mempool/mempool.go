@@ -0,0 +1,128 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// Mempool defines an application side mempool. BaseApp inserts transactions which
+// pass CheckTx and removes transactions once they are included in a block, so that
+// the mempool mirrors the transactions known to the node.
+//
+// Implementations are not safe for concurrent use. BaseApp only calls them from
+// CheckTx and DeliverTx, which are serialized by the ABCI connection.
+type Mempool interface {
+	// Insert attempts to insert a transaction into the mempool, returning an error
+	// if the transaction is invalid for the mempool or the mempool is full.
+	Insert(context.Context, sdk.Tx) error
+
+	// Select returns an Iterator over the transactions of the mempool in the order
+	// they should be included in a block. The optional txs are the raw transactions
+	// proposed so far, which an implementation may use as a hint.
+	Select(context.Context, [][]byte) Iterator
+
+	// CountTx returns the number of transactions in the mempool.
+	CountTx() int
+
+	// Remove attempts to remove a transaction from the mempool, returning
+	// ErrTxNotFound if the transaction is not in the mempool.
+	Remove(sdk.Tx) error
+}
+
+// Iterator defines an iterator over the transactions of a mempool.
+type Iterator interface {
+	// Next returns the next transaction of the mempool, or nil if there is none.
+	Next() Iterator
+
+	// Tx returns the transaction the iterator points to.
+	Tx() sdk.Tx
+}
+
+var (
+	// ErrTxNotFound is returned when removing a transaction which is not in the mempool.
+	ErrTxNotFound = errors.New("tx not found in mempool")
+
+	// ErrMempoolTxMaxCapacity is returned when inserting into a full mempool.
+	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+
+	// ErrTxReplacement is returned when inserting a transaction with the same sender
+	// and nonce as a transaction of the mempool which it may not replace.
+	ErrTxReplacement = errors.New("tx with the same sender and nonce is already in the mempool")
+)
+
+// IsEmpty returns an error if the mempool is not empty.
+func IsEmpty(mempool Mempool) error {
+	if n := mempool.CountTx(); n != 0 {
+		return fmt.Errorf("mempool is not empty: %d txs", n)
+	}
+	return nil
+}
+
+// SelectAll returns all the transactions returned by the iterator of Select.
+func SelectAll(ctx context.Context, mempool Mempool, txs [][]byte) []sdk.Tx {
+	var res []sdk.Tx
+	for iter := mempool.Select(ctx, txs); iter != nil; iter = iter.Next() {
+		res = append(res, iter.Tx())
+	}
+	return res
+}
+
+// txKey identifies a transaction of the mempool by the address of its first signer
+// and the sequence of its first signature. Unordered transactions do not use the
+// sequence, so they are identified by their timeout timestamp instead.
+type txKey struct {
+	sender string
+	nonce  uint64
+}
+
+func getTxKey(tx sdk.Tx) (txKey, error) {
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return txKey{}, fmt.Errorf("tx of type %T does not implement SigVerifiableTx", tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return txKey{}, err
+	}
+	signers := sigTx.GetSigners()
+	if len(sigs) == 0 || len(signers) == 0 {
+		return txKey{}, errors.New("tx must have at least one signer")
+	}
+
+	nonce := sigs[0].Sequence
+	if unorderedTx, ok := tx.(sdk.TxWithUnordered); ok && unorderedTx.GetUnordered() {
+		nonce = uint64(unorderedTx.GetTimeoutTimestamp().UnixNano())
+	}
+
+	return txKey{sender: signers[0].String(), nonce: nonce}, nil
+}
+
+// sliceIterator iterates over a snapshot of the transactions of a mempool, so that
+// changes to the mempool do not affect iterators which are already returned.
+type sliceIterator struct {
+	txs []sdk.Tx
+	pos int
+}
+
+func newSliceIterator(txs []sdk.Tx) Iterator {
+	if len(txs) == 0 {
+		return nil
+	}
+	return &sliceIterator{txs: txs}
+}
+
+func (i *sliceIterator) Next() Iterator {
+	if i.pos+1 >= len(i.txs) {
+		return nil
+	}
+	return &sliceIterator{txs: i.txs, pos: i.pos + 1}
+}
+
+func (i *sliceIterator) Tx() sdk.Tx {
+	return i.txs[i.pos]
+}
@@ -0,0 +1,277 @@
+package mempool_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	txsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// testTx is a transaction with a single signer and message.
+type testTx struct {
+	id       int
+	sender   sdk.AccAddress
+	nonce    uint64
+	priority int64
+	msg      sdk.Msg
+}
+
+var _ signing.SigVerifiableTx = testTx{}
+
+func (tx testTx) GetMsgs() []sdk.Msg                        { return []sdk.Msg{tx.msg} }
+func (tx testTx) ValidateBasic() error                      { return nil }
+func (tx testTx) GetSigners() []sdk.AccAddress              { return []sdk.AccAddress{tx.sender} }
+func (tx testTx) GetPubKeys() ([]cryptotypes.PubKey, error) { return nil, nil }
+func (tx testTx) GetSignaturesV2() ([]txsigning.SignatureV2, error) {
+	return []txsigning.SignatureV2{{Sequence: tx.nonce}}, nil
+}
+
+func (tx testTx) String() string {
+	return fmt.Sprintf("tx %d (sender %s, nonce %d, priority %d)", tx.id, tx.sender, tx.nonce, tx.priority)
+}
+
+var (
+	sendMsg = &testdata.TestMsg{}
+	laneMsg = &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "lane"}}
+)
+
+func newTestTx(id int, sender sdk.AccAddress, nonce uint64, priority int64) testTx {
+	return testTx{id: id, sender: sender, nonce: nonce, priority: priority, msg: sendMsg}
+}
+
+func newSenders(n int) []sdk.AccAddress {
+	senders := make([]sdk.AccAddress, n)
+	for i := range senders {
+		senders[i] = sdk.AccAddress(fmt.Sprintf("sender%d", i))
+	}
+	return senders
+}
+
+func insertCtx(priority int64) context.Context {
+	return sdk.Context{}.WithContext(context.Background()).WithPriority(priority)
+}
+
+func insert(t *testing.T, mp mempool.Mempool, tx testTx) {
+	t.Helper()
+	require.NoError(t, mp.Insert(insertCtx(tx.priority), tx))
+}
+
+func selectIDs(mp mempool.Mempool) []int {
+	var ids []int
+	for _, tx := range mempool.SelectAll(context.Background(), mp, nil) {
+		ids = append(ids, tx.(testTx).id)
+	}
+	return ids
+}
+
+func TestNoOpMempool(t *testing.T) {
+	mp := mempool.NoOpMempool{}
+	tx := newTestTx(0, newSenders(1)[0], 0, 0)
+
+	require.NoError(t, mp.Insert(insertCtx(0), tx))
+	require.Nil(t, mp.Select(context.Background(), nil))
+	require.NoError(t, mempool.IsEmpty(mp))
+	require.NoError(t, mp.Remove(tx))
+}
+
+func TestSenderNonceMempool(t *testing.T) {
+	s := newSenders(3)
+	mp := mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(6))
+
+	txs := []testTx{
+		newTestTx(0, s[1], 1, 100),
+		newTestTx(1, s[0], 0, 0),
+		newTestTx(2, s[1], 0, 0),
+		newTestTx(3, s[0], 2, 0),
+		newTestTx(4, s[2], 5, 0),
+		newTestTx(5, s[0], 1, 0),
+	}
+	for _, tx := range txs {
+		insert(t, mp, tx)
+	}
+	require.Equal(t, 6, mp.CountTx())
+
+	// one tx per sender and round, in nonce order, ignoring priorities
+	require.Equal(t, []int{1, 2, 4, 5, 0, 3}, selectIDs(mp))
+
+	require.ErrorIs(t, mp.Insert(insertCtx(0), newTestTx(6, s[2], 6, 0)), mempool.ErrMempoolTxMaxCapacity)
+	require.NoError(t, mp.Remove(txs[2]))
+	require.ErrorIs(t, mp.Remove(txs[2]), mempool.ErrTxNotFound)
+	require.ErrorIs(t, mp.Insert(insertCtx(10), newTestTx(6, s[0], 0, 10)), mempool.ErrTxReplacement)
+
+	require.Equal(t, []int{1, 0, 4, 5, 3}, selectIDs(mp))
+}
+
+func TestPriorityNonceMempool(t *testing.T) {
+	s := newSenders(3)
+	mp := mempool.NewPriorityMempool(mempool.PriorityNonceMaxTxOpt(4))
+
+	txs := []testTx{
+		newTestTx(0, s[0], 0, 1),
+		newTestTx(1, s[0], 1, 100),
+		newTestTx(2, s[1], 0, 50),
+		newTestTx(3, s[2], 3, 50),
+	}
+	for _, tx := range txs {
+		insert(t, mp, tx)
+	}
+
+	// the tx with priority 100 waits for the tx of its sender with a lower nonce, and
+	// txs with the same priority are ordered by sender
+	require.Equal(t, []int{2, 3, 0, 1}, selectIDs(mp))
+
+	require.ErrorIs(t, mp.Insert(insertCtx(10), newTestTx(4, s[1], 1, 10)), mempool.ErrMempoolTxMaxCapacity)
+
+	// a tx with the same sender and nonce only replaces a tx with a lower priority
+	require.ErrorIs(t, mp.Insert(insertCtx(1), newTestTx(4, s[0], 0, 1)), mempool.ErrTxReplacement)
+	insert(t, mp, newTestTx(4, s[0], 0, 200))
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, []int{4, 1, 2, 3}, selectIDs(mp))
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.ErrorIs(t, mp.Remove(txs[1]), mempool.ErrTxNotFound)
+	require.Equal(t, []int{4, 2, 3}, selectIDs(mp))
+}
+
+func TestLaneMempool(t *testing.T) {
+	s := newSenders(2)
+	lane := mempool.NewSenderNonceMempool()
+	mp := mempool.NewLaneMempool(mempool.NewPriorityMempool(), mempool.Lane{
+		Name:    "dogs",
+		Match:   mempool.MatchMsgTypeURLs(sdk.MsgTypeURL(laneMsg)),
+		Mempool: lane,
+	})
+
+	laneTx := newTestTx(0, s[0], 0, 0)
+	laneTx.msg = laneMsg
+
+	insert(t, mp, newTestTx(1, s[1], 0, 1000))
+	insert(t, mp, laneTx)
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, 1, mp.LaneCountTx("dogs"))
+	require.Equal(t, 1, lane.CountTx())
+
+	// lane txs do not compete with the fees of the default lane
+	require.Equal(t, []int{0, 1}, selectIDs(mp))
+
+	require.NoError(t, mp.Remove(laneTx))
+	require.Equal(t, 0, lane.CountTx())
+	require.Equal(t, []int{1}, selectIDs(mp))
+}
+
+// TestMempoolSimulation randomly inserts transactions into the mempools and removes
+// the transactions selected for blocks, and checks that every mempool returns all of
+// its transactions while keeping the transactions of each sender in nonce order.
+func TestMempoolSimulation(t *testing.T) {
+	mempools := map[string]func() mempool.Mempool{
+		"sender nonce":   func() mempool.Mempool { return mempool.NewSenderNonceMempool() },
+		"priority nonce": func() mempool.Mempool { return mempool.NewPriorityMempool() },
+		"lanes": func() mempool.Mempool {
+			return mempool.NewLaneMempool(mempool.NewPriorityMempool(), mempool.Lane{
+				Name:    "dogs",
+				Match:   mempool.MatchMsgTypeURLs(sdk.MsgTypeURL(laneMsg)),
+				Mempool: mempool.NewSenderNonceMempool(),
+			})
+		},
+	}
+
+	for name, newMempool := range mempools {
+		t.Run(name, func(t *testing.T) {
+			for seed := int64(0); seed < 10; seed++ {
+				simulateMempool(t, rand.New(rand.NewSource(seed)), newMempool(), name == "priority nonce")
+			}
+		})
+	}
+}
+
+func simulateMempool(t *testing.T, r *rand.Rand, mp mempool.Mempool, checkPriority bool) {
+	senders := newSenders(1 + r.Intn(10))
+	nextNonce := make(map[string]uint64)
+	pending := make(map[int]testTx)
+
+	for step, id := 0, 0; step < 300; step++ {
+		if r.Intn(4) != 0 {
+			// insert a tx, sometimes skipping nonces
+			sender := senders[r.Intn(len(senders))]
+			nonce := nextNonce[sender.String()] + uint64(r.Intn(2))
+			nextNonce[sender.String()] = nonce + 1
+
+			tx := newTestTx(id, sender, nonce, r.Int63n(100))
+			if r.Intn(3) == 0 {
+				tx.msg = laneMsg
+			}
+			insert(t, mp, tx)
+			pending[id] = tx
+			id++
+		} else {
+			// remove the first txs selected for a block, and some random txs
+			selected := mempool.SelectAll(context.Background(), mp, nil)
+			for _, tx := range selected[:r.Intn(len(selected)+1)] {
+				require.NoError(t, mp.Remove(tx))
+				delete(pending, tx.(testTx).id)
+			}
+			for id, tx := range pending {
+				if r.Intn(10) == 0 {
+					require.NoError(t, mp.Remove(tx))
+					delete(pending, id)
+				}
+			}
+		}
+
+		checkMempool(t, mp, pending, checkPriority)
+	}
+}
+
+func checkMempool(t *testing.T, mp mempool.Mempool, pending map[int]testTx, checkPriority bool) {
+	require.Equal(t, len(pending), mp.CountTx())
+
+	selected := mempool.SelectAll(context.Background(), mp, nil)
+	require.Len(t, selected, len(pending))
+
+	// the txs of each sender (and lane) are selected in nonce order
+	lastNonce := make(map[string]uint64)
+	for _, sdkTx := range selected {
+		tx := sdkTx.(testTx)
+		require.Contains(t, pending, tx.id)
+
+		key := tx.sender.String() + sdk.MsgTypeURL(tx.msg)
+		if last, ok := lastNonce[key]; ok {
+			require.Greater(t, tx.nonce, last, tx.String())
+		}
+		lastNonce[key] = tx.nonce
+	}
+
+	if !checkPriority {
+		return
+	}
+
+	// each selected tx has the highest priority among the lowest nonce txs of each sender
+	queues := make(map[string][]testTx)
+	for _, tx := range pending {
+		queues[tx.sender.String()] = append(queues[tx.sender.String()], tx)
+	}
+	for _, queue := range queues {
+		sort.Slice(queue, func(i, j int) bool { return queue[i].nonce < queue[j].nonce })
+	}
+	for _, sdkTx := range selected {
+		tx := sdkTx.(testTx)
+		queue := queues[tx.sender.String()]
+		require.Equal(t, tx.id, queue[0].id, tx.String())
+		for _, other := range queues {
+			if len(other) > 0 {
+				require.GreaterOrEqual(t, tx.priority, other[0].priority, tx.String())
+			}
+		}
+		queues[tx.sender.String()] = queue[1:]
+	}
+}
@@ -0,0 +1,19 @@
+package mempool
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Mempool = NoOpMempool{}
+
+// NoOpMempool defines a no-op mempool. Transactions are completely discarded and
+// ignored when BaseApp interacts with the mempool, and ordering is left to
+// Tendermint. It is the default mempool of BaseApp.
+type NoOpMempool struct{}
+
+func (NoOpMempool) Insert(context.Context, sdk.Tx) error      { return nil }
+func (NoOpMempool) Select(context.Context, [][]byte) Iterator { return nil }
+func (NoOpMempool) CountTx() int                              { return 0 }
+func (NoOpMempool) Remove(sdk.Tx) error                       { return nil }
@@ -0,0 +1,161 @@
+package mempool
+
+import (
+	"container/heap"
+	"context"
+
+	"github.com/tidwall/btree"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Mempool = (*PriorityNonceMempool)(nil)
+
+// PriorityNonceMempool is a mempool which orders transactions by the priority set
+// by the AnteHandler, typically derived from the fee, while keeping the transactions
+// of each sender in nonce order: a transaction is only selected after all the
+// transactions of its sender with a lower nonce.
+type PriorityNonceMempool struct {
+	senders map[string]*btree.BTreeG[*priorityTx]
+	index   map[txKey]*priorityTx
+	maxTx   int
+}
+
+type priorityTx struct {
+	key      txKey
+	priority int64
+	tx       sdk.Tx
+}
+
+func byPriorityTxNonce(a, b *priorityTx) bool { return a.key.nonce < b.key.nonce }
+
+// PriorityNonceOption is an option of the PriorityNonceMempool.
+type PriorityNonceOption func(*PriorityNonceMempool)
+
+// PriorityNonceMaxTxOpt limits the number of transactions of the mempool. A value
+// lower than or equal to 0 means no limit.
+func PriorityNonceMaxTxOpt(maxTx int) PriorityNonceOption {
+	return func(mp *PriorityNonceMempool) { mp.maxTx = maxTx }
+}
+
+// NewPriorityMempool creates a new PriorityNonceMempool.
+func NewPriorityMempool(opts ...PriorityNonceOption) *PriorityNonceMempool {
+	mp := &PriorityNonceMempool{
+		senders: make(map[string]*btree.BTreeG[*priorityTx]),
+		index:   make(map[txKey]*priorityTx),
+	}
+	for _, opt := range opts {
+		opt(mp)
+	}
+	return mp
+}
+
+// Insert adds a transaction to the mempool with the priority of the sdk.Context. A
+// transaction with the same sender and nonce as a transaction of the mempool replaces
+// it if its priority is higher, and is rejected with ErrTxReplacement otherwise.
+func (mp *PriorityNonceMempool) Insert(goCtx context.Context, tx sdk.Tx) error {
+	key, err := getTxKey(tx)
+	if err != nil {
+		return err
+	}
+	priority := sdk.UnwrapSDKContext(goCtx).Priority()
+
+	if existing, ok := mp.index[key]; ok {
+		if priority <= existing.priority {
+			return ErrTxReplacement
+		}
+		existing.priority, existing.tx = priority, tx
+		return nil
+	}
+	if mp.maxTx > 0 && len(mp.index) >= mp.maxTx {
+		return ErrMempoolTxMaxCapacity
+	}
+
+	txs, ok := mp.senders[key.sender]
+	if !ok {
+		txs = btree.NewBTreeGOptions(byPriorityTxNonce, btree.Options{NoLocks: true})
+		mp.senders[key.sender] = txs
+	}
+	ptx := &priorityTx{key: key, priority: priority, tx: tx}
+	txs.Set(ptx)
+	mp.index[key] = ptx
+
+	return nil
+}
+
+// Select returns an iterator over the transactions of the mempool. It repeatedly
+// takes the transaction with the highest priority among the transactions with the
+// lowest nonce of each sender. Ties are broken by sender address.
+func (mp *PriorityNonceMempool) Select(_ context.Context, _ [][]byte) Iterator {
+	h := make(senderHeap, 0, len(mp.senders))
+	for _, txs := range mp.senders {
+		h = append(h, txs.Items())
+	}
+	heap.Init(&h)
+
+	txs := make([]sdk.Tx, 0, len(mp.index))
+	for h.Len() > 0 {
+		queue := h[0]
+		txs = append(txs, queue[0].tx)
+		if len(queue) == 1 {
+			heap.Pop(&h)
+		} else {
+			h[0] = queue[1:]
+			heap.Fix(&h, 0)
+		}
+	}
+
+	return newSliceIterator(txs)
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *PriorityNonceMempool) CountTx() int {
+	return len(mp.index)
+}
+
+// Remove removes a transaction from the mempool.
+func (mp *PriorityNonceMempool) Remove(tx sdk.Tx) error {
+	key, err := getTxKey(tx)
+	if err != nil {
+		return err
+	}
+	ptx, ok := mp.index[key]
+	if !ok {
+		return ErrTxNotFound
+	}
+
+	txs := mp.senders[key.sender]
+	txs.Delete(ptx)
+	if txs.Len() == 0 {
+		delete(mp.senders, key.sender)
+	}
+	delete(mp.index, key)
+
+	return nil
+}
+
+// senderHeap is a max-heap of the nonce ordered transactions of each sender, ordered
+// by the priority of their first transaction.
+type senderHeap [][]*priorityTx
+
+func (h senderHeap) Len() int { return len(h) }
+
+func (h senderHeap) Less(i, j int) bool {
+	a, b := h[i][0], h[j][0]
+	if a.priority != b.priority {
+		return a.priority > b.priority
+	}
+	return a.key.sender < b.key.sender
+}
+
+func (h senderHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *senderHeap) Push(x interface{}) { *h = append(*h, x.([]*priorityTx)) }
+
+func (h *senderHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
@@ -0,0 +1,126 @@
+package mempool
+
+import (
+	"context"
+	"sort"
+
+	"github.com/tidwall/btree"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Mempool = (*SenderNonceMempool)(nil)
+
+// SenderNonceMempool is a mempool which orders the transactions of each sender by
+// nonce and does not let senders compete on fees: Select takes the transactions of
+// all senders in rounds, one transaction of each sender per round, with the senders
+// ordered by address.
+type SenderNonceMempool struct {
+	senders  map[string]*btree.BTreeG[nonceTx]
+	existing map[txKey]struct{}
+	maxTx    int
+}
+
+type nonceTx struct {
+	nonce uint64
+	tx    sdk.Tx
+}
+
+func byNonce(a, b nonceTx) bool { return a.nonce < b.nonce }
+
+// SenderNonceOption is an option of the SenderNonceMempool.
+type SenderNonceOption func(*SenderNonceMempool)
+
+// SenderNonceMaxTxOpt limits the number of transactions of the mempool. A value
+// lower than or equal to 0 means no limit.
+func SenderNonceMaxTxOpt(maxTx int) SenderNonceOption {
+	return func(mp *SenderNonceMempool) { mp.maxTx = maxTx }
+}
+
+// NewSenderNonceMempool creates a new SenderNonceMempool.
+func NewSenderNonceMempool(opts ...SenderNonceOption) *SenderNonceMempool {
+	mp := &SenderNonceMempool{
+		senders:  make(map[string]*btree.BTreeG[nonceTx]),
+		existing: make(map[txKey]struct{}),
+	}
+	for _, opt := range opts {
+		opt(mp)
+	}
+	return mp
+}
+
+// Insert adds a transaction to the mempool. A transaction with the same sender and
+// nonce as a transaction of the mempool is rejected with ErrTxReplacement.
+func (mp *SenderNonceMempool) Insert(_ context.Context, tx sdk.Tx) error {
+	key, err := getTxKey(tx)
+	if err != nil {
+		return err
+	}
+	if _, ok := mp.existing[key]; ok {
+		return ErrTxReplacement
+	}
+	if mp.maxTx > 0 && len(mp.existing) >= mp.maxTx {
+		return ErrMempoolTxMaxCapacity
+	}
+
+	txs, ok := mp.senders[key.sender]
+	if !ok {
+		txs = btree.NewBTreeGOptions(byNonce, btree.Options{NoLocks: true})
+		mp.senders[key.sender] = txs
+	}
+	txs.Set(nonceTx{nonce: key.nonce, tx: tx})
+	mp.existing[key] = struct{}{}
+
+	return nil
+}
+
+// Select returns an iterator over the transactions of the mempool, taking one
+// transaction of each sender per round in nonce order.
+func (mp *SenderNonceMempool) Select(_ context.Context, _ [][]byte) Iterator {
+	senders := make([]string, 0, len(mp.senders))
+	for sender := range mp.senders {
+		senders = append(senders, sender)
+	}
+	sort.Strings(senders)
+
+	queues := make([][]nonceTx, len(senders))
+	for i, sender := range senders {
+		queues[i] = mp.senders[sender].Items()
+	}
+
+	txs := make([]sdk.Tx, 0, len(mp.existing))
+	for round := 0; len(txs) < len(mp.existing); round++ {
+		for _, queue := range queues {
+			if round < len(queue) {
+				txs = append(txs, queue[round].tx)
+			}
+		}
+	}
+
+	return newSliceIterator(txs)
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *SenderNonceMempool) CountTx() int {
+	return len(mp.existing)
+}
+
+// Remove removes a transaction from the mempool.
+func (mp *SenderNonceMempool) Remove(tx sdk.Tx) error {
+	key, err := getTxKey(tx)
+	if err != nil {
+		return err
+	}
+	if _, ok := mp.existing[key]; !ok {
+		return ErrTxNotFound
+	}
+
+	txs := mp.senders[key.sender]
+	txs.Delete(nonceTx{nonce: key.nonce})
+	if txs.Len() == 0 {
+		delete(mp.senders, key.sender)
+	}
+	delete(mp.existing, key)
+
+	return nil
+}
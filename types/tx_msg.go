@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	fmt "fmt"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 
@@ -71,6 +72,23 @@ type (
 
 		GetTimeoutHeight() uint64
 	}
+
+	// TxWithTimeoutTimestamp extends the Tx interface by allowing a transaction to
+	// set a block time timeout.
+	TxWithTimeoutTimestamp interface {
+		Tx
+
+		GetTimeoutTimestamp() time.Time
+	}
+
+	// TxWithUnordered extends the Tx interface by allowing a transaction to be
+	// unordered, i.e. replay protected by its hash until its timeout timestamp
+	// instead of by the sequences of its signers.
+	TxWithUnordered interface {
+		TxWithTimeoutTimestamp
+
+		GetUnordered() bool
+	}
 )
 
 // TxDecoder unmarshals transaction bytes
@@ -0,0 +1,17 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BeginBlocker prunes the hashes of the unordered txs which timed out
+func BeginBlocker(ctx sdk.Context, ak keeper.AccountKeeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	ak.RemoveExpiredUnorderedTxs(ctx)
+}
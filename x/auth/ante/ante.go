@@ -1,6 +1,8 @@
 package ante
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -16,7 +18,22 @@ type HandlerOptions struct {
 	FeegrantKeeper         FeegrantKeeper
 	SignModeHandler        authsigning.SignModeHandler
 	SigGasConsumer         func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
+	MsgGasConsumer         MsgGasConsumer
 	TxFeeChecker           TxFeeChecker
+	// ContextSigGasConsumer takes precedence over SigGasConsumer, e.g. to read the
+	// signature verification costs from the state.
+	ContextSigGasConsumer ContextSignatureVerificationGasConsumer
+	// TxPriorityBooster boosts the priority set from the fee of the tx, e.g. for the
+	// txs of a priority lane.
+	TxPriorityBooster TxPriorityBooster
+	// UnorderedTxKeeper keeps the hashes of the unordered txs, which are rejected
+	// without it. MaxUnorderedTxTimeoutDuration defaults to
+	// DefaultMaxUnorderedTxTimeoutDuration.
+	UnorderedTxKeeper             UnorderedTxKeeper
+	MaxUnorderedTxTimeoutDuration time.Duration
+	// AuthenticatorRegistry holds the Authenticators of the AuthenticatedAccounts,
+	// whose txs are rejected without it.
+	AuthenticatorRegistry *AuthenticatorRegistry
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -35,18 +52,26 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	sigGasConsumeDecorator := NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer)
+	if options.ContextSigGasConsumer != nil {
+		sigGasConsumeDecorator = NewContextSigGasConsumeDecorator(options.AccountKeeper, options.ContextSigGasConsumer)
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		NewValidateBasicDecorator(),
 		NewTxTimeoutHeightDecorator(),
+		NewUnorderedTxDecorator(options.UnorderedTxKeeper, options.MaxUnorderedTxTimeoutDuration),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		NewConsumeMsgGasDecorator(options.MsgGasConsumer),
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		NewTxPriorityBoostDecorator(options.TxPriorityBooster),
 		NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(options.AccountKeeper),
-		NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
-		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		sigGasConsumeDecorator,
+		NewSigVerificationDecoratorWithAuthenticators(options.AccountKeeper, options.SignModeHandler, options.AuthenticatorRegistry),
 		NewIncrementSequenceDecorator(options.AccountKeeper),
 	}
 
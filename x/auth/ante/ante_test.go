@@ -498,7 +498,10 @@ func (suite *AnteTestSuite) TestAnteHandlerFees() {
 		{
 			"signer doesn't have any more funds",
 			func() {
+				// the fees reach the fee collector at the end of the block
 				modAcc := suite.app.AccountKeeper.GetModuleAccount(suite.ctx, types.FeeCollectorName)
+				suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, modAcc.GetAddress()).Empty())
+				suite.app.BankKeeper.WriteDeferredBalances(suite.ctx)
 
 				require.True(sdk.IntEq(suite.T(), suite.app.BankKeeper.GetAllBalances(suite.ctx, modAcc.GetAddress()).AmountOf("atom"), sdk.NewInt(150)))
 				require.True(sdk.IntEq(suite.T(), suite.app.BankKeeper.GetAllBalances(suite.ctx, addr0).AmountOf("atom"), sdk.NewInt(0)))
@@ -0,0 +1,83 @@
+package ante
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AuthenticatedAccount defines an account whose transactions are authenticated by
+// a registered Authenticator instead of the account's public key.
+type AuthenticatedAccount interface {
+	types.AccountI
+
+	// GetAuthenticatorName returns the name the Authenticator is registered with.
+	GetAuthenticatorName() string
+	// GetAuthenticatorConfig returns the account-specific configuration the
+	// Authenticator is run with.
+	GetAuthenticatorConfig() proto.Message
+}
+
+// AuthenticationRequest holds a signature of a tx to be authenticated on behalf
+// of an AuthenticatedAccount.
+type AuthenticationRequest struct {
+	Account AuthenticatedAccount
+	Tx      sdk.Tx
+	// Signature is the signature of the account, with the public key the tx was
+	// signed with, which is not necessarily the public key of the account.
+	Signature       signing.SignatureV2
+	SignerData      authsigning.SignerData
+	SignModeHandler authsigning.SignModeHandler
+}
+
+// Authenticator defines pluggable authentication logic for the transactions of
+// an AuthenticatedAccount, e.g. session keys, social recovery or spending limits.
+type Authenticator interface {
+	// ValidateConfig validates the configuration of an account before it is set.
+	ValidateConfig(config proto.Message) error
+	// Authenticate returns an error if the signature of the request is not
+	// valid for the account.
+	Authenticate(ctx sdk.Context, req AuthenticationRequest) error
+}
+
+// AuthenticatorRegistry maps names to the Authenticators accounts can be
+// authenticated by. The registry must be filled when the app is constructed and
+// must be the same on every node.
+type AuthenticatorRegistry struct {
+	authenticators map[string]Authenticator
+}
+
+// NewAuthenticatorRegistry returns an empty AuthenticatorRegistry.
+func NewAuthenticatorRegistry() *AuthenticatorRegistry {
+	return &AuthenticatorRegistry{
+		authenticators: make(map[string]Authenticator),
+	}
+}
+
+// Register registers an Authenticator under the given name. It panics if the
+// name is empty or already registered.
+func (r *AuthenticatorRegistry) Register(name string, authenticator Authenticator) {
+	if name == "" {
+		panic("authenticator name cannot be empty")
+	}
+	if _, ok := r.authenticators[name]; ok {
+		panic(fmt.Sprintf("authenticator %s is already registered", name))
+	}
+
+	r.authenticators[name] = authenticator
+}
+
+// Get returns the Authenticator registered under the given name.
+func (r *AuthenticatorRegistry) Get(name string) (Authenticator, bool) {
+	if r == nil {
+		return nil, false
+	}
+
+	authenticator, ok := r.authenticators[name]
+	return authenticator, ok
+}
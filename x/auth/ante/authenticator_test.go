@@ -0,0 +1,162 @@
+package ante_test
+
+import (
+	"time"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
+	authenticatortypes "github.com/cosmos/cosmos-sdk/x/auth/authenticator/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *AnteTestSuite) TestAuthenticatorAccount() {
+	suite.SetupTest(false) // setup
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(blockTime)
+
+	registry := ante.NewAuthenticatorRegistry()
+	registry.Register(authenticator.SessionKeyAuthenticatorName, authenticator.NewSessionKeyAuthenticator())
+	anteHandler, err := ante.NewAnteHandler(
+		ante.HandlerOptions{
+			AccountKeeper:         suite.app.AccountKeeper,
+			BankKeeper:            suite.app.BankKeeper,
+			SignModeHandler:       suite.clientCtx.TxConfig.SignModeHandler(),
+			SigGasConsumer:        ante.DefaultSigVerificationGasConsumer,
+			AuthenticatorRegistry: registry,
+		},
+	)
+	suite.Require().NoError(err)
+
+	accounts := suite.CreateTestAccounts(1)
+	priv, addr := accounts[0].priv, accounts[0].acc.GetAddress()
+	sessionPriv, _, _ := testdata.KeyTestPubAddr()
+
+	// setAuthenticator turns the account into an authenticator account with a
+	// session key of sessionPriv.
+	setAuthenticator := func(name string, expiration time.Time, allowedMsgs ...string) {
+		sessionKey, err := authenticatortypes.NewSessionKey(sessionPriv.PubKey(), &expiration, allowedMsgs)
+		suite.Require().NoError(err)
+		a, err := authenticatortypes.NewAuthenticator(name, sessionKey)
+		suite.Require().NoError(err)
+
+		baseAcc := authtypes.NewBaseAccount(addr, nil, 0, 0)
+		suite.app.AccountKeeper.SetAccount(suite.ctx, authenticatortypes.NewAuthenticatorAccount(baseAcc, a))
+	}
+	runTx := func(signer cryptotypes.PrivKey) error {
+		seq := suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetSequence()
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{signer}, []uint64{0}, []uint64{seq}, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+		_, err = anteHandler(suite.ctx, tx, false)
+		return err
+	}
+
+	setAuthenticator(authenticator.SessionKeyAuthenticatorName, blockTime.Add(time.Hour))
+
+	// the txs of the account are signed with the session key
+	suite.Require().NoError(runTx(sessionPriv))
+	suite.Require().Equal(uint64(1), suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetSequence())
+
+	// or with the key of the account
+	suite.Require().NoError(runTx(priv))
+	otherPriv, _, _ := testdata.KeyTestPubAddr()
+	suite.Require().ErrorIs(runTx(otherPriv), sdkerrors.ErrUnauthorized)
+
+	// until the session key expires
+	setAuthenticator(authenticator.SessionKeyAuthenticatorName, blockTime)
+	suite.Require().ErrorIs(runTx(sessionPriv), sdkerrors.ErrUnauthorized)
+
+	// and only for the allowed messages
+	setAuthenticator(authenticator.SessionKeyAuthenticatorName, blockTime.Add(time.Hour), sdk.MsgTypeURL(&testdata.TestMsg{}))
+	suite.Require().NoError(runTx(sessionPriv))
+	setAuthenticator(authenticator.SessionKeyAuthenticatorName, blockTime.Add(time.Hour), "/cosmos.bank.v1beta1.MsgSend")
+	suite.Require().ErrorIs(runTx(sessionPriv), sdkerrors.ErrUnauthorized)
+
+	// the txs of accounts with an unregistered authenticator are rejected
+	setAuthenticator("unknown", blockTime.Add(time.Hour))
+	suite.Require().ErrorIs(runTx(sessionPriv), sdkerrors.ErrUnauthorized)
+}
+
+func (suite *AnteTestSuite) TestAuthenticatorAccountRotation() {
+	suite.SetupTest(false) // setup
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(blockTime)
+
+	registry := ante.NewAuthenticatorRegistry()
+	registry.Register(authenticator.SessionKeyAuthenticatorName, authenticator.NewSessionKeyAuthenticator())
+	anteHandler, err := ante.NewAnteHandler(
+		ante.HandlerOptions{
+			AccountKeeper:         suite.app.AccountKeeper,
+			BankKeeper:            suite.app.BankKeeper,
+			SignModeHandler:       suite.clientCtx.TxConfig.SignModeHandler(),
+			SigGasConsumer:        ante.DefaultSigVerificationGasConsumer,
+			AuthenticatorRegistry: registry,
+		},
+	)
+	suite.Require().NoError(err)
+	msgServer := authenticator.NewMsgServerImpl(suite.app.AccountKeeper, registry)
+
+	accounts := suite.CreateTestAccounts(1)
+	priv, addr := accounts[0].priv, accounts[0].acc.GetAddress()
+	sessionPriv, _, _ := testdata.KeyTestPubAddr()
+
+	newSessionKey := func(expiration time.Time) authenticatortypes.Authenticator {
+		sessionKey, err := authenticatortypes.NewSessionKey(sessionPriv.PubKey(), &expiration, nil)
+		suite.Require().NoError(err)
+		a, err := authenticatortypes.NewAuthenticator(authenticator.SessionKeyAuthenticatorName, sessionKey)
+		suite.Require().NoError(err)
+		return a
+	}
+	// deliver runs the ante handler and, if it passes, the msg server on a tx of
+	// msg signed with signer.
+	deliver := func(signer cryptotypes.PrivKey, msg *authenticatortypes.MsgRotateAuthenticator) error {
+		seq := suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetSequence()
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+		suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{signer}, []uint64{0}, []uint64{seq}, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+		if _, err := anteHandler(suite.ctx, tx, false); err != nil {
+			return err
+		}
+		_, err = msgServer.RotateAuthenticator(sdk.WrapSDKContext(suite.ctx), msg)
+		return err
+	}
+
+	// a session key without allowed messages cannot rotate itself into a session
+	// key which never expires
+	baseAcc := authtypes.NewBaseAccount(addr, nil, 0, 0)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, authenticatortypes.NewAuthenticatorAccount(baseAcc, newSessionKey(blockTime.Add(time.Hour))))
+	neverExpiring, err := authenticatortypes.NewSessionKey(sessionPriv.PubKey(), nil, nil)
+	suite.Require().NoError(err)
+	a, err := authenticatortypes.NewAuthenticator(authenticator.SessionKeyAuthenticatorName, neverExpiring)
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(deliver(sessionPriv, authenticatortypes.NewMsgRotateAuthenticator(addr, a)), sdkerrors.ErrUnauthorized)
+
+	// nor be allowed to
+	allowRotate, err := authenticatortypes.NewSessionKey(sessionPriv.PubKey(), nil, []string{sdk.MsgTypeURL(&authenticatortypes.MsgRotateAuthenticator{})})
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(allowRotate.ValidateBasic(), sdkerrors.ErrInvalidRequest)
+
+	// once the session key expired, the key of the account recovers the account
+	// by rotating the session key
+	suite.ctx = suite.ctx.WithBlockTime(blockTime.Add(2 * time.Hour))
+	suite.Require().ErrorIs(deliver(sessionPriv, authenticatortypes.NewMsgRotateAuthenticator(addr, newSessionKey(blockTime.Add(3*time.Hour)))), sdkerrors.ErrUnauthorized)
+	suite.Require().NoError(deliver(priv, authenticatortypes.NewMsgRotateAuthenticator(addr, newSessionKey(blockTime.Add(3*time.Hour)))))
+
+	acc, ok := suite.app.AccountKeeper.GetAccount(suite.ctx, addr).(*authenticatortypes.AuthenticatorAccount)
+	suite.Require().True(ok)
+	sessionKey, ok := acc.GetAuthenticatorConfig().(*authenticatortypes.SessionKey)
+	suite.Require().True(ok)
+	suite.Require().Equal(blockTime.Add(3*time.Hour), *sessionKey.Expiration)
+}
@@ -188,7 +188,9 @@ func NewTxTimeoutHeightDecorator() TxTimeoutHeightDecorator {
 // AnteHandle implements an AnteHandler decorator for the TxHeightTimeoutDecorator
 // type where the current block height is checked against the tx's height timeout.
 // If a height timeout is provided (non-zero) and is less than the current block
-// height, then an error is returned.
+// height, then an error is returned. Likewise, if the tx implements
+// sdk.TxWithTimeoutTimestamp and has a timeout timestamp before the current block
+// time, then an error is returned.
 func (txh TxTimeoutHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	timeoutTx, ok := tx.(TxWithTimeoutHeight)
 	if !ok {
@@ -202,5 +204,44 @@ func (txh TxTimeoutHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		)
 	}
 
+	if timeoutTx, ok := tx.(sdk.TxWithTimeoutTimestamp); ok {
+		timeoutTimestamp := timeoutTx.GetTimeoutTimestamp()
+		if !timeoutTimestamp.IsZero() && ctx.BlockTime().After(timeoutTimestamp) {
+			return ctx, sdkerrors.Wrapf(
+				sdkerrors.ErrTxTimeout, "block time: %s, timeout timestamp: %s", ctx.BlockTime(), timeoutTimestamp,
+			)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// MsgGasConsumer is the type of function that is used to consume gas for every Msg
+// of a tx from the gas meter of the context, e.g. a flat cost for the type of the Msg.
+type MsgGasConsumer = func(ctx sdk.Context, msg sdk.Msg) error
+
+// ConsumeMsgGasDecorator consumes gas for every Msg of the tx with the MsgGasConsumer
+// before calling next AnteHandler. It is a no-op without a MsgGasConsumer.
+type ConsumeMsgGasDecorator struct {
+	msgGasConsumer MsgGasConsumer
+}
+
+func NewConsumeMsgGasDecorator(msgGasConsumer MsgGasConsumer) ConsumeMsgGasDecorator {
+	return ConsumeMsgGasDecorator{
+		msgGasConsumer: msgGasConsumer,
+	}
+}
+
+func (cmgd ConsumeMsgGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if cmgd.msgGasConsumer == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if err := cmgd.msgGasConsumer(ctx, msg); err != nil {
+			return ctx, err
+		}
+	}
+
 	return next(ctx, tx, simulate)
 }
@@ -1,6 +1,8 @@
 package ante
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -18,3 +20,9 @@ type AccountKeeper interface {
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// UnorderedTxKeeper defines the expected keeper of the hashes of the unordered txs.
+type UnorderedTxKeeper interface {
+	ContainsUnorderedTx(ctx sdk.Context, txHash []byte) bool
+	AddUnorderedTx(ctx sdk.Context, txHash []byte, timeout time.Time)
+}
@@ -0,0 +1,44 @@
+package ante
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TxPriorityBooster returns the priority added to the priority of a tx, e.g. for the
+// txs of a priority lane. It must be non-negative.
+type TxPriorityBooster = func(ctx sdk.Context, tx sdk.Tx) int64
+
+// TxPriorityBoostDecorator adds the priority boost of the TxPriorityBooster to the
+// priority set by the DeductFeeDecorator, capped at math.MaxInt64. It is a no-op
+// without a TxPriorityBooster.
+//
+// CONTRACT: Tx must be checked by the DeductFeeDecorator first.
+type TxPriorityBoostDecorator struct {
+	txPriorityBooster TxPriorityBooster
+}
+
+func NewTxPriorityBoostDecorator(txPriorityBooster TxPriorityBooster) TxPriorityBoostDecorator {
+	return TxPriorityBoostDecorator{
+		txPriorityBooster: txPriorityBooster,
+	}
+}
+
+func (tpbd TxPriorityBoostDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if tpbd.txPriorityBooster == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	if boost := tpbd.txPriorityBooster(ctx, tx); boost > 0 {
+		priority := ctx.Priority()
+		if priority > 0 && boost > math.MaxInt64-priority {
+			priority = math.MaxInt64
+		} else {
+			priority += boost
+		}
+		ctx = ctx.WithPriority(priority)
+	}
+
+	return next(ctx, tx, simulate)
+}
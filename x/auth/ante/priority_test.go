@@ -0,0 +1,39 @@
+package ante_test
+
+import (
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+func (suite *AnteTestSuite) TestTxPriorityBoostDecorator() {
+	suite.SetupTest(true)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	tx := suite.txBuilder.GetTx()
+
+	boost := func(_ sdk.Context, _ sdk.Tx) int64 { return 100 }
+	testCases := []struct {
+		name     string
+		booster  ante.TxPriorityBooster
+		priority int64
+		expected int64
+	}{
+		{"no booster", nil, 10, 10},
+		{"boost", boost, 10, 110},
+		{"capped boost", boost, math.MaxInt64 - 10, math.MaxInt64},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			antehandler := sdk.ChainAnteDecorators(ante.NewTxPriorityBoostDecorator(tc.booster))
+			ctx, err := antehandler(suite.ctx.WithPriority(tc.priority), tx, false)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expected, ctx.Priority())
+		})
+	}
+}
@@ -41,6 +41,10 @@ func init() {
 // This is where apps can define their own PubKey
 type SignatureVerificationGasConsumer = func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
 
+// ContextSignatureVerificationGasConsumer is a SignatureVerificationGasConsumer which
+// also receives the context of the tx, e.g. to read the gas costs from the state.
+type ContextSignatureVerificationGasConsumer = func(ctx sdk.Context, meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
+
 // SetPubKeyDecorator sets PubKeys in context for any signer which does not already have pubkey set
 // PubKeys must be set in context for all signers before any other sigverify decorators run
 // CONTRACT: Tx must implement SigVerifiableTx interface
@@ -74,16 +78,23 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSecp256k1Pubkey
 		}
+
+		acc, err := GetSignerAcc(ctx, spkd.ak, signers[i])
+		if err != nil {
+			return ctx, err
+		}
+		// the public keys of authenticated accounts are checked by their
+		// authenticator and do not belong to the account
+		if _, ok := acc.(AuthenticatedAccount); ok {
+			continue
+		}
+
 		// Only make check if simulate=false
 		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
 			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
 				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
 		}
 
-		acc, err := GetSignerAcc(ctx, spkd.ak, signers[i])
-		if err != nil {
-			return ctx, err
-		}
 		// account already has pubkey set,no need to reset
 		if acc.GetPubKey() != nil {
 			continue
@@ -132,7 +143,7 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigGasConsumeDecorator struct {
 	ak             AccountKeeper
-	sigGasConsumer SignatureVerificationGasConsumer
+	sigGasConsumer ContextSignatureVerificationGasConsumer
 }
 
 func NewSigGasConsumeDecorator(ak AccountKeeper, sigGasConsumer SignatureVerificationGasConsumer) SigGasConsumeDecorator {
@@ -140,6 +151,14 @@ func NewSigGasConsumeDecorator(ak AccountKeeper, sigGasConsumer SignatureVerific
 		sigGasConsumer = DefaultSigVerificationGasConsumer
 	}
 
+	return NewContextSigGasConsumeDecorator(ak, func(_ sdk.Context, meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error {
+		return sigGasConsumer(meter, sig, params)
+	})
+}
+
+// NewContextSigGasConsumeDecorator returns a SigGasConsumeDecorator consuming the gas of
+// the signatures with a ContextSignatureVerificationGasConsumer.
+func NewContextSigGasConsumeDecorator(ak AccountKeeper, sigGasConsumer ContextSignatureVerificationGasConsumer) SigGasConsumeDecorator {
 	return SigGasConsumeDecorator{
 		ak:             ak,
 		sigGasConsumer: sigGasConsumer,
@@ -169,6 +188,10 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		}
 
 		pubKey := signerAcc.GetPubKey()
+		// authenticated accounts are charged for the public key the tx is signed with
+		if _, ok := signerAcc.(AuthenticatedAccount); ok {
+			pubKey = sig.PubKey
+		}
 
 		// In simulate mode the transaction comes with no signatures, thus if the
 		// account's pubkey is nil, both signature verification and gasKVStore.Set()
@@ -185,7 +208,7 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			Sequence: sig.Sequence,
 		}
 
-		err = sgcd.sigGasConsumer(ctx.GasMeter(), sig, params)
+		err = sgcd.sigGasConsumer(ctx, ctx.GasMeter(), sig, params)
 		if err != nil {
 			return ctx, err
 		}
@@ -197,17 +220,30 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 // Verify all signatures for a tx and return an error if any are invalid. Note,
 // the SigVerificationDecorator will not check signatures on ReCheck.
 //
+// The signatures of AuthenticatedAccounts are verified by the Authenticator
+// registered under their authenticator name, and rejected if there is none.
+//
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigVerificationDecorator struct {
 	ak              AccountKeeper
 	signModeHandler authsigning.SignModeHandler
+	authenticators  *AuthenticatorRegistry
 }
 
 func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler authsigning.SignModeHandler) SigVerificationDecorator {
+	return NewSigVerificationDecoratorWithAuthenticators(ak, signModeHandler, nil)
+}
+
+// NewSigVerificationDecoratorWithAuthenticators returns a SigVerificationDecorator
+// that dispatches the signatures of AuthenticatedAccounts to the given registry.
+func NewSigVerificationDecoratorWithAuthenticators(
+	ak AccountKeeper, signModeHandler authsigning.SignModeHandler, authenticators *AuthenticatorRegistry,
+) SigVerificationDecorator {
 	return SigVerificationDecorator{
 		ak:              ak,
 		signModeHandler: signModeHandler,
+		authenticators:  authenticators,
 	}
 }
 
@@ -252,6 +288,7 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
 	}
 
+	unordered := IsUnorderedTx(tx)
 	for i, sig := range sigs {
 		acc, err := GetSignerAcc(ctx, svd.ak, signerAddrs[i])
 		if err != nil {
@@ -259,13 +296,21 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		}
 
 		// retrieve pubkey
+		authAcc, authenticated := acc.(AuthenticatedAccount)
 		pubKey := acc.GetPubKey()
+		if authenticated {
+			pubKey = sig.PubKey
+		}
 		if !simulate && pubKey == nil {
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
 
-		// Check account sequence number.
-		if sig.Sequence != acc.GetSequence() {
+		// Check account sequence number, unordered txs are replay protected by
+		// the UnorderedTxDecorator instead and sign over their own sequence.
+		sequence := acc.GetSequence()
+		if unordered {
+			sequence = sig.Sequence
+		} else if sig.Sequence != sequence {
 			return ctx, sdkerrors.Wrapf(
 				sdkerrors.ErrWrongSequence,
 				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
@@ -283,19 +328,23 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			Address:       acc.GetAddress().String(),
 			ChainID:       chainID,
 			AccountNumber: accNum,
-			Sequence:      acc.GetSequence(),
+			Sequence:      sequence,
 			PubKey:        pubKey,
 		}
 
 		// no need to verify signatures on recheck tx
-		if !simulate && !ctx.IsReCheckTx() {
+		if authenticated && !simulate && !ctx.IsReCheckTx() {
+			if err := svd.authenticate(ctx, authAcc, tx, sig, signerData); err != nil {
+				return ctx, err
+			}
+		} else if !simulate && !ctx.IsReCheckTx() {
 			err := authsigning.VerifySignature(pubKey, signerData, sig.Data, svd.signModeHandler, tx)
 			if err != nil {
 				var errMsg string
 				if OnlyLegacyAminoSigners(sig.Data) {
 					// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
 					// and therefore communicate sequence number as a potential cause of error.
-					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, acc.GetSequence(), chainID)
+					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, sequence, chainID)
 				} else {
 					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s)", accNum, chainID)
 				}
@@ -308,8 +357,43 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	return next(ctx, tx, simulate)
 }
 
+// authenticate verifies the signature of an AuthenticatedAccount with its
+// registered Authenticator. The signatures of the key the address of the account
+// is derived from are always verified as for any other account instead, so that
+// the owner of the account can rotate an expired or lost authenticator.
+func (svd SigVerificationDecorator) authenticate(
+	ctx sdk.Context, acc AuthenticatedAccount, tx sdk.Tx, sig signing.SignatureV2, signerData authsigning.SignerData,
+) error {
+	if sig.PubKey != nil && bytes.Equal(sig.PubKey.Address(), acc.GetAddress()) {
+		if err := authsigning.VerifySignature(sig.PubKey, signerData, sig.Data, svd.signModeHandler, tx); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "signature verification failed: %s", err)
+		}
+		return nil
+	}
+
+	name := acc.GetAuthenticatorName()
+	authenticator, ok := svd.authenticators.Get(name)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "authenticator %s of account %s is not registered", name, acc.GetAddress())
+	}
+
+	err := authenticator.Authenticate(ctx, AuthenticationRequest{
+		Account:         acc,
+		Tx:              tx,
+		Signature:       sig,
+		SignerData:      signerData,
+		SignModeHandler: svd.signModeHandler,
+	})
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "authenticator %s: %s", name, err)
+	}
+
+	return nil
+}
+
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
-// Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
+// Use the IncrementSequenceDecorator decorator to prevent replay attacks. The
+// sequences are not incremented for unordered txs, see UnorderedTxDecorator. Note,
 // there is need to execute IncrementSequenceDecorator on RecheckTx since
 // BaseApp.Commit() will set the check state based on the latest header.
 //
@@ -333,6 +417,10 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
 	}
 
+	if IsUnorderedTx(tx) {
+		return next(ctx, tx, simulate)
+	}
+
 	// increment sequence of all signers
 	for _, addr := range sigTx.GetSigners() {
 		acc := isd.ak.GetAccount(ctx, addr)
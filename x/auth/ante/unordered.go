@@ -0,0 +1,86 @@
+package ante
+
+import (
+	"crypto/sha256"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultMaxUnorderedTxTimeoutDuration is the default maximum duration between the
+// block time and the timeout timestamp of an unordered tx.
+const DefaultMaxUnorderedTxTimeoutDuration = 10 * time.Minute
+
+// UnorderedTxDecorator replaces the sequences of the signers of unordered txs for
+// replay protection. An unordered tx must have a timeout timestamp, not later than
+// the block time plus the maximum timeout duration, and is rejected if its hash is
+// already in the store of the UnorderedTxKeeper. Otherwise, its hash is added to the
+// store until the timeout timestamp, after which the tx cannot be executed anymore.
+// The maximum timeout duration bounds the number of hashes kept in the store.
+//
+// Unordered txs are rejected without an UnorderedTxKeeper. Ordered txs are passed
+// to the next AnteHandler.
+//
+// CONTRACT: Tx must implement sdk.TxWithUnordered to be unordered.
+type UnorderedTxDecorator struct {
+	keeper             UnorderedTxKeeper
+	maxTimeoutDuration time.Duration
+}
+
+// NewUnorderedTxDecorator returns an UnorderedTxDecorator. A zero maxTimeoutDuration
+// defaults to DefaultMaxUnorderedTxTimeoutDuration.
+func NewUnorderedTxDecorator(keeper UnorderedTxKeeper, maxTimeoutDuration time.Duration) UnorderedTxDecorator {
+	if maxTimeoutDuration == 0 {
+		maxTimeoutDuration = DefaultMaxUnorderedTxTimeoutDuration
+	}
+
+	return UnorderedTxDecorator{
+		keeper:             keeper,
+		maxTimeoutDuration: maxTimeoutDuration,
+	}
+}
+
+func (utd UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !IsUnorderedTx(tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	if utd.keeper == nil {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrNotSupported, "unordered transactions are not supported")
+	}
+
+	timeoutTimestamp := tx.(sdk.TxWithUnordered).GetTimeoutTimestamp()
+	if timeoutTimestamp.IsZero() {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unordered transaction must have a timeout timestamp")
+	}
+	// the hash of a timed out tx may be pruned already
+	if ctx.BlockTime().After(timeoutTimestamp) {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrTxTimeout, "block time: %s, timeout timestamp: %s", ctx.BlockTime(), timeoutTimestamp,
+		)
+	}
+	if maxTimeout := ctx.BlockTime().Add(utd.maxTimeoutDuration); timeoutTimestamp.After(maxTimeout) {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "unordered transaction timeout timestamp %s is after the maximum timeout %s", timeoutTimestamp, maxTimeout,
+		)
+	}
+
+	txHash := sha256.Sum256(ctx.TxBytes())
+	if utd.keeper.ContainsUnorderedTx(ctx, txHash[:]) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unordered transaction %X is a duplicate", txHash)
+	}
+
+	if !simulate {
+		utd.keeper.AddUnorderedTx(ctx, txHash[:], timeoutTimestamp)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// IsUnorderedTx returns true if the tx is unordered, in which case the sequences
+// of its signers are neither checked nor incremented.
+func IsUnorderedTx(tx sdk.Tx) bool {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	return ok && unorderedTx.GetUnordered()
+}
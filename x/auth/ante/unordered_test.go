@@ -0,0 +1,68 @@
+package ante_test
+
+import (
+	"time"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+func (suite *AnteTestSuite) TestUnorderedTx() {
+	suite.SetupTest(false) // setup
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(blockTime)
+
+	accounts := suite.CreateTestAccounts(1)
+	priv, acc := accounts[0].priv, accounts[0].acc
+
+	// newTx returns the bytes of an unordered tx, the fee makes txs with the same
+	// timeout different.
+	newTx := func(timeout time.Time, fee int64) (sdk.Tx, []byte) {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(acc.GetAddress())))
+		suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", fee)))
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		suite.txBuilder.SetUnordered(true)
+		suite.txBuilder.SetTimeoutTimestamp(timeout)
+
+		tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+		txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+		return tx, txBytes
+	}
+	runTx := func(ctx sdk.Context, tx sdk.Tx, txBytes []byte) error {
+		_, err := suite.anteHandler(ctx.WithTxBytes(txBytes), tx, false)
+		return err
+	}
+
+	tx, txBytes := newTx(time.Time{}, 150)
+	suite.Require().ErrorIs(runTx(suite.ctx, tx, txBytes), sdkerrors.ErrInvalidRequest)
+
+	tx, txBytes = newTx(blockTime.Add(ante.DefaultMaxUnorderedTxTimeoutDuration+time.Second), 150)
+	suite.Require().ErrorIs(runTx(suite.ctx, tx, txBytes), sdkerrors.ErrInvalidRequest)
+
+	// unordered txs with the same sequence are executed in any order
+	tx1, txBytes1 := newTx(blockTime.Add(time.Minute), 150)
+	tx2, txBytes2 := newTx(blockTime.Add(time.Minute), 151)
+	suite.Require().NoError(runTx(suite.ctx, tx2, txBytes2))
+	suite.Require().NoError(runTx(suite.ctx, tx1, txBytes1))
+	suite.Require().Equal(uint64(0), suite.app.AccountKeeper.GetAccount(suite.ctx, acc.GetAddress()).GetSequence())
+
+	// but only once
+	suite.Require().ErrorIs(runTx(suite.ctx, tx1, txBytes1), sdkerrors.ErrInvalidRequest)
+
+	// and not after their timeout, when their hash is pruned
+	ctx := suite.ctx.WithBlockTime(blockTime.Add(2 * time.Minute))
+	suite.app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx)
+	suite.Require().ErrorIs(runTx(ctx, tx1, txBytes1), sdkerrors.ErrTxTimeout)
+
+	// unordered txs are not supported without an UnorderedTxKeeper
+	decorator := ante.NewUnorderedTxDecorator(nil, 0)
+	tx, txBytes = newTx(blockTime.Add(time.Minute), 152)
+	_, err := decorator.AnteHandle(suite.ctx.WithTxBytes(txBytes), tx, false, nil)
+	suite.Require().ErrorIs(err, sdkerrors.ErrNotSupported)
+}
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator/types"
+)
+
+// GetTxCmd returns authenticator module's transaction commands.
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Authenticator transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewMsgRegisterAuthenticatorCmd(),
+		NewMsgRotateAuthenticatorCmd(),
+	)
+
+	return txCmd
+}
+
+// NewMsgRegisterAuthenticatorCmd returns a CLI command handler for creating a
+// MsgRegisterAuthenticator transaction.
+func NewMsgRegisterAuthenticatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [name] [config_file]",
+		Short: "Authenticate the sender account with a registered authenticator.",
+		Long: `Authenticate the sender account with the authenticator registered under the
+given name instead of its public key. The config file holds the JSON encoded
+config of the authenticator, e.g. for the session_key authenticator:
+
+{
+  "@type": "/cosmos.authenticator.v1beta1.SessionKey",
+  "pub_key": {"@type": "/cosmos.crypto.secp256k1.PubKey", "key": "..."},
+  "expiration": "2026-01-01T00:00:00Z",
+  "allowed_msgs": ["/cosmos.bank.v1beta1.MsgSend"]
+}`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authenticator, err := readAuthenticator(clientCtx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterAuthenticator(clientCtx.GetFromAddress(), authenticator)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgRotateAuthenticatorCmd returns a CLI command handler for creating a
+// MsgRotateAuthenticator transaction.
+func NewMsgRotateAuthenticatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate [name] [config_file]",
+		Short: "Replace the authenticator of the sender account.",
+		Long: `Replace the authenticator of the sender account, which must have been
+registered before, with the authenticator registered under the given name. The
+config file holds the JSON encoded config of the authenticator.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authenticator, err := readAuthenticator(clientCtx, args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRotateAuthenticator(clientCtx.GetFromAddress(), authenticator)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readAuthenticator reads the JSON encoded authenticator config from the given
+// file.
+func readAuthenticator(clientCtx client.Context, name, configFile string) (types.Authenticator, error) {
+	bz, err := os.ReadFile(configFile)
+	if err != nil {
+		return types.Authenticator{}, err
+	}
+
+	var config types.AuthenticatorConfig
+	if err := clientCtx.Codec.UnmarshalInterfaceJSON(bz, &config); err != nil {
+		return types.Authenticator{}, err
+	}
+
+	return types.NewAuthenticator(name, config)
+}
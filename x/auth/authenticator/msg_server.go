@@ -0,0 +1,103 @@
+package authenticator
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type msgServer struct {
+	keeper.AccountKeeper
+	authenticators *ante.AuthenticatorRegistry
+}
+
+// NewMsgServerImpl returns an implementation of the authenticator MsgServer
+// interface, wrapping the AccountKeeper and the registry of the authenticators
+// accounts may register.
+func NewMsgServerImpl(k keeper.AccountKeeper, authenticators *ante.AuthenticatorRegistry) types.MsgServer {
+	return &msgServer{AccountKeeper: k, authenticators: authenticators}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (s msgServer) RegisterAuthenticator(goCtx context.Context, msg *types.MsgRegisterAuthenticator) (*types.MsgRegisterAuthenticatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := s.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+	baseAcc, ok := acc.(*authtypes.BaseAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a base account", msg.Address)
+	}
+
+	if err := s.validateAuthenticator(msg.Authenticator); err != nil {
+		return nil, err
+	}
+
+	s.SetAccount(ctx, types.NewAuthenticatorAccount(baseAcc, msg.Authenticator))
+
+	defer telemetry.IncrCounter(1, "authenticator", "register")
+
+	return &types.MsgRegisterAuthenticatorResponse{}, nil
+}
+
+func (s msgServer) RotateAuthenticator(goCtx context.Context, msg *types.MsgRotateAuthenticator) (*types.MsgRotateAuthenticatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	acc := s.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+	authAcc, ok := acc.(*types.AuthenticatorAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not an authenticator account", msg.Address)
+	}
+
+	if err := s.validateAuthenticator(msg.Authenticator); err != nil {
+		return nil, err
+	}
+
+	authAcc.Authenticator = msg.Authenticator
+	s.SetAccount(ctx, authAcc)
+
+	defer telemetry.IncrCounter(1, "authenticator", "rotate")
+
+	return &types.MsgRotateAuthenticatorResponse{}, nil
+}
+
+// validateAuthenticator checks that the authenticator is registered and accepts
+// the config.
+func (s msgServer) validateAuthenticator(a types.Authenticator) error {
+	authenticator, ok := s.authenticators.Get(a.Name)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "authenticator %s is not registered", a.Name)
+	}
+
+	config, err := a.GetCachedConfig()
+	if err != nil {
+		return err
+	}
+	if err := authenticator.ValidateConfig(config); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid %s config: %s", a.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package authenticator_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator/types"
+)
+
+type MsgServerTestSuite struct {
+	suite.Suite
+
+	app       *simapp.SimApp
+	ctx       sdk.Context
+	msgServer types.MsgServer
+}
+
+func (s *MsgServerTestSuite) SetupTest() {
+	s.app = simapp.Setup(s.T(), false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Unix(1_700_000_000, 0).UTC()})
+	s.msgServer = authenticator.NewMsgServerImpl(s.app.AccountKeeper, s.app.Authenticators)
+}
+
+func (s *MsgServerTestSuite) newSessionKey(name string) types.Authenticator {
+	_, pubKey, _ := testdata.KeyTestPubAddr()
+	expiration := s.ctx.BlockTime().Add(time.Hour)
+	sessionKey, err := types.NewSessionKey(pubKey, &expiration, nil)
+	s.Require().NoError(err)
+	a, err := types.NewAuthenticator(name, sessionKey)
+	s.Require().NoError(err)
+	return a
+}
+
+func (s *MsgServerTestSuite) TestRegisterAuthenticator() {
+	_, _, addr := testdata.KeyTestPubAddr()
+	a := s.newSessionKey(authenticator.SessionKeyAuthenticatorName)
+
+	// the account must exist
+	_, err := s.msgServer.RegisterAuthenticator(sdk.WrapSDKContext(s.ctx), types.NewMsgRegisterAuthenticator(addr, a))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnknownAddress)
+
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, addr))
+
+	// the authenticator must be registered
+	_, err = s.msgServer.RegisterAuthenticator(sdk.WrapSDKContext(s.ctx), types.NewMsgRegisterAuthenticator(addr, s.newSessionKey("unknown")))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = s.msgServer.RegisterAuthenticator(sdk.WrapSDKContext(s.ctx), types.NewMsgRegisterAuthenticator(addr, a))
+	s.Require().NoError(err)
+
+	acc, ok := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.AuthenticatorAccount)
+	s.Require().True(ok)
+	s.Require().Equal(authenticator.SessionKeyAuthenticatorName, acc.GetAuthenticatorName())
+	s.Require().Equal(a.Config.GetCachedValue(), acc.GetAuthenticatorConfig())
+
+	// an authenticator account cannot register again
+	_, err = s.msgServer.RegisterAuthenticator(sdk.WrapSDKContext(s.ctx), types.NewMsgRegisterAuthenticator(addr, a))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
+func (s *MsgServerTestSuite) TestRotateAuthenticator() {
+	_, _, addr := testdata.KeyTestPubAddr()
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, addr))
+	a := s.newSessionKey(authenticator.SessionKeyAuthenticatorName)
+
+	// only authenticator accounts can rotate their authenticator
+	_, err := s.msgServer.RotateAuthenticator(sdk.WrapSDKContext(s.ctx), types.NewMsgRotateAuthenticator(addr, a))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = s.msgServer.RegisterAuthenticator(sdk.WrapSDKContext(s.ctx), types.NewMsgRegisterAuthenticator(addr, a))
+	s.Require().NoError(err)
+	accNum := s.app.AccountKeeper.GetAccount(s.ctx, addr).GetAccountNumber()
+
+	rotated := s.newSessionKey(authenticator.SessionKeyAuthenticatorName)
+	_, err = s.msgServer.RotateAuthenticator(sdk.WrapSDKContext(s.ctx), types.NewMsgRotateAuthenticator(addr, rotated))
+	s.Require().NoError(err)
+
+	acc, ok := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.AuthenticatorAccount)
+	s.Require().True(ok)
+	s.Require().Equal(accNum, acc.GetAccountNumber())
+	s.Require().Equal(rotated.Config.GetCachedValue(), acc.GetAuthenticatorConfig())
+
+	_, err = s.msgServer.RotateAuthenticator(sdk.WrapSDKContext(s.ctx), types.NewMsgRotateAuthenticator(addr, s.newSessionKey("unknown")))
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestMsgServerTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgServerTestSuite))
+}
@@ -0,0 +1,71 @@
+package authenticator
+
+import (
+	proto "github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/authenticator/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// SessionKeyAuthenticatorName is the name the SessionKeyAuthenticator is
+// registered with by default.
+const SessionKeyAuthenticatorName = "session_key"
+
+var _ ante.Authenticator = SessionKeyAuthenticator{}
+
+// SessionKeyAuthenticator authenticates the txs signed with the key of a
+// types.SessionKey config until its expiration. If the session key has allowed
+// messages, the txs may only contain messages of those types. A session key can
+// never sign MsgRotateAuthenticator, which is left to the key of the account.
+// Note that only the top-level messages of a tx are checked, the messages nested
+// in e.g. an authz MsgExec are not.
+type SessionKeyAuthenticator struct{}
+
+// NewSessionKeyAuthenticator returns a new SessionKeyAuthenticator.
+func NewSessionKeyAuthenticator() SessionKeyAuthenticator {
+	return SessionKeyAuthenticator{}
+}
+
+// ValidateConfig implements ante.Authenticator.
+func (SessionKeyAuthenticator) ValidateConfig(config proto.Message) error {
+	sessionKey, ok := config.(*types.SessionKey)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (*types.SessionKey)(nil), config)
+	}
+	return sessionKey.ValidateBasic()
+}
+
+// Authenticate implements ante.Authenticator.
+func (SessionKeyAuthenticator) Authenticate(ctx sdk.Context, req ante.AuthenticationRequest) error {
+	config := req.Account.GetAuthenticatorConfig()
+	sessionKey, ok := config.(*types.SessionKey)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (*types.SessionKey)(nil), config)
+	}
+
+	if sessionKey.IsExpired(ctx.BlockTime()) {
+		return sdkerrors.ErrUnauthorized.Wrapf("session key expired at %s", sessionKey.Expiration)
+	}
+
+	for _, msg := range req.Tx.GetMsgs() {
+		if _, ok := msg.(*types.MsgRotateAuthenticator); ok {
+			return sdkerrors.ErrUnauthorized.Wrap("session key is not allowed to rotate the authenticator")
+		}
+		if typeURL := sdk.MsgTypeURL(msg); !sessionKey.IsMsgAllowed(typeURL) {
+			return sdkerrors.ErrUnauthorized.Wrapf("session key is not allowed to sign %s", typeURL)
+		}
+	}
+
+	pubKey, err := sessionKey.GetCachedPubKey()
+	if err != nil {
+		return err
+	}
+	if req.Signature.PubKey == nil || !pubKey.Equals(req.Signature.PubKey) {
+		return sdkerrors.ErrInvalidPubKey.Wrap("tx is not signed with the session key")
+	}
+
+	return authsigning.VerifySignature(pubKey, req.SignerData, req.Signature.Data, req.SignModeHandler, req.Tx)
+}
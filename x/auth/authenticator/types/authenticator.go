@@ -0,0 +1,141 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AuthenticatorConfig defines the account-specific configuration of an
+// authenticator.
+type AuthenticatorConfig interface {
+	proto.Message
+
+	// ValidateBasic does a simple validation check that doesn't require access
+	// to any other information.
+	ValidateBasic() error
+}
+
+// NewAuthenticator returns a new Authenticator with the given name and config.
+func NewAuthenticator(name string, config AuthenticatorConfig) (Authenticator, error) {
+	any, err := codectypes.NewAnyWithValue(config)
+	if err != nil {
+		return Authenticator{}, err
+	}
+
+	return Authenticator{
+		Name:   name,
+		Config: any,
+	}, nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = Authenticator{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a Authenticator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var config AuthenticatorConfig
+	return unpacker.UnpackAny(a.Config, &config)
+}
+
+// GetCachedConfig returns the cached value of the config if present.
+func (a Authenticator) GetCachedConfig() (AuthenticatorConfig, error) {
+	if a.Config == nil {
+		return nil, sdkerrors.ErrInvalidType.Wrap("authenticator config is nil")
+	}
+	cv := a.Config.GetCachedValue()
+	config, ok := cv.(AuthenticatorConfig)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (AuthenticatorConfig)(nil), cv)
+	}
+	return config, nil
+}
+
+// Validate checks that the authenticator is named and its config is valid.
+func (a Authenticator) Validate() error {
+	if a.Name == "" {
+		return errors.New("authenticator name cannot be empty")
+	}
+	config, err := a.GetCachedConfig()
+	if err != nil {
+		return err
+	}
+	return config.ValidateBasic()
+}
+
+var (
+	_ authtypes.AccountI                 = (*AuthenticatorAccount)(nil)
+	_ authtypes.GenesisAccount           = (*AuthenticatorAccount)(nil)
+	_ ante.AuthenticatedAccount          = (*AuthenticatorAccount)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*AuthenticatorAccount)(nil)
+)
+
+// NewAuthenticatorAccount returns a new AuthenticatorAccount that is
+// authenticated by the given authenticator.
+func NewAuthenticatorAccount(baseAccount *authtypes.BaseAccount, authenticator Authenticator) *AuthenticatorAccount {
+	return &AuthenticatorAccount{
+		BaseAccount:   baseAccount,
+		Authenticator: authenticator,
+	}
+}
+
+// GetAuthenticatorName implements ante.AuthenticatedAccount.
+func (acc AuthenticatorAccount) GetAuthenticatorName() string {
+	return acc.Authenticator.Name
+}
+
+// GetAuthenticatorConfig implements ante.AuthenticatedAccount. It returns nil
+// if the config is not unpacked.
+func (acc AuthenticatorAccount) GetAuthenticatorConfig() proto.Message {
+	config, err := acc.Authenticator.GetCachedConfig()
+	if err != nil {
+		return nil
+	}
+	return config
+}
+
+// Validate checks for errors on the account fields.
+func (acc AuthenticatorAccount) Validate() error {
+	if err := acc.BaseAccount.Validate(); err != nil {
+		return err
+	}
+	if err := acc.Authenticator.Validate(); err != nil {
+		return fmt.Errorf("invalid authenticator: %w", err)
+	}
+	return nil
+}
+
+func (acc AuthenticatorAccount) String() string {
+	out, _ := acc.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of an AuthenticatorAccount. The
+// config must be one of the types registered by RegisterInterfaces.
+func (acc AuthenticatorAccount) MarshalYAML() (interface{}, error) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	RegisterInterfaces(registry)
+	bz, err := codec.MarshalYAML(codec.NewProtoCodec(registry), &acc)
+	if err != nil {
+		return nil, err
+	}
+	return string(bz), err
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (acc AuthenticatorAccount) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if acc.BaseAccount != nil {
+		if err := acc.BaseAccount.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return acc.Authenticator.UnpackInterfaces(unpacker)
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+)
+
+// RegisterLegacyAminoCodec registers the authenticator interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterInterface((*AuthenticatorConfig)(nil), nil)
+	cdc.RegisterConcrete(&SessionKey{}, "cosmos-sdk/SessionKey", nil)
+	cdc.RegisterConcrete(&AuthenticatorAccount{}, "cosmos-sdk/AuthenticatorAccount", nil)
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterAuthenticator{}, "cosmos-sdk/MsgRegisterAuthenticator")
+	legacy.RegisterAminoMsg(cdc, &MsgRotateAuthenticator{}, "cosmos-sdk/MsgRotateAuthenticator")
+}
+
+// RegisterInterfaces registers the AuthenticatorConfig interface and the concrete
+// account, config and message types of the module.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterInterface(
+		"cosmos.authenticator.v1beta1.AuthenticatorConfig",
+		(*AuthenticatorConfig)(nil),
+		&SessionKey{},
+	)
+
+	registry.RegisterImplementations(
+		(*authtypes.AccountI)(nil),
+		&AuthenticatorAccount{},
+	)
+
+	registry.RegisterImplementations(
+		(*authtypes.GenesisAccount)(nil),
+		&AuthenticatorAccount{},
+	)
+
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgRegisterAuthenticator{},
+		&MsgRotateAuthenticator{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+
+	// Register all Amino interfaces and concrete types on the authz Amino codec so that this can later be
+	// used to properly serialize MsgGrant and MsgExec instances
+	RegisterLegacyAminoCodec(authzcodec.Amino)
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TypeMsgRegisterAuthenticator defines the type value for a MsgRegisterAuthenticator.
+const TypeMsgRegisterAuthenticator = "msg_register_authenticator"
+
+// TypeMsgRotateAuthenticator defines the type value for a MsgRotateAuthenticator.
+const TypeMsgRotateAuthenticator = "msg_rotate_authenticator"
+
+var (
+	_ sdk.Msg                            = &MsgRegisterAuthenticator{}
+	_ sdk.Msg                            = &MsgRotateAuthenticator{}
+	_ codectypes.UnpackInterfacesMessage = MsgRegisterAuthenticator{}
+	_ codectypes.UnpackInterfacesMessage = MsgRotateAuthenticator{}
+)
+
+// NewMsgRegisterAuthenticator returns a reference to a new MsgRegisterAuthenticator.
+//
+//nolint:interfacer
+func NewMsgRegisterAuthenticator(addr sdk.AccAddress, authenticator Authenticator) *MsgRegisterAuthenticator {
+	return &MsgRegisterAuthenticator{
+		Address:       addr.String(),
+		Authenticator: authenticator,
+	}
+}
+
+// Route returns the message route for a MsgRegisterAuthenticator.
+func (msg MsgRegisterAuthenticator) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgRegisterAuthenticator.
+func (msg MsgRegisterAuthenticator) Type() string { return TypeMsgRegisterAuthenticator }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRegisterAuthenticator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+	if err := msg.Authenticator.Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid authenticator: %s", err)
+	}
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgRegisterAuthenticator.
+func (msg MsgRegisterAuthenticator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgRegisterAuthenticator.
+func (msg MsgRegisterAuthenticator) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRegisterAuthenticator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return msg.Authenticator.UnpackInterfaces(unpacker)
+}
+
+// NewMsgRotateAuthenticator returns a reference to a new MsgRotateAuthenticator.
+//
+//nolint:interfacer
+func NewMsgRotateAuthenticator(addr sdk.AccAddress, authenticator Authenticator) *MsgRotateAuthenticator {
+	return &MsgRotateAuthenticator{
+		Address:       addr.String(),
+		Authenticator: authenticator,
+	}
+}
+
+// Route returns the message route for a MsgRotateAuthenticator.
+func (msg MsgRotateAuthenticator) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgRotateAuthenticator.
+func (msg MsgRotateAuthenticator) Type() string { return TypeMsgRotateAuthenticator }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRotateAuthenticator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+	if err := msg.Authenticator.Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid authenticator: %s", err)
+	}
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgRotateAuthenticator.
+func (msg MsgRotateAuthenticator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgRotateAuthenticator.
+func (msg MsgRotateAuthenticator) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotateAuthenticator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return msg.Authenticator.UnpackInterfaces(unpacker)
+}
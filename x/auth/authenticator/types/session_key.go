@@ -0,0 +1,91 @@
+package types
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ AuthenticatorConfig                = (*SessionKey)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*SessionKey)(nil)
+)
+
+// NewSessionKey returns a new SessionKey for the given public key. A nil
+// expiration never expires and no allowed messages allow every message but
+// MsgRotateAuthenticator.
+func NewSessionKey(pubKey cryptotypes.PubKey, expiration *time.Time, allowedMsgs []string) (*SessionKey, error) {
+	any, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SessionKey{
+		PubKey:      any,
+		Expiration:  expiration,
+		AllowedMsgs: allowedMsgs,
+	}, nil
+}
+
+// GetCachedPubKey returns the cached value of the public key if present.
+func (sk SessionKey) GetCachedPubKey() (cryptotypes.PubKey, error) {
+	if sk.PubKey == nil {
+		return nil, sdkerrors.ErrInvalidPubKey.Wrap("session key public key is nil")
+	}
+	pk, ok := sk.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (cryptotypes.PubKey)(nil), sk.PubKey.GetCachedValue())
+	}
+	return pk, nil
+}
+
+// IsExpired returns true if the session key is expired at the given time.
+func (sk SessionKey) IsExpired(t time.Time) bool {
+	return sk.Expiration != nil && !t.Before(*sk.Expiration)
+}
+
+// IsMsgAllowed returns true if the session key may sign messages of the given
+// type URL.
+func (sk SessionKey) IsMsgAllowed(typeURL string) bool {
+	if len(sk.AllowedMsgs) == 0 {
+		return true
+	}
+	for _, allowed := range sk.AllowedMsgs {
+		if allowed == typeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateBasic implements AuthenticatorConfig.
+func (sk SessionKey) ValidateBasic() error {
+	if _, err := sk.GetCachedPubKey(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(sk.AllowedMsgs))
+	for _, typeURL := range sk.AllowedMsgs {
+		if typeURL == "" {
+			return sdkerrors.ErrInvalidRequest.Wrap("allowed message type URL cannot be empty")
+		}
+		if seen[typeURL] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate allowed message type URL %s", typeURL)
+		}
+		if typeURL == sdk.MsgTypeURL(&MsgRotateAuthenticator{}) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("session key cannot be allowed to sign %s", typeURL)
+		}
+		seen[typeURL] = true
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (sk SessionKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(sk.PubKey, &pubKey)
+}
@@ -0,0 +1,954 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/authenticator/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgRegisterAuthenticator defines a message that sets the authenticator of a
+// base account.
+type MsgRegisterAuthenticator struct {
+	Address       string        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Authenticator Authenticator `protobuf:"bytes,2,opt,name=authenticator,proto3" json:"authenticator"`
+}
+
+func (m *MsgRegisterAuthenticator) Reset()         { *m = MsgRegisterAuthenticator{} }
+func (m *MsgRegisterAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterAuthenticator) ProtoMessage()    {}
+func (*MsgRegisterAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87009b516f3edcf7, []int{0}
+}
+func (m *MsgRegisterAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterAuthenticator.Merge(m, src)
+}
+func (m *MsgRegisterAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterAuthenticator proto.InternalMessageInfo
+
+// MsgRegisterAuthenticatorResponse defines the Msg/RegisterAuthenticator
+// response type.
+type MsgRegisterAuthenticatorResponse struct {
+}
+
+func (m *MsgRegisterAuthenticatorResponse) Reset()         { *m = MsgRegisterAuthenticatorResponse{} }
+func (m *MsgRegisterAuthenticatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterAuthenticatorResponse) ProtoMessage()    {}
+func (*MsgRegisterAuthenticatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87009b516f3edcf7, []int{1}
+}
+func (m *MsgRegisterAuthenticatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterAuthenticatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterAuthenticatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterAuthenticatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterAuthenticatorResponse.Merge(m, src)
+}
+func (m *MsgRegisterAuthenticatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterAuthenticatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterAuthenticatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterAuthenticatorResponse proto.InternalMessageInfo
+
+// MsgRotateAuthenticator defines a message that replaces the authenticator of
+// an authenticator account.
+type MsgRotateAuthenticator struct {
+	Address       string        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Authenticator Authenticator `protobuf:"bytes,2,opt,name=authenticator,proto3" json:"authenticator"`
+}
+
+func (m *MsgRotateAuthenticator) Reset()         { *m = MsgRotateAuthenticator{} }
+func (m *MsgRotateAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateAuthenticator) ProtoMessage()    {}
+func (*MsgRotateAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87009b516f3edcf7, []int{2}
+}
+func (m *MsgRotateAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateAuthenticator.Merge(m, src)
+}
+func (m *MsgRotateAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateAuthenticator proto.InternalMessageInfo
+
+// MsgRotateAuthenticatorResponse defines the Msg/RotateAuthenticator response
+// type.
+type MsgRotateAuthenticatorResponse struct {
+}
+
+func (m *MsgRotateAuthenticatorResponse) Reset()         { *m = MsgRotateAuthenticatorResponse{} }
+func (m *MsgRotateAuthenticatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateAuthenticatorResponse) ProtoMessage()    {}
+func (*MsgRotateAuthenticatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87009b516f3edcf7, []int{3}
+}
+func (m *MsgRotateAuthenticatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateAuthenticatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateAuthenticatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateAuthenticatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateAuthenticatorResponse.Merge(m, src)
+}
+func (m *MsgRotateAuthenticatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateAuthenticatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateAuthenticatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateAuthenticatorResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterAuthenticator)(nil), "cosmos.authenticator.v1beta1.MsgRegisterAuthenticator")
+	proto.RegisterType((*MsgRegisterAuthenticatorResponse)(nil), "cosmos.authenticator.v1beta1.MsgRegisterAuthenticatorResponse")
+	proto.RegisterType((*MsgRotateAuthenticator)(nil), "cosmos.authenticator.v1beta1.MsgRotateAuthenticator")
+	proto.RegisterType((*MsgRotateAuthenticatorResponse)(nil), "cosmos.authenticator.v1beta1.MsgRotateAuthenticatorResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/authenticator/v1beta1/tx.proto", fileDescriptor_87009b516f3edcf7)
+}
+
+var fileDescriptor_87009b516f3edcf7 = []byte{
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x93, 0xb1, 0x4b, 0xfb, 0x40,
+	0x14, 0xc7, 0x73, 0xfd, 0xfd, 0x50, 0x3c, 0x71, 0x89, 0x55, 0x63, 0x90, 0x6b, 0x08, 0x08, 0x45,
+	0x69, 0x62, 0xab, 0x28, 0x88, 0x08, 0xed, 0xde, 0xc1, 0x38, 0x08, 0x2e, 0x92, 0xb6, 0xc7, 0x35,
+	0x48, 0x7b, 0x25, 0xf7, 0x5a, 0xea, 0xea, 0xa4, 0x93, 0xfe, 0x09, 0x05, 0xff, 0x01, 0x07, 0x37,
+	0x71, 0xef, 0x58, 0x9c, 0x9c, 0x44, 0xda, 0x41, 0xff, 0x0c, 0x69, 0x2f, 0x01, 0xa3, 0x6d, 0x84,
+	0x6e, 0x4e, 0x17, 0x78, 0xdf, 0xcf, 0x7b, 0xdf, 0xf7, 0xc2, 0x17, 0xaf, 0x97, 0xb9, 0xa8, 0x71,
+	0x61, 0xbb, 0x4d, 0xa8, 0xd2, 0x3a, 0x78, 0x65, 0x17, 0xb8, 0x6f, 0xb7, 0xb2, 0x25, 0x0a, 0x6e,
+	0xd6, 0x86, 0xb6, 0xd5, 0xf0, 0x39, 0x70, 0x75, 0x4d, 0xca, 0xac, 0x88, 0xcc, 0x0a, 0x64, 0x7a,
+	0x92, 0x71, 0xc6, 0x47, 0x42, 0x7b, 0xf8, 0x25, 0x19, 0x7d, 0x55, 0x32, 0x67, 0xb2, 0x10, 0x34,
+	0x90, 0xa5, 0xad, 0xd8, 0xa9, 0xd1, 0x21, 0x92, 0x58, 0x09, 0x88, 0x9a, 0x60, 0x76, 0x2b, 0x3b,
+	0x7c, 0x64, 0xc1, 0x7c, 0x42, 0x58, 0x2b, 0x0a, 0xe6, 0x50, 0xe6, 0x09, 0xa0, 0x7e, 0xfe, 0x2b,
+	0xab, 0xe6, 0xf0, 0xac, 0x5b, 0xa9, 0xf8, 0x54, 0x08, 0x0d, 0x19, 0x28, 0x3d, 0x57, 0xd0, 0x9e,
+	0x1f, 0x32, 0xc9, 0xc0, 0x4a, 0x5e, 0x56, 0x8e, 0xc1, 0xf7, 0xea, 0xcc, 0x09, 0x85, 0xea, 0x09,
+	0x5e, 0x88, 0x18, 0xd0, 0x12, 0x06, 0x4a, 0xcf, 0xe7, 0x36, 0xad, 0xb8, 0x13, 0x58, 0x91, 0xb9,
+	0x85, 0xff, 0xdd, 0xd7, 0x94, 0xe2, 0x44, 0xfb, 0xec, 0x27, 0xaf, 0x3a, 0x29, 0xe5, 0xa3, 0x93,
+	0x52, 0x2e, 0xdf, 0xef, 0x37, 0xc2, 0x71, 0xa6, 0x89, 0x8d, 0x49, 0xf6, 0x1d, 0x2a, 0x1a, 0xbc,
+	0x2e, 0xa8, 0xf9, 0x88, 0xf0, 0xf2, 0x50, 0xc4, 0xc1, 0x05, 0xfa, 0xe7, 0x36, 0x34, 0x30, 0x19,
+	0x6f, 0x3e, 0xdc, 0x2f, 0x77, 0x97, 0xc0, 0xff, 0x8a, 0x82, 0xa9, 0x37, 0x08, 0x2f, 0x8d, 0xff,
+	0x91, 0xbb, 0xf1, 0xde, 0x26, 0x5d, 0x50, 0x3f, 0x9c, 0x8e, 0x0b, 0x9d, 0xa9, 0xd7, 0x08, 0x2f,
+	0x8e, 0x3b, 0xfb, 0xce, 0xef, 0x7d, 0x7f, 0x52, 0xfa, 0xc1, 0x34, 0x54, 0xe8, 0xa5, 0x70, 0xd4,
+	0xed, 0x13, 0xd4, 0xeb, 0x13, 0xf4, 0xd6, 0x27, 0xe8, 0x76, 0x40, 0x94, 0xde, 0x80, 0x28, 0x2f,
+	0x03, 0xa2, 0x9c, 0xee, 0x31, 0x0f, 0xaa, 0xcd, 0x92, 0x55, 0xe6, 0xb5, 0x20, 0x67, 0xc1, 0x93,
+	0x11, 0x95, 0x73, 0xbb, 0x3d, 0x0a, 0xd4, 0xb7, 0xac, 0xc1, 0x45, 0x83, 0x8a, 0xd2, 0xcc, 0x28,
+	0x43, 0xdb, 0x9f, 0x03, 0x00, 0x36, 0xd1, 0x01, 0xe2, 0x06, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// RegisterAuthenticator converts a base account into an authenticator
+	// account that is authenticated by the given authenticator.
+	RegisterAuthenticator(ctx context.Context, in *MsgRegisterAuthenticator, opts ...grpc.CallOption) (*MsgRegisterAuthenticatorResponse, error)
+	// RotateAuthenticator replaces the authenticator of an authenticator account.
+	RotateAuthenticator(ctx context.Context, in *MsgRotateAuthenticator, opts ...grpc.CallOption) (*MsgRotateAuthenticatorResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterAuthenticator(ctx context.Context, in *MsgRegisterAuthenticator, opts ...grpc.CallOption) (*MsgRegisterAuthenticatorResponse, error) {
+	out := new(MsgRegisterAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authenticator.v1beta1.Msg/RegisterAuthenticator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateAuthenticator(ctx context.Context, in *MsgRotateAuthenticator, opts ...grpc.CallOption) (*MsgRotateAuthenticatorResponse, error) {
+	out := new(MsgRotateAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authenticator.v1beta1.Msg/RotateAuthenticator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// RegisterAuthenticator converts a base account into an authenticator
+	// account that is authenticated by the given authenticator.
+	RegisterAuthenticator(context.Context, *MsgRegisterAuthenticator) (*MsgRegisterAuthenticatorResponse, error)
+	// RotateAuthenticator replaces the authenticator of an authenticator account.
+	RotateAuthenticator(context.Context, *MsgRotateAuthenticator) (*MsgRotateAuthenticatorResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterAuthenticator(ctx context.Context, req *MsgRegisterAuthenticator) (*MsgRegisterAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAuthenticator not implemented")
+}
+func (*UnimplementedMsgServer) RotateAuthenticator(ctx context.Context, req *MsgRotateAuthenticator) (*MsgRotateAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateAuthenticator not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterAuthenticator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authenticator.v1beta1.Msg/RegisterAuthenticator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAuthenticator(ctx, req.(*MsgRegisterAuthenticator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateAuthenticator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authenticator.v1beta1.Msg/RotateAuthenticator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateAuthenticator(ctx, req.(*MsgRotateAuthenticator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.authenticator.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterAuthenticator",
+			Handler:    _Msg_RegisterAuthenticator_Handler,
+		},
+		{
+			MethodName: "RotateAuthenticator",
+			Handler:    _Msg_RotateAuthenticator_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/authenticator/v1beta1/tx.proto",
+}
+
+func (m *MsgRegisterAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Authenticator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterAuthenticatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAuthenticatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAuthenticatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Authenticator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateAuthenticatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateAuthenticatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateAuthenticatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Authenticator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterAuthenticatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRotateAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Authenticator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRotateAuthenticatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterAuthenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAuthenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Authenticator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAuthenticatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAuthenticatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAuthenticatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateAuthenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateAuthenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Authenticator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateAuthenticatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateAuthenticatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateAuthenticatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -11,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 const (
@@ -131,8 +133,8 @@ func TestSupply_ValidatePermissions(t *testing.T) {
 
 	cdc := simapp.MakeTestEncodingConfig().Codec
 	keeper := keeper.NewAccountKeeper(
-		cdc, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
-		types.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		cdc, app.GetKey(types.StoreKey),
+		types.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix, types.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	err := keeper.ValidatePermissions(multiPermAcc)
@@ -146,3 +148,32 @@ func TestSupply_ValidatePermissions(t *testing.T) {
 	err = app.AccountKeeper.ValidatePermissions(otherAcc)
 	require.Error(t, err)
 }
+
+func TestUnorderedTxs(t *testing.T) {
+	app, ctx := createTestApp(t, true)
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(blockTime)
+
+	hash1, hash2 := []byte("hash1"), []byte("hash2")
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash1))
+
+	app.AccountKeeper.AddUnorderedTx(ctx, hash1, blockTime.Add(time.Minute))
+	app.AccountKeeper.AddUnorderedTx(ctx, hash2, blockTime.Add(2*time.Minute))
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash1))
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash2))
+
+	// the hashes are kept until the block time is after the timeout
+	app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockTime(blockTime.Add(time.Minute)))
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash1))
+
+	app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockTime(blockTime.Add(time.Minute + time.Nanosecond)))
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash1))
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash2))
+
+	app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockTime(blockTime.Add(time.Hour)))
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash2))
+
+	iterator := ctx.KVStore(app.GetKey(types.StoreKey)).Iterator(types.UnorderedTxStoreKeyPrefix, sdk.PrefixEndBytes(types.UnorderedTxByTimeoutStoreKeyPrefix))
+	defer iterator.Close()
+	require.False(t, iterator.Valid())
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ContainsUnorderedTx returns true if the hash of an unordered tx is in the
+// store, i.e. the tx was executed and its timeout timestamp is not pruned yet.
+func (ak AccountKeeper) ContainsUnorderedTx(ctx sdk.Context, txHash []byte) bool {
+	store := ctx.KVStore(ak.key)
+	return store.Has(types.UnorderedTxStoreKey(txHash))
+}
+
+// AddUnorderedTx adds the hash of an unordered tx to the store, where it is kept
+// until the first block after its timeout timestamp.
+func (ak AccountKeeper) AddUnorderedTx(ctx sdk.Context, txHash []byte, timeout time.Time) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.UnorderedTxStoreKey(txHash), sdk.FormatTimeBytes(timeout))
+	store.Set(types.UnorderedTxByTimeoutStoreKey(timeout, txHash), []byte{})
+}
+
+// RemoveExpiredUnorderedTxs removes the hashes of the unordered txs which timed
+// out before the block time, as they cannot be executed anymore.
+func (ak AccountKeeper) RemoveExpiredUnorderedTxs(ctx sdk.Context) {
+	store := ctx.KVStore(ak.key)
+	end := types.UnorderedTxByTimeoutPrefix(ctx.BlockTime())
+	iterator := store.Iterator(types.UnorderedTxByTimeoutStoreKeyPrefix, end)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	// the timeout timestamps have a fixed length, hence the hashes follow the prefix
+	// of the block time
+	for _, key := range keys {
+		store.Delete(types.UnorderedTxStoreKey(key[len(end):]))
+		store.Delete(key)
+	}
+}
@@ -2,6 +2,7 @@ package legacytx
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -71,6 +72,16 @@ func (s *StdTxBuilder) SetTimeoutHeight(height uint64) {
 	s.TimeoutHeight = height
 }
 
+// SetTimeoutTimestamp panics, StdTx does not support timeout timestamps.
+func (s *StdTxBuilder) SetTimeoutTimestamp(_ time.Time) {
+	panic("StdTxBuilder does not support timeout timestamps")
+}
+
+// SetUnordered panics, StdTx does not support unordered transactions.
+func (s *StdTxBuilder) SetUnordered(_ bool) {
+	panic("StdTxBuilder does not support unordered transactions")
+}
+
 // SetFeeGranter does nothing for stdtx
 func (s *StdTxBuilder) SetFeeGranter(_ sdk.AccAddress) {}
 
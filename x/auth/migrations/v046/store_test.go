@@ -27,7 +27,7 @@ func TestMigrateMapAccAddressToAccNumberKey(t *testing.T) {
 		Time: time.Now(),
 	})
 
-	m := keeper.NewMigrator(app.AccountKeeper, app.GRPCQueryRouter())
+	m := keeper.NewMigrator(app.AccountKeeper, app.GRPCQueryRouter(), app.GetSubspace(types.ModuleName))
 
 	// set the account to store with map acc addr to acc number
 	require.NoError(t, m.V45_SetAccount(ctx, acc))
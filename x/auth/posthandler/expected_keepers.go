@@ -0,0 +1,27 @@
+package posthandler
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BankKeeper defines the contract needed for the bank keeper of the posthandlers.
+type BankKeeper interface {
+	types.BankKeeper
+	SendDeferredCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// FeegrantKeeper defines the expected feegrant keeper.
+type FeegrantKeeper interface {
+	RefundGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee, refund sdk.Coins) error
+}
+
+// GasRefundKeeper defines the expected keeper of the gas refunds, e.g. the x/feemarket
+// keeper.
+type GasRefundKeeper interface {
+	// GetGasRefundRatio returns the share of the fee for the unused gas of a tx
+	// that is refunded.
+	GetGasRefundRatio(ctx sdk.Context) sdk.Dec
+	// RefundTxFee is called with the share of the fee of the tx that was refunded.
+	RefundTxFee(ctx sdk.Context, share sdk.Dec)
+}
@@ -0,0 +1,118 @@
+package posthandler
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MaxGasRefundRatio is the maximum share of the fee for the unused gas that is
+// refunded. As the gas limit is not fully paid for, the refund must not make
+// inflated gas limits, which take up the block gas, close to free.
+var MaxGasRefundRatio = sdk.NewDecWithPrec(5, 1)
+
+// GasRefundDecorator refunds a share of the fee for the unused gas of a tx, i.e. the
+// gas limit minus the gas consumed when the decorator runs, to the fee granter or
+// else to the fee payer. The share is the gas refund ratio of the GasRefundKeeper,
+// capped at MaxGasRefundRatio, times the fraction of the gas limit left unused, and
+// the refund is taken from the fee deferred to the fee collector by the tx, so only
+// the rest of the fee is distributed at the next block. The refund itself is not charged, so that it cannot
+// run the tx out of gas, and the txs whose messages fail are not refunded.
+//
+// CONTRACT: the fee of the tx was deducted by the DeductFeeDecorator and the refund
+// of a granted fee is given back to the allowance by the FeegrantKeeper.
+type GasRefundDecorator struct {
+	bankKeeper      BankKeeper
+	feegrantKeeper  FeegrantKeeper
+	gasRefundKeeper GasRefundKeeper
+}
+
+func NewGasRefundDecorator(bk BankKeeper, fk FeegrantKeeper, rk GasRefundKeeper) GasRefundDecorator {
+	return GasRefundDecorator{
+		bankKeeper:      bk,
+		feegrantKeeper:  fk,
+		gasRefundKeeper: rk,
+	}
+}
+
+func (grd GasRefundDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// the refund does a bounded amount of work regardless of the tx
+	refundCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	if err := grd.refundGas(refundCtx, ctx.GasMeter().GasConsumed(), feeTx); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (grd GasRefundDecorator) refundGas(ctx sdk.Context, gasUsed uint64, feeTx sdk.FeeTx) error {
+	gasLimit := feeTx.GetGas()
+	fee := feeTx.GetFee()
+	if gasUsed >= gasLimit || fee.IsZero() {
+		return nil
+	}
+
+	share := GasRefundShare(grd.gasRefundKeeper.GetGasRefundRatio(ctx), gasLimit, gasUsed)
+	if !share.IsPositive() {
+		return nil
+	}
+
+	var refund sdk.Coins
+	for _, coin := range fee {
+		if amount := share.MulInt(coin.Amount).TruncateInt(); amount.IsPositive() {
+			refund = append(refund, sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	if refund.IsZero() {
+		return nil
+	}
+
+	feePayer := feeTx.FeePayer()
+	refundTo := feePayer
+	if feeGranter := feeTx.FeeGranter(); feeGranter != nil {
+		if !feeGranter.Equals(feePayer) {
+			if grd.feegrantKeeper == nil {
+				return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
+			}
+			if err := grd.feegrantKeeper.RefundGrantedFees(ctx, feeGranter, feePayer, fee, refund); err != nil {
+				return sdkerrors.Wrapf(err, "%s does not allow to refund fees to %s", feeGranter, feePayer)
+			}
+		}
+		refundTo = feeGranter
+	}
+
+	if err := grd.bankKeeper.SendDeferredCoinsFromModuleToAccount(ctx, types.FeeCollectorName, refundTo, refund); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+	grd.gasRefundKeeper.RefundTxFee(ctx, share)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFeeRefund, refund.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, refundTo.String()),
+		),
+	)
+
+	return nil
+}
+
+// GasRefundShare returns the share of the fee of a tx that is refunded for the
+// unused gas, the ratio capped at MaxGasRefundRatio times the unused fraction of
+// the gas limit.
+func GasRefundShare(ratio sdk.Dec, gasLimit, gasUsed uint64) sdk.Dec {
+	if gasUsed >= gasLimit || ratio.IsNil() || !ratio.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	if ratio.GT(MaxGasRefundRatio) {
+		ratio = MaxGasRefundRatio
+	}
+
+	unused := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasLimit - gasUsed))
+	return unused.QuoInt(sdk.NewIntFromUint64(gasLimit)).Mul(ratio)
+}
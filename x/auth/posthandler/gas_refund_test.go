@@ -0,0 +1,82 @@
+package posthandler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func TestGasRefundShare(t *testing.T) {
+	ratio := sdk.NewDecWithPrec(2, 1)
+	require.Equal(t, sdk.NewDecWithPrec(15, 2), posthandler.GasRefundShare(ratio, 1000, 250))
+	require.True(t, posthandler.GasRefundShare(ratio, 1000, 1000).IsZero())
+	require.True(t, posthandler.GasRefundShare(ratio, 1000, 1500).IsZero())
+	require.True(t, posthandler.GasRefundShare(sdk.ZeroDec(), 1000, 0).IsZero())
+
+	// the ratio is capped
+	require.Equal(t, posthandler.MaxGasRefundRatio, posthandler.GasRefundShare(sdk.OneDec(), 1000, 0))
+}
+
+func TestGasRefundDecorator(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(1000))
+	payer, granter, funder := addrs[0], addrs[1], addrs[2]
+
+	params := app.FeeMarketKeeper.GetParams(ctx)
+	params.GasRefundRatio = sdk.NewDecWithPrec(5, 1)
+	require.NoError(t, app.FeeMarketKeeper.SetParams(ctx, params))
+
+	postHandler, err := posthandler.NewPostHandler(posthandler.HandlerOptions{
+		BankKeeper:      app.BankKeeper,
+		FeegrantKeeper:  app.FeeGrantKeeper,
+		GasRefundKeeper: app.FeeMarketKeeper,
+	})
+	require.NoError(t, err)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	newTx := func(feeGranter sdk.AccAddress) sdk.Tx {
+		txBuilder := simapp.MakeTestEncodingConfig().TxConfig.NewTxBuilder()
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetGasLimit(10000)
+		txBuilder.SetFeePayer(payer)
+		txBuilder.SetFeeGranter(feeGranter)
+		return txBuilder.GetTx()
+	}
+	runPostHandler := func(tx sdk.Tx, gasUsed uint64) {
+		// the refund is taken from the fee deferred to the fee collector by the tx
+		require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, funder, fee))
+		require.NoError(t, app.BankKeeper.DeferredSendCoinsFromAccountToModule(ctx, funder, authtypes.FeeCollectorName, fee))
+		gasMeter := sdk.NewGasMeter(10000)
+		gasMeter.ConsumeGas(gasUsed, "tx")
+		_, err := postHandler(ctx.WithGasMeter(gasMeter), tx, false)
+		require.NoError(t, err)
+		require.Equal(t, gasUsed, gasMeter.GasConsumed())
+	}
+
+	// half of the fee for the unused 40% of the gas is refunded to the fee payer
+	runPostHandler(newTx(nil), 6000)
+	require.Equal(t, int64(1200), app.BankKeeper.GetBalance(ctx, payer, sdk.DefaultBondDenom).Amount.Int64())
+
+	// a fully used gas limit is not refunded
+	runPostHandler(newTx(nil), 10000)
+	require.Equal(t, int64(1200), app.BankKeeper.GetBalance(ctx, payer, sdk.DefaultBondDenom).Amount.Int64())
+
+	// a granted fee is refunded to the fee granter and its allowance
+	allowance := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000))}
+	require.NoError(t, app.FeeGrantKeeper.GrantAllowance(ctx, granter, payer, allowance))
+	require.NoError(t, app.FeeGrantKeeper.UseGrantedFees(ctx, granter, payer, fee, nil))
+	runPostHandler(newTx(granter), 8000)
+	require.Equal(t, int64(1100), app.BankKeeper.GetBalance(ctx, granter, sdk.DefaultBondDenom).Amount.Int64())
+	grant, err := app.FeeGrantKeeper.GetAllowance(ctx, granter, payer)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1100)), grant.(*feegrant.BasicAllowance).SpendLimit)
+}
@@ -2,14 +2,29 @@ package posthandler
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // HandlerOptions are the options required for constructing a default SDK PostHandler.
-type HandlerOptions struct{}
+type HandlerOptions struct {
+	BankKeeper     BankKeeper
+	FeegrantKeeper FeegrantKeeper
+	// GasRefundKeeper enables the refunds of the fees for the unused gas, which
+	// also require the BankKeeper.
+	GasRefundKeeper GasRefundKeeper
+}
 
-// NewPostHandler returns an empty posthandler chain.
+// NewPostHandler returns a posthandler chain that refunds the fees for the unused
+// gas if a GasRefundKeeper is set, and is empty otherwise.
 func NewPostHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	postDecorators := []sdk.AnteDecorator{}
 
+	if options.GasRefundKeeper != nil {
+		if options.BankKeeper == nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for gas refunds")
+		}
+		postDecorators = append(postDecorators, NewGasRefundDecorator(options.BankKeeper, options.FeegrantKeeper, options.GasRefundKeeper))
+	}
+
 	return sdk.ChainAnteDecorators(postDecorators...), nil
 }
@@ -56,6 +56,12 @@ func NewDecodeStore(ak AuthUnmarshaler) func(kvA, kvB kv.Pair) string {
 
 			return fmt.Sprintf("AccNumA: %s\nAccNumB: %s", accNumA, accNumB)
 
+		case bytes.Equal(kvA.Key[:1], types.UnorderedTxStoreKeyPrefix):
+			return fmt.Sprintf("TimeoutA: %s\nTimeoutB: %s", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.UnorderedTxByTimeoutStoreKeyPrefix):
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
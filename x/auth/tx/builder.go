@@ -1,6 +1,8 @@
 package tx
 
 import (
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -37,6 +39,7 @@ var (
 	_ authsigning.Tx             = &wrapper{}
 	_ client.TxBuilder           = &wrapper{}
 	_ tx.TipTx                   = &wrapper{}
+	_ sdk.TxWithUnordered        = &wrapper{}
 	_ ante.HasExtensionOptionsTx = &wrapper{}
 	_ ExtensionOptionsTxBuilder  = &wrapper{}
 	_ tx.TipTx                   = &wrapper{}
@@ -167,6 +170,19 @@ func (w *wrapper) GetTimeoutHeight() uint64 {
 	return w.tx.Body.TimeoutHeight
 }
 
+// GetTimeoutTimestamp returns the transaction's timeout timestamp (if set).
+func (w *wrapper) GetTimeoutTimestamp() time.Time {
+	if w.tx.Body.TimeoutTimestamp == nil {
+		return time.Time{}
+	}
+	return *w.tx.Body.TimeoutTimestamp
+}
+
+// GetUnordered returns true if the transaction is unordered.
+func (w *wrapper) GetUnordered() bool {
+	return w.tx.Body.Unordered
+}
+
 func (w *wrapper) GetSignaturesV2() ([]signing.SignatureV2, error) {
 	signerInfos := w.tx.AuthInfo.SignerInfos
 	sigs := w.tx.Signatures
@@ -223,6 +239,27 @@ func (w *wrapper) SetTimeoutHeight(height uint64) {
 	w.bodyBz = nil
 }
 
+// SetTimeoutTimestamp sets the transaction's timeout timestamp, a zero timestamp
+// unsets it.
+func (w *wrapper) SetTimeoutTimestamp(timestamp time.Time) {
+	if timestamp.IsZero() {
+		w.tx.Body.TimeoutTimestamp = nil
+	} else {
+		w.tx.Body.TimeoutTimestamp = &timestamp
+	}
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	w.bodyBz = nil
+}
+
+// SetUnordered sets whether the transaction is unordered.
+func (w *wrapper) SetUnordered(unordered bool) {
+	w.tx.Body.Unordered = unordered
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	w.bodyBz = nil
+}
+
 func (w *wrapper) SetMemo(memo string) {
 	w.tx.Body.Memo = memo
 
@@ -393,6 +430,12 @@ func (w *wrapper) AddAuxSignerData(data tx.AuxSignerData) error {
 	if w.tx.Body.TimeoutHeight != 0 && w.tx.Body.TimeoutHeight != body.TimeoutHeight {
 		return sdkerrors.ErrInvalidRequest.Wrapf("TxBuilder has timeout height %d, got %d in AuxSignerData", w.tx.Body.TimeoutHeight, body.TimeoutHeight)
 	}
+	if w.tx.Body.TimeoutTimestamp != nil && (body.TimeoutTimestamp == nil || !w.tx.Body.TimeoutTimestamp.Equal(*body.TimeoutTimestamp)) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("TxBuilder has timeout timestamp %s, got %v in AuxSignerData", w.tx.Body.TimeoutTimestamp, body.TimeoutTimestamp)
+	}
+	if w.tx.Body.Unordered && !body.Unordered {
+		return sdkerrors.ErrInvalidRequest.Wrap("TxBuilder is unordered, got an ordered tx in AuxSignerData")
+	}
 	if len(w.tx.Body.ExtensionOptions) != 0 {
 		if len(w.tx.Body.ExtensionOptions) != len(body.ExtensionOptions) {
 			return sdkerrors.ErrInvalidRequest.Wrapf("TxBuilder has %d extension options, got %d in AuxSignerData", len(w.tx.Body.ExtensionOptions), len(body.ExtensionOptions))
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -19,6 +21,9 @@ const (
 )
 
 var (
+	// ParamsKey is the prefix for params key
+	ParamsKey = []byte{0x00}
+
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
 
@@ -27,6 +32,12 @@ var (
 
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = []byte("accountNumber")
+
+	// UnorderedTxStoreKeyPrefix prefix for the timeout timestamps of unordered txs by hash
+	UnorderedTxStoreKeyPrefix = []byte{0x02}
+
+	// UnorderedTxByTimeoutStoreKeyPrefix prefix for the hashes of unordered txs by timeout timestamp
+	UnorderedTxByTimeoutStoreKeyPrefix = []byte{0x03}
 )
 
 // AddressStoreKey turn an address to key used to get it from the account store
@@ -38,3 +49,18 @@ func AddressStoreKey(addr sdk.AccAddress) []byte {
 func AccountNumberStoreKey(accountNumber uint64) []byte {
 	return append(AccountNumberStoreKeyPrefix, sdk.Uint64ToBigEndian(accountNumber)...)
 }
+
+// UnorderedTxStoreKey turn the hash of an unordered tx to key used to get its timeout timestamp from the store
+func UnorderedTxStoreKey(txHash []byte) []byte {
+	return append(UnorderedTxStoreKeyPrefix, txHash...)
+}
+
+// UnorderedTxByTimeoutStoreKey turn the timeout timestamp and the hash of an unordered tx to key used to prune it from the store
+func UnorderedTxByTimeoutStoreKey(timeout time.Time, txHash []byte) []byte {
+	return append(UnorderedTxByTimeoutPrefix(timeout), txHash...)
+}
+
+// UnorderedTxByTimeoutPrefix returns the prefix of the hashes of the unordered txs with a timeout timestamp
+func UnorderedTxByTimeoutPrefix(timeout time.Time) []byte {
+	return append(UnorderedTxByTimeoutStoreKeyPrefix, sdk.FormatTimeBytes(timeout)...)
+}
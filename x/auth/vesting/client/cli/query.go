@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// GetQueryCmd returns the vesting module's query commands.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the vesting module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetVestingScheduleCmd(),
+	)
+
+	return queryCmd
+}
+
+// GetVestingScheduleCmd returns the command to query the combined vesting
+// schedule of a vesting account.
+func GetVestingScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule [address]",
+		Short: "Query the vesting schedule of a vesting account",
+		Long: fmt.Sprintf(`Query the combined vesting schedule of a vesting account, including all
+grants merged into it, along with the amounts vested and still vesting at the
+latest block time.
+
+Example:
+$ %s query %s schedule cosmos1...
+`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VestingSchedule(cmd.Context(), &types.QueryVestingScheduleRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
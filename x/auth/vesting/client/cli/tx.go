@@ -18,6 +18,10 @@ import (
 // Transaction command flags
 const (
 	FlagDelayed = "delayed"
+	FlagMerge   = "merge"
+	FlagLockup  = "lockup"
+	FlagVesting = "vesting"
+	FlagDest    = "dest"
 )
 
 // GetTxCmd returns vesting module's transaction commands.
@@ -34,6 +38,8 @@ func GetTxCmd() *cobra.Command {
 		NewMsgCreateVestingAccountCmd(),
 		NewMsgCreatePermanentLockedAccountCmd(),
 		NewMsgCreatePeriodicVestingAccountCmd(),
+		NewMsgCreateClawbackVestingAccountCmd(),
+		NewMsgClawbackCmd(),
 	)
 
 	return txCmd
@@ -153,6 +159,8 @@ func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
  },
 ]
 	}
+
+With the '--merge' flag, the grant is added to the existing periodic vesting account at to_address instead, which must have been funded by the sender. The new periods are interleaved with the existing schedule.
 		`,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -194,7 +202,163 @@ func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
 				periods = append(periods, period)
 			}
 
-			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods)
+			merge, _ := cmd.Flags().GetBool(FlagMerge)
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods).WithMerge(merge)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagMerge, false, "Add the grant to an existing periodic vesting account funded by the sender")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ReadScheduleFile reads a vesting schedule in the format accepted by
+// create-periodic-vesting-account from the given JSON file.
+func ReadScheduleFile(path string) (int64, []types.Period, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var data VestingData
+	if err := json.Unmarshal(contents, &data); err != nil {
+		return 0, nil, err
+	}
+
+	periods := make([]types.Period, 0, len(data.Periods))
+	for i, p := range data.Periods {
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		if p.Length < 0 {
+			return 0, nil, fmt.Errorf("invalid period length of %d in period %d, length must not be negative", p.Length, i)
+		}
+		periods = append(periods, types.Period{Length: p.Length, Amount: amount})
+	}
+
+	return data.StartTime, periods, nil
+}
+
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address]",
+		Short: "Create a new vesting account funded with an allocation of tokens, subject to clawback.",
+		Long: `Must provide a lockup schedule, a vesting schedule or both. The schedules are
+given as JSON files in the format accepted by create-periodic-vesting-account:
+
+{ "start_time": 1625204910,
+  "periods": [
+    { "coins": "10test", "length_seconds": 2592000 },
+    { "coins": "10test", "length_seconds": 2592000 }
+  ]
+}
+
+An omitted schedule means the coins are unlocked (respectively vested) at the
+start time. If both schedules are given, their start times and total amounts
+must be equal. The sender of the transaction becomes the funder of the account
+and is the only one able to claw back unvested tokens.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			lockupFile, _ := cmd.Flags().GetString(FlagLockup)
+			vestingFile, _ := cmd.Flags().GetString(FlagVesting)
+			if lockupFile == "" && vestingFile == "" {
+				return fmt.Errorf("must specify at least one of --%s or --%s", FlagLockup, FlagVesting)
+			}
+
+			var (
+				lockupStart, vestingStart     int64
+				lockupPeriods, vestingPeriods []types.Period
+			)
+			if lockupFile != "" {
+				lockupStart, lockupPeriods, err = ReadScheduleFile(lockupFile)
+				if err != nil {
+					return err
+				}
+			}
+			if vestingFile != "" {
+				vestingStart, vestingPeriods, err = ReadScheduleFile(vestingFile)
+				if err != nil {
+					return err
+				}
+			}
+
+			startTime := lockupStart
+			switch {
+			case lockupFile == "":
+				startTime = vestingStart
+			case vestingFile != "" && lockupStart != vestingStart:
+				return fmt.Errorf("lockup start time %d does not match vesting start time %d", lockupStart, vestingStart)
+			}
+
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, lockupPeriods, vestingPeriods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagLockup, "", "path to the JSON file describing the lockup schedule")
+	cmd.Flags().String(FlagVesting, "", "path to the JSON file describing the vesting schedule")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a
+// MsgClawback transaction.
+func NewMsgClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Transfer unvested amount out of a ClawbackVestingAccount.",
+		Long: `Must be requested by the original funder address (--from).
+May provide a destination address (--dest), otherwise the coins return to the funder.
+Delegated or unbonding staking tokens will be transferred in the delegated (or
+unbonding) state. The recipient is vulnerable to slashing, and must act to
+unbond the tokens if desired.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var dest sdk.AccAddress
+			if destString, _ := cmd.Flags().GetString(FlagDest); destString != "" {
+				dest, err = sdk.AccAddressFromBech32(destString)
+				if err != nil {
+					return fmt.Errorf("bad dest address: %w", err)
+				}
+			}
+
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, dest)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -203,6 +367,7 @@ func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagDest, "", "address of the destination (defaults to the funder)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
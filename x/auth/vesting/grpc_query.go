@@ -0,0 +1,71 @@
+package vesting
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+type queryServer struct {
+	keeper.AccountKeeper
+}
+
+// NewQueryServerImpl returns an implementation of the vesting QueryServer
+// interface, wrapping the corresponding AccountKeeper.
+func NewQueryServerImpl(k keeper.AccountKeeper) types.QueryServer {
+	return &queryServer{AccountKeeper: k}
+}
+
+var _ types.QueryServer = queryServer{}
+
+// VestingSchedule returns the combined vesting schedule of a vesting account.
+func (s queryServer) VestingSchedule(goCtx context.Context, req *types.QueryVestingScheduleRequest) (*types.QueryVestingScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	acc := s.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", req.Address)
+	}
+
+	va, ok := acc.(exported.VestingAccount)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "account %s is not a vesting account", req.Address)
+	}
+
+	res := &types.QueryVestingScheduleResponse{
+		StartTime:       va.GetStartTime(),
+		EndTime:         va.GetEndTime(),
+		OriginalVesting: va.GetOriginalVesting(),
+		Vested:          va.GetVestedCoins(ctx.BlockTime()),
+		Vesting:         va.GetVestingCoins(ctx.BlockTime()),
+	}
+
+	switch va := va.(type) {
+	case *types.PeriodicVestingAccount:
+		res.VestingPeriods = va.VestingPeriods
+		res.Grants = va.GetGrants()
+	case *types.ClawbackVestingAccount:
+		res.VestingPeriods = va.VestingPeriods
+		res.LockupPeriods = va.LockupPeriods
+	case *types.DelayedVestingAccount:
+		// delayed vesting accounts have no start time and vest all at once
+		res.VestingPeriods = []types.Period{{Length: va.EndTime, Amount: va.OriginalVesting}}
+	}
+
+	return res, nil
+}
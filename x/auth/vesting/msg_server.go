@@ -2,7 +2,9 @@ package vesting
 
 import (
 	"context"
+	gomath "math"
 
+	"cosmossdk.io/math"
 	"github.com/armon/go-metrics"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -16,12 +18,13 @@ import (
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	types.StakingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -168,7 +171,8 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 		return nil, err
 	}
 
-	if acc := ak.GetAccount(ctx, to); acc != nil {
+	acc := ak.GetAccount(ctx, to)
+	if acc != nil && !msg.Merge {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
 	}
 
@@ -181,9 +185,90 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 		return nil, err
 	}
 
+	if acc != nil {
+		vestingAccount, ok := acc.(*types.PeriodicVestingAccount)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a periodic vesting account", msg.ToAddress)
+		}
+		if vestingAccount.FunderAddress != msg.FromAddress {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "only the funder of account %s can add grants to it", msg.ToAddress)
+		}
+		vestingAccount.AddGrant(ctx.BlockTime(), msg.StartTime, msg.VestingPeriods)
+		ak.SetAccount(ctx, vestingAccount)
+	} else {
+		baseAccount := authtypes.NewBaseAccountWithAddress(to)
+		baseAccount = ak.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+		vestingAccount := types.NewPeriodicVestingAccount(baseAccount, totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
+		vestingAccount.FunderAddress = msg.FromAddress
+
+		ak.SetAccount(ctx, vestingAccount)
+	}
+
+	defer func() {
+		if acc == nil {
+			telemetry.IncrCounter(1, "new", "account")
+		}
+
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_periodic_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	if err = bk.SendCoins(ctx, from, to, totalCoins); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+}
+
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	// either schedule may be empty, in which case the other defines the grant
+	totalCoins := types.Periods(msg.VestingPeriods).TotalAmount()
+	if len(msg.VestingPeriods) == 0 {
+		totalCoins = types.Periods(msg.LockupPeriods).TotalAmount()
+	}
+
+	if err := bk.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
 	baseAccount := authtypes.NewBaseAccountWithAddress(to)
 	baseAccount = ak.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
-	vestingAccount := types.NewPeriodicVestingAccount(baseAccount, totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
+	vestingAccount := types.NewClawbackVestingAccount(baseAccount, from, totalCoins.Sort(), msg.StartTime, msg.LockupPeriods, msg.VestingPeriods)
 
 	ak.SetAccount(ctx, vestingAccount)
 
@@ -193,7 +278,7 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 		for _, a := range totalCoins {
 			if a.Amount.IsInt64() {
 				telemetry.SetGaugeWithLabels(
-					[]string{"tx", "msg", "create_periodic_vesting_account"},
+					[]string{"tx", "msg", "create_clawback_vesting_account"},
 					float32(a.Amount.Int64()),
 					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
 				)
@@ -211,5 +296,134 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	)
-	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	dest, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	if msg.DestAddress != "" {
+		dest, err = sdk.AccAddressFromBech32(msg.DestAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if bk.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "account %s does not exist", msg.Address)
+	}
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+	if va.FunderAddress != msg.FunderAddress {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "clawback can only be requested by original funder %s", va.FunderAddress)
+	}
+
+	// Remove the unvested coins from the schedules first, so that they become
+	// spendable and can be moved with a regular transfer.
+	delegated := va.DelegatedFree.Add(va.DelegatedVesting...)
+	toClawBack := va.ComputeClawback(ctx.BlockTime().Unix())
+	va.ResetDelegationTracking(ctx.BlockTime(), delegated)
+	ak.SetAccount(ctx, va)
+
+	toXfer := toClawBack.Min(bk.SpendableCoins(ctx, addr))
+	if !toXfer.IsZero() {
+		if err := bk.SendCoins(ctx, addr, dest, toXfer); err != nil {
+			return nil, err
+		}
+	}
+
+	// Any remainder of the staking denom is recovered from the unbonding and
+	// bonded tokens of the account, without unbonding them.
+	bondDenom := s.StakingKeeper.BondDenom(ctx)
+	want := toClawBack.Sub(toXfer...).AmountOf(bondDenom)
+	if want.IsPositive() {
+		transferred, err := s.clawbackStake(ctx, addr, dest, want)
+		if err != nil {
+			return nil, err
+		}
+
+		delegatedBonds := sdk.MaxInt(delegated.AmountOf(bondDenom).Sub(transferred), sdk.ZeroInt())
+		delegated = delegated.Sub(sdk.NewCoin(bondDenom, delegated.AmountOf(bondDenom))).Add(sdk.NewCoin(bondDenom, delegatedBonds))
+
+		va = ak.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+		va.ResetDelegationTracking(ctx.BlockTime(), delegated)
+		ak.SetAccount(ctx, va)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &types.MsgClawbackResponse{}, nil
+}
+
+// clawbackStake transfers up to want staking tokens from the unbonding and
+// bonded delegations of addr to dest and returns the amount transferred.
+// Unbonding tokens are taken first.
+func (s msgServer) clawbackStake(ctx sdk.Context, addr, dest sdk.AccAddress, want math.Int) (math.Int, error) {
+	sk := s.StakingKeeper
+	transferred := sdk.ZeroInt()
+
+	for _, ubd := range sk.GetUnbondingDelegations(ctx, addr, gomath.MaxUint16) {
+		if !want.IsPositive() {
+			return transferred, nil
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+		if err != nil {
+			return transferred, err
+		}
+
+		amt := sk.TransferUnbonding(ctx, addr, dest, valAddr, want)
+		want = want.Sub(amt)
+		transferred = transferred.Add(amt)
+	}
+
+	for _, delegation := range sk.GetDelegatorDelegations(ctx, addr, gomath.MaxUint16) {
+		if !want.IsPositive() {
+			return transferred, nil
+		}
+
+		validator, found := sk.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			continue
+		}
+
+		wantShares, err := validator.SharesFromTokens(want)
+		if err != nil {
+			// the validator has no tokens left, so its shares are worthless
+			continue
+		}
+
+		shares, err := sk.TransferDelegation(ctx, addr, dest, delegation.GetValidatorAddr(), wantShares)
+		if err != nil {
+			return transferred, err
+		}
+
+		amt := validator.TokensFromShares(shares).TruncateInt()
+		want = sdk.MaxInt(want.Sub(amt), sdk.ZeroInt())
+		transferred = transferred.Add(amt)
+	}
+
+	return transferred, nil
 }
@@ -0,0 +1,208 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type MsgServerTestSuite struct {
+	suite.Suite
+
+	app       *simapp.SimApp
+	ctx       sdk.Context
+	msgServer types.MsgServer
+	bondDenom string
+	startTime time.Time
+}
+
+func (s *MsgServerTestSuite) SetupTest() {
+	s.app = simapp.Setup(s.T(), false)
+	s.startTime = time.Unix(1_700_000_000, 0).UTC()
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: s.startTime})
+	s.msgServer = vesting.NewMsgServerImpl(s.app.AccountKeeper, s.app.BankKeeper, s.app.StakingKeeper)
+	s.bondDenom = s.app.StakingKeeper.BondDenom(s.ctx)
+}
+
+func (s *MsgServerTestSuite) coins(amt int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(s.bondDenom, amt))
+}
+
+// createClawbackAccount funds a new funder and creates a clawback vesting
+// account vesting 100 tokens every 100 seconds for 300 seconds.
+func (s *MsgServerTestSuite) createClawbackAccount() (funder, addr sdk.AccAddress) {
+	_, _, funder = testdata.KeyTestPubAddr()
+	_, _, addr = testdata.KeyTestPubAddr()
+	s.Require().NoError(banktestutil.FundAccount(s.app.BankKeeper, s.ctx, funder, s.coins(1000)))
+
+	periods := []types.Period{
+		{Length: 100, Amount: s.coins(100)},
+		{Length: 100, Amount: s.coins(100)},
+		{Length: 100, Amount: s.coins(100)},
+	}
+	msg := types.NewMsgCreateClawbackVestingAccount(funder, addr, s.startTime.Unix(), nil, periods)
+	_, err := s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	return funder, addr
+}
+
+func (s *MsgServerTestSuite) TestCreateClawbackVestingAccount() {
+	funder, addr := s.createClawbackAccount()
+
+	acc, ok := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.ClawbackVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(funder.String(), acc.FunderAddress)
+	s.Require().Equal(s.coins(300), acc.OriginalVesting)
+	s.Require().Equal(s.startTime.Unix()+300, acc.EndTime)
+	s.Require().Equal(s.coins(300), s.app.BankKeeper.GetAllBalances(s.ctx, addr))
+
+	// the account cannot be created twice
+	msg := types.NewMsgCreateClawbackVestingAccount(funder, addr, s.startTime.Unix(), nil, []types.Period{{Length: 100, Amount: s.coins(100)}})
+	_, err := s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().Error(err)
+}
+
+func (s *MsgServerTestSuite) TestClawback() {
+	funder, addr := s.createClawbackAccount()
+	_, _, dest := testdata.KeyTestPubAddr()
+
+	ctx := s.ctx.WithBlockTime(s.startTime.Add(150 * time.Second))
+
+	// only the funder can claw back
+	_, err := s.msgServer.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(dest, addr, nil))
+	s.Require().Error(err)
+
+	// only clawback vesting accounts can be clawed back
+	_, err = s.msgServer.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(funder, funder, nil))
+	s.Require().Error(err)
+
+	_, err = s.msgServer.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(funder, addr, dest))
+	s.Require().NoError(err)
+
+	s.Require().Equal(s.coins(200), s.app.BankKeeper.GetAllBalances(ctx, dest))
+	s.Require().Equal(s.coins(100), s.app.BankKeeper.GetAllBalances(ctx, addr))
+
+	acc := s.app.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	s.Require().Equal(s.coins(100), acc.OriginalVesting)
+	s.Require().Equal(s.startTime.Unix()+100, acc.EndTime)
+	s.Require().Equal(s.coins(100), s.app.BankKeeper.SpendableCoins(ctx, addr))
+}
+
+func (s *MsgServerTestSuite) TestClawbackDelegated() {
+	funder, addr := s.createClawbackAccount()
+
+	validator := s.app.StakingKeeper.GetAllValidators(s.ctx)[0]
+	_, err := s.app.StakingKeeper.Delegate(s.ctx, addr, sdk.NewInt(250), stakingtypes.Unbonded, validator, true)
+	s.Require().NoError(err)
+
+	// unbond some of the delegation, so that part of the clawback is taken
+	// from the unbonding tokens
+	shares, err := s.app.StakingKeeper.ValidateUnbondAmount(s.ctx, addr, validator.GetOperator(), sdk.NewInt(30))
+	s.Require().NoError(err)
+	_, err = s.app.StakingKeeper.Undelegate(s.ctx, addr, validator.GetOperator(), shares)
+	s.Require().NoError(err)
+
+	ctx := s.ctx.WithBlockTime(s.startTime.Add(150 * time.Second))
+	_, err = s.msgServer.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(funder, addr, nil))
+	s.Require().NoError(err)
+
+	// 50 tokens are taken from the balance, 30 from the unbonding delegation
+	// and the remaining 120 from the delegation
+	s.Require().Equal(s.coins(1000-300+50), s.app.BankKeeper.GetAllBalances(ctx, funder))
+	s.Require().True(s.app.BankKeeper.GetAllBalances(ctx, addr).IsZero())
+
+	ubd, found := s.app.StakingKeeper.GetUnbondingDelegation(ctx, funder, validator.GetOperator())
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(30), ubd.Entries[0].Balance)
+	_, found = s.app.StakingKeeper.GetUnbondingDelegation(ctx, addr, validator.GetOperator())
+	s.Require().False(found)
+
+	validator, _ = s.app.StakingKeeper.GetValidator(ctx, validator.GetOperator())
+	funderDel, found := s.app.StakingKeeper.GetDelegation(ctx, funder, validator.GetOperator())
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(120), validator.TokensFromShares(funderDel.Shares).TruncateInt())
+	addrDel, found := s.app.StakingKeeper.GetDelegation(ctx, addr, validator.GetOperator())
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(100), validator.TokensFromShares(addrDel.Shares).TruncateInt())
+
+	acc := s.app.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	s.Require().Equal(s.coins(100), acc.OriginalVesting)
+	s.Require().True(acc.DelegatedVesting.IsZero())
+	s.Require().Equal(s.coins(100), acc.DelegatedFree)
+}
+
+func (s *MsgServerTestSuite) TestCreatePeriodicVestingAccountMerge() {
+	_, _, funder := testdata.KeyTestPubAddr()
+	_, _, addr := testdata.KeyTestPubAddr()
+	s.Require().NoError(banktestutil.FundAccount(s.app.BankKeeper, s.ctx, funder, s.coins(1000)))
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	periods := []types.Period{{Length: 100, Amount: s.coins(100)}, {Length: 100, Amount: s.coins(100)}}
+	_, err := s.msgServer.CreatePeriodicVestingAccount(goCtx, types.NewMsgCreatePeriodicVestingAccount(funder, addr, s.startTime.Unix(), periods))
+	s.Require().NoError(err)
+
+	// a second grant requires merging
+	grant := []types.Period{{Length: 150, Amount: s.coins(50)}}
+	_, err = s.msgServer.CreatePeriodicVestingAccount(goCtx, types.NewMsgCreatePeriodicVestingAccount(funder, addr, s.startTime.Unix()+100, grant))
+	s.Require().Error(err)
+
+	_, err = s.msgServer.CreatePeriodicVestingAccount(goCtx, types.NewMsgCreatePeriodicVestingAccount(funder, addr, s.startTime.Unix()+100, grant).WithMerge(true))
+	s.Require().NoError(err)
+
+	// only the funder of the account can add grants to it
+	_, _, other := testdata.KeyTestPubAddr()
+	s.Require().NoError(banktestutil.FundAccount(s.app.BankKeeper, s.ctx, other, s.coins(1000)))
+	_, err = s.msgServer.CreatePeriodicVestingAccount(goCtx, types.NewMsgCreatePeriodicVestingAccount(other, addr, s.startTime.Unix(), grant).WithMerge(true))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	acc := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.PeriodicVestingAccount)
+	s.Require().NoError(acc.Validate())
+	s.Require().Equal(funder.String(), acc.FunderAddress)
+	s.Require().Equal(s.coins(250), acc.OriginalVesting)
+	s.Require().Equal(s.startTime.Unix(), acc.StartTime)
+	s.Require().Equal(s.startTime.Unix()+250, acc.EndTime)
+	s.Require().Equal(s.coins(250), s.app.BankKeeper.GetAllBalances(s.ctx, addr))
+
+	// grants can only be merged into periodic vesting accounts
+	_, err = s.msgServer.CreatePeriodicVestingAccount(goCtx, types.NewMsgCreatePeriodicVestingAccount(addr, funder, s.startTime.Unix(), grant).WithMerge(true))
+	s.Require().Error(err)
+
+	// the query returns the combined schedule
+	queryServer := vesting.NewQueryServerImpl(s.app.AccountKeeper)
+	ctx := s.ctx.WithBlockTime(s.startTime.Add(200 * time.Second))
+	res, err := queryServer.VestingSchedule(sdk.WrapSDKContext(ctx), &types.QueryVestingScheduleRequest{Address: addr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(s.startTime.Unix(), res.StartTime)
+	s.Require().Equal(s.startTime.Unix()+250, res.EndTime)
+	s.Require().Equal(s.coins(250), res.OriginalVesting)
+	s.Require().Equal([]types.Period{
+		{Length: 100, Amount: s.coins(100)},
+		{Length: 100, Amount: s.coins(100)},
+		{Length: 50, Amount: s.coins(50)},
+	}, res.VestingPeriods)
+	s.Require().Equal(s.coins(200), res.Vested)
+	s.Require().Equal(s.coins(50), res.Vesting)
+	s.Require().Equal([]types.VestingGrant{
+		{StartTime: s.startTime.Unix(), VestingPeriods: periods},
+		{StartTime: s.startTime.Unix() + 100, VestingPeriods: grant},
+	}, res.Grants)
+
+	_, err = queryServer.VestingSchedule(sdk.WrapSDKContext(ctx), &types.QueryVestingScheduleRequest{Address: funder.String()})
+	s.Require().Error(err)
+}
+
+func TestMsgServerTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgServerTestSuite))
+}
@@ -58,3 +58,103 @@ func (p Periods) String() string {
 	return strings.TrimSpace(fmt.Sprintf(`Vesting Periods:
 		%s`, strings.Join(periodsListString, ", ")))
 }
+
+// ReadSchedule returns the value of the schedule at readTime, i.e. the sum of
+// the amounts of all periods which have ended by readTime. Periods are
+// sequential and the first one starts at startTime.
+func ReadSchedule(startTime int64, periods Periods, readTime int64) sdk.Coins {
+	vested := sdk.NewCoins()
+
+	time := startTime
+	for _, period := range periods {
+		time += period.Length
+		if readTime < time {
+			break
+		}
+		vested = vested.Add(period.Amount...)
+	}
+
+	return vested
+}
+
+// ConjunctPeriods returns the combination of the two schedules whose value at
+// any time is the minimum of the values of p and q, denomination by
+// denomination. The start time of the result is the earlier of the two start
+// times, and its end time is the time after which it no longer changes.
+func ConjunctPeriods(startP int64, p Periods, startQ int64, q Periods) (startTime, endTime int64, periods Periods) {
+	return combinePeriods(startP, p, startQ, q, func(a, b sdk.Coins) sdk.Coins {
+		return a.Min(b)
+	})
+}
+
+// DisjunctPeriods returns the combination of the two schedules whose value at
+// any time is the sum of the values of p and q. The start time of the result
+// is the earlier of the two start times, and its end time is the later of the
+// two end times.
+func DisjunctPeriods(startP int64, p Periods, startQ int64, q Periods) (startTime, endTime int64, periods Periods) {
+	return combinePeriods(startP, p, startQ, q, func(a, b sdk.Coins) sdk.Coins {
+		return a.Add(b...)
+	})
+}
+
+// combinePeriods walks the period boundaries of both schedules in time order
+// and emits a period at every boundary where the combined value changes.
+func combinePeriods(startP int64, p Periods, startQ int64, q Periods, combine func(a, b sdk.Coins) sdk.Coins) (startTime, endTime int64, periods Periods) {
+	startTime = startP
+	if startQ < startTime {
+		startTime = startQ
+	}
+	endTime = startTime
+	periods = Periods{}
+
+	var (
+		i, j         int
+		timeP, timeQ = startP, startQ
+		valP, valQ   = sdk.NewCoins(), sdk.NewCoins()
+		current      = sdk.NewCoins()
+	)
+	if len(p) > 0 {
+		timeP += p[0].Length
+	}
+	if len(q) > 0 {
+		timeQ += q[0].Length
+	}
+
+	for i < len(p) || j < len(q) {
+		// pick the earliest pending boundary and apply every period of either
+		// schedule ending at that time
+		var now int64
+		switch {
+		case j >= len(q) || (i < len(p) && timeP <= timeQ):
+			now = timeP
+		default:
+			now = timeQ
+		}
+		for i < len(p) && timeP == now {
+			valP = valP.Add(p[i].Amount...)
+			i++
+			if i < len(p) {
+				timeP += p[i].Length
+			}
+		}
+		for j < len(q) && timeQ == now {
+			valQ = valQ.Add(q[j].Amount...)
+			j++
+			if j < len(q) {
+				timeQ += q[j].Length
+			}
+		}
+
+		// both combinations are monotonic, so the difference is never negative
+		next := combine(valP, valQ)
+		delta := next.Sub(current...)
+		if delta.IsZero() {
+			continue
+		}
+		periods = append(periods, Period{Length: now - endTime, Amount: delta})
+		current = next
+		endTime = now
+	}
+
+	return startTime, endTime, periods
+}
@@ -0,0 +1,170 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestReadSchedule(t *testing.T) {
+	periods := types.Periods{
+		{Length: 10, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 10)}},
+		{Length: 0, Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 5)}},
+		{Length: 20, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 30)}},
+	}
+
+	require.True(t, types.ReadSchedule(100, periods, 109).IsZero())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 5), sdk.NewInt64Coin(stakeDenom, 10)}, types.ReadSchedule(100, periods, 110))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 5), sdk.NewInt64Coin(stakeDenom, 40)}, types.ReadSchedule(100, periods, 130))
+}
+
+func TestConjunctPeriods(t *testing.T) {
+	p := types.Periods{
+		{Length: 10, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		{Length: 10, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	q := types.Periods{
+		{Length: 5, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}},
+		{Length: 30, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)}},
+	}
+
+	startTime, endTime, periods := types.ConjunctPeriods(100, p, 105, q)
+	require.Equal(t, int64(100), startTime)
+	require.Equal(t, int64(140), endTime)
+	require.Equal(t, types.Periods{
+		{Length: 10, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}},
+		{Length: 30, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)}},
+	}, periods)
+
+	for readTime := int64(95); readTime <= 145; readTime++ {
+		expected := types.ReadSchedule(100, p, readTime).Min(types.ReadSchedule(105, q, readTime))
+		require.True(t, expected.IsEqual(types.ReadSchedule(startTime, periods, readTime)), "time %d", readTime)
+	}
+}
+
+func TestDisjunctPeriods(t *testing.T) {
+	p := types.Periods{
+		{Length: 10, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		{Length: 10, Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 50)}},
+	}
+	q := types.Periods{
+		{Length: 5, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}},
+		{Length: 5, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)}},
+	}
+
+	startTime, endTime, periods := types.DisjunctPeriods(100, p, 90, q)
+	require.Equal(t, int64(90), startTime)
+	require.Equal(t, int64(120), endTime)
+	require.Equal(t, types.Periods{
+		{Length: 5, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}},
+		{Length: 5, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)}},
+		{Length: 10, Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		{Length: 10, Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 50)}},
+	}, periods)
+	require.Equal(t, p.TotalAmount().Add(q.TotalAmount()...), periods.TotalAmount())
+}
+
+// genPeriods generates a random schedule of up to 8 periods vesting positive
+// amounts of up to two denominations.
+func genPeriods(t *rapid.T, label string) types.Periods {
+	n := rapid.IntRange(0, 8).Draw(t, label+"Len").(int)
+	periods := make(types.Periods, n)
+	for i := range periods {
+		amount := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, rapid.Int64Range(1, 1000).Draw(t, label+"Stake").(int64)))
+		if rapid.Bool().Draw(t, label+"HasFee").(bool) {
+			amount = amount.Add(sdk.NewInt64Coin(feeDenom, rapid.Int64Range(1, 1000).Draw(t, label+"Fee").(int64)))
+		}
+		periods[i] = types.Period{
+			Length: rapid.Int64Range(0, 100).Draw(t, label+"Length").(int64),
+			Amount: amount,
+		}
+	}
+	return periods
+}
+
+// checkCombinedSchedule verifies that the combined schedule is well formed and
+// that its value at every period boundary of either input, and just before
+// it, equals the combination of the values of the inputs.
+func checkCombinedSchedule(
+	t *rapid.T, startP int64, p types.Periods, startQ int64, q types.Periods,
+	startTime, endTime int64, periods types.Periods, combine func(a, b sdk.Coins) sdk.Coins,
+) {
+	require.Equal(t, endTime, startTime+periods.TotalLength())
+	for _, period := range periods {
+		require.GreaterOrEqual(t, period.Length, int64(0))
+		require.True(t, period.Amount.IsAllPositive())
+	}
+
+	times := []int64{startP - 1, startQ - 1, endTime + 1}
+	for _, schedule := range []struct {
+		start   int64
+		periods types.Periods
+	}{{startP, p}, {startQ, q}, {startTime, periods}} {
+		time := schedule.start
+		for _, period := range schedule.periods {
+			time += period.Length
+			times = append(times, time-1, time)
+		}
+	}
+
+	for _, readTime := range times {
+		expected := combine(types.ReadSchedule(startP, p, readTime), types.ReadSchedule(startQ, q, readTime))
+		actual := types.ReadSchedule(startTime, periods, readTime)
+		require.True(t, coinsEqual(expected, actual), "time %d: expected %s, got %s", readTime, expected, actual)
+	}
+}
+
+// coinsEqual compares coins regardless of whether empty coins are nil.
+func coinsEqual(a, b sdk.Coins) bool {
+	return a.IsAllLTE(b) && b.IsAllLTE(a)
+}
+
+func TestDisjunctPeriodsProperties(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		startP := rapid.Int64Range(0, 200).Draw(t, "startP").(int64)
+		startQ := rapid.Int64Range(0, 200).Draw(t, "startQ").(int64)
+		p := genPeriods(t, "p")
+		q := genPeriods(t, "q")
+
+		startTime, endTime, periods := types.DisjunctPeriods(startP, p, startQ, q)
+
+		// merging is commutative
+		startTime2, endTime2, periods2 := types.DisjunctPeriods(startQ, q, startP, p)
+		require.Equal(t, startTime, startTime2)
+		require.Equal(t, endTime, endTime2)
+		require.Equal(t, periods, periods2)
+
+		// no coins are lost or created, and all grants end by the end time
+		require.True(t, coinsEqual(p.TotalAmount().Add(q.TotalAmount()...), periods.TotalAmount()))
+		if len(p) > 0 {
+			require.LessOrEqual(t, startP+p.TotalLength(), endTime)
+		}
+		if len(q) > 0 {
+			require.LessOrEqual(t, startQ+q.TotalLength(), endTime)
+		}
+
+		checkCombinedSchedule(t, startP, p, startQ, q, startTime, endTime, periods, func(a, b sdk.Coins) sdk.Coins {
+			return a.Add(b...)
+		})
+	})
+}
+
+func TestConjunctPeriodsProperties(t *testing.T) {
+	rapid.Check(t, func(t *rapid.T) {
+		startP := rapid.Int64Range(0, 200).Draw(t, "startP").(int64)
+		startQ := rapid.Int64Range(0, 200).Draw(t, "startQ").(int64)
+		p := genPeriods(t, "p")
+		q := genPeriods(t, "q")
+
+		startTime, endTime, periods := types.ConjunctPeriods(startP, p, startQ, q)
+		require.True(t, coinsEqual(p.TotalAmount().Min(q.TotalAmount()), periods.TotalAmount()))
+
+		checkCombinedSchedule(t, startP, p, startQ, q, startTime, endTime, periods, func(a, b sdk.Coins) sdk.Coins {
+			return a.Min(b)
+		})
+	})
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"sigs.k8s.io/yaml"
@@ -18,6 +19,7 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 // Base Vesting Account
@@ -162,8 +164,11 @@ type vestingAccountYAML struct {
 	EndTime          int64          `json:"end_time"`
 
 	// custom fields based on concrete vesting type which can be omitted
-	StartTime      int64   `json:"start_time,omitempty"`
-	VestingPeriods Periods `json:"vesting_periods,omitempty"`
+	StartTime      int64          `json:"start_time,omitempty"`
+	VestingPeriods Periods        `json:"vesting_periods,omitempty"`
+	FunderAddress  string         `json:"funder_address,omitempty"`
+	LockupPeriods  Periods        `json:"lockup_periods,omitempty"`
+	Grants         []VestingGrant `json:"grants,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -405,6 +410,42 @@ func (pva PeriodicVestingAccount) GetVestingPeriods() Periods {
 	return pva.VestingPeriods
 }
 
+// GetGrants returns the grants merged into the vesting schedule of the account,
+// i.e. only the schedule of the account if no grant was added to it.
+func (pva PeriodicVestingAccount) GetGrants() []VestingGrant {
+	if len(pva.Grants) == 0 {
+		return []VestingGrant{{StartTime: pva.StartTime, VestingPeriods: pva.VestingPeriods}}
+	}
+	return pva.Grants
+}
+
+// AddGrant merges a new grant of the given periods, starting at startTime, into
+// the vesting schedule of the account. The periods of the existing schedule
+// and of the grant are interleaved, so that at any time the amount vested is
+// the sum of the amounts vested under each of them, and the grant is recorded
+// with its own periods in Grants. The delegated vesting and delegated free
+// amounts are recomputed for the new vesting amount at blockTime, as the
+// delegated coins are counted as vesting first.
+//
+// CONTRACT: It is the callers responsibility to transfer the grant coins to
+// the account.
+func (pva *PeriodicVestingAccount) AddGrant(blockTime time.Time, startTime int64, periods Periods) {
+	grantCoins := periods.TotalAmount()
+	delegated := pva.DelegatedFree.Add(pva.DelegatedVesting...)
+
+	pva.Grants = append(pva.GetGrants(), VestingGrant{StartTime: startTime, VestingPeriods: periods})
+
+	newStart, newEnd, newPeriods := DisjunctPeriods(pva.StartTime, pva.VestingPeriods, startTime, periods)
+	pva.StartTime = newStart
+	pva.EndTime = newEnd
+	pva.VestingPeriods = newPeriods
+	pva.OriginalVesting = pva.OriginalVesting.Add(grantCoins...)
+
+	delegatedVesting := delegated.Min(pva.GetVestingCoins(blockTime))
+	pva.DelegatedVesting = delegatedVesting
+	pva.DelegatedFree = delegated.Sub(delegatedVesting...)
+}
+
 // Validate checks for errors on the account fields
 func (pva PeriodicVestingAccount) Validate() error {
 	if pva.GetStartTime() >= pva.GetEndTime() {
@@ -422,6 +463,23 @@ func (pva PeriodicVestingAccount) Validate() error {
 	if !originalVesting.IsEqual(pva.OriginalVesting) {
 		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
 	}
+	if pva.FunderAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(pva.FunderAddress); err != nil {
+			return fmt.Errorf("invalid funder address: %w", err)
+		}
+	}
+	if len(pva.Grants) > 0 {
+		grantsVesting := sdk.NewCoins()
+		for _, g := range pva.Grants {
+			if g.StartTime < pva.StartTime {
+				return errors.New("vesting grant cannot start before the vesting start-time")
+			}
+			grantsVesting = grantsVesting.Add(Periods(g.VestingPeriods).TotalAmount()...)
+		}
+		if !grantsVesting.IsEqual(pva.OriginalVesting) {
+			return errors.New("original vesting coins does not match the sum of all coins in vesting grants")
+		}
+	}
 
 	return pva.BaseVestingAccount.Validate()
 }
@@ -449,6 +507,8 @@ func (pva PeriodicVestingAccount) MarshalYAML() (interface{}, error) {
 		EndTime:          pva.EndTime,
 		StartTime:        pva.StartTime,
 		VestingPeriods:   pva.VestingPeriods,
+		FunderAddress:    pva.FunderAddress,
+		Grants:           pva.Grants,
 	}
 	return marshalYaml(out)
 }
@@ -591,6 +651,203 @@ func (plva PermanentLockedAccount) String() string {
 	return out.(string)
 }
 
+// Clawback Vesting Account
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount. An empty
+// lockup schedule means the coins are unlocked at the start time, and an empty
+// vesting schedule means they are vested at the start time.
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins, startTime int64, lockupPeriods, vestingPeriods Periods) *ClawbackVestingAccount {
+	if len(lockupPeriods) == 0 {
+		lockupPeriods = Periods{{Length: 0, Amount: originalVesting}}
+	}
+	if len(vestingPeriods) == 0 {
+		vestingPeriods = Periods{{Length: 0, Amount: originalVesting}}
+	}
+
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         clawbackEndTime(startTime, lockupPeriods, vestingPeriods),
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+	}
+}
+
+// clawbackEndTime returns the time at which both the lockup and the vesting
+// schedules have completed.
+func clawbackEndTime(startTime int64, lockupPeriods, vestingPeriods Periods) int64 {
+	endTime := startTime + lockupPeriods.TotalLength()
+	if vestingEnd := startTime + vestingPeriods.TotalLength(); vestingEnd > endTime {
+		endTime = vestingEnd
+	}
+	return endTime
+}
+
+// GetVestedCoins returns the total number of vested coins, defined as the
+// coins which are both vested and unlocked. If no coins are vested, nil is
+// returned.
+func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	vested := va.GetVestedOnly(blockTime).Min(va.GetUnlockedOnly(blockTime))
+	if vested.IsZero() {
+		return nil
+	}
+	return vested
+}
+
+// GetVestingCoins returns the total number of vesting coins, defined as the
+// coins which are either still vesting or still locked. If no coins are
+// vesting, nil is returned.
+func (va ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return va.BaseVestingAccount.LockedCoinsFromVesting(va.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (va *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	va.BaseVestingAccount.TrackDelegation(balance, va.GetVestingCoins(blockTime), amount)
+}
+
+// GetVestedOnly returns the coins which have vested according to the vesting
+// schedule alone, regardless of the lockup schedule.
+func (va ClawbackVestingAccount) GetVestedOnly(blockTime time.Time) sdk.Coins {
+	return ReadSchedule(va.StartTime, va.VestingPeriods, blockTime.Unix())
+}
+
+// GetUnlockedOnly returns the coins which have been unlocked according to the
+// lockup schedule alone, regardless of the vesting schedule.
+func (va ClawbackVestingAccount) GetUnlockedOnly(blockTime time.Time) sdk.Coins {
+	return ReadSchedule(va.StartTime, va.LockupPeriods, blockTime.Unix())
+}
+
+// GetStartTime returns the time when vesting and lockup start for a clawback
+// vesting account.
+func (va ClawbackVestingAccount) GetStartTime() int64 {
+	return va.StartTime
+}
+
+// GetVestingPeriods returns the vesting periods of a clawback vesting account.
+func (va ClawbackVestingAccount) GetVestingPeriods() Periods {
+	return va.VestingPeriods
+}
+
+// GetLockupPeriods returns the lockup periods of a clawback vesting account.
+func (va ClawbackVestingAccount) GetLockupPeriods() Periods {
+	return va.LockupPeriods
+}
+
+// ComputeClawback removes all future vesting events from the account, caps
+// the lockup schedule to the remaining vested amount and returns the coins
+// which are to be clawed back. Delegation tracking is not adjusted; callers
+// must call ResetDelegationTracking once the clawed back coins are moved.
+func (va *ClawbackVestingAccount) ComputeClawback(clawbackTime int64) sdk.Coins {
+	vestedPeriods := Periods{}
+	vested := sdk.NewCoins()
+
+	time := va.StartTime
+	for _, period := range va.VestingPeriods {
+		time += period.Length
+		if clawbackTime < time {
+			break
+		}
+		vestedPeriods = append(vestedPeriods, period)
+		vested = vested.Add(period.Amount...)
+	}
+
+	// the lockup schedule can never release more than what has vested
+	_, _, lockupPeriods := ConjunctPeriods(va.StartTime, va.LockupPeriods, va.StartTime, Periods{{Length: 0, Amount: vested}})
+
+	toClawBack := va.OriginalVesting.Sub(vested...)
+
+	va.VestingPeriods = vestedPeriods
+	va.LockupPeriods = lockupPeriods
+	va.OriginalVesting = vested
+	va.EndTime = clawbackEndTime(va.StartTime, lockupPeriods, vestedPeriods)
+
+	return toClawBack
+}
+
+// ResetDelegationTracking recomputes the delegated vesting and delegated free
+// amounts from the total amount of coins the account has delegated, including
+// coins which are unbonding.
+func (va *ClawbackVestingAccount) ResetDelegationTracking(blockTime time.Time, delegated sdk.Coins) {
+	delegatedVesting := delegated.Min(va.GetVestingCoins(blockTime))
+	va.DelegatedVesting = delegatedVesting
+	va.DelegatedFree = delegated.Sub(delegatedVesting...)
+}
+
+// Validate checks for errors on the account fields
+func (va ClawbackVestingAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(va.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+	if va.GetStartTime() > va.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+	for _, p := range append(append(Periods{}, va.LockupPeriods...), va.VestingPeriods...) {
+		if p.Length < 0 {
+			return errors.New("period length cannot be negative")
+		}
+	}
+	if clawbackEndTime(va.StartTime, va.LockupPeriods, va.VestingPeriods) != va.EndTime {
+		return errors.New("vesting end time does not match length of all lockup and vesting periods")
+	}
+	if !Periods(va.LockupPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in lockup periods")
+	}
+	if !Periods(va.VestingPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return va.BaseVestingAccount.Validate()
+}
+
+func (va ClawbackVestingAccount) String() string {
+	out, _ := va.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (va ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(va.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	out := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    va.AccountNumber,
+		PubKey:           getPKString(va),
+		Sequence:         va.Sequence,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+		StartTime:        va.StartTime,
+		VestingPeriods:   va.VestingPeriods,
+		FunderAddress:    va.FunderAddress,
+		LockupPeriods:    va.LockupPeriods,
+	}
+	return marshalYaml(out)
+}
+
 type getPK interface {
 	GetPubKey() cryptotypes.PubKey
 }
@@ -548,6 +548,62 @@ func TestTrackUndelegationPeriodicVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedVesting)
 }
 
+func TestAddGrantPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	stake := func(amt int64) sdk.Coins { return sdk.Coins{sdk.NewInt64Coin(stakeDenom, amt)} }
+
+	bacc, _ := initBaseAccount()
+	pva := types.NewPeriodicVestingAccount(bacc, stake(100), now.Unix(), types.Periods{
+		{Length: 100, Amount: stake(50)},
+		{Length: 100, Amount: stake(50)},
+	})
+
+	// delegate 80 coins, of which all are vesting
+	pva.TrackDelegation(now, stake(100), stake(80))
+	require.Equal(t, stake(80), pva.DelegatedVesting)
+
+	// after half of the first grant has vested, the delegation is partially free
+	pva.TrackUndelegation(stake(80))
+	pva.TrackDelegation(now.Add(100*time.Second), stake(100), stake(80))
+	require.Equal(t, stake(50), pva.DelegatedVesting)
+	require.Equal(t, stake(30), pva.DelegatedFree)
+
+	// add a grant starting later and ending after the first one
+	pva.AddGrant(now.Add(100*time.Second), now.Unix()+50, types.Periods{
+		{Length: 100, Amount: stake(30)},
+		{Length: 200, Amount: stake(30)},
+	})
+	require.NoError(t, pva.Validate())
+	require.Equal(t, now.Unix(), pva.StartTime)
+	require.Equal(t, now.Unix()+350, pva.EndTime)
+	require.Equal(t, stake(160), pva.OriginalVesting)
+	require.Equal(t, []types.Period{
+		{Length: 100, Amount: stake(50)},
+		{Length: 50, Amount: stake(30)},
+		{Length: 50, Amount: stake(50)},
+		{Length: 150, Amount: stake(30)},
+	}, pva.VestingPeriods)
+
+	// the free delegation is now covering the additional vesting coins
+	require.Equal(t, stake(80), pva.DelegatedVesting)
+	require.Nil(t, pva.DelegatedFree)
+
+	require.Equal(t, stake(50), pva.GetVestedCoins(now.Add(100*time.Second)))
+	require.Equal(t, stake(80), pva.GetVestedCoins(now.Add(150*time.Second)))
+	require.Equal(t, stake(130), pva.GetVestedCoins(now.Add(200*time.Second)))
+	require.Equal(t, stake(160), pva.GetVestedCoins(now.Add(350*time.Second)))
+
+	// each grant keeps its own periods
+	require.Equal(t, []types.VestingGrant{
+		{StartTime: now.Unix(), VestingPeriods: []types.Period{{Length: 100, Amount: stake(50)}, {Length: 100, Amount: stake(50)}}},
+		{StartTime: now.Unix() + 50, VestingPeriods: []types.Period{{Length: 100, Amount: stake(30)}, {Length: 200, Amount: stake(30)}}},
+	}, pva.GetGrants())
+
+	// and the grants must add up to the original vesting
+	pva.Grants = pva.Grants[:1]
+	require.Error(t, pva.Validate())
+}
+
 func TestGetVestedCoinsPermLockedVestingAcc(t *testing.T) {
 	now := tmtime.Now()
 	endTime := now.Add(1000 * 24 * time.Hour)
@@ -677,6 +733,111 @@ func TestTrackUndelegationPermLockedVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, plva.DelegatedVesting)
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	half := sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: half},
+		types.Period{Length: int64(12 * 60 * 60), Amount: half},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: half},
+		types.Period{Length: int64(24 * 60 * 60), Amount: half},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.NoError(t, va.Validate())
+	require.Equal(t, now.Add(30*time.Hour).Unix(), va.GetEndTime())
+
+	// require no coins vested at the beginning of the schedules
+	require.Nil(t, va.GetVestedCoins(now))
+	require.Equal(t, origCoins, va.GetVestingCoins(now))
+
+	// vested but still locked coins are not vested
+	require.Equal(t, half, va.GetVestedOnly(now.Add(6*time.Hour)))
+	require.Nil(t, va.GetVestedCoins(now.Add(6*time.Hour)))
+
+	// require 50% of coins vested once they are both vested and unlocked
+	require.Equal(t, half, va.GetVestedCoins(now.Add(12*time.Hour)))
+
+	// unlocked but still vesting coins are not vested
+	require.Equal(t, origCoins, va.GetUnlockedOnly(now.Add(24*time.Hour)))
+	require.Equal(t, half, va.GetVestedCoins(now.Add(24*time.Hour)))
+
+	// require 100% of coins vested at the end of both schedules
+	require.Equal(t, origCoins, va.GetVestedCoins(now.Add(30*time.Hour)))
+	require.Equal(t, origCoins, va.GetVestedCoins(now.Add(48*time.Hour)))
+
+	// an omitted lockup schedule unlocks everything at the start
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), nil, vestingPeriods)
+	require.NoError(t, va.Validate())
+	require.Equal(t, half, va.GetVestedCoins(now.Add(6*time.Hour)))
+}
+
+func TestComputeClawback(t *testing.T) {
+	now := tmtime.Now()
+	quarter := sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}
+	half := quarter.Add(quarter...)
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: half},
+		types.Period{Length: int64(12 * 60 * 60), Amount: half},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: quarter},
+		types.Period{Length: int64(6 * 60 * 60), Amount: quarter},
+		types.Period{Length: int64(6 * 60 * 60), Amount: half},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+
+	// nothing vested before the start
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.Equal(t, origCoins, va.ComputeClawback(now.Add(-time.Hour).Unix()))
+	require.True(t, va.OriginalVesting.IsZero())
+	require.Empty(t, va.VestingPeriods)
+	require.Empty(t, va.LockupPeriods)
+	require.Equal(t, now.Unix(), va.EndTime)
+	require.NoError(t, va.Validate())
+
+	// clawback after the first vesting event
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.Equal(t, origCoins.Sub(quarter...), va.ComputeClawback(now.Add(7*time.Hour).Unix()))
+	require.Equal(t, quarter, va.OriginalVesting)
+	require.Equal(t, []types.Period{vestingPeriods[0]}, va.VestingPeriods)
+	require.Equal(t, []types.Period{{Length: int64(12 * 60 * 60), Amount: quarter}}, va.LockupPeriods)
+	require.Equal(t, now.Add(12*time.Hour).Unix(), va.EndTime)
+	require.NoError(t, va.Validate())
+
+	// the remaining coins stay locked until the lockup schedule releases them
+	require.Nil(t, va.GetVestedCoins(now.Add(8*time.Hour)))
+	require.Equal(t, quarter, va.GetVestedCoins(now.Add(12*time.Hour)))
+
+	// nothing to claw back once everything vested
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.True(t, va.ComputeClawback(now.Add(18*time.Hour).Unix()).IsZero())
+	require.Equal(t, origCoins, va.OriginalVesting)
+	require.NoError(t, va.Validate())
+}
+
+func TestResetDelegationTrackingClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	periods := types.Periods{types.Period{Length: int64(12 * 60 * 60), Amount: origCoins}}
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods, periods)
+
+	va.ResetDelegationTracking(now, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 150)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, va.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.DelegatedFree)
+
+	va.ResetDelegationTracking(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 150)})
+	require.Empty(t, va.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 150)}, va.DelegatedFree)
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -742,6 +903,30 @@ func TestGenesisAccountValidate(t *testing.T) {
 			&types.PermanentLockedAccount{BaseVestingAccount: baseVestingWithCoins},
 			true,
 		},
+		{
+			"valid clawback vesting account",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0, nil, types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			false,
+		},
+		{
+			"invalid clawback vesting account funder",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: types.NewBaseVestingAccount(baseAcc, initialVesting, 100),
+				LockupPeriods:      types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+				VestingPeriods:     types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+			},
+			true,
+		},
+		{
+			"invalid clawback vesting account lockup amount",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: types.NewBaseVestingAccount(baseAcc, initialVesting, 100),
+				FunderAddress:      addr.String(),
+				LockupPeriods:      types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}},
+				VestingPeriods:     types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+			},
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -830,6 +1015,26 @@ func (s *VestingAccountTestSuite) TestPermanentLockedAccountMarshal() {
 	require.NotNil(err)
 }
 
+func (s *VestingAccountTestSuite) TestClawbackVestingAccountMarshal() {
+	app := s.app
+	require := s.Require()
+	baseAcc, coins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(), types.Periods{types.Period{3600, coins}}, types.Periods{types.Period{7200, coins}})
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(err)
+	require.IsType(&types.ClawbackVestingAccount{}, acc2)
+	require.Equal(acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(err)
+}
+
 func initBaseAccount() (*authtypes.BaseAccount, sdk.Coins) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// DeferredSendCoinsFromAccountToModule transfers coins from an AccAddress to a
+// ModuleAccount like SendCoinsFromAccountToModule, except that the coins are only
+// added to the balance of the module account by WriteDeferredBalances at the end of
+// the block. Until then, they are kept apart for the current tx, so that the txs
+// of a block sending coins to the same module, e.g. the fees sent to the fee
+// collector, do not depend on each other. It will panic if the module account does
+// not exist.
+func (k BaseKeeper) DeferredSendCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) error {
+	recipientAcc := k.ak.GetModuleAccount(ctx, recipientModule)
+	if recipientAcc == nil {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
+	}
+	recipientAddr := recipientAcc.GetAddress()
+
+	if err := k.subUnlockedCoins(ctx, senderAddr, amt); err != nil {
+		return err
+	}
+
+	store := k.getDeferredBalancesStore(ctx, recipientAddr)
+	for _, coin := range amt {
+		setDeferredBalance(store, coin.Denom, getDeferredBalance(store, coin.Denom).Add(coin.Amount))
+	}
+
+	senderAddrString := senderAddr.String()
+	ctx.EventManager().EmitEvents(sdk.Events{
+		types.NewCoinReceivedEvent(recipientAddr, amt),
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipientAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySender, senderAddrString),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, senderAddrString),
+		),
+	})
+
+	return nil
+}
+
+// SendDeferredCoinsFromModuleToAccount transfers coins sent to a ModuleAccount by the
+// current tx with DeferredSendCoinsFromAccountToModule to an AccAddress, e.g. to
+// refund a part of the fee of the tx. It will panic if the module account does not
+// exist. An error is returned if the recipient address is black-listed or if the
+// coins deferred to the module account by the tx do not cover the amount.
+func (k BaseKeeper) SendDeferredCoinsFromModuleToAccount(
+	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+) error {
+	senderAddr := k.ak.GetModuleAddress(senderModule)
+	if senderAddr == nil {
+		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
+	}
+
+	if k.BlockedAddr(recipientAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipientAddr)
+	}
+
+	if !amt.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	store := k.getDeferredBalancesStore(ctx, senderAddr)
+	for _, coin := range amt {
+		deferred := getDeferredBalance(store, coin.Denom)
+		if deferred.LT(coin.Amount) {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", sdk.NewCoin(coin.Denom, deferred), coin,
+			)
+		}
+		setDeferredBalance(store, coin.Denom, deferred.Sub(coin.Amount))
+	}
+	ctx.EventManager().EmitEvent(types.NewCoinSpentEvent(senderAddr, amt))
+
+	if err := k.addCoins(ctx, recipientAddr, amt); err != nil {
+		return err
+	}
+
+	if !k.ak.HasAccount(ctx, recipientAddr) {
+		defer telemetry.IncrCounter(1, "new", "account")
+		k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, recipientAddr))
+	}
+
+	senderAddrString := senderAddr.String()
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipientAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySender, senderAddrString),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, senderAddrString),
+		),
+	})
+
+	return nil
+}
+
+// WriteDeferredBalances adds the coins deferred to the module accounts by the txs of
+// the block to their balances. It is called at the end of the block, before the
+// EndBlockers reading the balances of the module accounts.
+func (k BaseKeeper) WriteDeferredBalances(ctx sdk.Context) {
+	type deferredBalance struct {
+		key     []byte
+		addr    sdk.AccAddress
+		balance sdk.Coin
+	}
+
+	var deferred []deferredBalance
+	k.iterateDeferredBalances(ctx, func(key []byte, addr sdk.AccAddress, balance sdk.Coin) bool {
+		deferred = append(deferred, deferredBalance{key: key, addr: addr, balance: balance})
+		return false
+	})
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeferredBalancesPrefix)
+	for _, d := range deferred {
+		balance := k.GetBalance(ctx, d.addr, d.balance.Denom).Add(d.balance)
+		if err := k.setBalance(ctx, d.addr, balance); err != nil {
+			panic(err)
+		}
+		store.Delete(d.key)
+	}
+}
+
+// IterateDeferredBalances iterates over the coins deferred to the module accounts by
+// the txs of the block, per tx and denom, and performs a callback function.
+func (k BaseKeeper) IterateDeferredBalances(ctx sdk.Context, cb func(addr sdk.AccAddress, balance sdk.Coin) bool) {
+	k.iterateDeferredBalances(ctx, func(_ []byte, addr sdk.AccAddress, balance sdk.Coin) bool {
+		return cb(addr, balance)
+	})
+}
+
+func (k BaseKeeper) iterateDeferredBalances(ctx sdk.Context, cb func(key []byte, addr sdk.AccAddress, balance sdk.Coin) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeferredBalancesPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		addr, denom, err := types.AddressAndDenomFromDeferredBalancesStore(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		if cb(iterator.Key(), addr, sdk.NewCoin(denom, amount)) {
+			break
+		}
+	}
+}
+
+// getDeferredBalancesStore returns the store of the coins deferred to the module
+// account by the current tx, identified by the hash of its bytes.
+func (k BaseKeeper) getDeferredBalancesStore(ctx sdk.Context, addr sdk.AccAddress) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateDeferredBalancesPrefix(addr, tmhash.Sum(ctx.TxBytes())))
+}
+
+func getDeferredBalance(store prefix.Store, denom string) math.Int {
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+func setDeferredBalance(store prefix.Store, denom string, amount math.Int) {
+	if amount.IsZero() {
+		store.Delete([]byte(denom))
+		return
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(denom), bz)
+}
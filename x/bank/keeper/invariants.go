@@ -47,7 +47,8 @@ func NonnegativeBalanceInvariant(k ViewKeeper) sdk.Invariant {
 	}
 }
 
-// TotalSupply checks that the total supply reflects all the coins held in accounts
+// TotalSupply checks that the total supply reflects all the coins held in accounts,
+// including the coins deferred to module accounts during the block
 func TotalSupply(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		expectedTotal := sdk.Coins{}
@@ -61,6 +62,10 @@ func TotalSupply(k Keeper) sdk.Invariant {
 			expectedTotal = expectedTotal.Add(balance)
 			return false
 		})
+		k.IterateDeferredBalances(ctx, func(_ sdk.AccAddress, balance sdk.Coin) bool {
+			expectedTotal = expectedTotal.Add(balance)
+			return false
+		})
 
 		broken := !expectedTotal.IsEqual(supply)
 
@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -82,12 +83,12 @@ func (suite *IntegrationTestSuite) initKeepersWithmAccPerms(blockedAddrs map[str
 	maccPerms[multiPerm] = []string{authtypes.Burner, authtypes.Minter, authtypes.Staking}
 	maccPerms[randomPerm] = []string{"random"}
 	authKeeper := authkeeper.NewAccountKeeper(
-		appCodec, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		appCodec, app.GetKey(types.StoreKey),
+		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	keeper := keeper.NewBaseKeeper(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), blockedAddrs,
+		blockedAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	return authKeeper, keeper
@@ -211,6 +212,43 @@ func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
 	suite.Require().Equal(initCoins, getCoinsByName(ctx, keeper, authKeeper, authtypes.Burner))
 }
 
+func (suite *IntegrationTestSuite) TestDeferredSendCoins() {
+	app, ctx := suite.app, suite.ctx
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, sdk.NewCoins(newFooCoin(100))))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr2, sdk.NewCoins(newFooCoin(100))))
+
+	tx1, tx2 := ctx.WithTxBytes([]byte("tx1")), ctx.WithTxBytes([]byte("tx2"))
+	suite.Require().Panics(func() {
+		_ = app.BankKeeper.DeferredSendCoinsFromAccountToModule(tx1, addr1, "", sdk.NewCoins(newFooCoin(10))) // nolint:errcheck
+	})
+	suite.Require().Error(app.BankKeeper.DeferredSendCoinsFromAccountToModule(tx1, addr1, authtypes.FeeCollectorName, sdk.NewCoins(newFooCoin(101))))
+
+	// the coins leave the sender but only reach the module account at the end of the block
+	suite.Require().NoError(app.BankKeeper.DeferredSendCoinsFromAccountToModule(tx1, addr1, authtypes.FeeCollectorName, sdk.NewCoins(newFooCoin(40))))
+	suite.Require().NoError(app.BankKeeper.DeferredSendCoinsFromAccountToModule(tx2, addr2, authtypes.FeeCollectorName, sdk.NewCoins(newFooCoin(30))))
+	suite.Require().Equal(newFooCoin(60), app.BankKeeper.GetBalance(ctx, addr1, fooDenom))
+	suite.Require().True(app.BankKeeper.GetBalance(ctx, feeCollector, fooDenom).IsZero())
+	_, broken := keeper.TotalSupply(app.BankKeeper)(ctx)
+	suite.Require().False(broken)
+
+	// a tx only sends back the coins it deferred
+	suite.Require().Error(app.BankKeeper.SendDeferredCoinsFromModuleToAccount(tx2, authtypes.FeeCollectorName, addr2, sdk.NewCoins(newFooCoin(31))))
+	suite.Require().NoError(app.BankKeeper.SendDeferredCoinsFromModuleToAccount(tx2, authtypes.FeeCollectorName, addr2, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal(newFooCoin(80), app.BankKeeper.GetBalance(ctx, addr2, fooDenom))
+
+	app.BankKeeper.WriteDeferredBalances(ctx)
+	suite.Require().Equal(newFooCoin(60), app.BankKeeper.GetBalance(ctx, feeCollector, fooDenom))
+	app.BankKeeper.IterateDeferredBalances(ctx, func(sdk.AccAddress, sdk.Coin) bool {
+		suite.Fail("deferred balances left after the end of the block")
+		return true
+	})
+	_, broken = keeper.TotalSupply(app.BankKeeper)(ctx)
+	suite.Require().False(broken)
+}
+
 func (suite *IntegrationTestSuite) TestSupply_MintCoins() {
 	ctx := suite.ctx
 
@@ -1049,12 +1087,12 @@ func (suite *IntegrationTestSuite) TestBalanceTrackingEvents() {
 	maccPerms[multiPerm] = []string{authtypes.Burner, authtypes.Minter, authtypes.Staking}
 
 	suite.app.AccountKeeper = authkeeper.NewAccountKeeper(
-		suite.app.AppCodec(), suite.app.GetKey(authtypes.StoreKey), suite.app.GetSubspace(authtypes.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		suite.app.AppCodec(), suite.app.GetKey(authtypes.StoreKey),
+		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil)
+		suite.app.AccountKeeper, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	// set account with multiple permissions
 	suite.app.AccountKeeper.SetModuleAccount(suite.ctx, multiPermAcc)
@@ -1175,8 +1213,8 @@ func (suite *IntegrationTestSuite) TestMintCoinRestrictions() {
 	maccPerms[multiPerm] = []string{authtypes.Burner, authtypes.Minter, authtypes.Staking}
 
 	suite.app.AccountKeeper = authkeeper.NewAccountKeeper(
-		suite.app.AppCodec(), suite.app.GetKey(authtypes.StoreKey), suite.app.GetSubspace(authtypes.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		suite.app.AppCodec(), suite.app.GetKey(authtypes.StoreKey),
+		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	suite.app.AccountKeeper.SetModuleAccount(suite.ctx, multiPermAcc)
 
@@ -1215,7 +1253,7 @@ func (suite *IntegrationTestSuite) TestMintCoinRestrictions() {
 
 	for _, test := range tests {
 		suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-			suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil).WithMintCoinsRestriction(keeper.MintingRestrictionFn(test.restrictionFn))
+			suite.app.AccountKeeper, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String()).WithMintCoinsRestriction(keeper.MintingRestrictionFn(test.restrictionFn))
 		for _, testCase := range test.testCases {
 			if testCase.expectPass {
 				suite.Require().NoError(
@@ -2,18 +2,21 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/exported"
 	v043 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v043"
 	v046 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v046"
+	v047 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v047"
 )
 
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
-	keeper BaseKeeper
+	keeper         BaseKeeper
+	legacySubspace exported.Subspace
 }
 
 // NewMigrator returns a new Migrator.
-func NewMigrator(keeper BaseKeeper) Migrator {
-	return Migrator{keeper: keeper}
+func NewMigrator(keeper BaseKeeper, legacySubspace exported.Subspace) Migrator {
+	return Migrator{keeper: keeper, legacySubspace: legacySubspace}
 }
 
 // Migrate1to2 migrates from version 1 to 2.
@@ -26,6 +29,13 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v046.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
 
+// Migrate3to4 migrates x/bank storage from version 3 to 4. Specifically, it
+// moves the module parameters out of the x/params subspace and into the x/bank
+// store.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v047.Migrate(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc)
+}
+
 // Migrate3_V046_4_To_V046_5 fixes migrations from version 2 to for chains based on SDK 0.46.0 - v0.46.4 ONLY.
 // See v046.Migrate_V046_4_To_V046_5 for more details.
 func (m Migrator) Migrate3_V046_4_To_V046_5(ctx sdk.Context) error {
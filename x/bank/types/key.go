@@ -29,6 +29,14 @@ var (
 	// BalancesPrefix is the prefix for the account balances store. We use a byte
 	// (instead of `[]byte("balances")` to save some disk space).
 	BalancesPrefix = []byte{0x02}
+
+	// ParamsKey is the prefix for x/bank parameters
+	ParamsKey = []byte{0x05}
+
+	// DeferredBalancesPrefix is the prefix of the coins sent to module accounts by the
+	// txs of the current block, which are added to the module balances at the end of
+	// the block.
+	DeferredBalancesPrefix = []byte{0x06}
 )
 
 // AddressAndDenomFromBalancesStore returns an account address and denom from a balances prefix
@@ -63,6 +71,36 @@ func CreateAccountBalancesPrefix(addr []byte) []byte {
 	return append(BalancesPrefix, address.MustLengthPrefix(addr)...)
 }
 
+// CreateDeferredBalancesPrefix creates the prefix of the coins deferred to a module
+// account by a tx, given the hash of the tx.
+func CreateDeferredBalancesPrefix(addr []byte, txHash []byte) []byte {
+	key := append(DeferredBalancesPrefix, address.MustLengthPrefix(addr)...)
+	return append(key, address.MustLengthPrefix(txHash)...)
+}
+
+// AddressAndDenomFromDeferredBalancesStore returns the module account address and the
+// denom of a key of the deferred balances store. The key must not contain the prefix
+// DeferredBalancesPrefix.
+//
+// If invalid key is passed, AddressAndDenomFromDeferredBalancesStore returns ErrInvalidKey.
+func AddressAndDenomFromDeferredBalancesStore(key []byte) (sdk.AccAddress, string, error) {
+	if len(key) == 0 {
+		return nil, "", ErrInvalidKey
+	}
+
+	addrBound := int(key[0])
+	if len(key)-1 <= addrBound {
+		return nil, "", ErrInvalidKey
+	}
+
+	hashBound := addrBound + 1 + int(key[addrBound+1])
+	if len(key)-1 < hashBound {
+		return nil, "", ErrInvalidKey
+	}
+
+	return key[1 : addrBound+1], string(key[hashBound+1:]), nil
+}
+
 // CreateDenomAddressPrefix creates a prefix for a reverse index of denomination
 // to account balance for that denomination.
 func CreateDenomAddressPrefix(denom string) []byte {
@@ -68,3 +68,37 @@ func TestCreateDenomAddressPrefix(t *testing.T) {
 	require.Len(key, len(types.DenomAddressPrefix)+4)
 	require.Equal(append(types.DenomAddressPrefix, 'a', 'b', 'c', 0), key)
 }
+
+func TestAddressAndDenomFromDeferredBalancesStore(t *testing.T) {
+	addr := sdk.AccAddress("fee_collector_______")
+	txHash := []byte("tx_hash_________________________")
+	prefix := types.CreateDeferredBalancesPrefix(addr, txHash)
+	require.Equal(t, types.DeferredBalancesPrefix, prefix[:1])
+	key := cloneAppend(prefix[1:], []byte("stake"))
+
+	tests := []struct {
+		name    string
+		key     []byte
+		wantErr bool
+	}{
+		{"valid", key, false},
+		{"empty", []byte(""), true},
+		{"no tx hash", address.MustLengthPrefix(addr), true},
+		{"short tx hash", cloneAppend(address.MustLengthPrefix(addr), []byte{0x20, 't', 'x'}), true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			gotAddr, denom, err := types.AddressAndDenomFromDeferredBalancesStore(tc.key)
+			if tc.wantErr {
+				require.ErrorIs(t, err, types.ErrInvalidKey)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, addr, gotAddr)
+			require.Equal(t, "stake", denom)
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+// GetQueryCmd returns the cli query commands for the capability module.
+func GetQueryCmd() *cobra.Command {
+	capabilityQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the capability module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	capabilityQueryCmd.AddCommand(
+		GetCmdQueryCapabilities(),
+		GetCmdQueryCapability(),
+		GetCmdQueryCapabilityOwners(),
+		GetCmdQueryModuleCapabilities(),
+		GetCmdQueryInconsistencies(),
+	)
+
+	return capabilityQueryCmd
+}
+
+// GetCmdQueryCapabilities implements a command to return all capabilities
+// with their owners.
+func GetCmdQueryCapabilities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "capabilities",
+		Short: "Query all capabilities with their owners",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Capabilities(cmd.Context(), &types.QueryCapabilitiesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "capabilities")
+
+	return cmd
+}
+
+// GetCmdQueryCapability implements a command to return the owners of a
+// capability by index.
+func GetCmdQueryCapability() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "capability [index]",
+		Short:   "Query the owners of a capability by index",
+		Example: fmt.Sprintf("%s query %s capability 1", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			index, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid capability index %s: %w", args[0], err)
+			}
+
+			res, err := queryClient.Capability(cmd.Context(), &types.QueryCapabilityRequest{Index: index})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Owners)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCapabilityOwners implements a command to return all owners of the
+// capability a module owns under a name.
+func GetCmdQueryCapabilityOwners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "owners [module] [name]",
+		Short:   "Query all owners of the capability a module owns under the given name",
+		Example: fmt.Sprintf("%s query %s owners ibc ports/transfer", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CapabilityOwners(cmd.Context(), &types.QueryCapabilityOwnersRequest{
+				Module: args[0],
+				Name:   args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryModuleCapabilities implements a command to return the capabilities
+// claimed by a module.
+func GetCmdQueryModuleCapabilities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "module-capabilities [module]",
+		Short:   "Query the capabilities claimed by a module",
+		Example: fmt.Sprintf("%s query %s module-capabilities transfer", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ModuleCapabilities(cmd.Context(), &types.QueryModuleCapabilitiesRequest{
+				Module:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "module capabilities")
+
+	return cmd
+}
+
+// GetCmdQueryInconsistencies implements a command to find capabilities without
+// owners and owners whose in-memory mappings disagree with the persisted owners.
+func GetCmdQueryInconsistencies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inconsistencies",
+		Short: "Find capabilities without owners or with in-memory mappings that disagree with the persisted owners",
+		Long: strings.TrimSpace(`Find capabilities without owners, and owners whose in-memory forward or reverse
+mappings disagree with the persisted owners set. This runs the same checks as the
+capability owners invariant.`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Inconsistencies(cmd.Context(), &types.QueryInconsistenciesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
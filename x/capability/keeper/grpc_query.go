@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Capabilities implements the Query/Capabilities gRPC method
+func (k Keeper) Capabilities(c context.Context, req *types.QueryCapabilitiesRequest) (*types.QueryCapabilitiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
+
+	var capabilities []types.IndexedCapabilityOwners
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var owners types.CapabilityOwners
+		if err := k.cdc.Unmarshal(value, &owners); err != nil {
+			return err
+		}
+		capabilities = append(capabilities, types.IndexedCapabilityOwners{Index: types.IndexFromKey(key), Owners: owners})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryCapabilitiesResponse{Capabilities: capabilities, Pagination: pageRes}, nil
+}
+
+// Capability implements the Query/Capability gRPC method
+func (k Keeper) Capability(c context.Context, req *types.QueryCapabilityRequest) (*types.QueryCapabilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owners, found := k.GetOwners(sdk.UnwrapSDKContext(c), req.Index)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "capability %d not found", req.Index)
+	}
+
+	return &types.QueryCapabilityResponse{Owners: owners}, nil
+}
+
+// CapabilityOwners implements the Query/CapabilityOwners gRPC method
+func (k Keeper) CapabilityOwners(c context.Context, req *types.QueryCapabilityOwnersRequest) (*types.QueryCapabilityOwnersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if strings.TrimSpace(req.Module) == "" {
+		return nil, status.Error(codes.InvalidArgument, "module cannot be empty")
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Error(codes.InvalidArgument, "capability name cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	indexBz := ctx.KVStore(k.memKey).Get(types.RevCapabilityKey(req.Module, req.Name))
+	if indexBz == nil {
+		return nil, status.Errorf(codes.NotFound, "module %s does not own capability %s", req.Module, req.Name)
+	}
+
+	index := sdk.BigEndianToUint64(indexBz)
+	owners, found := k.GetOwners(ctx, index)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "owners of capability %d not found", index)
+	}
+
+	return &types.QueryCapabilityOwnersResponse{Index: index, Owners: owners}, nil
+}
+
+// ModuleCapabilities implements the Query/ModuleCapabilities gRPC method
+func (k Keeper) ModuleCapabilities(c context.Context, req *types.QueryModuleCapabilitiesRequest) (*types.QueryModuleCapabilitiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, ok := k.scopedModules[req.Module]; !ok {
+		return nil, status.Errorf(codes.NotFound, "module %s has no scoped keeper", req.Module)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.memKey), types.RevCapabilityKeyPrefix(req.Module))
+
+	var capabilities []types.ModuleCapability
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		capabilities = append(capabilities, types.ModuleCapability{Name: string(key), Index: sdk.BigEndianToUint64(value)})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryModuleCapabilitiesResponse{Capabilities: capabilities, Pagination: pageRes}, nil
+}
+
+// Inconsistencies implements the Query/Inconsistencies gRPC method
+func (k Keeper) Inconsistencies(c context.Context, req *types.QueryInconsistenciesRequest) (*types.QueryInconsistenciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryInconsistenciesResponse{Inconsistencies: k.CheckCapabilities(sdk.UnwrapSDKContext(c))}, nil
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestGRPCQueryCapabilities() {
+	sk1 := suite.keeper.ScopeToModule(banktypes.ModuleName)
+	sk2 := suite.keeper.ScopeToModule(stakingtypes.ModuleName)
+	suite.keeper.InitMemStore(suite.ctx)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, *suite.keeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	cap1, err := sk1.NewCapability(suite.ctx, "ports/transfer")
+	suite.Require().NoError(err)
+	suite.Require().NoError(sk2.ClaimCapability(suite.ctx, cap1, "bank/transfer"))
+	cap2, err := sk1.NewCapability(suite.ctx, "ports/other")
+	suite.Require().NoError(err)
+
+	// capabilities by index
+	res, err := queryClient.Capabilities(gocontext.Background(), &types.QueryCapabilitiesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Capabilities, 2)
+	suite.Require().Equal(cap1.GetIndex(), res.Capabilities[0].Index)
+	suite.Require().Len(res.Capabilities[0].Owners.Owners, 2)
+	suite.Require().Equal(cap2.GetIndex(), res.Capabilities[1].Index)
+
+	res, err = queryClient.Capabilities(gocontext.Background(), &types.QueryCapabilitiesRequest{Pagination: &query.PageRequest{Limit: 1}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Capabilities, 1)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	capRes, err := queryClient.Capability(gocontext.Background(), &types.QueryCapabilityRequest{Index: cap2.GetIndex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Owner{types.NewOwner(banktypes.ModuleName, "ports/other")}, capRes.Owners.Owners)
+
+	_, err = queryClient.Capability(gocontext.Background(), &types.QueryCapabilityRequest{Index: 1000})
+	suite.Require().Error(err)
+
+	// owners by module and name
+	ownersRes, err := queryClient.CapabilityOwners(gocontext.Background(), &types.QueryCapabilityOwnersRequest{
+		Module: stakingtypes.ModuleName,
+		Name:   "bank/transfer",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(cap1.GetIndex(), ownersRes.Index)
+	suite.Require().Len(ownersRes.Owners.Owners, 2)
+
+	_, err = queryClient.CapabilityOwners(gocontext.Background(), &types.QueryCapabilityOwnersRequest{
+		Module: stakingtypes.ModuleName,
+		Name:   "ports/transfer",
+	})
+	suite.Require().Error(err)
+
+	_, err = queryClient.CapabilityOwners(gocontext.Background(), &types.QueryCapabilityOwnersRequest{Module: stakingtypes.ModuleName})
+	suite.Require().Error(err)
+
+	// capabilities claimed by a module
+	modRes, err := queryClient.ModuleCapabilities(gocontext.Background(), &types.QueryModuleCapabilitiesRequest{Module: banktypes.ModuleName})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ModuleCapability{
+		{Name: "ports/other", Index: cap2.GetIndex()},
+		{Name: "ports/transfer", Index: cap1.GetIndex()},
+	}, modRes.Capabilities)
+	suite.Require().Equal(modRes.Capabilities, sk1.GetOwnedCapabilities(suite.ctx))
+
+	_, err = queryClient.ModuleCapabilities(gocontext.Background(), &types.QueryModuleCapabilitiesRequest{Module: "unknown"})
+	suite.Require().Error(err)
+
+	incRes, err := queryClient.Inconsistencies(gocontext.Background(), &types.QueryInconsistenciesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(incRes.Inconsistencies)
+}
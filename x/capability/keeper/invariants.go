@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+// RegisterInvariants registers the capability module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "owners", OwnersInvariant(k))
+}
+
+// AllInvariants runs all invariants of the capability module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return OwnersInvariant(k)(ctx)
+	}
+}
+
+// OwnersInvariant checks that every persisted capability has at least one owner
+// and that the in-memory store agrees with the persisted owners.
+func OwnersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		inconsistencies := k.CheckCapabilities(ctx)
+
+		var msg string
+		for _, inc := range inconsistencies {
+			msg += fmt.Sprintf("\tcapability %d (module: %q, name: %q): %s\n", inc.Index, inc.Module, inc.Name, inc.Reason)
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "owners",
+			fmt.Sprintf("amount of inconsistent capabilities found %d\n%s", len(inconsistencies), msg),
+		), len(inconsistencies) != 0
+	}
+}
+
+// CheckCapabilities returns the capabilities without owners, and, once the in-memory
+// store is initialized, the owners whose in-memory mappings disagree with the
+// persisted owners set.
+func (k Keeper) CheckCapabilities(ctx sdk.Context) []types.CapabilityInconsistency {
+	var res []types.CapabilityInconsistency
+	memInitialized := k.IsInitialized(ctx)
+	memStore := ctx.KVStore(k.memKey)
+
+	persisted := make(map[types.Owner]uint64)
+	k.IterateCapabilities(ctx, func(index uint64, owners types.CapabilityOwners) bool {
+		if len(owners.Owners) == 0 {
+			res = append(res, types.CapabilityInconsistency{Index: index, Reason: "capability has no owners"})
+			return false
+		}
+
+		for _, owner := range owners.Owners {
+			persisted[owner] = index
+		}
+		if !memInitialized {
+			return false
+		}
+
+		cap := k.capMap[index]
+		if cap == nil {
+			res = append(res, types.CapabilityInconsistency{Index: index, Reason: "capability is missing from the in-memory map"})
+			return false
+		}
+
+		for _, owner := range owners.Owners {
+			inc := types.CapabilityInconsistency{Index: index, Module: owner.Module, Name: owner.Name}
+
+			revIndex := memStore.Get(types.RevCapabilityKey(owner.Module, owner.Name))
+			switch {
+			case revIndex == nil:
+				inc.Reason = "reverse mapping is missing from the in-memory store"
+			case sdk.BigEndianToUint64(revIndex) != index:
+				inc.Reason = fmt.Sprintf("reverse mapping points to capability %d", sdk.BigEndianToUint64(revIndex))
+			case string(memStore.Get(types.FwdCapabilityKey(owner.Module, cap))) != owner.Name:
+				inc.Reason = "forward mapping does not match the owner name"
+			default:
+				continue
+			}
+			res = append(res, inc)
+		}
+		return false
+	})
+
+	if !memInitialized {
+		return res
+	}
+
+	// every in-memory mapping of a scoped module must be backed by a persisted owner
+	for _, module := range k.GetScopedModules() {
+		for _, mc := range k.GetModuleCapabilities(ctx, module) {
+			owner := types.NewOwner(module, mc.Name)
+			if index, ok := persisted[owner]; !ok || index != mc.Index {
+				res = append(res, types.CapabilityInconsistency{
+					Index:  mc.Index,
+					Module: module,
+					Name:   mc.Name,
+					Reason: "in-memory mapping is not backed by a persisted owner",
+				})
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestOwnersInvariant() {
+	sk1 := suite.keeper.ScopeToModule(banktypes.ModuleName)
+	sk2 := suite.keeper.ScopeToModule(stakingtypes.ModuleName)
+	suite.keeper.InitMemStore(suite.ctx)
+	invariant := keeper.OwnersInvariant(*suite.keeper)
+
+	cap1, err := sk1.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+	suite.Require().NoError(sk2.ClaimCapability(suite.ctx, cap1, "transfer"))
+	cap2, err := sk1.NewCapability(suite.ctx, "other")
+	suite.Require().NoError(err)
+
+	_, broken := invariant(suite.ctx)
+	suite.Require().False(broken)
+
+	// a capability without owners is orphaned
+	suite.keeper.SetOwners(suite.ctx, cap2.GetIndex(), types.CapabilityOwners{})
+	msg, broken := invariant(suite.ctx)
+	suite.Require().True(broken)
+	suite.Require().Contains(msg, "capability has no owners")
+
+	// the persisted owner of cap2 is gone, but its in-memory mapping is still there
+	inconsistencies := suite.keeper.CheckCapabilities(suite.ctx)
+	suite.Require().Equal([]types.CapabilityInconsistency{
+		{Index: cap2.GetIndex(), Reason: "capability has no owners"},
+		{Index: cap2.GetIndex(), Module: banktypes.ModuleName, Name: "other", Reason: "in-memory mapping is not backed by a persisted owner"},
+	}, inconsistencies)
+
+	// the in-memory store disagrees with the persisted owners
+	suite.ctx.KVStore(suite.app.GetMemKey(types.MemStoreKey)).Delete(types.RevCapabilityKey(stakingtypes.ModuleName, "transfer"))
+	inconsistencies = suite.keeper.CheckCapabilities(suite.ctx)
+	suite.Require().Len(inconsistencies, 3)
+	suite.Require().Equal(types.CapabilityInconsistency{
+		Index:  cap1.GetIndex(),
+		Module: stakingtypes.ModuleName,
+		Name:   "transfer",
+		Reason: "reverse mapping is missing from the in-memory store",
+	}, inconsistencies[0])
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -193,6 +194,54 @@ func (k Keeper) GetOwners(ctx sdk.Context, index uint64) (types.CapabilityOwners
 	return owners, true
 }
 
+// IterateCapabilities iterates over the persisted owners of all capabilities in
+// ascending order of their index. Iteration stops when the callback returns true.
+func (k Keeper) IterateCapabilities(ctx sdk.Context, cb func(index uint64, owners types.CapabilityOwners) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
+	iterator := sdk.KVStorePrefixIterator(prefixStore, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var owners types.CapabilityOwners
+		k.cdc.MustUnmarshal(iterator.Value(), &owners)
+
+		if cb(types.IndexFromKey(iterator.Key()), owners) {
+			break
+		}
+	}
+}
+
+// GetScopedModules returns the names of all modules with a ScopedKeeper in
+// ascending order.
+func (k Keeper) GetScopedModules() []string {
+	modules := make([]string, 0, len(k.scopedModules))
+	for module := range k.scopedModules {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+	return modules
+}
+
+// GetModuleCapabilities returns the capabilities the given module owns according
+// to the in-memory store, ordered by name.
+func (k Keeper) GetModuleCapabilities(ctx sdk.Context, module string) []types.ModuleCapability {
+	return getModuleCapabilities(ctx.KVStore(k.memKey), module)
+}
+
+func getModuleCapabilities(memStore storetypes.KVStore, module string) []types.ModuleCapability {
+	iterator := sdk.KVStorePrefixIterator(prefix.NewStore(memStore, types.RevCapabilityKeyPrefix(module)), nil)
+	defer iterator.Close()
+
+	var caps []types.ModuleCapability
+	for ; iterator.Valid(); iterator.Next() {
+		caps = append(caps, types.ModuleCapability{
+			Name:  string(iterator.Key()),
+			Index: sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+	return caps
+}
+
 // InitializeCapability takes in an index and an owners array. It creates the capability in memory
 // and sets the fwd and reverse keys for each owner in the memstore.
 // It is used during initialization from genesis.
@@ -427,6 +476,12 @@ func (sk ScopedKeeper) GetOwners(ctx sdk.Context, name string) (*types.Capabilit
 	return &capOwners, true
 }
 
+// GetOwnedCapabilities returns all capabilities owned by the scoped module,
+// ordered by name.
+func (sk ScopedKeeper) GetOwnedCapabilities(ctx sdk.Context) []types.ModuleCapability {
+	return getModuleCapabilities(ctx.KVStore(sk.memKey), sk.module)
+}
+
 // LookupModules returns all the module owners for a given capability
 // as a string array and the capability itself.
 // The method returns an error if either the capability or the owners cannot be
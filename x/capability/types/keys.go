@@ -36,6 +36,11 @@ func RevCapabilityKey(module, name string) []byte {
 	return []byte(fmt.Sprintf("%s/rev/%s", module, name))
 }
 
+// RevCapabilityKeyPrefix returns the prefix of all reverse lookup keys of a given module.
+func RevCapabilityKeyPrefix(module string) []byte {
+	return []byte(fmt.Sprintf("%s/rev/", module))
+}
+
 // FwdCapabilityKey returns a forward lookup key for a given module and capability
 // reference.
 func FwdCapabilityKey(module string, cap *Capability) []byte {
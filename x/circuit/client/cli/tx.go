@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+var permissionLevels = map[string]types.Permissions_Level{
+	"none":        types.Permissions_LEVEL_NONE_UNSPECIFIED,
+	"some-msgs":   types.Permissions_LEVEL_SOME_MSGS,
+	"all-msgs":    types.Permissions_LEVEL_ALL_MSGS,
+	"super-admin": types.Permissions_LEVEL_SUPER_ADMIN,
+}
+
+// NewTxCmd returns a root CLI command handler for all x/circuit transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Circuit breaker transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewAuthorizeCmd(),
+		NewTripCmd(),
+		NewResetCmd(),
+	)
+
+	return txCmd
+}
+
+// NewAuthorizeCmd returns a CLI command handler for creating a
+// MsgAuthorizeCircuitBreaker transaction.
+func NewAuthorizeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorize [grantee] [level] [msg-type-urls]",
+		Short: "Grant circuit breaker permissions to an account",
+		Long: strings.TrimSpace(`Grant circuit breaker permissions to an account. The level is one of none,
+some-msgs, all-msgs or super-admin, and the comma separated Msg type URLs are only
+allowed with some-msgs. The level none revokes all permissions of the account.
+The sender must be a super-admin or the module authority.`),
+		Example: fmt.Sprintf("%s tx %s authorize cosmos1... some-msgs /cosmos.bank.v1beta1.MsgSend,/cosmos.bank.v1beta1.MsgMultiSend --from admin",
+			version.AppName, types.ModuleName),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			level, ok := permissionLevels[strings.ToLower(args[1])]
+			if !ok {
+				return fmt.Errorf("invalid permission level %q, expected one of none, some-msgs, all-msgs or super-admin", args[1])
+			}
+
+			var typeURLs []string
+			if len(args) > 2 {
+				typeURLs = parseTypeURLs(args[2])
+			}
+
+			perms := types.NewPermissions(level, typeURLs...)
+			msg := types.NewMsgAuthorizeCircuitBreaker(clientCtx.GetFromAddress(), grantee, &perms)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewTripCmd returns a CLI command handler for creating a MsgTripCircuitBreaker
+// transaction.
+func NewTripCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "disable [msg-type-urls]",
+		Short:   "Disable the execution of the given comma separated Msg type URLs",
+		Example: fmt.Sprintf("%s tx %s disable /cosmos.bank.v1beta1.MsgSend --from admin", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTripCircuitBreaker(clientCtx.GetFromAddress(), parseTypeURLs(args[0]))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewResetCmd returns a CLI command handler for creating a MsgResetCircuitBreaker
+// transaction.
+func NewResetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reset [msg-type-urls]",
+		Short:   "Resume the execution of the given comma separated Msg type URLs",
+		Example: fmt.Sprintf("%s tx %s reset /cosmos.bank.v1beta1.MsgSend --from admin", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResetCircuitBreaker(clientCtx.GetFromAddress(), parseTypeURLs(args[0]))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseTypeURLs(s string) []string {
+	var typeURLs []string
+	for _, url := range strings.Split(s, ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			typeURLs = append(typeURLs, url)
+		}
+	}
+	return typeURLs
+}
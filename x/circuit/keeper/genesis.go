@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// InitGenesis sets the circuit breaker permissions and the tripped circuit breakers
+// from the genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	for _, acc := range genState.AccountPermissions {
+		addr, err := sdk.AccAddressFromBech32(acc.Address)
+		if err != nil {
+			panic(err)
+		}
+		k.SetPermissions(ctx, addr, *acc.Permissions)
+	}
+
+	for _, typeURL := range genState.DisabledTypeUrls {
+		k.DisableMsg(ctx, typeURL)
+	}
+}
+
+// ExportGenesis returns the circuit module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	var accounts []types.GenesisAccountPermissions
+	k.IteratePermissions(ctx, func(addr sdk.AccAddress, perms types.Permissions) bool {
+		accounts = append(accounts, types.GenesisAccountPermissions{Address: addr.String(), Permissions: &perms})
+		return false
+	})
+
+	return types.NewGenesisState(accounts, k.GetDisableList(ctx))
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Account implements the Query/Account gRPC method
+func (k Keeper) Account(c context.Context, req *types.QueryAccountRequest) (*types.AccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+
+	perms := k.GetPermissions(sdk.UnwrapSDKContext(c), addr)
+	if perms == nil {
+		return nil, status.Errorf(codes.NotFound, "account %s has no circuit breaker permissions", req.Address)
+	}
+
+	return &types.AccountResponse{Permission: perms}, nil
+}
+
+// Accounts implements the Query/Accounts gRPC method
+func (k Keeper) Accounts(c context.Context, req *types.QueryAccountsRequest) (*types.AccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountPermissionPrefix)
+
+	var accounts []types.GenesisAccountPermissions
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var perms types.Permissions
+		if err := k.cdc.Unmarshal(value, &perms); err != nil {
+			return err
+		}
+		// the key is the length prefixed address
+		addr := sdk.AccAddress(key[1:])
+		accounts = append(accounts, types.GenesisAccountPermissions{Address: addr.String(), Permissions: &perms})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.AccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+// DisabledList implements the Query/DisabledList gRPC method
+func (k Keeper) DisabledList(c context.Context, req *types.QueryDisabledListRequest) (*types.DisabledListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.DisabledListResponse{DisabledList: k.GetDisableList(sdk.UnwrapSDKContext(c))}, nil
+}
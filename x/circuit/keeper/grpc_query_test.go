@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	require := s.Require()
+	ctx := sdk.WrapSDKContext(s.ctx)
+	k := s.app.CircuitKeeper
+
+	perms := types.NewPermissions(types.Permissions_LEVEL_ALL_MSGS)
+	k.SetPermissions(s.ctx, s.addrs[0], perms)
+	k.SetPermissions(s.ctx, s.addrs[1], types.NewPermissions(types.Permissions_LEVEL_SUPER_ADMIN))
+	k.DisableMsg(s.ctx, msgSend)
+
+	_, err := k.Account(ctx, &types.QueryAccountRequest{Address: "invalid"})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = k.Account(ctx, &types.QueryAccountRequest{Address: s.addrs[2].String()})
+	require.Equal(codes.NotFound, status.Code(err))
+
+	accRes, err := k.Account(ctx, &types.QueryAccountRequest{Address: s.addrs[0].String()})
+	require.NoError(err)
+	require.Equal(&perms, accRes.Permission)
+
+	accsRes, err := k.Accounts(ctx, &types.QueryAccountsRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(err)
+	require.Len(accsRes.Accounts, 1)
+	require.Equal(uint64(2), accsRes.Pagination.Total)
+
+	listRes, err := k.DisabledList(ctx, &types.QueryDisabledListRequest{})
+	require.NoError(err)
+	require.Equal([]string{msgSend}, listRes.DisabledList)
+}
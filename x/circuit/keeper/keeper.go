@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+var _ baseapp.CircuitBreaker = Keeper{}
+
+// Keeper - circuit keeper
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	// the address capable of executing all circuit breaker messages without
+	// being granted permissions. Typically, this should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new Keeper object
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, authority string) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		storeKey:  storeKey,
+		cdc:       cdc,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the x/circuit module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// IsAllowed implements the baseapp.CircuitBreaker interface. It returns false if the
+// circuit breaker of the given Msg type URL is tripped.
+func (k Keeper) IsAllowed(goCtx context.Context, typeURL string) (bool, error) {
+	return !k.IsMsgDisabled(sdk.UnwrapSDKContext(goCtx), typeURL), nil
+}
+
+// GetPermissions returns the circuit breaker permissions of an account, or nil if the
+// account has no permissions.
+func (k Keeper) GetPermissions(ctx sdk.Context, addr sdk.AccAddress) *types.Permissions {
+	bz := ctx.KVStore(k.storeKey).Get(types.AccountPermissionKey(addr))
+	if bz == nil {
+		return nil
+	}
+
+	var perms types.Permissions
+	k.cdc.MustUnmarshal(bz, &perms)
+	return &perms
+}
+
+// SetPermissions sets the circuit breaker permissions of an account. Permissions with
+// LEVEL_NONE_UNSPECIFIED revoke all the permissions of the account.
+func (k Keeper) SetPermissions(ctx sdk.Context, addr sdk.AccAddress, perms types.Permissions) {
+	store := ctx.KVStore(k.storeKey)
+	if perms.Level == types.Permissions_LEVEL_NONE_UNSPECIFIED {
+		store.Delete(types.AccountPermissionKey(addr))
+		return
+	}
+
+	store.Set(types.AccountPermissionKey(addr), k.cdc.MustMarshal(&perms))
+}
+
+// IteratePermissions iterates over all the accounts with circuit breaker permissions.
+func (k Keeper) IteratePermissions(ctx sdk.Context, cb func(addr sdk.AccAddress, perms types.Permissions) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountPermissionPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var perms types.Permissions
+		k.cdc.MustUnmarshal(iterator.Value(), &perms)
+
+		// the key is the length prefixed address
+		if cb(sdk.AccAddress(iterator.Key()[1:]), perms) {
+			break
+		}
+	}
+}
+
+// IsMsgDisabled returns whether the circuit breaker of the given Msg type URL is tripped.
+func (k Keeper) IsMsgDisabled(ctx sdk.Context, typeURL string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.DisableListKey(typeURL))
+}
+
+// DisableMsg trips the circuit breaker of the given Msg type URL.
+func (k Keeper) DisableMsg(ctx sdk.Context, typeURL string) {
+	ctx.KVStore(k.storeKey).Set(types.DisableListKey(typeURL), []byte{})
+}
+
+// EnableMsg resets the circuit breaker of the given Msg type URL.
+func (k Keeper) EnableMsg(ctx sdk.Context, typeURL string) {
+	ctx.KVStore(k.storeKey).Delete(types.DisableListKey(typeURL))
+}
+
+// IterateDisableList iterates over all the Msg type URLs whose circuit breaker is tripped.
+func (k Keeper) IterateDisableList(ctx sdk.Context, cb func(typeURL string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DisableListPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// GetDisableList returns all the Msg type URLs whose circuit breaker is tripped.
+func (k Keeper) GetDisableList(ctx sdk.Context) []string {
+	var typeURLs []string
+	k.IterateDisableList(ctx, func(typeURL string) bool {
+		typeURLs = append(typeURLs, typeURL)
+		return false
+	})
+	return typeURLs
+}
+
+// canTrip returns whether the account may trip or reset the circuit breaker of the
+// given Msg type URL. The authority may trip all circuit breakers.
+func (k Keeper) canTrip(ctx sdk.Context, addr sdk.AccAddress, typeURL string) bool {
+	if addr.String() == k.authority {
+		return true
+	}
+
+	perms := k.GetPermissions(ctx, addr)
+	return perms != nil && perms.CanTrip(typeURL)
+}
+
+// isSuperAdmin returns whether the account may grant circuit breaker permissions.
+func (k Keeper) isSuperAdmin(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if addr.String() == k.authority {
+		return true
+	}
+
+	perms := k.GetPermissions(ctx, addr)
+	return perms != nil && perms.Level == types.Permissions_LEVEL_SUPER_ADMIN
+}
@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	msgSend      = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgMultiSend = sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app       *simapp.SimApp
+	ctx       sdk.Context
+	msgServer types.MsgServer
+	authority string
+	addrs     []sdk.AccAddress
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = simapp.Setup(s.T(), false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{})
+	s.msgServer = keeper.NewMsgServerImpl(s.app.CircuitKeeper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.addrs = simapp.AddTestAddrsIncremental(s.app, s.ctx, 4, sdk.NewInt(1000))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) authorize(granter, grantee sdk.AccAddress, perms types.Permissions) error {
+	_, err := s.msgServer.AuthorizeCircuitBreaker(sdk.WrapSDKContext(s.ctx), &types.MsgAuthorizeCircuitBreaker{
+		Granter:     granter.String(),
+		Grantee:     grantee.String(),
+		Permissions: &perms,
+	})
+	return err
+}
+
+func (s *KeeperTestSuite) trip(authority string, typeURLs ...string) error {
+	_, err := s.msgServer.TripCircuitBreaker(sdk.WrapSDKContext(s.ctx), &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: typeURLs})
+	return err
+}
+
+func (s *KeeperTestSuite) reset(authority string, typeURLs ...string) error {
+	_, err := s.msgServer.ResetCircuitBreaker(sdk.WrapSDKContext(s.ctx), &types.MsgResetCircuitBreaker{Authority: authority, MsgTypeUrls: typeURLs})
+	return err
+}
+
+func (s *KeeperTestSuite) TestAuthorizeCircuitBreaker() {
+	require := s.Require()
+	authority := sdk.MustAccAddressFromBech32(s.authority)
+	admin, allMsgs, someMsgs := s.addrs[0], s.addrs[1], s.addrs[2]
+
+	// accounts without permissions cannot grant permissions
+	err := s.authorize(admin, admin, types.NewPermissions(types.Permissions_LEVEL_SUPER_ADMIN))
+	require.ErrorIs(err, types.ErrUnauthorized)
+
+	// the authority can grant permissions
+	require.NoError(s.authorize(authority, admin, types.NewPermissions(types.Permissions_LEVEL_SUPER_ADMIN)))
+	require.Equal(types.Permissions_LEVEL_SUPER_ADMIN, s.app.CircuitKeeper.GetPermissions(s.ctx, admin).Level)
+
+	// a super-admin can grant permissions
+	require.NoError(s.authorize(admin, allMsgs, types.NewPermissions(types.Permissions_LEVEL_ALL_MSGS)))
+	require.NoError(s.authorize(admin, someMsgs, types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, msgSend)))
+
+	// other levels cannot grant permissions
+	err = s.authorize(allMsgs, s.addrs[3], types.NewPermissions(types.Permissions_LEVEL_ALL_MSGS))
+	require.ErrorIs(err, types.ErrUnauthorized)
+
+	// invalid permissions are rejected
+	err = s.authorize(admin, s.addrs[3], types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS))
+	require.ErrorIs(err, types.ErrInvalidPermissions)
+
+	// LEVEL_NONE_UNSPECIFIED revokes all permissions
+	require.NoError(s.authorize(admin, allMsgs, types.NewPermissions(types.Permissions_LEVEL_NONE_UNSPECIFIED)))
+	require.Nil(s.app.CircuitKeeper.GetPermissions(s.ctx, allMsgs))
+}
+
+func (s *KeeperTestSuite) TestTripAndResetCircuitBreaker() {
+	require := s.Require()
+	authority := sdk.MustAccAddressFromBech32(s.authority)
+	allMsgs, someMsgs, none := s.addrs[0], s.addrs[1], s.addrs[2]
+	require.NoError(s.authorize(authority, allMsgs, types.NewPermissions(types.Permissions_LEVEL_ALL_MSGS)))
+	require.NoError(s.authorize(authority, someMsgs, types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, msgSend)))
+
+	require.ErrorIs(s.trip(none.String(), msgSend), types.ErrUnauthorized)
+	require.ErrorIs(s.trip(someMsgs.String(), msgMultiSend), types.ErrUnauthorized)
+
+	require.NoError(s.trip(someMsgs.String(), msgSend))
+	require.True(s.app.CircuitKeeper.IsMsgDisabled(s.ctx, msgSend))
+	require.ErrorIs(s.trip(allMsgs.String(), msgSend), types.ErrCircuitTripped)
+
+	require.NoError(s.trip(s.authority, msgMultiSend))
+	require.Equal([]string{msgMultiSend, msgSend}, s.app.CircuitKeeper.GetDisableList(s.ctx))
+
+	// the circuit breaker messages cannot be disabled
+	require.Error(s.trip(s.authority, sdk.MsgTypeURL(&types.MsgResetCircuitBreaker{})))
+
+	require.ErrorIs(s.reset(someMsgs.String(), msgMultiSend), types.ErrUnauthorized)
+	require.NoError(s.reset(someMsgs.String(), msgSend))
+	require.NoError(s.reset(allMsgs.String(), msgMultiSend))
+	require.ErrorIs(s.reset(allMsgs.String(), msgMultiSend), types.ErrCircuitNotTripped)
+	require.Empty(s.app.CircuitKeeper.GetDisableList(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestCircuitBreaker() {
+	require := s.Require()
+	from, to := s.addrs[0], s.addrs[1]
+	msg := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	handler := s.app.MsgServiceRouter().Handler(msg)
+
+	require.NoError(s.trip(s.authority, msgSend))
+
+	allowed, err := s.app.CircuitKeeper.IsAllowed(sdk.WrapSDKContext(s.ctx), msgSend)
+	require.NoError(err)
+	require.False(allowed)
+
+	_, err = handler(s.ctx, msg)
+	require.ErrorContains(err, "circuit breaker disables execution of this message")
+
+	require.NoError(s.reset(s.authority, msgSend))
+
+	_, err = handler(s.ctx, msg)
+	require.NoError(err)
+	require.Equal(sdk.NewInt(1010), s.app.BankKeeper.GetBalance(s.ctx, to, sdk.DefaultBondDenom).Amount)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	require := s.Require()
+	perms := types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, msgSend)
+	genState := types.NewGenesisState(
+		[]types.GenesisAccountPermissions{{Address: s.addrs[0].String(), Permissions: &perms}},
+		[]string{msgSend},
+	)
+
+	s.app.CircuitKeeper.InitGenesis(s.ctx, genState)
+	require.Equal(&perms, s.app.CircuitKeeper.GetPermissions(s.ctx, s.addrs[0]))
+	require.True(s.app.CircuitKeeper.IsMsgDisabled(s.ctx, msgSend))
+	require.Equal(genState, s.app.CircuitKeeper.ExportGenesis(s.ctx))
+}
+
+func TestDefaultGenesis(t *testing.T) {
+	require.NoError(t, types.ValidateGenesis(types.DefaultGenesisState()))
+}
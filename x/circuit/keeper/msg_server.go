@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the circuit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{Keeper: k}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// AuthorizeCircuitBreaker grants (or revokes) the circuit breaker permissions of an account.
+func (k msgServer) AuthorizeCircuitBreaker(goCtx context.Context, msg *types.MsgAuthorizeCircuitBreaker) (*types.MsgAuthorizeCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.isSuperAdmin(ctx, granter) {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%s must be a super-admin to grant permissions", msg.Granter)
+	}
+	if msg.Permissions == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPermissions, "permissions cannot be empty")
+	}
+	if err := msg.Permissions.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.SetPermissions(ctx, grantee, *msg.Permissions)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuthorizeCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+			sdk.NewAttribute(types.AttributeKeyPermissions, msg.Permissions.String()),
+		),
+	)
+
+	return &types.MsgAuthorizeCircuitBreakerResponse{}, nil
+}
+
+// TripCircuitBreaker disables the execution of the given Msg type URLs.
+func (k msgServer) TripCircuitBreaker(goCtx context.Context, msg *types.MsgTripCircuitBreaker) (*types.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+	if err := types.ValidateMsgTypeURLs(msg.MsgTypeUrls); err != nil {
+		return nil, err
+	}
+
+	for _, typeURL := range msg.MsgTypeUrls {
+		// the circuit breaker messages can never be disabled, as that would make it
+		// impossible to reset the circuit breakers
+		if strings.HasPrefix(typeURL, "/"+types.ProtoPackage+".") {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot trip the circuit breaker of %s", typeURL)
+		}
+		if !k.canTrip(ctx, authority, typeURL) {
+			return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%s cannot trip the circuit breaker of %s", msg.Authority, typeURL)
+		}
+		if k.IsMsgDisabled(ctx, typeURL) {
+			return nil, sdkerrors.Wrap(types.ErrCircuitTripped, typeURL)
+		}
+
+		k.DisableMsg(ctx, typeURL)
+		k.Logger(ctx).Info("circuit breaker tripped", "msg_type_url", typeURL, "authority", msg.Authority)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTripCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, typeURL),
+			),
+		)
+	}
+
+	return &types.MsgTripCircuitBreakerResponse{}, nil
+}
+
+// ResetCircuitBreaker resumes the execution of the given Msg type URLs.
+func (k msgServer) ResetCircuitBreaker(goCtx context.Context, msg *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+	if err := types.ValidateMsgTypeURLs(msg.MsgTypeUrls); err != nil {
+		return nil, err
+	}
+
+	for _, typeURL := range msg.MsgTypeUrls {
+		if !k.canTrip(ctx, authority, typeURL) {
+			return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%s cannot reset the circuit breaker of %s", msg.Authority, typeURL)
+		}
+		if !k.IsMsgDisabled(ctx, typeURL) {
+			return nil, sdkerrors.Wrap(types.ErrCircuitNotTripped, typeURL)
+		}
+
+		k.EnableMsg(ctx, typeURL)
+		k.Logger(ctx).Info("circuit breaker reset", "msg_type_url", typeURL, "authority", msg.Authority)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeResetCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, typeURL),
+			),
+		)
+	}
+
+	return &types.MsgResetCircuitBreakerResponse{}, nil
+}
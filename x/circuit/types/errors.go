@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/circuit module sentinel errors
+var (
+	ErrUnauthorized       = sdkerrors.Register(ModuleName, 2, "account is not authorized")
+	ErrInvalidPermissions = sdkerrors.Register(ModuleName, 3, "invalid permissions")
+	ErrCircuitTripped     = sdkerrors.Register(ModuleName, 4, "circuit breaker is already tripped")
+	ErrCircuitNotTripped  = sdkerrors.Register(ModuleName, 5, "circuit breaker is not tripped")
+	ErrMsgDisabled        = sdkerrors.Register(ModuleName, 6, "message is disabled by the circuit breaker")
+)
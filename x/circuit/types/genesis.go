@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(accounts []GenesisAccountPermissions, disabledTypeURLs []string) *GenesisState {
+	return &GenesisState{
+		AccountPermissions: accounts,
+		DisabledTypeUrls:   disabledTypeURLs,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// ValidateGenesis validates the circuit genesis data
+func ValidateGenesis(data *GenesisState) error {
+	seen := make(map[string]bool, len(data.AccountPermissions))
+	for _, acc := range data.AccountPermissions {
+		if _, err := sdk.AccAddressFromBech32(acc.Address); err != nil {
+			return fmt.Errorf("invalid account address %s: %w", acc.Address, err)
+		}
+		if seen[acc.Address] {
+			return fmt.Errorf("duplicate permissions for account %s", acc.Address)
+		}
+		seen[acc.Address] = true
+
+		if acc.Permissions == nil {
+			return fmt.Errorf("permissions of account %s cannot be empty", acc.Address)
+		}
+		if err := acc.Permissions.Validate(); err != nil {
+			return fmt.Errorf("invalid permissions of account %s: %w", acc.Address, err)
+		}
+	}
+
+	if len(data.DisabledTypeUrls) != 0 {
+		if err := ValidateMsgTypeURLs(data.DisabledTypeUrls); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
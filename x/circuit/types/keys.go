@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "circuit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// ProtoPackage defines the protobuf package of the circuit module
+	ProtoPackage = "cosmos.circuit.v1beta1"
+)
+
+// KVStore keys
+var (
+	AccountPermissionPrefix = []byte{0x01}
+	DisableListPrefix       = []byte{0x02}
+)
+
+// AccountPermissionKey returns the store key of the permissions of an account.
+func AccountPermissionKey(addr sdk.AccAddress) []byte {
+	return append(AccountPermissionPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// DisableListKey returns the store key of a disabled Msg type URL.
+func DisableListKey(typeURL string) []byte {
+	return append(DisableListPrefix, []byte(typeURL)...)
+}
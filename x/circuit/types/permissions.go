@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewPermissions creates a new Permissions instance
+func NewPermissions(level Permissions_Level, limitTypeURLs ...string) Permissions {
+	return Permissions{Level: level, LimitTypeUrls: limitTypeURLs}
+}
+
+// Validate performs a basic validation of the permissions.
+func (p Permissions) Validate() error {
+	if _, ok := Permissions_Level_name[int32(p.Level)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidPermissions, "unknown level %d", p.Level)
+	}
+
+	if p.Level != Permissions_LEVEL_SOME_MSGS {
+		if len(p.LimitTypeUrls) != 0 {
+			return sdkerrors.Wrapf(ErrInvalidPermissions, "limit type urls can only be used with %s", Permissions_LEVEL_SOME_MSGS)
+		}
+		return nil
+	}
+
+	if len(p.LimitTypeUrls) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidPermissions, "%s requires at least one limit type url", Permissions_LEVEL_SOME_MSGS)
+	}
+	return ValidateMsgTypeURLs(p.LimitTypeUrls)
+}
+
+// CanTrip returns whether the permissions allow to trip or reset the circuit
+// breaker of the given Msg type URL.
+func (p Permissions) CanTrip(typeURL string) bool {
+	switch p.Level {
+	case Permissions_LEVEL_SUPER_ADMIN, Permissions_LEVEL_ALL_MSGS:
+		return true
+	case Permissions_LEVEL_SOME_MSGS:
+		for _, url := range p.LimitTypeUrls {
+			if url == typeURL {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ValidateMsgTypeURLs validates a non-empty list of unique Msg type URLs.
+func ValidateMsgTypeURLs(typeURLs []string) error {
+	if len(typeURLs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "msg type urls cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(typeURLs))
+	for _, url := range typeURLs {
+		if len(url) < 2 || url[0] != '/' {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid msg type url %q", url)
+		}
+		if seen[url] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate msg type url %s", url)
+		}
+		seen[url] = true
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+const msgSend = "/cosmos.bank.v1beta1.MsgSend"
+
+func TestPermissionsValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		perms  types.Permissions
+		expErr bool
+	}{
+		{"none", types.NewPermissions(types.Permissions_LEVEL_NONE_UNSPECIFIED), false},
+		{"some msgs", types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, msgSend), false},
+		{"all msgs", types.NewPermissions(types.Permissions_LEVEL_ALL_MSGS), false},
+		{"super admin", types.NewPermissions(types.Permissions_LEVEL_SUPER_ADMIN), false},
+		{"unknown level", types.NewPermissions(types.Permissions_Level(10)), true},
+		{"some msgs without type urls", types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS), true},
+		{"some msgs with invalid type url", types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, "cosmos.bank.v1beta1.MsgSend"), true},
+		{"some msgs with duplicate type urls", types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, msgSend, msgSend), true},
+		{"all msgs with type urls", types.NewPermissions(types.Permissions_LEVEL_ALL_MSGS, msgSend), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.perms.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPermissionsCanTrip(t *testing.T) {
+	require.False(t, types.NewPermissions(types.Permissions_LEVEL_NONE_UNSPECIFIED).CanTrip(msgSend))
+	require.True(t, types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, msgSend).CanTrip(msgSend))
+	require.False(t, types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, msgSend).CanTrip("/cosmos.bank.v1beta1.MsgMultiSend"))
+	require.True(t, types.NewPermissions(types.Permissions_LEVEL_ALL_MSGS).CanTrip(msgSend))
+	require.True(t, types.NewPermissions(types.Permissions_LEVEL_SUPER_ADMIN).CanTrip(msgSend))
+}
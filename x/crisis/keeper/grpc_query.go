@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+type queryServer struct {
+	k *Keeper
+}
+
+var _ types.QueryServer = queryServer{}
+
+// NewQueryServerImpl returns an implementation of the crisis QueryServer interface
+// for the provided Keeper. The keeper is referenced so that schedules and settings
+// applied after the services are registered are reflected by the queries.
+func NewQueryServerImpl(k *Keeper) types.QueryServer {
+	return queryServer{k: k}
+}
+
+// Invariants implements the Query/Invariants gRPC method
+func (q queryServer) Invariants(_ context.Context, req *types.QueryInvariantsRequest) (*types.QueryInvariantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	routes := q.k.Routes()
+	invariants := make([]types.InvariantSchedule, len(routes))
+	for i, ir := range routes {
+		invariants[i] = q.k.InvariantSchedule(ir)
+	}
+
+	return &types.QueryInvariantsResponse{Invariants: invariants}, nil
+}
+
+// CheckInvariant implements the Query/CheckInvariant gRPC method
+func (q queryServer) CheckInvariant(c context.Context, req *types.QueryCheckInvariantRequest) (*types.QueryCheckInvariantResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if !q.k.invariantRPCEnabled {
+		return nil, status.Error(codes.Unimplemented, "invariant checks are disabled on this node")
+	}
+
+	fullRoute := req.InvariantModuleName + "/" + req.InvariantRoute
+	for _, ir := range q.k.Routes() {
+		if ir.FullRoute() != fullRoute {
+			continue
+		}
+
+		// use a cached context so that the invariant cannot write to the state
+		ctx, _ := sdk.UnwrapSDKContext(c).CacheContext()
+		res, broken := ir.Invar(ctx)
+		return &types.QueryCheckInvariantResponse{Broken: broken, Message: res, Height: ctx.BlockHeight()}, nil
+	}
+
+	return nil, status.Errorf(codes.NotFound, "invariant %s is not registered", fullRoute)
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+func TestGRPCQueryInvariants(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := sdk.WrapSDKContext(app.NewContext(true, tmproto.Header{}))
+	queryServer := keeper.NewQueryServerImpl(&app.CrisisKeeper)
+
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute", func(sdk.Context) (string, bool) { return "", false })
+	schedule := types.NewInvariantSchedule("testModule", "testRoute", 10, types.InvariantMode_INVARIANT_MODE_OFF_CHAIN)
+	require.NoError(t, app.CrisisKeeper.SetInvariantSchedules([]types.InvariantSchedule{schedule}))
+
+	_, err := queryServer.Invariants(ctx, nil)
+	require.Error(t, err)
+
+	res, err := queryServer.Invariants(ctx, &types.QueryInvariantsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Invariants, len(app.CrisisKeeper.Routes()))
+	require.Equal(t, schedule, res.Invariants[len(res.Invariants)-1])
+	require.Equal(t, types.InvariantMode_INVARIANT_MODE_HALT, res.Invariants[0].Mode)
+}
+
+func TestGRPCQueryCheckInvariant(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := sdk.WrapSDKContext(app.NewContext(true, tmproto.Header{Height: 10}))
+	queryServer := keeper.NewQueryServerImpl(&app.CrisisKeeper)
+
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute", func(sdk.Context) (string, bool) { return "broken", true })
+	req := &types.QueryCheckInvariantRequest{InvariantModuleName: "testModule", InvariantRoute: "testRoute"}
+
+	// the query is disabled by default
+	_, err := queryServer.CheckInvariant(ctx, req)
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	app.CrisisKeeper.SetInvariantRPCEnabled(true)
+
+	_, err = queryServer.CheckInvariant(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = queryServer.CheckInvariant(ctx, &types.QueryCheckInvariantRequest{InvariantModuleName: "testModule", InvariantRoute: "unknown"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	res, err := queryServer.CheckInvariant(ctx, req)
+	require.NoError(t, err)
+	require.True(t, res.Broken)
+	require.Equal(t, "broken", res.Message)
+	require.Equal(t, int64(10), res.Height)
+}
@@ -6,41 +6,56 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Keeper - crisis keeper
 type Keeper struct {
 	routes         []types.InvarRoute
-	paramSpace     paramtypes.Subspace
 	invCheckPeriod uint
+	storeKey       storetypes.StoreKey
+	cdc            codec.BinaryCodec
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 
 	supplyKeeper types.SupplyKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	schedules           map[string]types.InvariantSchedule
+	committedState      CommittedStateProvider
+	invariantRPCEnabled bool
+	offChain            *offChainChecks
 }
 
 // NewKeeper creates a new Keeper object
 func NewKeeper(
-	paramSpace paramtypes.Subspace, invCheckPeriod uint, supplyKeeper types.SupplyKeeper,
-	feeCollectorName string,
+	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, invCheckPeriod uint,
+	supplyKeeper types.SupplyKeeper, feeCollectorName string, authority string,
 ) Keeper {
-	// set KeyTable if it has not already been set
-	if !paramSpace.HasKeyTable() {
-		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
-	}
-
 	return Keeper{
+		storeKey:         storeKey,
+		cdc:              cdc,
 		routes:           make([]types.InvarRoute, 0),
-		paramSpace:       paramSpace,
 		invCheckPeriod:   invCheckPeriod,
 		supplyKeeper:     supplyKeeper,
 		feeCollectorName: feeCollectorName,
+		authority:        authority,
+		schedules:        make(map[string]types.InvariantSchedule),
+		offChain:         &offChainChecks{running: make(map[string]bool)},
 	}
 }
 
+// GetAuthority returns the x/crisis module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -76,13 +91,7 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	n := len(invarRoutes)
 	for i, ir := range invarRoutes {
 		logger.Info("asserting crisis invariants", "inv", fmt.Sprint(i+1, "/", n), "name", ir.FullRoute())
-		if res, stop := ir.Invar(ctx); stop {
-			// TODO: Include app name as part of context to allow for this to be
-			// variable.
-			panic(fmt.Errorf("invariant broken: %s\n"+
-				"\tCRITICAL please submit the following transaction:\n"+
-				"\t\t tx crisis invariant-broken %s %s", res, ir.ModuleName, ir.Route))
-		}
+		k.assertInvariant(ctx, ir)
 	}
 
 	diff := time.Since(start)
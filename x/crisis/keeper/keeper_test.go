@@ -9,7 +9,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestLogger(t *testing.T) {
@@ -48,3 +50,31 @@ func TestAssertInvariants(t *testing.T) {
 	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
 }
+
+func TestMsgUpdateParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.NewContext(true, tmproto.Header{})
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	newFee := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
+
+	// only the authority may update the constant fee
+	_, err := app.CrisisKeeper.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority:   sdk.AccAddress("invalid").String(),
+		ConstantFee: newFee,
+	})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = app.CrisisKeeper.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority:   authority,
+		ConstantFee: sdk.Coin{Denom: "", Amount: sdk.NewInt(1000)},
+	})
+	require.Error(t, err)
+
+	_, err = app.CrisisKeeper.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{
+		Authority:   authority,
+		ConstantFee: newFee,
+	})
+	require.NoError(t, err)
+	require.Equal(t, newFee, app.CrisisKeeper.GetConstantFee(ctx))
+}
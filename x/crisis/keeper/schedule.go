@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// CommittedStateProvider returns a read-only view of the committed state at the given height,
+// typically the CacheMultiStoreWithVersion method of the app's CommitMultiStore.
+type CommittedStateProvider func(height int64) (sdk.CacheMultiStore, error)
+
+// offChainChecks tracks the invariants running off-chain. It is shared by all copies of a Keeper.
+type offChainChecks struct {
+	mu      sync.Mutex
+	running map[string]bool
+	wg      sync.WaitGroup
+}
+
+// SetInvariantSchedules sets the schedules of the given invariant routes. Routes without a
+// schedule are checked every InvCheckPeriod blocks and halt the chain when broken.
+// The invariants must be registered before their schedules are set.
+func (k *Keeper) SetInvariantSchedules(schedules []types.InvariantSchedule) error {
+	registered := make(map[string]bool, len(k.routes))
+	for _, ir := range k.routes {
+		registered[ir.FullRoute()] = true
+	}
+
+	res := make(map[string]types.InvariantSchedule, len(schedules))
+	for _, s := range schedules {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		if !registered[s.FullRoute()] {
+			return fmt.Errorf("invariant %s is not registered", s.FullRoute())
+		}
+		res[s.FullRoute()] = s
+	}
+
+	k.schedules = res
+	return nil
+}
+
+// SetCommittedStateProvider sets the provider of the committed state which off-chain invariants
+// are checked against. Off-chain invariants are skipped while no provider is set.
+func (k *Keeper) SetCommittedStateProvider(provider CommittedStateProvider) {
+	k.committedState = provider
+}
+
+// SetInvariantRPCEnabled enables or disables the CheckInvariant query.
+func (k *Keeper) SetInvariantRPCEnabled(enabled bool) {
+	k.invariantRPCEnabled = enabled
+}
+
+// InvariantSchedule returns the schedule of the given invariant route.
+func (k Keeper) InvariantSchedule(ir types.InvarRoute) types.InvariantSchedule {
+	if s, ok := k.schedules[ir.FullRoute()]; ok {
+		return s
+	}
+	return types.NewInvariantSchedule(ir.ModuleName, ir.Route, uint64(k.invCheckPeriod), types.InvariantMode_INVARIANT_MODE_HALT)
+}
+
+// CheckScheduledInvariants checks every invariant which is due at the current height
+// according to its schedule.
+func (k Keeper) CheckScheduledInvariants(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+	for _, ir := range k.Routes() {
+		s := k.InvariantSchedule(ir)
+		if s.Period == 0 || height%int64(s.Period) != 0 {
+			continue
+		}
+
+		switch s.Mode {
+		case types.InvariantMode_INVARIANT_MODE_EVENT:
+			k.checkInvariantWithEvent(ctx, ir)
+		case types.InvariantMode_INVARIANT_MODE_OFF_CHAIN:
+			k.checkInvariantOffChain(ctx, ir)
+		default:
+			k.assertInvariant(ctx, ir)
+		}
+	}
+}
+
+// assertInvariant panics if the invariant is broken.
+func (k Keeper) assertInvariant(ctx sdk.Context, ir types.InvarRoute) {
+	if res, stop := ir.Invar(ctx); stop {
+		// TODO: Include app name as part of context to allow for this to be
+		// variable.
+		panic(fmt.Errorf("invariant broken: %s\n"+
+			"\tCRITICAL please submit the following transaction:\n"+
+			"\t\t tx crisis invariant-broken %s %s", res, ir.ModuleName, ir.Route))
+	}
+}
+
+// checkInvariantWithEvent emits an event if the invariant is broken.
+func (k Keeper) checkInvariantWithEvent(ctx sdk.Context, ir types.InvarRoute) {
+	res, broken := ir.Invar(ctx)
+	reportInvariant(ir, broken)
+	if !broken {
+		return
+	}
+
+	k.Logger(ctx).Error("invariant broken", "route", ir.FullRoute(), "height", ctx.BlockHeight(), "result", res)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInvariantBroken,
+			sdk.NewAttribute(types.AttributeKeyRoute, ir.FullRoute()),
+			sdk.NewAttribute(types.AttributeKeyResult, res),
+		),
+	)
+}
+
+// checkInvariantOffChain checks the invariant against the last committed state on a separate
+// goroutine. The check is skipped while the previous check of the same invariant still runs.
+//
+// NOTE: only state of versioned stores is isolated from block execution. Invariants reading
+// memory or transient stores must not be checked off-chain.
+func (k Keeper) checkInvariantOffChain(ctx sdk.Context, ir types.InvarRoute) {
+	logger := k.Logger(ctx)
+	height := ctx.BlockHeight() - 1
+	if k.committedState == nil || height < 1 {
+		return
+	}
+
+	k.offChain.mu.Lock()
+	if k.offChain.running[ir.FullRoute()] {
+		k.offChain.mu.Unlock()
+		logger.Info("skipping off-chain invariant check, previous check still running", "route", ir.FullRoute())
+		return
+	}
+	k.offChain.running[ir.FullRoute()] = true
+	k.offChain.mu.Unlock()
+
+	cms, err := k.committedState(height)
+	if err != nil {
+		k.offChain.finish(ir)
+		logger.Error("failed to load committed state for off-chain invariant check", "route", ir.FullRoute(), "height", height, "err", err)
+		return
+	}
+	header := tmproto.Header{ChainID: ctx.ChainID(), Height: height}
+	snapshotCtx := sdk.NewContext(cms, header, false, logger).WithBlockGasMeter(sdk.NewInfiniteGasMeter())
+
+	k.offChain.wg.Add(1)
+	go func() {
+		defer k.offChain.wg.Done()
+		defer k.offChain.finish(ir)
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error("off-chain invariant check panicked", "route", ir.FullRoute(), "height", height, "err", r)
+			}
+		}()
+
+		start := time.Now()
+		res, broken := ir.Invar(snapshotCtx)
+		telemetry.MeasureSince(start, types.ModuleName, "invariant", "offchain", ir.ModuleName, ir.Route)
+		reportInvariant(ir, broken)
+
+		if broken {
+			logger.Error("invariant broken", "route", ir.FullRoute(), "height", height, "result", res)
+		}
+	}()
+}
+
+// WaitOffChainChecks blocks until all running off-chain invariant checks are done.
+func (k Keeper) WaitOffChainChecks() {
+	k.offChain.wg.Wait()
+}
+
+func (c *offChainChecks) finish(ir types.InvarRoute) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.running, ir.FullRoute())
+}
+
+// reportInvariant reports the result of an invariant check through telemetry.
+func reportInvariant(ir types.InvarRoute, broken bool) {
+	var val float32
+	if broken {
+		val = 1
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "invariant", "broken"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("route", ir.FullRoute())},
+		)
+	}
+	telemetry.SetGaugeWithLabels(
+		[]string{types.ModuleName, "invariant", "status"},
+		val,
+		[]metrics.Label{telemetry.NewLabel("route", ir.FullRoute())},
+	)
+}
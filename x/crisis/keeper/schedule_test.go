@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+func TestSetInvariantSchedules(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute", func(sdk.Context) (string, bool) { return "", false })
+
+	err := app.CrisisKeeper.SetInvariantSchedules([]types.InvariantSchedule{
+		types.NewInvariantSchedule("testModule", "unknown", 1, types.InvariantMode_INVARIANT_MODE_EVENT),
+	})
+	require.Error(t, err)
+
+	ir := app.CrisisKeeper.Routes()[len(app.CrisisKeeper.Routes())-1]
+	require.Equal(t,
+		types.NewInvariantSchedule("testModule", "testRoute", uint64(app.CrisisKeeper.InvCheckPeriod()), types.InvariantMode_INVARIANT_MODE_HALT),
+		app.CrisisKeeper.InvariantSchedule(ir))
+
+	schedule := types.NewInvariantSchedule("testModule", "testRoute", 3, types.InvariantMode_INVARIANT_MODE_EVENT)
+	require.NoError(t, app.CrisisKeeper.SetInvariantSchedules([]types.InvariantSchedule{schedule}))
+	require.Equal(t, schedule, app.CrisisKeeper.InvariantSchedule(ir))
+}
+
+func TestCheckScheduledInvariants(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	var halts, events int
+	app.CrisisKeeper.RegisterRoute("testModule", "halt", func(sdk.Context) (string, bool) { halts++; return "halt broken", true })
+	app.CrisisKeeper.RegisterRoute("testModule", "event", func(sdk.Context) (string, bool) { events++; return "event broken", true })
+	require.NoError(t, app.CrisisKeeper.SetInvariantSchedules([]types.InvariantSchedule{
+		types.NewInvariantSchedule("testModule", "halt", 4, types.InvariantMode_INVARIANT_MODE_HALT),
+		types.NewInvariantSchedule("testModule", "event", 3, types.InvariantMode_INVARIANT_MODE_EVENT),
+	}))
+
+	// neither invariant is due
+	ctx := app.NewContext(true, tmproto.Header{Height: 1})
+	require.NotPanics(t, func() { app.CrisisKeeper.CheckScheduledInvariants(ctx) })
+	require.Equal(t, 0, halts)
+	require.Equal(t, 0, events)
+
+	// a broken invariant in event mode emits an event and does not halt
+	ctx = app.NewContext(true, tmproto.Header{Height: 3}).WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() { app.CrisisKeeper.CheckScheduledInvariants(ctx) })
+	require.Equal(t, 0, halts)
+	require.Equal(t, 1, events)
+
+	evs := ctx.EventManager().Events()
+	require.Len(t, evs, 1)
+	require.Equal(t, types.EventTypeInvariantBroken, evs[0].Type)
+	require.Equal(t, types.AttributeKeyRoute, string(evs[0].Attributes[0].Key))
+	require.Equal(t, "testModule/event", string(evs[0].Attributes[0].Value))
+	require.Equal(t, "event broken", string(evs[0].Attributes[1].Value))
+
+	// a broken invariant in halt mode panics
+	ctx = app.NewContext(true, tmproto.Header{Height: 4})
+	require.Panics(t, func() { app.CrisisKeeper.CheckScheduledInvariants(ctx) })
+	require.Equal(t, 1, halts)
+}
+
+func TestCheckScheduledInvariantsOffChain(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	heights := make(chan int64, 1)
+	app.CrisisKeeper.RegisterRoute("testModule", "offchain", func(ctx sdk.Context) (string, bool) {
+		heights <- ctx.BlockHeight()
+		return "offchain broken", true
+	})
+	require.NoError(t, app.CrisisKeeper.SetInvariantSchedules([]types.InvariantSchedule{
+		types.NewInvariantSchedule("testModule", "offchain", 1, types.InvariantMode_INVARIANT_MODE_OFF_CHAIN),
+	}))
+
+	// the broken invariant is checked against the last committed state and does not halt
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+	require.NotPanics(t, func() { app.CrisisKeeper.CheckScheduledInvariants(ctx) })
+	app.CrisisKeeper.WaitOffChainChecks()
+	require.Equal(t, app.LastBlockHeight(), <-heights)
+
+	// the check is skipped without a committed state provider
+	app.CrisisKeeper.SetCommittedStateProvider(nil)
+	app.CrisisKeeper.CheckScheduledInvariants(ctx)
+	app.CrisisKeeper.WaitOffChainChecks()
+	require.Len(t, heights, 0)
+}
@@ -0,0 +1,27 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/exported"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// Migrate migrates the x/crisis module state from the consensus version 1 to
+// version 2. Specifically, it takes the constant fee that is currently stored
+// and managed by the x/params modules and stores it directly into the x/crisis
+// module state.
+func Migrate(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace exported.Subspace, cdc codec.BinaryCodec) error {
+	var currConstantFee sdk.Coin
+	legacySubspace.Get(ctx, types.ParamStoreKeyConstantFee, &currConstantFee)
+
+	if !currConstantFee.IsValid() {
+		return types.ErrInvalidConstantFee
+	}
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.ConstantFeeKey, cdc.MustMarshal(&currConstantFee))
+
+	return nil
+}
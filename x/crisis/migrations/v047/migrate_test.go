@@ -0,0 +1,47 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047 "github.com/cosmos/cosmos-sdk/x/crisis/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+type mockSubspace struct {
+	constantFee sdk.Coin
+}
+
+func newMockSubspace(fee sdk.Coin) mockSubspace {
+	return mockSubspace{constantFee: fee}
+}
+
+func (ms mockSubspace) Get(ctx sdk.Context, key []byte, ptr interface{}) {
+	*ptr.(*sdk.Coin) = ms.constantFee
+}
+
+func TestMigrate(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	legacySubspace := newMockSubspace(types.DefaultGenesisState().ConstantFee)
+	require.NoError(t, v047.Migrate(ctx, storeKey, legacySubspace, cdc))
+
+	var res sdk.Coin
+	bz := store.Get(types.ConstantFeeKey)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+	require.Equal(t, legacySubspace.constantFee, res)
+
+	// an invalid constant fee is rejected
+	invalidSubspace := newMockSubspace(sdk.Coin{Denom: "", Amount: sdk.NewInt(-1)})
+	require.ErrorIs(t, v047.Migrate(ctx, storeKey, invalidSubspace, cdc), types.ErrInvalidConstantFee)
+}
@@ -3,4 +3,11 @@ package types
 const (
 	// module name
 	ModuleName = "crisis"
+
+	// StoreKey is the store key string for crisis
+	StoreKey = ModuleName
 )
+
+// ConstantFeeKey is the key used to store the constant fee in the crisis
+// store.
+var ConstantFeeKey = []byte{0x01}
@@ -0,0 +1,1398 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/crisis/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InvariantMode defines what happens when a scheduled invariant is broken.
+type InvariantMode int32
+
+const (
+	// INVARIANT_MODE_HALT halts the chain.
+	InvariantMode_INVARIANT_MODE_HALT InvariantMode = 0
+	// INVARIANT_MODE_EVENT emits an event and keeps the chain running.
+	InvariantMode_INVARIANT_MODE_EVENT InvariantMode = 1
+	// INVARIANT_MODE_OFF_CHAIN runs the invariant on a separate goroutine against
+	// the last committed state and reports the result through telemetry.
+	InvariantMode_INVARIANT_MODE_OFF_CHAIN InvariantMode = 2
+)
+
+var InvariantMode_name = map[int32]string{
+	0: "INVARIANT_MODE_HALT",
+	1: "INVARIANT_MODE_EVENT",
+	2: "INVARIANT_MODE_OFF_CHAIN",
+}
+
+var InvariantMode_value = map[string]int32{
+	"INVARIANT_MODE_HALT":      0,
+	"INVARIANT_MODE_EVENT":     1,
+	"INVARIANT_MODE_OFF_CHAIN": 2,
+}
+
+func (x InvariantMode) String() string {
+	return proto.EnumName(InvariantMode_name, int32(x))
+}
+
+func (InvariantMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{0}
+}
+
+// InvariantSchedule defines how often and in which mode an invariant route is
+// checked.
+type InvariantSchedule struct {
+	InvariantModuleName string `protobuf:"bytes,1,opt,name=invariant_module_name,json=invariantModuleName,proto3" json:"invariant_module_name,omitempty"`
+	InvariantRoute      string `protobuf:"bytes,2,opt,name=invariant_route,json=invariantRoute,proto3" json:"invariant_route,omitempty"`
+	// period is the number of blocks between two checks, 0 disables the checks.
+	Period uint64        `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	Mode   InvariantMode `protobuf:"varint,4,opt,name=mode,proto3,enum=cosmos.crisis.v1beta1.InvariantMode" json:"mode,omitempty"`
+}
+
+func (m *InvariantSchedule) Reset()         { *m = InvariantSchedule{} }
+func (m *InvariantSchedule) String() string { return proto.CompactTextString(m) }
+func (*InvariantSchedule) ProtoMessage()    {}
+func (*InvariantSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{0}
+}
+func (m *InvariantSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantSchedule.Merge(m, src)
+}
+func (m *InvariantSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantSchedule proto.InternalMessageInfo
+
+func (m *InvariantSchedule) GetInvariantModuleName() string {
+	if m != nil {
+		return m.InvariantModuleName
+	}
+	return ""
+}
+
+func (m *InvariantSchedule) GetInvariantRoute() string {
+	if m != nil {
+		return m.InvariantRoute
+	}
+	return ""
+}
+
+func (m *InvariantSchedule) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *InvariantSchedule) GetMode() InvariantMode {
+	if m != nil {
+		return m.Mode
+	}
+	return InvariantMode_INVARIANT_MODE_HALT
+}
+
+// QueryInvariantsRequest is the request type for the Query/Invariants RPC method.
+type QueryInvariantsRequest struct {
+}
+
+func (m *QueryInvariantsRequest) Reset()         { *m = QueryInvariantsRequest{} }
+func (m *QueryInvariantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsRequest) ProtoMessage()    {}
+func (*QueryInvariantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{1}
+}
+func (m *QueryInvariantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsRequest.Merge(m, src)
+}
+func (m *QueryInvariantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantsRequest proto.InternalMessageInfo
+
+// QueryInvariantsResponse is the response type for the Query/Invariants RPC method.
+type QueryInvariantsResponse struct {
+	Invariants []InvariantSchedule `protobuf:"bytes,1,rep,name=invariants,proto3" json:"invariants"`
+}
+
+func (m *QueryInvariantsResponse) Reset()         { *m = QueryInvariantsResponse{} }
+func (m *QueryInvariantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsResponse) ProtoMessage()    {}
+func (*QueryInvariantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{2}
+}
+func (m *QueryInvariantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsResponse.Merge(m, src)
+}
+func (m *QueryInvariantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantsResponse proto.InternalMessageInfo
+
+func (m *QueryInvariantsResponse) GetInvariants() []InvariantSchedule {
+	if m != nil {
+		return m.Invariants
+	}
+	return nil
+}
+
+// QueryCheckInvariantRequest is the request type for the Query/CheckInvariant RPC method.
+type QueryCheckInvariantRequest struct {
+	InvariantModuleName string `protobuf:"bytes,1,opt,name=invariant_module_name,json=invariantModuleName,proto3" json:"invariant_module_name,omitempty"`
+	InvariantRoute      string `protobuf:"bytes,2,opt,name=invariant_route,json=invariantRoute,proto3" json:"invariant_route,omitempty"`
+}
+
+func (m *QueryCheckInvariantRequest) Reset()         { *m = QueryCheckInvariantRequest{} }
+func (m *QueryCheckInvariantRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckInvariantRequest) ProtoMessage()    {}
+func (*QueryCheckInvariantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{3}
+}
+func (m *QueryCheckInvariantRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckInvariantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckInvariantRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckInvariantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckInvariantRequest.Merge(m, src)
+}
+func (m *QueryCheckInvariantRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckInvariantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckInvariantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckInvariantRequest proto.InternalMessageInfo
+
+func (m *QueryCheckInvariantRequest) GetInvariantModuleName() string {
+	if m != nil {
+		return m.InvariantModuleName
+	}
+	return ""
+}
+
+func (m *QueryCheckInvariantRequest) GetInvariantRoute() string {
+	if m != nil {
+		return m.InvariantRoute
+	}
+	return ""
+}
+
+// QueryCheckInvariantResponse is the response type for the Query/CheckInvariant RPC method.
+type QueryCheckInvariantResponse struct {
+	Broken  bool   `protobuf:"varint,1,opt,name=broken,proto3" json:"broken,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// height is the height of the state the invariant was checked against.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryCheckInvariantResponse) Reset()         { *m = QueryCheckInvariantResponse{} }
+func (m *QueryCheckInvariantResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckInvariantResponse) ProtoMessage()    {}
+func (*QueryCheckInvariantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{4}
+}
+func (m *QueryCheckInvariantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckInvariantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckInvariantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckInvariantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckInvariantResponse.Merge(m, src)
+}
+func (m *QueryCheckInvariantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckInvariantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckInvariantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckInvariantResponse proto.InternalMessageInfo
+
+func (m *QueryCheckInvariantResponse) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+func (m *QueryCheckInvariantResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *QueryCheckInvariantResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.crisis.v1beta1.InvariantMode", InvariantMode_name, InvariantMode_value)
+	proto.RegisterType((*InvariantSchedule)(nil), "cosmos.crisis.v1beta1.InvariantSchedule")
+	proto.RegisterType((*QueryInvariantsRequest)(nil), "cosmos.crisis.v1beta1.QueryInvariantsRequest")
+	proto.RegisterType((*QueryInvariantsResponse)(nil), "cosmos.crisis.v1beta1.QueryInvariantsResponse")
+	proto.RegisterType((*QueryCheckInvariantRequest)(nil), "cosmos.crisis.v1beta1.QueryCheckInvariantRequest")
+	proto.RegisterType((*QueryCheckInvariantResponse)(nil), "cosmos.crisis.v1beta1.QueryCheckInvariantResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/crisis/v1beta1/query.proto", fileDescriptor_3ca16352ca9a50b9) }
+
+var fileDescriptor_3ca16352ca9a50b9 = []byte{
+	// 556 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xcd, 0x26, 0x21, 0xc0, 0x20, 0x42, 0xd8, 0xb6, 0xa9, 0x15, 0x2a, 0x93, 0x1a, 0x24, 0x02,
+	0xa8, 0x5e, 0x25, 0x5c, 0xb8, 0xa6, 0x25, 0x55, 0x23, 0x51, 0x57, 0x75, 0xa3, 0x4a, 0x70, 0x09,
+	0x8e, 0xb3, 0x72, 0xac, 0xd4, 0xde, 0xd4, 0xeb, 0x54, 0x44, 0x55, 0x2f, 0x7c, 0x01, 0x12, 0x12,
+	0x1f, 0xc4, 0xa9, 0xc7, 0x0a, 0x2e, 0x9c, 0x10, 0x4a, 0xf8, 0x05, 0xee, 0xc8, 0x1b, 0xdb, 0x69,
+	0x83, 0xa1, 0x70, 0xe8, 0xc9, 0x9e, 0x79, 0x6f, 0xf6, 0xcd, 0xbe, 0x19, 0x1b, 0x56, 0x4d, 0xc6,
+	0x1d, 0xc6, 0x89, 0xe9, 0xd9, 0xdc, 0xe6, 0xe4, 0xa8, 0xda, 0xa1, 0xbe, 0x51, 0x25, 0x87, 0x43,
+	0xea, 0x8d, 0xd4, 0x81, 0xc7, 0x7c, 0x86, 0x97, 0xa6, 0x14, 0x75, 0x4a, 0x51, 0x43, 0x4a, 0x69,
+	0xd1, 0x62, 0x16, 0x13, 0x0c, 0x12, 0xbc, 0x4d, 0xc9, 0xa5, 0x15, 0x8b, 0x31, 0xeb, 0x80, 0x12,
+	0x63, 0x60, 0x13, 0xc3, 0x75, 0x99, 0x6f, 0xf8, 0x36, 0x73, 0xf9, 0x14, 0x55, 0x3e, 0x21, 0xb8,
+	0xdb, 0x74, 0x8f, 0x0c, 0xcf, 0x36, 0x5c, 0x7f, 0xcf, 0xec, 0xd1, 0xee, 0xf0, 0x80, 0xe2, 0x1a,
+	0x2c, 0xd9, 0x51, 0xb2, 0xed, 0xb0, 0x20, 0xd7, 0x76, 0x0d, 0x87, 0x4a, 0xa8, 0x8c, 0x2a, 0x37,
+	0xf5, 0x85, 0x18, 0xdc, 0x16, 0x98, 0x66, 0x38, 0x14, 0x3f, 0x82, 0x3b, 0xb3, 0x1a, 0x8f, 0x0d,
+	0x7d, 0x2a, 0xa5, 0x05, 0x3b, 0x1f, 0xa7, 0xf5, 0x20, 0x8b, 0x8b, 0x90, 0x1b, 0x50, 0xcf, 0x66,
+	0x5d, 0x29, 0x53, 0x46, 0x95, 0xac, 0x1e, 0x46, 0xf8, 0x39, 0x64, 0x1d, 0xd6, 0xa5, 0x52, 0xb6,
+	0x8c, 0x2a, 0xf9, 0xda, 0x43, 0x35, 0xf1, 0x92, 0x6a, 0xf3, 0x9c, 0x34, 0xd5, 0x45, 0x85, 0x22,
+	0x41, 0x71, 0x37, 0xb0, 0x27, 0xc6, 0xb8, 0x4e, 0x0f, 0x87, 0x94, 0xfb, 0x8a, 0x0d, 0xcb, 0xbf,
+	0x21, 0x7c, 0xc0, 0x5c, 0x4e, 0xb1, 0x06, 0x10, 0x37, 0xc6, 0x25, 0x54, 0xce, 0x54, 0x6e, 0xd5,
+	0x2a, 0x97, 0x89, 0x46, 0x0e, 0xad, 0x67, 0x4f, 0xbf, 0xdd, 0x4f, 0xe9, 0xe7, 0x4e, 0x50, 0x46,
+	0x50, 0x12, 0x52, 0x1b, 0x3d, 0x6a, 0xf6, 0xe3, 0x82, 0xb0, 0x91, 0x2b, 0x75, 0x54, 0xb1, 0xe0,
+	0x5e, 0xa2, 0x74, 0x78, 0xd3, 0x22, 0xe4, 0x3a, 0x1e, 0xeb, 0x53, 0x57, 0x88, 0xdd, 0xd0, 0xc3,
+	0x08, 0x4b, 0x70, 0xdd, 0xa1, 0x9c, 0x1b, 0x56, 0x74, 0x6e, 0x14, 0x06, 0x15, 0x3d, 0x6a, 0x5b,
+	0x3d, 0x5f, 0x8c, 0x28, 0xa3, 0x87, 0xd1, 0x93, 0x37, 0x70, 0xfb, 0x82, 0xff, 0x78, 0x19, 0x16,
+	0x9a, 0xda, 0x7e, 0x5d, 0x6f, 0xd6, 0xb5, 0x56, 0x7b, 0x7b, 0xe7, 0x45, 0xa3, 0xbd, 0x55, 0x7f,
+	0xd9, 0x2a, 0xa4, 0xb0, 0x04, 0x8b, 0x73, 0x40, 0x63, 0xbf, 0xa1, 0xb5, 0x0a, 0x08, 0xaf, 0x80,
+	0x34, 0x87, 0xec, 0x6c, 0x6e, 0xb6, 0x37, 0xb6, 0xea, 0x4d, 0xad, 0x90, 0xae, 0xfd, 0x4c, 0xc3,
+	0x35, 0x71, 0x17, 0xfc, 0x11, 0x01, 0xcc, 0xc6, 0x86, 0xd7, 0xfe, 0x30, 0x9a, 0xe4, 0xc1, 0x97,
+	0xd4, 0x7f, 0xa5, 0x4f, 0x3d, 0x52, 0x1e, 0xbf, 0xfb, 0xf2, 0xe3, 0x43, 0xfa, 0x01, 0x5e, 0x25,
+	0xc9, 0x9f, 0xdf, 0x6c, 0xd0, 0xf8, 0x33, 0x82, 0xfc, 0x45, 0xa7, 0x71, 0xf5, 0x6f, 0x6a, 0x89,
+	0x0b, 0x51, 0xaa, 0xfd, 0x4f, 0x49, 0xd8, 0xe4, 0x2b, 0xd1, 0xe4, 0x1e, 0xde, 0xbd, 0xb4, 0x49,
+	0x72, 0x9c, 0xb8, 0x6d, 0x27, 0xe4, 0x78, 0x6e, 0xa3, 0x4e, 0x88, 0x19, 0x68, 0xad, 0x37, 0x4e,
+	0xc7, 0x32, 0x3a, 0x1b, 0xcb, 0xe8, 0xfb, 0x58, 0x46, 0xef, 0x27, 0x72, 0xea, 0x6c, 0x22, 0xa7,
+	0xbe, 0x4e, 0xe4, 0xd4, 0xeb, 0xa7, 0x96, 0xed, 0xf7, 0x86, 0x1d, 0xd5, 0x64, 0x4e, 0x2c, 0x2b,
+	0x1e, 0x6b, 0xbc, 0xdb, 0x27, 0x6f, 0xa3, 0x1e, 0xfc, 0xd1, 0x80, 0xf2, 0x4e, 0x4e, 0xfc, 0x55,
+	0x9e, 0xfd, 0x1a, 0x00, 0x3e, 0x07, 0x86, 0xc5, 0xc5, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Invariants returns all registered invariant routes with their schedules.
+	Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error)
+	// CheckInvariant runs a single invariant against the latest state of the
+	// node. It is meant for operators and is disabled unless the node enables it.
+	CheckInvariant(ctx context.Context, in *QueryCheckInvariantRequest, opts ...grpc.CallOption) (*QueryCheckInvariantResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error) {
+	out := new(QueryInvariantsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.crisis.v1beta1.Query/Invariants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckInvariant(ctx context.Context, in *QueryCheckInvariantRequest, opts ...grpc.CallOption) (*QueryCheckInvariantResponse, error) {
+	out := new(QueryCheckInvariantResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.crisis.v1beta1.Query/CheckInvariant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Invariants returns all registered invariant routes with their schedules.
+	Invariants(context.Context, *QueryInvariantsRequest) (*QueryInvariantsResponse, error)
+	// CheckInvariant runs a single invariant against the latest state of the
+	// node. It is meant for operators and is disabled unless the node enables it.
+	CheckInvariant(context.Context, *QueryCheckInvariantRequest) (*QueryCheckInvariantResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Invariants(ctx context.Context, req *QueryInvariantsRequest) (*QueryInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invariants not implemented")
+}
+func (*UnimplementedQueryServer) CheckInvariant(ctx context.Context, req *QueryCheckInvariantRequest) (*QueryCheckInvariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInvariant not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.crisis.v1beta1.Query/Invariants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*QueryInvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckInvariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckInvariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckInvariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.crisis.v1beta1.Query/CheckInvariant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckInvariant(ctx, req.(*QueryCheckInvariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.crisis.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
+		{
+			MethodName: "CheckInvariant",
+			Handler:    _Query_CheckInvariant_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/crisis/v1beta1/query.proto",
+}
+
+func (m *InvariantSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Period != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.InvariantRoute) > 0 {
+		i -= len(m.InvariantRoute)
+		copy(dAtA[i:], m.InvariantRoute)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvariantRoute)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InvariantModuleName) > 0 {
+		i -= len(m.InvariantModuleName)
+		copy(dAtA[i:], m.InvariantModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvariantModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Invariants) > 0 {
+		for iNdEx := len(m.Invariants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Invariants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckInvariantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckInvariantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckInvariantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InvariantRoute) > 0 {
+		i -= len(m.InvariantRoute)
+		copy(dAtA[i:], m.InvariantRoute)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvariantRoute)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InvariantModuleName) > 0 {
+		i -= len(m.InvariantModuleName)
+		copy(dAtA[i:], m.InvariantModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvariantModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckInvariantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckInvariantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckInvariantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InvariantSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvariantModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.InvariantRoute)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovQuery(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *QueryInvariantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInvariantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Invariants) > 0 {
+		for _, e := range m.Invariants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCheckInvariantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvariantModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.InvariantRoute)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckInvariantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Broken {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InvariantSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvariantModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvariantModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvariantRoute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvariantRoute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= InvariantMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInvariantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInvariantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invariants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Invariants = append(m.Invariants, InvariantSchedule{})
+			if err := m.Invariants[len(m.Invariants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckInvariantRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckInvariantRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckInvariantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvariantModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvariantModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvariantRoute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvariantRoute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckInvariantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckInvariantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckInvariantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
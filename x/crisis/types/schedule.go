@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var invariantModeNames = map[string]InvariantMode{
+	"halt":     InvariantMode_INVARIANT_MODE_HALT,
+	"event":    InvariantMode_INVARIANT_MODE_EVENT,
+	"offchain": InvariantMode_INVARIANT_MODE_OFF_CHAIN,
+}
+
+// ParseInvariantMode parses an invariant mode given as "halt", "event" or "offchain".
+func ParseInvariantMode(s string) (InvariantMode, error) {
+	mode, ok := invariantModeNames[strings.ToLower(strings.TrimSpace(s))]
+	if !ok {
+		return 0, fmt.Errorf("invalid invariant mode %q, expected one of halt, event or offchain", s)
+	}
+	return mode, nil
+}
+
+// NewInvariantSchedule creates a new InvariantSchedule instance
+func NewInvariantSchedule(moduleName, route string, period uint64, mode InvariantMode) InvariantSchedule {
+	return InvariantSchedule{
+		InvariantModuleName: moduleName,
+		InvariantRoute:      route,
+		Period:              period,
+		Mode:                mode,
+	}
+}
+
+// FullRoute returns the full invariant route of the schedule.
+func (s InvariantSchedule) FullRoute() string {
+	return s.InvariantModuleName + "/" + s.InvariantRoute
+}
+
+// Validate performs a basic validation of the schedule.
+func (s InvariantSchedule) Validate() error {
+	if s.InvariantModuleName == "" || s.InvariantRoute == "" {
+		return fmt.Errorf("invalid invariant route %q", s.FullRoute())
+	}
+	if _, ok := InvariantMode_name[int32(s.Mode)]; !ok {
+		return fmt.Errorf("invalid invariant mode %d for %s", s.Mode, s.FullRoute())
+	}
+	return nil
+}
+
+// ParseInvariantSchedules parses a comma separated list of invariant schedules of the form
+// "<module>/<route>=<period>[:<mode>]", e.g. "bank/total-supply=10:halt,distribution/can-withdraw=1000:offchain".
+// The mode defaults to halt.
+func ParseInvariantSchedules(s string) ([]InvariantSchedule, error) {
+	var schedules []InvariantSchedule
+	seen := make(map[string]bool)
+
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		route, spec, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid invariant schedule %q, expected <module>/<route>=<period>[:<mode>]", entry)
+		}
+		moduleName, routeName, ok := strings.Cut(strings.TrimSpace(route), "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid invariant route %q, expected <module>/<route>", route)
+		}
+
+		periodStr, modeStr, hasMode := strings.Cut(spec, ":")
+		period, err := strconv.ParseUint(strings.TrimSpace(periodStr), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid period in invariant schedule %q: %w", entry, err)
+		}
+
+		mode := InvariantMode_INVARIANT_MODE_HALT
+		if hasMode {
+			if mode, err = ParseInvariantMode(modeStr); err != nil {
+				return nil, err
+			}
+		}
+
+		schedule := NewInvariantSchedule(moduleName, routeName, period, mode)
+		if err := schedule.Validate(); err != nil {
+			return nil, err
+		}
+		if seen[schedule.FullRoute()] {
+			return nil, fmt.Errorf("duplicate invariant schedule for %s", schedule.FullRoute())
+		}
+		seen[schedule.FullRoute()] = true
+
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules, nil
+}
@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+func TestParseInvariantSchedules(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		expSch []types.InvariantSchedule
+		expErr bool
+	}{
+		{"empty", "", nil, false},
+		{
+			"default mode",
+			"bank/total-supply=10",
+			[]types.InvariantSchedule{types.NewInvariantSchedule("bank", "total-supply", 10, types.InvariantMode_INVARIANT_MODE_HALT)},
+			false,
+		},
+		{
+			"multiple schedules",
+			" bank/total-supply=10:event, distribution/can-withdraw=1000:offchain,staking/supply=0:halt ",
+			[]types.InvariantSchedule{
+				types.NewInvariantSchedule("bank", "total-supply", 10, types.InvariantMode_INVARIANT_MODE_EVENT),
+				types.NewInvariantSchedule("distribution", "can-withdraw", 1000, types.InvariantMode_INVARIANT_MODE_OFF_CHAIN),
+				types.NewInvariantSchedule("staking", "supply", 0, types.InvariantMode_INVARIANT_MODE_HALT),
+			},
+			false,
+		},
+		{"missing period", "bank/total-supply", nil, true},
+		{"missing route", "bank=10", nil, true},
+		{"empty route", "bank/=10", nil, true},
+		{"invalid period", "bank/total-supply=-1", nil, true},
+		{"invalid mode", "bank/total-supply=10:warn", nil, true},
+		{"duplicate", "bank/total-supply=10,bank/total-supply=20", nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			schedules, err := types.ParseInvariantSchedules(tc.input)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expSch, schedules)
+		})
+	}
+}
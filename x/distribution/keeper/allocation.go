@@ -36,20 +36,10 @@ func (k Keeper) AllocateTokens(
 	if len(feesCollectedInt) > 0 {
 		// burn fee: ratio.Burn
 		burnFee := k.CalculatePercentage(feesCollectedInt, ratio.Burn)
-		err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnFee)
-		if err != nil {
+		if err := k.burnFees(ctx, burnFee); err != nil {
 			panic(err)
 		}
 
-		// emit burn fee
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeBurnFee,
-				sdk.NewAttribute(sdk.AttributeKeyAmount, burnFee.String()),
-			),
-		)
-		logger.Info("Event Emitted", "type", types.EventTypeBurnFee, "key", sdk.AttributeKeyAmount, "value", burnFee.String())
-
 		// base fee: ratio.Base
 		baseAddr := sdk.MustAccAddressFromBech32(k.GetBaseAddress(ctx))
 		baseFee := k.CalculatePercentage(feesCollectedInt, ratio.Base)
@@ -195,3 +185,48 @@ func (k Keeper) CalculatePercentage(coins sdk.Coins, percentage sdk.Dec) sdk.Coi
 	}
 	return result
 }
+
+// BurnCollectedFees burns fees of the fee collector through the burn path of the
+// distribution Ratio, before the remaining collected fees are allocated. It lets
+// other modules burn a portion of the fees in full, e.g. the base fees of x/feemarket.
+func (k Keeper) BurnCollectedFees(ctx sdk.Context, fees sdk.Coins) error {
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, fees); err != nil {
+		return err
+	}
+
+	return k.burnFees(ctx, fees)
+}
+
+// FundCommunityPoolFromCollectedFees moves fees of the fee collector to the community
+// pool, before the remaining collected fees are allocated. It lets other modules set
+// aside a portion of the fees that cannot be burned, e.g. the base fees of x/feemarket
+// paid in alternative fee denoms.
+func (k Keeper) FundCommunityPoolFromCollectedFees(ctx sdk.Context, fees sdk.Coins) error {
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, fees); err != nil {
+		return err
+	}
+
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(fees...)...)
+	k.SetFeePool(ctx, feePool)
+
+	return nil
+}
+
+// burnFees burns fees of the distribution module account.
+func (k Keeper) burnFees(ctx sdk.Context, fees sdk.Coins) error {
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, fees); err != nil {
+		return err
+	}
+
+	// emit burn fee
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnFee,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fees.String()),
+		),
+	)
+	k.Logger(ctx).Info("Event Emitted", "type", types.EventTypeBurnFee, "key", sdk.AttributeKeyAmount, "value", fees.String())
+
+	return nil
+}
@@ -2,20 +2,29 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/exported"
 	v043 "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v043"
+	v047 "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v047"
 )
 
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
-	keeper Keeper
+	keeper         Keeper
+	legacySubspace exported.Subspace
 }
 
 // NewMigrator returns a new Migrator.
-func NewMigrator(keeper Keeper) Migrator {
-	return Migrator{keeper: keeper}
+func NewMigrator(keeper Keeper, legacySubspace exported.Subspace) Migrator {
+	return Migrator{keeper: keeper, legacySubspace: legacySubspace}
 }
 
 // Migrate1to2 migrates from version 1 to 2.
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v043.MigrateStore(ctx, m.keeper.storeKey)
 }
+
+// Migrate3to4 migrates from version 3 to 4. Specifically, it moves the module
+// parameters out of the x/params subspace and into the x/distribution store.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v047.Migrate(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc)
+}
@@ -56,6 +56,7 @@ var (
 	ModeratorAddrKey                     = []byte{0x09} // key for storing the moderator
 	BaseAddrKey                          = []byte{0x10} // key for storing the base address
 	RatioKey                             = []byte{0x11} // key for storing the distribution ratio
+	ParamsKey                            = []byte{0x12} // key for distribution module params
 
 )
 
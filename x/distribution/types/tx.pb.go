@@ -629,6 +629,101 @@ func (m *MsgChangeModeratorResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgChangeModeratorResponse proto.InternalMessageInfo
 
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/distribution parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{14}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{15}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
@@ -644,6 +739,8 @@ func init() {
 	proto.RegisterType((*MsgChangeBaseAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgChangeBaseAddressResponse")
 	proto.RegisterType((*MsgChangeModerator)(nil), "cosmos.distribution.v1beta1.MsgChangeModerator")
 	proto.RegisterType((*MsgChangeModeratorResponse)(nil), "cosmos.distribution.v1beta1.MsgChangeModeratorResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.distribution.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.distribution.v1beta1.MsgUpdateParamsResponse")
 }
 
 func init() {
@@ -651,57 +748,62 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 786 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6b, 0x13, 0x4f,
-	0x1c, 0xcd, 0x7c, 0xfb, 0xb5, 0xd2, 0x29, 0xb4, 0xe9, 0xda, 0x1f, 0xe9, 0xb6, 0x6e, 0xca, 0x22,
-	0x52, 0x94, 0x6e, 0x4c, 0x0a, 0x16, 0x23, 0x28, 0x26, 0xd6, 0x93, 0x8b, 0x92, 0x82, 0x82, 0x97,
-	0xb2, 0xc9, 0x0e, 0xdb, 0xc1, 0xee, 0x4e, 0xd8, 0x99, 0x74, 0x5b, 0x3c, 0x29, 0x82, 0x7a, 0x10,
-	0x04, 0xff, 0x00, 0x7b, 0x14, 0x2f, 0x7a, 0xf0, 0xa2, 0x37, 0xf1, 0x52, 0xf4, 0x52, 0x3c, 0x79,
-	0x52, 0x49, 0x0f, 0xfa, 0x67, 0xc8, 0xfe, 0x9a, 0x6e, 0xba, 0x49, 0x36, 0xb1, 0xa5, 0xa7, 0x84,
-	0x9d, 0xf7, 0xde, 0xe7, 0xbd, 0x99, 0xcf, 0x7e, 0x66, 0xe1, 0x99, 0x1a, 0xa1, 0x26, 0xa1, 0x39,
-	0x1d, 0x53, 0x66, 0xe3, 0x6a, 0x83, 0x61, 0x62, 0xe5, 0x36, 0xf2, 0x55, 0xc4, 0xb4, 0x7c, 0x8e,
-	0x6d, 0x2a, 0x75, 0x9b, 0x30, 0x22, 0xcc, 0xf8, 0x28, 0x25, 0x8a, 0x52, 0x02, 0x94, 0x38, 0x6e,
-	0x10, 0x83, 0x78, 0xb8, 0x9c, 0xfb, 0xcf, 0xa7, 0x88, 0x52, 0x20, 0x5c, 0xd5, 0x28, 0xe2, 0x82,
-	0x35, 0x82, 0xad, 0x60, 0x5d, 0xe9, 0x56, 0xb8, 0xa5, 0x8e, 0x8f, 0x9f, 0xf6, 0xf1, 0xab, 0x7e,
-	0xa1, 0xc0, 0x8f, 0xbf, 0x34, 0x15, 0x48, 0x99, 0xd4, 0xc8, 0x6d, 0xe4, 0xdd, 0x1f, 0x7f, 0x41,
-	0xfe, 0x0c, 0xe0, 0x84, 0x4a, 0x8d, 0x15, 0xc4, 0xee, 0x62, 0xb6, 0xa6, 0xdb, 0x9a, 0x73, 0x4d,
-	0xd7, 0x6d, 0x44, 0xa9, 0xb0, 0x0c, 0xc7, 0x74, 0xb4, 0x8e, 0x0c, 0x8d, 0x11, 0x7b, 0x55, 0xf3,
-	0x1f, 0x66, 0xc0, 0x1c, 0x98, 0x1f, 0x2a, 0x65, 0xbe, 0xbd, 0x5f, 0x18, 0x0f, 0xf4, 0x03, 0xf8,
-	0x0a, 0xb3, 0xb1, 0x65, 0x54, 0xd2, 0x9c, 0x12, 0xca, 0x94, 0x61, 0xda, 0x09, 0x94, 0xb9, 0xca,
-	0x7f, 0x09, 0x2a, 0xa3, 0x4e, 0xab, 0x97, 0xa2, 0xf4, 0x74, 0x3b, 0x9b, 0xfa, 0xb3, 0x9d, 0x4d,
-	0x3d, 0xfa, 0xfd, 0xee, 0x5c, 0xdc, 0x96, 0x9c, 0x85, 0xa7, 0xdb, 0x86, 0xa8, 0x20, 0x5a, 0x27,
-	0x16, 0x45, 0xf2, 0x17, 0x00, 0x45, 0x95, 0x1a, 0xe1, 0xf2, 0xf5, 0x50, 0xa1, 0x82, 0x1c, 0xcd,
-	0xd6, 0x8f, 0x2a, 0xeb, 0x32, 0x1c, 0xdb, 0xd0, 0xd6, 0xb1, 0xde, 0x22, 0x93, 0x14, 0x36, 0xcd,
-	0x29, 0xbd, 0xa6, 0x7d, 0x06, 0xa0, 0xdc, 0x39, 0x4c, 0x98, 0x59, 0xa8, 0xc1, 0x41, 0xcd, 0x24,
-	0x0d, 0x8b, 0x65, 0xc0, 0xdc, 0xc0, 0xfc, 0x70, 0x61, 0x3a, 0xe8, 0x27, 0xc5, 0xed, 0xb7, 0xb0,
-	0x35, 0x95, 0x32, 0xc1, 0x56, 0xe9, 0xc2, 0xce, 0x8f, 0x6c, 0xea, 0xcd, 0xcf, 0xec, 0xbc, 0x81,
-	0xd9, 0x5a, 0xa3, 0xaa, 0xd4, 0x88, 0x19, 0xf4, 0x4f, 0xf0, 0xb3, 0x40, 0xf5, 0xfb, 0x39, 0xb6,
-	0x55, 0x47, 0xd4, 0x23, 0xd0, 0x4a, 0x20, 0x2d, 0x3f, 0x01, 0x50, 0x8a, 0x78, 0xb9, 0x13, 0x66,
-	0x29, 0x13, 0xd3, 0xc4, 0x94, 0x62, 0x62, 0xb5, 0xdf, 0x15, 0x70, 0xc8, 0x5d, 0x89, 0x29, 0xca,
-	0xcf, 0x01, 0x3c, 0xdb, 0xdd, 0xc9, 0xf1, 0xee, 0xcc, 0x57, 0x00, 0xc7, 0x55, 0x6a, 0xdc, 0x68,
-	0x58, 0xba, 0x6b, 0xa1, 0x61, 0x61, 0xb6, 0x75, 0x9b, 0x90, 0xf5, 0x63, 0xa9, 0x2e, 0x5c, 0x84,
-	0x43, 0x3a, 0xaa, 0x13, 0x8a, 0x19, 0xb1, 0x13, 0x5b, 0x70, 0x1f, 0x5a, 0x9c, 0x8c, 0xee, 0xf2,
-	0xfe, 0x73, 0x59, 0x82, 0xb3, 0xed, 0xc2, 0xf0, 0x17, 0xec, 0x2d, 0x80, 0x23, 0x2a, 0x35, 0xca,
-	0x6b, 0x9a, 0x65, 0xa0, 0x8a, 0xc6, 0x30, 0x71, 0xcf, 0xdd, 0x24, 0x3a, 0xb2, 0xfb, 0x3b, 0x77,
-	0x4e, 0x09, 0x5f, 0xaa, 0x2b, 0xf0, 0x84, 0xed, 0xea, 0x79, 0x29, 0x86, 0x0b, 0xb2, 0xd2, 0x65,
-	0xd0, 0x2a, 0x5e, 0xe5, 0xd2, 0xff, 0xee, 0xb6, 0x55, 0x7c, 0x5a, 0x71, 0xd2, 0xeb, 0x97, 0x98,
-	0x13, 0x39, 0x03, 0x27, 0x5b, 0x0d, 0xf3, 0x2c, 0x1f, 0xfc, 0x93, 0xf3, 0x97, 0x4a, 0x1a, 0x45,
-	0x91, 0xf7, 0xfb, 0x28, 0x12, 0x95, 0x60, 0xda, 0x42, 0xce, 0xaa, 0x7b, 0xdc, 0x3d, 0x4f, 0x89,
-	0x11, 0x0b, 0x39, 0x11, 0x2b, 0x1d, 0x53, 0xf9, 0xe7, 0x14, 0xb3, 0xce, 0xb3, 0x7d, 0x02, 0x50,
-	0xe0, 0x00, 0x35, 0xa4, 0x1f, 0x55, 0xb2, 0x9b, 0x70, 0xc2, 0x4d, 0x16, 0x97, 0x4a, 0x8a, 0x77,
-	0xca, 0x42, 0x8e, 0x7a, 0x40, 0xad, 0x63, 0xc6, 0x59, 0x6f, 0x96, 0x1f, 0x88, 0x10, 0x26, 0x2c,
-	0x7c, 0x3c, 0x09, 0x07, 0x54, 0x6a, 0x08, 0x8f, 0x01, 0x14, 0xda, 0x5c, 0x6b, 0x85, 0xae, 0xfd,
-	0xd3, 0xf6, 0x16, 0x11, 0x8b, 0xfd, 0x73, 0xf8, 0xac, 0x79, 0x09, 0xe0, 0x54, 0xa7, 0x6b, 0x67,
-	0x29, 0x49, 0xb7, 0x03, 0x51, 0xbc, 0xfa, 0x8f, 0x44, 0xee, 0xea, 0x15, 0x80, 0x33, 0xdd, 0x66,
-	0xf6, 0xe5, 0x5e, 0x0b, 0xb4, 0x21, 0x8b, 0xe5, 0x43, 0x90, 0xb9, 0xc3, 0x87, 0x00, 0x8e, 0xc5,
-	0x67, 0x67, 0x3e, 0x49, 0x3a, 0x46, 0x11, 0x2f, 0xf5, 0x4d, 0xe1, 0x1e, 0x08, 0x1c, 0x8e, 0x0e,
-	0xb4, 0xf3, 0x49, 0x4a, 0x11, 0xb0, 0xb8, 0xd8, 0x07, 0xb8, 0x25, 0x74, 0x7c, 0xec, 0xe4, 0x7b,
-	0x93, 0x8a, 0x50, 0x92, 0x43, 0x77, 0x9c, 0x10, 0xc2, 0x03, 0x38, 0x7a, 0x70, 0x3a, 0xe4, 0x7a,
-	0x53, 0xe3, 0x04, 0x71, 0xa9, 0x4f, 0x42, 0x58, 0xbc, 0x74, 0xeb, 0x75, 0x53, 0x02, 0x3b, 0x4d,
-	0x09, 0xec, 0x36, 0x25, 0xf0, 0xab, 0x29, 0x81, 0x17, 0x7b, 0x52, 0x6a, 0x77, 0x4f, 0x4a, 0x7d,
-	0xdf, 0x93, 0x52, 0xf7, 0xf2, 0x5d, 0xaf, 0xc1, 0xcd, 0xd6, 0x0f, 0x65, 0xef, 0x56, 0xac, 0x0e,
-	0x7a, 0x9f, 0xb9, 0x8b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x79, 0xd6, 0xbc, 0x02, 0xc5, 0x0b,
-	0x00, 0x00,
+	// 865 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6b, 0x1b, 0x47,
+	0x18, 0xd5, 0xd4, 0xad, 0xa9, 0x3f, 0x17, 0x5b, 0xde, 0xfa, 0x87, 0xbc, 0x76, 0x57, 0x66, 0x5b,
+	0x8a, 0x69, 0xeb, 0x55, 0x25, 0x97, 0x1a, 0xab, 0xd0, 0x62, 0xa9, 0xee, 0xa9, 0x4b, 0x8d, 0x4c,
+	0x5b, 0xe8, 0xc5, 0xac, 0xb4, 0xc3, 0x6a, 0xa9, 0x77, 0x47, 0xec, 0x8c, 0x2c, 0x9b, 0x9e, 0x5a,
+	0x0a, 0x49, 0x0e, 0x81, 0x40, 0xae, 0x81, 0xf8, 0x18, 0x72, 0x48, 0x72, 0xc8, 0x25, 0xc7, 0x90,
+	0x8b, 0x49, 0x2e, 0x26, 0xa7, 0x9c, 0x92, 0x20, 0x1f, 0x92, 0x3f, 0x23, 0xec, 0xaf, 0xf1, 0xca,
+	0x2b, 0x69, 0xa5, 0xd8, 0xf8, 0x24, 0xa1, 0x79, 0xef, 0x7d, 0xef, 0xcd, 0x7c, 0xf3, 0xad, 0x16,
+	0xbe, 0xa8, 0x11, 0x6a, 0x11, 0x9a, 0xd3, 0x4d, 0xca, 0x1c, 0xb3, 0xda, 0x64, 0x26, 0xb1, 0x73,
+	0x7b, 0xf9, 0x2a, 0x66, 0x5a, 0x3e, 0xc7, 0xf6, 0x95, 0x86, 0x43, 0x18, 0x11, 0x16, 0x7c, 0x94,
+	0x12, 0x45, 0x29, 0x01, 0x4a, 0x9c, 0x36, 0x88, 0x41, 0x3c, 0x5c, 0xce, 0xfd, 0xe6, 0x53, 0x44,
+	0x29, 0x10, 0xae, 0x6a, 0x14, 0x73, 0xc1, 0x1a, 0x31, 0xed, 0x60, 0x5d, 0xe9, 0x57, 0xb8, 0xa3,
+	0x8e, 0x8f, 0x9f, 0xf7, 0xf1, 0x3b, 0x7e, 0xa1, 0xc0, 0x8f, 0xbf, 0x34, 0x17, 0x48, 0x59, 0xd4,
+	0xc8, 0xed, 0xe5, 0xdd, 0x0f, 0x7f, 0x41, 0x7e, 0x82, 0x60, 0x46, 0xa5, 0xc6, 0x36, 0x66, 0x7f,
+	0x9a, 0xac, 0xae, 0x3b, 0x5a, 0x6b, 0x43, 0xd7, 0x1d, 0x4c, 0xa9, 0xb0, 0x09, 0x53, 0x3a, 0xde,
+	0xc5, 0x86, 0xc6, 0x88, 0xb3, 0xa3, 0xf9, 0x3f, 0x66, 0xd0, 0x12, 0x5a, 0x1e, 0x2b, 0x65, 0x9e,
+	0x3f, 0x5c, 0x99, 0x0e, 0xf4, 0x03, 0xf8, 0x36, 0x73, 0x4c, 0xdb, 0xa8, 0xa4, 0x39, 0x25, 0x94,
+	0x29, 0x43, 0xba, 0x15, 0x28, 0x73, 0x95, 0x0f, 0x12, 0x54, 0x26, 0x5b, 0x9d, 0x5e, 0x8a, 0xd2,
+	0xd5, 0xc3, 0x6c, 0xea, 0xed, 0x61, 0x36, 0xf5, 0xdf, 0x9b, 0x07, 0x5f, 0xc5, 0x6d, 0xc9, 0x59,
+	0xf8, 0xac, 0x6b, 0x88, 0x0a, 0xa6, 0x0d, 0x62, 0x53, 0x2c, 0x3f, 0x45, 0x20, 0xaa, 0xd4, 0x08,
+	0x97, 0x7f, 0x0e, 0x15, 0x2a, 0xb8, 0xa5, 0x39, 0xfa, 0x45, 0x65, 0xdd, 0x84, 0xa9, 0x3d, 0x6d,
+	0xd7, 0xd4, 0x3b, 0x64, 0x92, 0xc2, 0xa6, 0x39, 0x65, 0xd0, 0xb4, 0xd7, 0x10, 0xc8, 0xbd, 0xc3,
+	0x84, 0x99, 0x85, 0x1a, 0x8c, 0x6a, 0x16, 0x69, 0xda, 0x2c, 0x83, 0x96, 0x46, 0x96, 0xc7, 0x0b,
+	0xf3, 0x41, 0x3f, 0x29, 0x6e, 0xbf, 0x85, 0xad, 0xa9, 0x94, 0x89, 0x69, 0x97, 0xbe, 0x3d, 0x7a,
+	0x99, 0x4d, 0xdd, 0x7d, 0x95, 0x5d, 0x36, 0x4c, 0x56, 0x6f, 0x56, 0x95, 0x1a, 0xb1, 0x82, 0xfe,
+	0x09, 0x3e, 0x56, 0xa8, 0xfe, 0x77, 0x8e, 0x1d, 0x34, 0x30, 0xf5, 0x08, 0xb4, 0x12, 0x48, 0xcb,
+	0x57, 0x10, 0x48, 0x11, 0x2f, 0x7f, 0x84, 0x59, 0xca, 0xc4, 0xb2, 0x4c, 0x4a, 0x4d, 0x62, 0x77,
+	0xdf, 0x15, 0x74, 0xce, 0x5d, 0x89, 0x29, 0xca, 0xd7, 0x11, 0x7c, 0xd9, 0xdf, 0xc9, 0xe5, 0xee,
+	0xcc, 0x33, 0x04, 0xd3, 0x2a, 0x35, 0x7e, 0x69, 0xda, 0xba, 0x6b, 0xa1, 0x69, 0x9b, 0xec, 0x60,
+	0x8b, 0x90, 0xdd, 0x4b, 0xa9, 0x2e, 0x7c, 0x0f, 0x63, 0x3a, 0x6e, 0x10, 0x6a, 0x32, 0xe2, 0x24,
+	0xb6, 0xe0, 0x29, 0xb4, 0x38, 0x1b, 0xdd, 0xe5, 0xd3, 0xdf, 0x65, 0x09, 0x16, 0xbb, 0x85, 0xe1,
+	0x17, 0xec, 0x3e, 0x82, 0x09, 0x95, 0x1a, 0xe5, 0xba, 0x66, 0x1b, 0xb8, 0xa2, 0x31, 0x93, 0xb8,
+	0xe7, 0x6e, 0x11, 0x1d, 0x3b, 0xc3, 0x9d, 0x3b, 0xa7, 0x84, 0x97, 0xea, 0x47, 0xf8, 0xc8, 0x71,
+	0xf5, 0xbc, 0x14, 0xe3, 0x05, 0x59, 0xe9, 0x33, 0x68, 0x15, 0xaf, 0x72, 0xe9, 0x43, 0x77, 0xdb,
+	0x2a, 0x3e, 0xad, 0x38, 0xeb, 0xf5, 0x4b, 0xcc, 0x89, 0x9c, 0x81, 0xd9, 0x4e, 0xc3, 0x3c, 0xcb,
+	0x23, 0xff, 0xe4, 0xfc, 0xa5, 0x92, 0x46, 0x71, 0xe4, 0x7e, 0x5f, 0x44, 0xa2, 0x12, 0xa4, 0x6d,
+	0xdc, 0xda, 0x71, 0x8f, 0x7b, 0xe0, 0x29, 0x31, 0x61, 0xe3, 0x56, 0xc4, 0x4a, 0xcf, 0x54, 0xfe,
+	0x39, 0xc5, 0xac, 0xf3, 0x6c, 0x8f, 0x11, 0x08, 0x1c, 0xa0, 0x86, 0xf4, 0x8b, 0x4a, 0xf6, 0x2b,
+	0xcc, 0xb8, 0xc9, 0xe2, 0x52, 0x49, 0xf1, 0x3e, 0xb5, 0x71, 0x4b, 0x3d, 0xa3, 0xd6, 0x33, 0xe3,
+	0x22, 0x88, 0xf1, 0x08, 0x3c, 0xe1, 0x2d, 0x04, 0x93, 0x2a, 0x35, 0x7e, 0x6f, 0xe8, 0x1a, 0xc3,
+	0x5b, 0x9a, 0xa3, 0x59, 0xd4, 0xbd, 0x0d, 0x5a, 0x93, 0xd5, 0x89, 0x63, 0xb2, 0x83, 0xc4, 0x58,
+	0xa7, 0x50, 0x61, 0x03, 0x46, 0x1b, 0x9e, 0x42, 0xd0, 0x7c, 0x9f, 0xf7, 0x6d, 0x3e, 0xbf, 0x58,
+	0xd0, 0x7d, 0x01, 0xb1, 0x38, 0xe1, 0x5d, 0x24, 0x2e, 0x29, 0xcf, 0xc3, 0xdc, 0x19, 0x77, 0xa1,
+	0xf3, 0xc2, 0xbd, 0x8f, 0x61, 0x44, 0xa5, 0x86, 0xf0, 0x3f, 0x02, 0xa1, 0xcb, 0x03, 0xb9, 0xd0,
+	0xb7, 0x78, 0xd7, 0xe7, 0x9f, 0x58, 0x1c, 0x9e, 0xc3, 0xa7, 0xe4, 0x4d, 0x04, 0x73, 0xbd, 0x1e,
+	0x98, 0x6b, 0x49, 0xba, 0x3d, 0x88, 0xe2, 0x4f, 0xef, 0x49, 0xe4, 0xae, 0x6e, 0x23, 0x58, 0xe8,
+	0xf7, 0xb4, 0xf9, 0x61, 0xd0, 0x02, 0x5d, 0xc8, 0x62, 0xf9, 0x1c, 0x64, 0xee, 0xf0, 0x5f, 0x04,
+	0x53, 0xf1, 0xa9, 0x9f, 0x4f, 0x92, 0x8e, 0x51, 0xc4, 0xf5, 0xa1, 0x29, 0xdc, 0x03, 0x81, 0xf1,
+	0xe8, 0x28, 0xfe, 0x3a, 0x49, 0x29, 0x02, 0x16, 0x57, 0x87, 0x00, 0x77, 0x84, 0x8e, 0x0f, 0xcc,
+	0xfc, 0x60, 0x52, 0x11, 0x8a, 0xb8, 0x3e, 0x34, 0x85, 0x7b, 0xf8, 0x07, 0x26, 0xcf, 0xce, 0xb5,
+	0xdc, 0x60, 0x6a, 0x9c, 0x20, 0xae, 0x0d, 0x49, 0xe0, 0xc5, 0x1d, 0xf8, 0xa4, 0x63, 0xe4, 0x7c,
+	0x93, 0x24, 0x14, 0x45, 0x8b, 0xdf, 0x0d, 0x83, 0x0e, 0x6b, 0x96, 0x7e, 0xbb, 0xd3, 0x96, 0xd0,
+	0x51, 0x5b, 0x42, 0xc7, 0x6d, 0x09, 0xbd, 0x6e, 0x4b, 0xe8, 0xc6, 0x89, 0x94, 0x3a, 0x3e, 0x91,
+	0x52, 0x2f, 0x4e, 0xa4, 0xd4, 0x5f, 0xf9, 0xbe, 0x7f, 0x1a, 0xf6, 0x3b, 0x5f, 0x2b, 0xbc, 0xff,
+	0x10, 0xd5, 0x51, 0xef, 0xa5, 0x60, 0xf5, 0xdd, 0x00, 0x57, 0xde, 0xd2, 0xac, 0xf3, 0x0c, 0x00,
+	0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -948,6 +1050,54 @@ func (this *MsgChangeModeratorResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgUpdateParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParams)
+	if !ok {
+		that2, ok := that.(MsgUpdateParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParamsResponse)
+	if !ok {
+		that2, ok := that.(MsgUpdateParamsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -979,6 +1129,9 @@ type MsgClient interface {
 	ChangeBaseAddress(ctx context.Context, in *MsgChangeBaseAddress, opts ...grpc.CallOption) (*MsgChangeBaseAddressResponse, error)
 	// ChangeModerator defines a method to allow changing the moderator
 	ChangeModerator(ctx context.Context, in *MsgChangeModerator, opts ...grpc.CallOption) (*MsgChangeModeratorResponse, error)
+	// UpdateParams defines a governance operation for updating the x/distribution module
+	// parameters. The authority is defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -1052,6 +1205,15 @@ func (c *msgClient) ChangeModerator(ctx context.Context, in *MsgChangeModerator,
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -1072,6 +1234,9 @@ type MsgServer interface {
 	ChangeBaseAddress(context.Context, *MsgChangeBaseAddress) (*MsgChangeBaseAddressResponse, error)
 	// ChangeModerator defines a method to allow changing the moderator
 	ChangeModerator(context.Context, *MsgChangeModerator) (*MsgChangeModeratorResponse, error)
+	// UpdateParams defines a governance operation for updating the x/distribution module
+	// parameters. The authority is defined in the keeper.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1099,6 +1264,9 @@ func (*UnimplementedMsgServer) ChangeBaseAddress(ctx context.Context, req *MsgCh
 func (*UnimplementedMsgServer) ChangeModerator(ctx context.Context, req *MsgChangeModerator) (*MsgChangeModeratorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeModerator not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1230,6 +1398,24 @@ func _Msg_ChangeModerator_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1262,6 +1448,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeModerator",
 			Handler:    _Msg_ChangeModerator_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -1718,6 +1908,69 @@ func (m *MsgChangeModeratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1919,6 +2172,30 @@ func (m *MsgChangeModeratorResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3112,6 +3389,171 @@ func (m *MsgChangeModeratorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,10 +1,18 @@
 package cli
 
 import (
-	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"fmt"
+	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
 // GetTxCmd returns a CLI command that has all the native evidence module tx
@@ -22,11 +30,12 @@ func GetTxCmd(childCmds []*cobra.Command) *cobra.Command {
 	}
 
 	submitEvidenceCmd := SubmitEvidenceCmd()
+	submitEvidenceCmd.AddCommand(SubmitConflictingVotesCmd())
 	for _, childCmd := range childCmds {
 		submitEvidenceCmd.AddCommand(childCmd)
 	}
 
-	// TODO: Add tx commands.
+	cmd.AddCommand(submitEvidenceCmd)
 
 	return cmd
 }
@@ -42,3 +51,56 @@ func SubmitEvidenceCmd() *cobra.Command {
 
 	return cmd
 }
+
+// SubmitConflictingVotesCmd returns the command handler for submitting
+// evidence of a validator signing two conflicting votes.
+func SubmitConflictingVotesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conflicting-votes [vote-a-file] [vote-b-file]",
+		Short: "Submit evidence of a validator signing two conflicting votes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit evidence of a validator signing two conflicting votes for the same
+height, round and vote type. Each file must contain a signed Tendermint vote in
+its protobuf JSON representation. The votes are verified against the validator
+set at their height, and the validator is slashed, jailed and tombstoned.
+
+Example:
+$ %s tx evidence submit conflicting-votes vote_a.json vote_b.json --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			votes := make([]*tmproto.Vote, len(args))
+			for i, file := range args {
+				bz, err := os.ReadFile(file)
+				if err != nil {
+					return err
+				}
+
+				votes[i] = &tmproto.Vote{}
+				if err := clientCtx.Codec.UnmarshalJSON(bz, votes[i]); err != nil {
+					return fmt.Errorf("failed to parse vote in %s: %w", file, err)
+				}
+			}
+
+			evidence := types.NewConflictingVotes(votes[0], votes[1])
+			msg, err := types.NewMsgSubmitEvidence(clientCtx.GetFromAddress(), evidence)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
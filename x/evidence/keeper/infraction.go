@@ -1,10 +1,14 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
@@ -12,7 +16,83 @@ import (
 // evidence is valid, the validator committing the misbehavior will be slashed,
 // jailed and tombstoned. Once tombstoned, the validator will not be able to
 // recover. Note, the evidence contains the block time and height at the time of
-// the equivocation.
+// the equivocation. Evidence that cannot be handled is ignored.
+func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equivocation) {
+	if err := k.slashEquivocation(ctx, evidence); err != nil {
+		k.Logger(ctx).Info(
+			"ignored equivocation",
+			"validator", evidence.GetConsensusAddress(),
+			"infraction_height", evidence.GetHeight(),
+			"infraction_time", evidence.GetTime(),
+			"reason", err,
+		)
+		return
+	}
+
+	k.SetEvidence(ctx, evidence)
+}
+
+// HandleConflictingVotesEvidence implements the evidence Handler for
+// ConflictingVotes evidence submitted through MsgSubmitEvidence. Both votes are
+// verified against the validator set recorded in the x/staking historical info
+// at the height of the votes. Valid evidence is converted into an Equivocation
+// and the validator is slashed, jailed and tombstoned exactly as for
+// equivocation evidence reported by Tendermint.
+func (k Keeper) HandleConflictingVotesEvidence(ctx sdk.Context, evidence exported.Evidence) error {
+	conflictingVotes, ok := evidence.(*types.ConflictingVotes)
+	if !ok {
+		return fmt.Errorf("unexpected evidence type: %T", evidence)
+	}
+
+	equivocation, err := k.conflictingVotesToEquivocation(ctx, conflictingVotes)
+	if err != nil {
+		return err
+	}
+
+	return k.slashEquivocation(ctx, equivocation)
+}
+
+// conflictingVotesToEquivocation verifies the signatures of both conflicting
+// votes with the consensus public key of the validator as recorded in the
+// historical info at the height of the votes, and returns the corresponding
+// Equivocation.
+func (k Keeper) conflictingVotesToEquivocation(ctx sdk.Context, evidence *types.ConflictingVotes) (*types.Equivocation, error) {
+	height := evidence.GetHeight()
+	histInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, height)
+	if !found {
+		return nil, fmt.Errorf("no historical info found at height %d", height)
+	}
+
+	consAddr := evidence.GetConsensusAddress()
+	for _, validator := range histInfo.Valset {
+		consPk, err := validator.ConsPubKey()
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(consPk.Address(), consAddr) {
+			continue
+		}
+
+		for _, vote := range []*tmproto.Vote{evidence.VoteA, evidence.VoteB} {
+			if !consPk.VerifySignature(tmtypes.VoteSignBytes(ctx.ChainID(), vote), vote.Signature) {
+				return nil, fmt.Errorf("invalid signature on vote for block %X", vote.BlockID.Hash)
+			}
+		}
+
+		return &types.Equivocation{
+			Height:           height,
+			Time:             histInfo.Header.Time,
+			Power:            validator.ConsensusPower(k.stakingKeeper.PowerReduction(ctx)),
+			ConsensusAddress: consAddr.String(),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("validator %s not in the validator set at height %d", consAddr, height)
+}
+
+// slashEquivocation slashes, jails and tombstones the validator committing the
+// equivocation. An error is returned, and no penalty is applied, if the
+// evidence cannot be handled.
 //
 // The evidence is considered invalid if:
 // - the evidence is too old
@@ -22,7 +102,7 @@ import (
 //
 // TODO: Some of the invalid constraints listed above may need to be reconsidered
 // in the case of a lunatic attack.
-func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equivocation) {
+func (k Keeper) slashEquivocation(ctx sdk.Context, evidence *types.Equivocation) error {
 	logger := k.Logger(ctx)
 	consAddr := evidence.GetConsensusAddress()
 
@@ -36,7 +116,7 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		// allowable but none of the disallowed evidence types.  Instead of
 		// getting this coordination right, it is easier to relax the
 		// constraints and ignore evidence that cannot be handled.
-		return
+		return err
 	}
 
 	// calculate the age of the evidence
@@ -51,15 +131,10 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	cp := ctx.ConsensusParams()
 	if cp != nil && cp.Evidence != nil {
 		if ageDuration > cp.Evidence.MaxAgeDuration && ageBlocks > cp.Evidence.MaxAgeNumBlocks {
-			logger.Info(
-				"ignored equivocation; evidence too old",
-				"validator", consAddr,
-				"infraction_height", infractionHeight,
-				"max_age_num_blocks", cp.Evidence.MaxAgeNumBlocks,
-				"infraction_time", infractionTime,
-				"max_age_duration", cp.Evidence.MaxAgeDuration,
+			return fmt.Errorf(
+				"evidence too old; max age num blocks %d, max age duration %s",
+				cp.Evidence.MaxAgeNumBlocks, cp.Evidence.MaxAgeDuration,
 			)
-			return
 		}
 	}
 
@@ -67,7 +142,7 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	if validator == nil || validator.IsUnbonded() {
 		// Defensive: Simulation doesn't take unbonding periods into account, and
 		// Tendermint might break this assumption at some point.
-		return
+		return fmt.Errorf("validator %s is unbonded or does not exist", consAddr)
 	}
 
 	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
@@ -76,13 +151,7 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 
 	// ignore if the validator is already tombstoned
 	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
-		logger.Info(
-			"ignored equivocation; validator already tombstoned",
-			"validator", consAddr,
-			"infraction_height", infractionHeight,
-			"infraction_time", infractionTime,
-		)
-		return
+		return fmt.Errorf("validator %s already tombstoned", consAddr)
 	}
 
 	logger.Info(
@@ -119,5 +188,6 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 
 	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
 	k.slashingKeeper.Tombstone(ctx, consAddr)
-	k.SetEvidence(ctx, evidence)
+
+	return nil
 }
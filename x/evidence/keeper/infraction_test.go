@@ -3,6 +3,11 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -111,3 +116,65 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
 	suite.False(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
 	suite.False(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
 }
+
+func (suite *KeeperTestSuite) TestHandleConflictingVotes() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Now())
+	suite.populateValidators(ctx)
+
+	power := int64(100)
+	operatorAddr, consPrivKey := valAddresses[0], ed25519.GenPrivKey()
+	consAddr := sdk.ConsAddress(consPrivKey.PubKey().Address())
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, consPrivKey.PubKey(), power, true)
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+
+	// record the validator set of the next block in the historical info
+	ctx = ctx.WithBlockHeight(2)
+	staking.BeginBlocker(ctx, suite.app.StakingKeeper)
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, consPrivKey.PubKey().Address(), selfDelegation.Int64(), true)
+
+	newVote := func(blockHash string) *tmproto.Vote {
+		vote := &tmproto.Vote{
+			Type:   tmproto.PrecommitType,
+			Height: 2,
+			BlockID: tmproto.BlockID{
+				Hash:          tmhash.Sum([]byte(blockHash)),
+				PartSetHeader: tmproto.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte(blockHash))},
+			},
+			Timestamp:        ctx.BlockTime(),
+			ValidatorAddress: consAddr,
+		}
+
+		sig, err := consPrivKey.Sign(tmtypes.VoteSignBytes(ctx.ChainID(), vote))
+		suite.Require().NoError(err)
+		vote.Signature = sig
+
+		return vote
+	}
+
+	// votes signed by another key are rejected
+	forged := newVote("block_b")
+	forged.Signature = make([]byte, len(forged.Signature))
+	evidence := types.NewConflictingVotes(newVote("block_a"), forged)
+	suite.Require().NoError(evidence.ValidateBasic())
+	suite.Require().Error(suite.app.EvidenceKeeper.SubmitEvidence(ctx, evidence))
+	suite.False(suite.app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+
+	// valid conflicting votes slash, jail and tombstone the validator
+	oldTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	evidence = types.NewConflictingVotes(newVote("block_a"), newVote("block_b"))
+	suite.Require().NoError(evidence.ValidateBasic())
+	suite.Require().NoError(suite.app.EvidenceKeeper.SubmitEvidence(ctx, evidence))
+
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+
+	stored, found := suite.app.EvidenceKeeper.GetEvidence(ctx, evidence.Hash())
+	suite.True(found)
+	suite.Equal(evidence.Hash(), stored.Hash())
+
+	// the same votes in reverse order are rejected as the validator is already tombstoned
+	suite.Require().Error(suite.app.EvidenceKeeper.SubmitEvidence(ctx, types.NewConflictingVotes(evidence.VoteB, evidence.VoteA)))
+}
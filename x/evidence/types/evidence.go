@@ -1,12 +1,15 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 	"sigs.k8s.io/yaml"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,11 +18,16 @@ import (
 
 // Evidence type constants
 const (
-	RouteEquivocation = "equivocation"
-	TypeEquivocation  = "equivocation"
+	RouteEquivocation     = "equivocation"
+	TypeEquivocation      = "equivocation"
+	RouteConflictingVotes = "conflictingvotes"
+	TypeConflictingVotes  = "conflictingvotes"
 )
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.Evidence = &Equivocation{}
+	_ exported.Evidence = &ConflictingVotes{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -101,3 +109,90 @@ func FromABCIEvidence(e abci.Evidence) exported.Evidence {
 		Time:             e.Time,
 	}
 }
+
+// NewConflictingVotes returns evidence of a validator signing the two given
+// conflicting votes.
+func NewConflictingVotes(voteA, voteB *tmproto.Vote) *ConflictingVotes {
+	return &ConflictingVotes{
+		VoteA: voteA,
+		VoteB: voteB,
+	}
+}
+
+// Route returns the Evidence Handler route for a ConflictingVotes type.
+func (e *ConflictingVotes) Route() string { return RouteConflictingVotes }
+
+// Type returns the Evidence Handler type for a ConflictingVotes type.
+func (e *ConflictingVotes) Type() string { return TypeConflictingVotes }
+
+func (e *ConflictingVotes) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of a ConflictingVotes object.
+func (e *ConflictingVotes) Hash() tmbytes.HexBytes {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on a
+// ConflictingVotes object. Both votes must be well formed and signed by the
+// same validator for the same height, round and vote type, but for different
+// blocks. Signatures are verified against the validator set when the evidence
+// is handled.
+func (e *ConflictingVotes) ValidateBasic() error {
+	if e.VoteA == nil || e.VoteB == nil {
+		return fmt.Errorf("conflicting votes evidence requires two votes")
+	}
+
+	votes := make([]*tmtypes.Vote, 2)
+	for i, pv := range []*tmproto.Vote{e.VoteA, e.VoteB} {
+		vote, err := tmtypes.VoteFromProto(pv)
+		if err != nil {
+			return fmt.Errorf("invalid vote: %w", err)
+		}
+		if err := vote.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid vote: %w", err)
+		}
+		votes[i] = vote
+	}
+
+	if e.VoteA.Height != e.VoteB.Height || e.VoteA.Round != e.VoteB.Round || e.VoteA.Type != e.VoteB.Type {
+		return fmt.Errorf(
+			"votes are for different height/round/type: %d/%d/%s vs %d/%d/%s",
+			e.VoteA.Height, e.VoteA.Round, e.VoteA.Type, e.VoteB.Height, e.VoteB.Round, e.VoteB.Type,
+		)
+	}
+	if !bytes.Equal(e.VoteA.ValidatorAddress, e.VoteB.ValidatorAddress) {
+		return fmt.Errorf(
+			"votes are signed by different validators: %X vs %X",
+			e.VoteA.ValidatorAddress, e.VoteB.ValidatorAddress,
+		)
+	}
+	if votes[0].BlockID.Equals(votes[1].BlockID) {
+		return fmt.Errorf("votes are for the same block: %s", votes[0].BlockID)
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the consensus address of the validator that
+// signed both votes.
+func (e ConflictingVotes) GetConsensusAddress() sdk.ConsAddress {
+	if e.VoteA == nil {
+		return nil
+	}
+	return sdk.ConsAddress(e.VoteA.ValidatorAddress)
+}
+
+// GetHeight returns the height at which the conflicting votes were signed.
+func (e ConflictingVotes) GetHeight() int64 {
+	if e.VoteA == nil {
+		return 0
+	}
+	return e.VoteA.Height
+}
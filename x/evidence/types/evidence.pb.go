@@ -9,6 +9,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types "github.com/tendermint/tendermint/proto/tendermint/types"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -69,8 +70,51 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// ConflictingVotes implements the Evidence interface and defines evidence of a
+// validator signing two conflicting votes for the same height, round and vote
+// type. It can be submitted by any account through MsgSubmitEvidence.
+type ConflictingVotes struct {
+	// vote_a is the first of the two conflicting votes.
+	VoteA *types.Vote `protobuf:"bytes,1,opt,name=vote_a,json=voteA,proto3" json:"vote_a,omitempty"`
+	// vote_b is the second of the two conflicting votes.
+	VoteB *types.Vote `protobuf:"bytes,2,opt,name=vote_b,json=voteB,proto3" json:"vote_b,omitempty"`
+}
+
+func (m *ConflictingVotes) Reset()      { *m = ConflictingVotes{} }
+func (*ConflictingVotes) ProtoMessage() {}
+func (*ConflictingVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *ConflictingVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConflictingVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConflictingVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConflictingVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConflictingVotes.Merge(m, src)
+}
+func (m *ConflictingVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConflictingVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConflictingVotes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConflictingVotes proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*ConflictingVotes)(nil), "cosmos.evidence.v1beta1.ConflictingVotes")
 }
 
 func init() {
@@ -78,28 +122,33 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x51, 0xbb, 0x4e, 0xf3, 0x30,
-	0x18, 0xb5, 0xff, 0xf6, 0xaf, 0x20, 0x74, 0x80, 0xa8, 0x82, 0xd0, 0xc1, 0xa9, 0x18, 0x50, 0x97,
-	0x26, 0x2a, 0x2c, 0x88, 0x8d, 0x4a, 0x9d, 0xd8, 0x0a, 0x13, 0x4b, 0x95, 0x8b, 0x71, 0x2d, 0x48,
-	0xbe, 0x10, 0x3b, 0x05, 0xde, 0x80, 0xb1, 0x23, 0x63, 0x47, 0x1e, 0x80, 0x87, 0xa8, 0xc4, 0x52,
-	0x31, 0x31, 0x01, 0x4a, 0x17, 0x1e, 0x03, 0xd5, 0x76, 0xcb, 0x64, 0x9f, 0xe3, 0x73, 0xb1, 0xfd,
-	0x59, 0x87, 0x11, 0x88, 0x04, 0x84, 0x4f, 0xc7, 0x3c, 0xa6, 0x69, 0x44, 0xfd, 0x71, 0x37, 0xa4,
-	0x32, 0xe8, 0xae, 0x09, 0x2f, 0xcb, 0x41, 0x82, 0xbd, 0xa7, 0x75, 0xde, 0x9a, 0x36, 0xba, 0x66,
-	0x83, 0x01, 0x03, 0xa5, 0xf1, 0x97, 0x3b, 0x2d, 0x6f, 0xba, 0x0c, 0x80, 0xdd, 0x52, 0x5f, 0xa1,
-	0xb0, 0xb8, 0xf6, 0x25, 0x4f, 0xa8, 0x90, 0x41, 0x92, 0x19, 0xc1, 0xbe, 0xce, 0x1b, 0x6a, 0xa7,
-	0x09, 0x57, 0xe0, 0xe0, 0x0d, 0x5b, 0xf5, 0xfe, 0x5d, 0xc1, 0xc7, 0x10, 0x05, 0x92, 0x43, 0x6a,
-	0xef, 0x5a, 0xb5, 0x11, 0xe5, 0x6c, 0x24, 0x1d, 0xdc, 0xc2, 0xed, 0xca, 0xc0, 0x20, 0xfb, 0xc4,
-	0xaa, 0x2e, 0x63, 0x9d, 0x7f, 0x2d, 0xdc, 0xde, 0x3a, 0x6a, 0x7a, 0xba, 0xd3, 0x5b, 0x75, 0x7a,
-	0x97, 0xab, 0xce, 0xde, 0xc6, 0xec, 0xd3, 0x45, 0x93, 0x2f, 0x17, 0x0f, 0x94, 0xc3, 0x6e, 0x58,
-	0xff, 0x33, 0xb8, 0xa7, 0xb9, 0x53, 0x51, 0x81, 0x1a, 0xd8, 0x7d, 0x6b, 0x27, 0x82, 0x54, 0xd0,
-	0x54, 0x14, 0x62, 0x18, 0xc4, 0x71, 0x4e, 0x85, 0x70, 0xaa, 0x2d, 0xdc, 0xde, 0xec, 0x39, 0xef,
-	0xaf, 0x9d, 0x86, 0xb9, 0xe5, 0x99, 0x3e, 0xb9, 0x90, 0x39, 0x4f, 0xd9, 0x60, 0x7b, 0x6d, 0x31,
-	0xfc, 0x69, 0xfd, 0x69, 0xea, 0xa2, 0xe7, 0xa9, 0x8b, 0x7e, 0xa6, 0x2e, 0xea, 0x9d, 0xbf, 0x94,
-	0x04, 0xcf, 0x4a, 0x82, 0xe7, 0x25, 0xc1, 0xdf, 0x25, 0xc1, 0x93, 0x05, 0x41, 0xf3, 0x05, 0x41,
-	0x1f, 0x0b, 0x82, 0xae, 0x3a, 0x8c, 0xcb, 0x51, 0x11, 0x7a, 0x11, 0x24, 0xe6, 0x13, 0xcc, 0xd2,
-	0x11, 0xf1, 0x8d, 0xff, 0xf0, 0x37, 0x16, 0xf9, 0x98, 0x51, 0x11, 0xd6, 0xd4, 0xdb, 0x8e, 0x7f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x32, 0xf0, 0x2c, 0xb6, 0x01, 0x00, 0x00,
+	// 402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x3d, 0x8f, 0xd3, 0x30,
+	0x18, 0xc7, 0xe3, 0x7b, 0xa9, 0xc0, 0x77, 0xc3, 0x11, 0x55, 0x47, 0xa8, 0x50, 0x52, 0xdd, 0x80,
+	0xba, 0xc4, 0xd1, 0x1d, 0x0b, 0x62, 0xbb, 0xa0, 0x4e, 0x6c, 0x01, 0x31, 0xb0, 0x54, 0x79, 0x71,
+	0x5d, 0x8b, 0xc6, 0x4f, 0x88, 0x9d, 0x00, 0x0b, 0x33, 0x63, 0x47, 0xc6, 0x8e, 0x7c, 0x00, 0x3e,
+	0x44, 0x25, 0x96, 0x8a, 0x89, 0x09, 0x50, 0xba, 0xf0, 0x31, 0x50, 0x62, 0x37, 0x15, 0x13, 0x4b,
+	0xe2, 0xe7, 0x79, 0x7e, 0xcf, 0xdb, 0xdf, 0xc6, 0x8f, 0x52, 0x90, 0x39, 0xc8, 0x80, 0xd6, 0x3c,
+	0xa3, 0x22, 0xa5, 0x41, 0x7d, 0x9d, 0x50, 0x15, 0x5f, 0xf7, 0x0e, 0x52, 0x94, 0xa0, 0xc0, 0xbe,
+	0xaf, 0x39, 0xd2, 0xbb, 0x0d, 0x37, 0x1a, 0x32, 0x60, 0xd0, 0x31, 0x41, 0x7b, 0xd2, 0xf8, 0xc8,
+	0x63, 0x00, 0x6c, 0x49, 0x83, 0xce, 0x4a, 0xaa, 0x79, 0xa0, 0x78, 0x4e, 0xa5, 0x8a, 0xf3, 0xc2,
+	0x00, 0x0f, 0x74, 0xbd, 0x99, 0xce, 0x34, 0xc5, 0x75, 0xe8, 0xa1, 0xa2, 0x22, 0xa3, 0x65, 0xce,
+	0x85, 0x0a, 0xd4, 0x87, 0x82, 0x4a, 0xfd, 0xd5, 0xd1, 0xab, 0x6f, 0x08, 0x9f, 0x4f, 0xdf, 0x56,
+	0xbc, 0x86, 0x34, 0x56, 0x1c, 0x84, 0x7d, 0x89, 0x07, 0x0b, 0xca, 0xd9, 0x42, 0x39, 0x68, 0x8c,
+	0x26, 0xc7, 0x91, 0xb1, 0xec, 0x27, 0xf8, 0xa4, 0x6d, 0xea, 0x1c, 0x8d, 0xd1, 0xe4, 0xec, 0x66,
+	0x44, 0xf4, 0x44, 0x64, 0x3f, 0x11, 0x79, 0xb9, 0x9f, 0x28, 0xbc, 0xb3, 0xf9, 0xe9, 0x59, 0xab,
+	0x5f, 0x1e, 0x8a, 0xba, 0x0c, 0x7b, 0x88, 0x4f, 0x0b, 0x78, 0x47, 0x4b, 0xe7, 0xb8, 0x2b, 0xa8,
+	0x0d, 0x7b, 0x8a, 0xef, 0xa5, 0x20, 0x24, 0x15, 0xb2, 0x92, 0xb3, 0x38, 0xcb, 0x4a, 0x2a, 0xa5,
+	0x73, 0x32, 0x46, 0x93, 0xbb, 0xa1, 0xf3, 0xfd, 0xab, 0x3f, 0x34, 0x3b, 0xdc, 0xea, 0xc8, 0x0b,
+	0x55, 0x72, 0xc1, 0xa2, 0x8b, 0x3e, 0xc5, 0xf8, 0x9f, 0x9e, 0x7f, 0x5a, 0x7b, 0xd6, 0xe7, 0xb5,
+	0x67, 0xfd, 0x59, 0x7b, 0xd6, 0xd5, 0x47, 0x7c, 0xf1, 0x0c, 0xc4, 0x7c, 0xc9, 0x53, 0xc5, 0x05,
+	0x7b, 0x05, 0x8a, 0x4a, 0xdb, 0xc7, 0x83, 0x1a, 0x14, 0x9d, 0xc5, 0xdd, 0x42, 0x67, 0x37, 0x97,
+	0xe4, 0x20, 0x08, 0xd1, 0x52, 0xb4, 0x60, 0x74, 0xda, 0x52, 0xb7, 0x3d, 0x9e, 0x38, 0x47, 0xff,
+	0xc7, 0xc3, 0x7f, 0xfb, 0x87, 0xcf, 0xbf, 0x34, 0x2e, 0xda, 0x34, 0x2e, 0xda, 0x36, 0x2e, 0xfa,
+	0xdd, 0xb8, 0x68, 0xb5, 0x73, 0xad, 0xed, 0xce, 0xb5, 0x7e, 0xec, 0x5c, 0xeb, 0xb5, 0xcf, 0xb8,
+	0x5a, 0x54, 0x09, 0x49, 0x21, 0x37, 0x57, 0x64, 0x7e, 0xbe, 0xcc, 0xde, 0x04, 0xef, 0x0f, 0x8f,
+	0xa6, 0x6b, 0x93, 0x0c, 0x3a, 0x6d, 0x1f, 0xff, 0x1d, 0x00, 0x42, 0x4c, 0x2e, 0x32, 0x54, 0x02,
+	0x00, 0x00,
 }
 
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
@@ -150,6 +199,53 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConflictingVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConflictingVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConflictingVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VoteB != nil {
+		{
+			size, err := m.VoteB.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.VoteA != nil {
+		{
+			size, err := m.VoteA.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -182,6 +278,23 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *ConflictingVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VoteA != nil {
+		l = m.VoteA.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.VoteB != nil {
+		l = m.VoteB.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -341,6 +454,128 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConflictingVotes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConflictingVotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConflictingVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoteA == nil {
+				m.VoteA = &types.Vote{}
+			}
+			if err := m.VoteA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoteB == nil {
+				m.VoteB = &types.Vote{}
+			}
+			if err := m.VoteB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
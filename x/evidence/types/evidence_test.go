@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
@@ -70,6 +72,47 @@ func TestEquivocationValidateBasic(t *testing.T) {
 	}
 }
 
+func TestConflictingVotesValidateBasic(t *testing.T) {
+	addr := sdk.ConsAddress("foo_________________")
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+
+	newVote := func(height int64, round int32, blockHash string, valAddr sdk.ConsAddress) *tmproto.Vote {
+		return &tmproto.Vote{
+			Type:   tmproto.PrecommitType,
+			Height: height,
+			Round:  round,
+			BlockID: tmproto.BlockID{
+				Hash:          tmhash.Sum([]byte(blockHash)),
+				PartSetHeader: tmproto.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte(blockHash))},
+			},
+			Timestamp:        n,
+			ValidatorAddress: valAddr,
+			Signature:        []byte("signature"),
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		e         *types.ConflictingVotes
+		expectErr bool
+	}{
+		{"valid", types.NewConflictingVotes(newVote(100, 0, "a", addr), newVote(100, 0, "b", addr)), false},
+		{"missing vote", types.NewConflictingVotes(newVote(100, 0, "a", addr), nil), true},
+		{"same block", types.NewConflictingVotes(newVote(100, 0, "a", addr), newVote(100, 0, "a", addr)), true},
+		{"different height", types.NewConflictingVotes(newVote(100, 0, "a", addr), newVote(101, 0, "b", addr)), true},
+		{"different round", types.NewConflictingVotes(newVote(100, 0, "a", addr), newVote(100, 1, "b", addr)), true},
+		{"different validator", types.NewConflictingVotes(newVote(100, 0, "a", addr), newVote(100, 0, "b", sdk.ConsAddress("bar_________________"))), true},
+		{"invalid vote", types.NewConflictingVotes(newVote(0, 0, "a", addr), newVote(0, 0, "b", addr)), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+		})
+	}
+}
+
 func TestEvidenceAddressConversion(t *testing.T) {
 	sdk.GetConfig().SetBech32PrefixForConsensusNode("testcnclcons", "testcnclconspub")
 	tmEvidence := abci.Evidence{
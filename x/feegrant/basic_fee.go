@@ -7,7 +7,10 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ FeeAllowanceI = (*BasicAllowance)(nil)
+var (
+	_ FeeAllowanceI           = (*BasicAllowance)(nil)
+	_ RefundableFeeAllowanceI = (*BasicAllowance)(nil)
+)
 
 // Accept can use fee payment requested as well as timestamp of the current block
 // to determine whether or not to process this. This is checked in
@@ -37,6 +40,15 @@ func (a *BasicAllowance) Accept(ctx sdk.Context, fee sdk.Coins, _ []sdk.Msg) (bo
 	return false, nil
 }
 
+// Refund adds the refund, capped at the fee, back to the spend limit, if any.
+func (a *BasicAllowance) Refund(fee, refund sdk.Coins) error {
+	if a.SpendLimit != nil {
+		a.SpendLimit = a.SpendLimit.Add(refund.Min(fee)...)
+	}
+
+	return nil
+}
+
 // ValidateBasic implements FeeAllowance and enforces basic sanity checks
 func (a BasicAllowance) ValidateBasic() error {
 	if a.SpendLimit != nil {
@@ -28,3 +28,15 @@ type FeeAllowanceI interface {
 	// ExpiresAt returns the expiry time of the allowance.
 	ExpiresAt() (*time.Time, error)
 }
+
+// RefundableFeeAllowanceI is a FeeAllowance that can give back a part of the fees
+// it accepted when they are refunded, e.g. for the unused gas of a tx.
+type RefundableFeeAllowanceI interface {
+	FeeAllowanceI
+
+	// Refund adds the refunded part of the fees accepted in the same tx back to
+	// the allowance, capped at the accepted fees, so that the allowance never gets
+	// above what it was before the tx. It is called by Keeper.RefundGrantedFees, and
+	// the updated allowance is saved again.
+	Refund(fee, refund sdk.Coins) error
+}
@@ -259,6 +259,35 @@ func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress,
 	return k.UpdateAllowance(ctx, granter, grantee, grant)
 }
 
+// RefundGrantedFees gives the refunded part of the fee used by UseGrantedFees in
+// the same tx back to the allowance of the grantee, capped at the fee. Nothing is
+// refunded if the allowance was removed after being used up or cannot be refunded.
+func (k Keeper) RefundGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee, refund sdk.Coins) error {
+	f, err := k.getGrant(ctx, granter, grantee)
+	if err != nil {
+		if sdkerrors.ErrNotFound.Is(err) {
+			return nil
+		}
+		return err
+	}
+
+	grant, err := f.GetGrant()
+	if err != nil {
+		return err
+	}
+
+	refundable, ok := grant.(feegrant.RefundableFeeAllowanceI)
+	if !ok {
+		return nil
+	}
+
+	if err := refundable.Refund(fee, refund); err != nil {
+		return err
+	}
+
+	return k.UpdateAllowance(ctx, granter, grantee, refundable)
+}
+
 func emitUseGrantEvent(ctx sdk.Context, granter, grantee string) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -3,12 +3,14 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 )
@@ -242,6 +244,95 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 	suite.Contains(err.Error(), "fee-grant not found")
 }
 
+func (suite *KeeperTestSuite) TestRefundGrantedFees() {
+	oneYear := suite.sdkCtx.BlockTime().AddDate(1, 0, 0)
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	refund := sdk.NewCoins(sdk.NewInt64Coin("atom", 40))
+
+	basic := &feegrant.BasicAllowance{
+		SpendLimit: suite.atom,
+		Expiration: &oneYear,
+	}
+	periodic := &feegrant.PeriodicAllowance{
+		Basic:            *basic,
+		Period:           time.Hour,
+		PeriodSpendLimit: smallAtom.Add(smallAtom...),
+	}
+	filtered, err := feegrant.NewAllowedMsgAllowance(basic, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})})
+	suite.Require().NoError(err)
+
+	cases := map[string]struct {
+		allowance feegrant.FeeAllowanceI
+		fee       sdk.Coins
+		expected  feegrant.FeeAllowanceI
+	}{
+		"basic": {
+			allowance: basic,
+			fee:       smallAtom,
+			expected: &feegrant.BasicAllowance{
+				SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 495)),
+				Expiration: &oneYear,
+			},
+		},
+		"basic without spend limit": {
+			allowance: &feegrant.BasicAllowance{},
+			fee:       smallAtom,
+			expected:  &feegrant.BasicAllowance{},
+		},
+		"periodic": {
+			allowance: periodic,
+			fee:       smallAtom,
+			expected: &feegrant.PeriodicAllowance{
+				Basic: feegrant.BasicAllowance{
+					SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 495)),
+					Expiration: &oneYear,
+				},
+				Period:           time.Hour,
+				PeriodSpendLimit: smallAtom.Add(smallAtom...),
+				PeriodCanSpend:   sdk.NewCoins(sdk.NewInt64Coin("atom", 140)),
+				PeriodReset:      suite.sdkCtx.BlockTime().Add(time.Hour),
+			},
+		},
+		"used up": {
+			allowance: basic,
+			fee:       suite.atom,
+			expected:  nil,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		suite.Run(name, func() {
+			ctx, _ := suite.sdkCtx.CacheContext()
+			err := suite.keeper.GrantAllowance(ctx, suite.addrs[0], suite.addrs[1], tc.allowance)
+			suite.Require().NoError(err)
+
+			err = suite.keeper.UseGrantedFees(ctx, suite.addrs[0], suite.addrs[1], tc.fee, []sdk.Msg{})
+			suite.Require().NoError(err)
+			err = suite.keeper.RefundGrantedFees(ctx, suite.addrs[0], suite.addrs[1], tc.fee, refund)
+			suite.Require().NoError(err)
+
+			loaded, _ := suite.keeper.GetAllowance(ctx, suite.addrs[0], suite.addrs[1])
+			suite.Require().Equal(tc.expected, loaded)
+		})
+	}
+
+	// the allowance wrapped by an AllowedMsgAllowance is refunded
+	err = suite.keeper.GrantAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[2], filtered)
+	suite.Require().NoError(err)
+	msgs := []sdk.Msg{&banktypes.MsgSend{}}
+	err = suite.keeper.UseGrantedFees(suite.sdkCtx, suite.addrs[0], suite.addrs[2], smallAtom, msgs)
+	suite.Require().NoError(err)
+	err = suite.keeper.RefundGrantedFees(suite.sdkCtx, suite.addrs[0], suite.addrs[2], smallAtom, refund)
+	suite.Require().NoError(err)
+
+	loaded, err := suite.keeper.GetAllowance(suite.sdkCtx, suite.addrs[0], suite.addrs[2])
+	suite.Require().NoError(err)
+	inner, err := loaded.(*feegrant.AllowedMsgAllowance).GetAllowance()
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 495)), inner.(*feegrant.BasicAllowance).SpendLimit)
+}
+
 func (suite *KeeperTestSuite) TestIterateGrants() {
 	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
 	exp := suite.sdkCtx.BlockTime().AddDate(1, 0, 0)
@@ -7,7 +7,10 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ FeeAllowanceI = (*PeriodicAllowance)(nil)
+var (
+	_ FeeAllowanceI           = (*PeriodicAllowance)(nil)
+	_ RefundableFeeAllowanceI = (*PeriodicAllowance)(nil)
+)
 
 // Accept can use fee payment requested as well as timestamp of the current block
 // to determine whether or not to process this. This is checked in
@@ -47,6 +50,24 @@ func (a *PeriodicAllowance) Accept(ctx sdk.Context, fee sdk.Coins, _ []sdk.Msg)
 	return false, nil
 }
 
+// Refund adds the refund, capped at the fee, back to both the current period and the
+// max amount. As Accept may have reset the period before deducting the fees, the
+// current period is capped at min(PeriodSpendLimit, Basic.SpendLimit), like on a
+// period reset.
+func (a *PeriodicAllowance) Refund(fee, refund sdk.Coins) error {
+	if err := a.Basic.Refund(fee, refund); err != nil {
+		return err
+	}
+
+	periodLimit := a.PeriodSpendLimit
+	if !a.Basic.SpendLimit.Empty() {
+		periodLimit = periodLimit.Min(a.Basic.SpendLimit)
+	}
+	a.PeriodCanSpend = a.PeriodCanSpend.Add(refund.Min(fee)...).Min(periodLimit)
+
+	return nil
+}
+
 // tryResetPeriod will check if the PeriodReset has been hit. If not, it is a no-op.
 // If we hit the reset period, it will top up the PeriodCanSpend amount to
 // min(PeriodSpendLimit, Basic.SpendLimit) so it is never more than the maximum allowed.
@@ -210,3 +210,66 @@ func TestPeriodicFeeValidAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestPeriodicFeeRefundAfterReset(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{
+		Time: time.Now(),
+	})
+	now := ctx.BlockTime()
+
+	atom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amount)) }
+
+	cases := map[string]struct {
+		spendLimit   sdk.Coins
+		refund       sdk.Coins
+		canSpend     sdk.Coins
+		remainsLimit sdk.Coins
+	}{
+		"refund of the fee": {
+			spendLimit:   atom(1000),
+			refund:       atom(60),
+			canSpend:     atom(100),
+			remainsLimit: atom(1000),
+		},
+		"refund above the fee capped by the fee": {
+			spendLimit:   atom(1000),
+			refund:       atom(90),
+			canSpend:     atom(100),
+			remainsLimit: atom(1000),
+		},
+		"refund capped by the period spend limit": {
+			spendLimit:   atom(1000),
+			refund:       atom(50),
+			canSpend:     atom(90),
+			remainsLimit: atom(990),
+		},
+		"refund without spend limit": {
+			refund:   atom(90),
+			canSpend: atom(100),
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			allow := feegrant.PeriodicAllowance{
+				Basic:            feegrant.BasicAllowance{SpendLimit: tc.spendLimit},
+				Period:           time.Hour,
+				PeriodSpendLimit: atom(100),
+				PeriodCanSpend:   atom(10),
+				PeriodReset:      now.Add(-time.Minute),
+			}
+
+			// the period is reset before the fee is deducted
+			remove, err := allow.Accept(ctx, atom(60), []sdk.Msg{})
+			require.NoError(t, err)
+			require.False(t, remove)
+			require.Equal(t, now.Add(time.Hour-time.Minute), allow.PeriodReset)
+
+			require.NoError(t, allow.Refund(atom(60), tc.refund))
+			require.Equal(t, tc.canSpend, allow.PeriodCanSpend)
+			require.Equal(t, tc.remainsLimit, allow.Basic.SpendLimit)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package feemarket
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// EndBlocker burns the base fees paid by the txs of the block, and adjusts the base
+// fee of the next block toward the target gas.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if err := k.BurnBlockBaseFees(ctx); err != nil {
+		panic(err)
+	}
+
+	k.UpdateBaseFee(ctx, ctx.BlockGasMeter().GasConsumed())
+}
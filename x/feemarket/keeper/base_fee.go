@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BurnBlockBaseFees burns the base fees paid by the txs of the block in the base fee
+// denom through the burn path of the distribution Ratio. The base fees paid in the
+// alternative fee denoms, e.g. IBC vouchers whose supply is backed on their origin
+// chain, are not burned but sent to the community pool.
+func (k Keeper) BurnBlockBaseFees(ctx sdk.Context) error {
+	fees := k.GetBlockBaseFees(ctx)
+	if fees.IsZero() {
+		return nil
+	}
+
+	denom := k.GetParams(ctx).Denom
+	burn := sdk.NewCoins(sdk.NewCoin(denom, fees.AmountOf(denom)))
+	if !burn.IsZero() {
+		if err := k.distrKeeper.BurnCollectedFees(ctx, burn); err != nil {
+			return err
+		}
+	}
+
+	if other := fees.Sub(burn...); !other.IsZero() {
+		return k.distrKeeper.FundCommunityPoolFromCollectedFees(ctx, other)
+	}
+
+	return nil
+}
+
+// UpdateBaseFee sets the base fee of the next block from the gas used by the block.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context, gasUsed uint64) {
+	k.SetBaseFee(ctx, k.GetParams(ctx).NextBaseFee(k.GetBaseFee(ctx), gasUsed))
+}
@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// maxFeeValueBitLen bounds the bit length of the value of a fee in the base fee
+// denom, below the bit length of sdk.Dec to convert it without overflow.
+const maxFeeValueBitLen = 256
+
+var _ ante.TxFeeChecker = Keeper{}.CheckTxFee
+
+// CheckTxFee implements ante.TxFeeChecker. It checks in both CheckTx and DeliverTx that
+// the fee of the tx covers the base fee of the block for its gas limit, and in CheckTx
+// that it also meets the minimum gas prices of the validator. The base fee portion of
+// the fee is burned at the end of the block, and the tx priority is the tip per gas,
+// i.e. the fee per gas above the base fee.
+//
+// A fee paid in one of the alternative fee denoms of the params is converted into the
+// base fee denom at the price of the denom, and must consist of that single coin. It
+// meets the minimum gas prices of the validator either in its denom or converted, and
+// its base fee portion is sent to the community pool at the end of the block.
+func (k Keeper) CheckTxFee(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, 0, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// The params are not set while the genesis transactions are delivered, which
+	// then do not pay the base fee.
+	params := k.GetParams(ctx)
+	if params.Denom == "" {
+		return ante.CheckTxFeeWithValidatorMinGasPrices(ctx, tx)
+	}
+
+	feeCoins := feeTx.GetFee()
+	gas := feeTx.GetGas()
+
+	paid, price, err := k.getPaidFee(ctx, params, feeCoins)
+	if err != nil {
+		return nil, 0, err
+	}
+	converted := paid.Denom != params.Denom
+	value, err := convertFee(paid, price, params.Denom)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	minGasFees := feeCoins
+	if converted {
+		minGasFees = feeCoins.Add(value)
+	}
+	if err := ante.CheckValidatorMinGasPrices(ctx, minGasFees, gas); err != nil {
+		return nil, 0, err
+	}
+
+	baseFee := sdk.NewCoin(params.Denom, k.GetBaseFee(ctx).MulInt(sdk.NewIntFromUint64(gas)).Ceil().RoundInt())
+	if value.Amount.LT(baseFee.Amount) {
+		if converted {
+			return nil, 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s worth %s base fee required: %s", feeCoins, value, baseFee)
+		}
+		return nil, 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s base fee required: %s", feeCoins, baseFee)
+	}
+
+	if baseFee.IsPositive() {
+		paidBaseFee := baseFee
+		if converted {
+			// the base fee in the paid denom is rounded up, but cannot exceed the fee
+			amount := sdk.NewDecFromInt(baseFee.Amount).Quo(price).Ceil().RoundInt()
+			paidBaseFee = sdk.NewCoin(paid.Denom, sdk.MinInt(amount, paid.Amount))
+		}
+		k.addBlockBaseFee(ctx, paidBaseFee)
+		k.setTxBaseFee(ctx, paidBaseFee)
+	}
+
+	return feeCoins, getTxPriority(value.Amount.Sub(baseFee.Amount), gas), nil
+}
+
+// GetGasRefundRatio implements posthandler.GasRefundKeeper and returns the share of
+// the fee for the unused gas of a tx that is refunded.
+func (k Keeper) GetGasRefundRatio(ctx sdk.Context) sdk.Dec {
+	ratio := k.GetParams(ctx).GasRefundRatio
+	if ratio.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return ratio
+}
+
+// RefundTxFee implements posthandler.GasRefundKeeper. The same share of the base fee
+// paid by the tx as of its fee is refunded, and thus not burned at the end of the
+// block. As the fee covers the base fee, the refunded base fee, rounded down, never
+// exceeds the refunded fee.
+func (k Keeper) RefundTxFee(ctx sdk.Context, share sdk.Dec) {
+	baseFee, ok := k.popTxBaseFee(ctx)
+	if !ok {
+		return
+	}
+
+	if amount := share.MulInt(baseFee.Amount).TruncateInt(); amount.IsPositive() {
+		k.subBlockBaseFee(ctx, sdk.NewCoin(baseFee.Denom, amount))
+	}
+}
+
+// GetFeeDenomPrice returns the amount of the base fee denom one unit of the
+// alternative fee denom is worth. The price of the price oracle takes precedence
+// over the price in the params.
+func (k Keeper) GetFeeDenomPrice(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	return k.getFeeDenomPrice(ctx, k.GetParams(ctx), denom)
+}
+
+func (k Keeper) getFeeDenomPrice(ctx sdk.Context, params types.Params, denom string) (sdk.Dec, error) {
+	feeDenom, ok := params.GetFeeDenom(denom)
+	if !ok {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidFeeDenom, "%s is not a fee denom", denom)
+	}
+
+	if k.priceOracle != nil {
+		if price, ok := k.priceOracle.GetPrice(ctx, denom, params.Denom); ok {
+			if price.IsNil() || !price.IsPositive() {
+				return sdk.Dec{}, sdkerrors.Wrapf(types.ErrFeeConversion, "invalid oracle price of %s: %s", denom, price)
+			}
+			return price, nil
+		}
+	}
+
+	return feeDenom.Price, nil
+}
+
+// getPaidFee returns the coin of the fee the base fee is paid with and its price
+// in the base fee denom. It is the coin of an alternative fee denom, which must be
+// the only coin of the fee, or else the coin of the base fee denom.
+func (k Keeper) getPaidFee(ctx sdk.Context, params types.Params, feeCoins sdk.Coins) (sdk.Coin, sdk.Dec, error) {
+	for _, coin := range feeCoins {
+		if _, ok := params.GetFeeDenom(coin.Denom); !ok {
+			continue
+		}
+		if len(feeCoins) != 1 {
+			return sdk.Coin{}, sdk.Dec{}, sdkerrors.Wrapf(
+				types.ErrInvalidFeeDenom, "fees paid in %s cannot be combined with other denoms: %s", coin.Denom, feeCoins,
+			)
+		}
+
+		price, err := k.getFeeDenomPrice(ctx, params, coin.Denom)
+		if err != nil {
+			return sdk.Coin{}, sdk.Dec{}, err
+		}
+		return coin, price, nil
+	}
+
+	return sdk.NewCoin(params.Denom, feeCoins.AmountOf(params.Denom)), sdk.OneDec(), nil
+}
+
+// convertFee returns the value of the fee in the given denom at the given price,
+// rounded down.
+func convertFee(fee sdk.Coin, price sdk.Dec, denom string) (sdk.Coin, error) {
+	if fee.Denom == denom {
+		return fee, nil
+	}
+
+	// the product of the fee and the price has at most the sum of their bit lengths
+	if fee.Amount.BigInt().BitLen()+price.TruncateInt().BigInt().BitLen()+1 > maxFeeValueBitLen {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrFeeConversion, "fee %s is too large to convert at price %s", fee, price)
+	}
+
+	return sdk.NewCoin(denom, sdk.NewDecFromInt(fee.Amount).Mul(price).TruncateInt()), nil
+}
+
+// getTxPriority returns the tip per gas of the tx.
+func getTxPriority(tip sdk.Int, gas uint64) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	tipPerGas := tip.Quo(sdk.NewIntFromUint64(gas))
+	if !tipPerGas.IsInt64() {
+		return math.MaxInt64
+	}
+	return tipPerGas.Int64()
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryParamsResponse{Params: k.GetParams(sdk.UnwrapSDKContext(c))}, nil
+}
+
+// BaseFee implements the Query/BaseFee gRPC method
+func (k Keeper) BaseFee(c context.Context, req *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	baseFee := sdk.NewDecCoinFromDec(k.GetParams(ctx).Denom, k.GetBaseFee(ctx))
+	return &types.QueryBaseFeeResponse{BaseFee: baseFee}, nil
+}
+
+// FeeDenomPrice implements the Query/FeeDenomPrice gRPC method
+func (k Keeper) FeeDenomPrice(c context.Context, req *types.QueryFeeDenomPriceRequest) (*types.QueryFeeDenomPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	price, err := k.getFeeDenomPrice(ctx, params, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryFeeDenomPriceResponse{Price: sdk.NewDecCoinFromDec(params.Denom, price)}, nil
+}
@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	storeKey     storetypes.StoreKey
+	transientKey storetypes.StoreKey
+	cdc          codec.BinaryCodec
+	distrKeeper  types.DistributionKeeper
+	priceOracle  types.PriceOracle
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, storeKey, transientKey storetypes.StoreKey,
+	dk types.DistributionKeeper, authority string,
+) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		storeKey:     storeKey,
+		transientKey: transientKey,
+		cdc:          cdc,
+		distrKeeper:  dk,
+		authority:    authority,
+	}
+}
+
+// SetPriceOracle sets the oracle of the prices of the alternative fee denoms, which
+// takes precedence over the prices in the params.
+func (k *Keeper) SetPriceOracle(oracle types.PriceOracle) *Keeper {
+	if k.priceOracle != nil {
+		panic("cannot set price oracle twice")
+	}
+
+	k.priceOracle = oracle
+
+	return k
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the total set of feemarket parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the total set of feemarket parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// GetBaseFee returns the base fee per gas of the current block.
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(types.BaseFeeKey)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var baseFee sdk.Dec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return baseFee
+}
+
+// SetBaseFee sets the base fee per gas.
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.BaseFeeKey, bz)
+}
+
+// GetBlockBaseFees returns the base fees paid by the txs of the current block.
+func (k Keeper) GetBlockBaseFees(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.BlockBaseFeesKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	fees := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		fees = fees.Add(sdk.NewCoin(string(iterator.Key()[tmhash.Size:]), amount))
+	}
+	return fees
+}
+
+// addBlockBaseFee adds a base fee paid by the current tx to the base fees of the
+// block. The base fees are kept per tx, identified by the hash of its bytes, and
+// summed by GetBlockBaseFees at the end of the block, so that the txs paying base
+// fees do not depend on each other.
+func (k Keeper) addBlockBaseFee(ctx sdk.Context, fee sdk.Coin) {
+	store := k.txBlockBaseFeesStore(ctx)
+
+	amount := fee.Amount
+	if bz := store.Get([]byte(fee.Denom)); bz != nil {
+		var total sdk.Int
+		if err := total.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+		amount = amount.Add(total)
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(fee.Denom), bz)
+}
+
+// subBlockBaseFee subtracts a refunded base fee from the base fees paid by the
+// current tx.
+func (k Keeper) subBlockBaseFee(ctx sdk.Context, fee sdk.Coin) {
+	store := k.txBlockBaseFeesStore(ctx)
+
+	bz := store.Get([]byte(fee.Denom))
+	if bz == nil {
+		return
+	}
+
+	var total sdk.Int
+	if err := total.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	amount := total.Sub(sdk.MinInt(total, fee.Amount))
+	if amount.IsZero() {
+		store.Delete([]byte(fee.Denom))
+		return
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set([]byte(fee.Denom), bz)
+}
+
+// txBlockBaseFeesStore returns the store of the base fees paid by the current tx.
+func (k Keeper) txBlockBaseFeesStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.TransientStore(k.transientKey), types.BlockBaseFeesPrefix(tmhash.Sum(ctx.TxBytes())))
+}
+
+// setTxBaseFee sets the base fee paid by the current tx, to be refunded by
+// RefundTxFee.
+func (k Keeper) setTxBaseFee(ctx sdk.Context, fee sdk.Coin) {
+	ctx.TransientStore(k.transientKey).Set(types.TxBaseFeeKey(tmhash.Sum(ctx.TxBytes())), k.cdc.MustMarshal(&fee))
+}
+
+// popTxBaseFee returns and removes the base fee paid by the current tx.
+func (k Keeper) popTxBaseFee(ctx sdk.Context) (sdk.Coin, bool) {
+	store := ctx.TransientStore(k.transientKey)
+	key := types.TxBaseFeeKey(tmhash.Sum(ctx.TxBytes()))
+	bz := store.Get(key)
+	if bz == nil {
+		return sdk.Coin{}, false
+	}
+	store.Delete(key)
+
+	var fee sdk.Coin
+	k.cdc.MustUnmarshal(bz, &fee)
+	return fee, true
+}
@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app       *simapp.SimApp
+	ctx       sdk.Context
+	msgServer types.MsgServer
+	authority string
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = simapp.Setup(s.T(), false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	s.msgServer = keeper.NewMsgServerImpl(s.app.FeeMarketKeeper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) newTx(fee sdk.Coins, gas uint64) sdk.Tx {
+	txBuilder := simapp.MakeTestEncodingConfig().TxConfig.NewTxBuilder()
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(gas)
+	return txBuilder.GetTx()
+}
+
+func (s *KeeperTestSuite) TestCheckTxFee() {
+	k := s.app.FeeMarketKeeper
+	k.SetBaseFee(s.ctx, sdk.NewDecWithPrec(5, 1))
+
+	// the fee must cover the base fee for the gas limit
+	_, _, err := k.CheckTxFee(s.ctx, s.newTx(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 49)), 100))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+	_, _, err = k.CheckTxFee(s.ctx, s.newTx(sdk.NewCoins(sdk.NewInt64Coin("other", 100)), 100))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+	s.Require().True(k.GetBlockBaseFees(s.ctx).IsZero())
+
+	// the priority is the tip per gas
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 350))
+	res, priority, err := k.CheckTxFee(s.ctx, s.newTx(fee, 100))
+	s.Require().NoError(err)
+	s.Require().Equal(fee, res)
+	s.Require().Equal(int64(3), priority)
+
+	_, priority, err = k.CheckTxFee(s.ctx, s.newTx(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 51)), 101))
+	s.Require().NoError(err)
+	s.Require().Zero(priority)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 101)), k.GetBlockBaseFees(s.ctx))
+
+	// the validator minimum gas prices still apply to CheckTx
+	checkCtx := s.ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 1)))
+	_, _, err = k.CheckTxFee(checkCtx, s.newTx(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 99)), 100))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+}
+
+// priceOracle is a types.PriceOracle with fixed prices.
+type priceOracle map[string]sdk.Dec
+
+func (o priceOracle) GetPrice(_ sdk.Context, denom, _ string) (sdk.Dec, bool) {
+	price, ok := o[denom]
+	return price, ok
+}
+
+func (s *KeeperTestSuite) TestCheckTxFeeInFeeDenom() {
+	k := s.app.FeeMarketKeeper
+	params := k.GetParams(s.ctx)
+	params.FeeDenoms = []types.FeeDenom{types.NewFeeDenom("uusdc", sdk.NewDec(2))}
+	s.Require().NoError(k.SetParams(s.ctx, params))
+	k.SetBaseFee(s.ctx, sdk.NewDecWithPrec(5, 1))
+
+	// the fee is converted into the base fee denom
+	_, _, err := k.CheckTxFee(s.ctx, s.newTx(sdk.NewCoins(sdk.NewInt64Coin("uusdc", 24)), 100))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 175))
+	res, priority, err := k.CheckTxFee(s.ctx, s.newTx(fee, 100))
+	s.Require().NoError(err)
+	s.Require().Equal(fee, res)
+	s.Require().Equal(int64(3), priority)
+
+	// and its base fee portion is sent to the community pool instead of being burned
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uusdc", 25)), k.GetBlockBaseFees(s.ctx))
+	s.Require().NoError(banktestutil.FundModuleAccount(s.app.BankKeeper, s.ctx, authtypes.FeeCollectorName, fee))
+	supply := s.app.BankKeeper.GetSupply(s.ctx, "uusdc")
+	communityPool := s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx)
+	feemarket.EndBlocker(s.ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter()), k)
+	s.Require().Equal(supply, s.app.BankKeeper.GetSupply(s.ctx, "uusdc"))
+	s.Require().Equal(
+		communityPool.Add(sdk.NewInt64DecCoin("uusdc", 25)),
+		s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx),
+	)
+
+	// it cannot be combined with other denoms
+	_, _, err = k.CheckTxFee(s.ctx, s.newTx(fee.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)), 100))
+	s.Require().ErrorIs(err, types.ErrInvalidFeeDenom)
+
+	// it meets the validator minimum gas prices in either denom
+	checkCtx := s.ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 1)))
+	_, _, err = k.CheckTxFee(checkCtx, s.newTx(sdk.NewCoins(sdk.NewInt64Coin("uusdc", 49)), 100))
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+	_, _, err = k.CheckTxFee(checkCtx, s.newTx(sdk.NewCoins(sdk.NewInt64Coin("uusdc", 50)), 100))
+	s.Require().NoError(err)
+
+	// the oracle price takes precedence over the params
+	k.SetPriceOracle(priceOracle{"uusdc": sdk.NewDec(4)})
+	_, _, err = k.CheckTxFee(s.ctx, s.newTx(sdk.NewCoins(sdk.NewInt64Coin("uusdc", 13)), 100))
+	s.Require().NoError(err)
+
+	queryRes, err := k.FeeDenomPrice(sdk.WrapSDKContext(s.ctx), &types.QueryFeeDenomPriceRequest{Denom: "uusdc"})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(4)), queryRes.Price)
+	_, err = k.FeeDenomPrice(sdk.WrapSDKContext(s.ctx), &types.QueryFeeDenomPriceRequest{Denom: "other"})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestEndBlocker() {
+	k := s.app.FeeMarketKeeper
+	params := k.GetParams(s.ctx)
+	params.TargetGas = 1000
+	params.MinBaseFee = sdk.NewDecWithPrec(1, 1)
+	s.Require().NoError(k.SetParams(s.ctx, params))
+	k.SetBaseFee(s.ctx, sdk.NewDec(1))
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3000))
+	s.Require().NoError(banktestutil.FundModuleAccount(s.app.BankKeeper, s.ctx, authtypes.FeeCollectorName, fees))
+	_, _, err := k.CheckTxFee(s.ctx, s.newTx(fees, 2000))
+	s.Require().NoError(err)
+
+	supply := s.app.BankKeeper.GetSupply(s.ctx, sdk.DefaultBondDenom)
+	feeCollector := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	blockGasMeter := sdk.NewInfiniteGasMeter()
+	blockGasMeter.ConsumeGas(2000, "block gas")
+	feemarket.EndBlocker(s.ctx.WithBlockGasMeter(blockGasMeter), k)
+
+	// the base fee portion is burned and the tip is left to the fee collector
+	s.Require().Equal(supply.SubAmount(sdk.NewInt(2000)), s.app.BankKeeper.GetSupply(s.ctx, sdk.DefaultBondDenom))
+	s.Require().Equal(int64(1000), s.app.BankKeeper.GetBalance(s.ctx, feeCollector, sdk.DefaultBondDenom).Amount.Int64())
+	s.Require().Equal(sdk.MustNewDecFromStr("1.125"), k.GetBaseFee(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestRefundTxFee() {
+	k := s.app.FeeMarketKeeper
+	k.SetBaseFee(s.ctx, sdk.NewDec(1))
+
+	_, _, err := k.CheckTxFee(s.ctx, s.newTx(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)), 100))
+	s.Require().NoError(err)
+	_, _, err = k.CheckTxFee(s.ctx, s.newTx(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 205)), 205))
+	s.Require().NoError(err)
+
+	// the refunded share of the base fee of the last tx is not burned, rounded down
+	k.RefundTxFee(s.ctx, sdk.NewDecWithPrec(25, 2))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 254)), k.GetBlockBaseFees(s.ctx))
+
+	// and it is refunded once
+	k.RefundTxFee(s.ctx, sdk.NewDecWithPrec(25, 2))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 254)), k.GetBlockBaseFees(s.ctx))
+
+	// the base fees are kept per tx, so a tx refunds its own base fee whatever the
+	// txs executed after it
+	tx1, tx2 := s.ctx.WithTxBytes([]byte("tx1")), s.ctx.WithTxBytes([]byte("tx2"))
+	_, _, err = k.CheckTxFee(tx1, s.newTx(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), 100))
+	s.Require().NoError(err)
+	_, _, err = k.CheckTxFee(tx2, s.newTx(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)), 200))
+	s.Require().NoError(err)
+	k.RefundTxFee(tx1, sdk.NewDecWithPrec(5, 1))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 504)), k.GetBlockBaseFees(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	k := s.app.FeeMarketKeeper
+	k.SetBaseFee(s.ctx, sdk.NewDec(10))
+
+	params := types.DefaultParams()
+	params.MaxBaseFee = sdk.NewDec(5)
+	_, err := s.msgServer.UpdateParams(sdk.WrapSDKContext(s.ctx), &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().NoError(err)
+	s.Require().Equal(params, k.GetParams(s.ctx))
+	s.Require().Equal(sdk.NewDec(5), k.GetBaseFee(s.ctx))
+
+	_, err = s.msgServer.UpdateParams(sdk.WrapSDKContext(s.ctx), &types.MsgUpdateParams{Authority: s.authority[:len(s.authority)-1], Params: params})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	res, err := k.BaseFee(sdk.WrapSDKContext(s.ctx), &types.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(5)), res.BaseFee)
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/feemarket module sentinel errors
+var (
+	ErrInvalidParams   = sdkerrors.Register(ModuleName, 2, "invalid params")
+	ErrInvalidFeeDenom = sdkerrors.Register(ModuleName, 3, "invalid fee denom")
+	ErrFeeConversion   = sdkerrors.Register(ModuleName, 4, "fee conversion failed")
+)
@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DistributionKeeper defines the expected distribution keeper, which burns the base
+// fees paid by the txs, or moves them to the community pool.
+type DistributionKeeper interface {
+	BurnCollectedFees(ctx sdk.Context, fees sdk.Coins) error
+	FundCommunityPoolFromCollectedFees(ctx sdk.Context, fees sdk.Coins) error
+}
+
+// PriceOracle defines the expected oracle of the prices of the alternative fee
+// denoms. GetPrice returns the amount of the quote denom one unit of the denom is
+// worth, and false if it has no price for the denom.
+type PriceOracle interface {
+	GetPrice(ctx sdk.Context, denom, quoteDenom string) (sdk.Dec, bool)
+}
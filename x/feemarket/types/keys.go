@@ -0,0 +1,42 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feemarket"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// TStoreKey defines the transient store key
+	TStoreKey = "transient_" + ModuleName
+)
+
+// KVStore keys
+var (
+	// ParamsKey is the key to use for the x/feemarket parameters.
+	ParamsKey = []byte{0x01}
+	// BaseFeeKey is the key of the base fee per gas.
+	BaseFeeKey = []byte{0x02}
+)
+
+// Transient store keys
+var (
+	// BlockBaseFeesKey is the prefix of the base fees paid by the txs of the block,
+	// per tx and denom.
+	BlockBaseFeesKey = []byte{0x01}
+	// TxBaseFeesKey is the prefix of the base fee paid by each tx of the block, until
+	// it is refunded.
+	TxBaseFeesKey = []byte{0x02}
+)
+
+// BlockBaseFeesPrefix returns the prefix of the base fees paid by a tx, given the
+// hash of the tx.
+func BlockBaseFeesPrefix(txHash []byte) []byte {
+	return append(append([]byte{}, BlockBaseFeesKey...), txHash...)
+}
+
+// TxBaseFeeKey returns the key of the base fee paid by a tx, given the hash of the
+// tx.
+func TxBaseFeeKey(txHash []byte) []byte {
+	return append(append([]byte{}, TxBaseFeesKey...), txHash...)
+}
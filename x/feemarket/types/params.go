@@ -0,0 +1,138 @@
+package types
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
+)
+
+// Default values of the feemarket parameters
+var (
+	DefaultTargetGas      = uint64(10_000_000)
+	DefaultAdjustmentRate = sdk.NewDecWithPrec(125, 3)
+	DefaultMinBaseFee     = sdk.ZeroDec()
+	DefaultMaxBaseFee     = sdk.ZeroDec()
+	DefaultGasRefundRatio = sdk.ZeroDec()
+)
+
+// NewParams creates a new Params instance
+func NewParams(denom string, targetGas uint64, adjustmentRate, minBaseFee, maxBaseFee sdk.Dec) Params {
+	return Params{
+		Denom:          denom,
+		TargetGas:      targetGas,
+		AdjustmentRate: adjustmentRate,
+		MinBaseFee:     minBaseFee,
+		MaxBaseFee:     maxBaseFee,
+		GasRefundRatio: DefaultGasRefundRatio,
+	}
+}
+
+// DefaultParams returns the default feemarket parameters. With a zero minimum base
+// fee, the base fee stays at the genesis base fee, zero by default.
+func DefaultParams() Params {
+	return NewParams(sdk.DefaultBondDenom, DefaultTargetGas, DefaultAdjustmentRate, DefaultMinBaseFee, DefaultMaxBaseFee)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+	if p.TargetGas == 0 {
+		return fmt.Errorf("target gas must be positive")
+	}
+	if p.AdjustmentRate.IsNil() || p.AdjustmentRate.IsNegative() || p.AdjustmentRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("adjustment rate must be between 0 and 1: %s", p.AdjustmentRate)
+	}
+	if p.MinBaseFee.IsNil() || p.MinBaseFee.IsNegative() {
+		return fmt.Errorf("min base fee cannot be negative: %s", p.MinBaseFee)
+	}
+	if p.MaxBaseFee.IsNil() || p.MaxBaseFee.IsNegative() {
+		return fmt.Errorf("max base fee cannot be negative: %s", p.MaxBaseFee)
+	}
+	if p.MaxBaseFee.IsPositive() && p.MaxBaseFee.LT(p.MinBaseFee) {
+		return fmt.Errorf("max base fee %s is lower than min base fee %s", p.MaxBaseFee, p.MinBaseFee)
+	}
+	if p.GasRefundRatio.IsNil() || p.GasRefundRatio.IsNegative() || p.GasRefundRatio.GT(posthandler.MaxGasRefundRatio) {
+		return fmt.Errorf("gas refund ratio must be between 0 and %s: %s", posthandler.MaxGasRefundRatio, p.GasRefundRatio)
+	}
+
+	seen := make(map[string]bool, len(p.FeeDenoms))
+	for _, feeDenom := range p.FeeDenoms {
+		if err := feeDenom.Validate(); err != nil {
+			return err
+		}
+		if feeDenom.Denom == p.Denom {
+			return fmt.Errorf("fee denom %s is the base fee denom", feeDenom.Denom)
+		}
+		if seen[feeDenom.Denom] {
+			return fmt.Errorf("duplicate fee denom %s", feeDenom.Denom)
+		}
+		seen[feeDenom.Denom] = true
+	}
+
+	return nil
+}
+
+// GetFeeDenom returns the alternative fee denom with the given denom.
+func (p Params) GetFeeDenom(denom string) (FeeDenom, bool) {
+	for _, feeDenom := range p.FeeDenoms {
+		if feeDenom.Denom == denom {
+			return feeDenom, true
+		}
+	}
+	return FeeDenom{}, false
+}
+
+// NewFeeDenom creates a new FeeDenom instance
+func NewFeeDenom(denom string, price sdk.Dec) FeeDenom {
+	return FeeDenom{
+		Denom: denom,
+		Price: price,
+	}
+}
+
+// Validate validates the fee denom
+func (fd FeeDenom) Validate() error {
+	if err := sdk.ValidateDenom(fd.Denom); err != nil {
+		return fmt.Errorf("invalid fee denom: %w", err)
+	}
+	if fd.Price.IsNil() || !fd.Price.IsPositive() {
+		return fmt.Errorf("price of fee denom %s must be positive: %s", fd.Denom, fd.Price)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ClampBaseFee returns the base fee bounded by the min and max base fees.
+func (p Params) ClampBaseFee(baseFee sdk.Dec) sdk.Dec {
+	if baseFee.LT(p.MinBaseFee) {
+		return p.MinBaseFee
+	}
+	if p.MaxBaseFee.IsPositive() && baseFee.GT(p.MaxBaseFee) {
+		return p.MaxBaseFee
+	}
+	return baseFee
+}
+
+// NextBaseFee returns the base fee of the next block given the base fee and the gas
+// used by the current block. The base fee changes by the adjustment rate times the
+// relative deviation of the gas used from the target gas, which is capped to one, and
+// it is bounded by the min and max base fees.
+func (p Params) NextBaseFee(baseFee sdk.Dec, gasUsed uint64) sdk.Dec {
+	target := sdk.NewDecFromInt(sdk.NewIntFromUint64(p.TargetGas))
+	deviation := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed)).Sub(target).Quo(target)
+	if deviation.GT(sdk.OneDec()) {
+		deviation = sdk.OneDec()
+	}
+
+	return p.ClampBaseFee(baseFee.Add(baseFee.Mul(p.AdjustmentRate).Mul(deviation)))
+}
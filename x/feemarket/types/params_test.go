@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	testCases := []struct {
+		name     string
+		malleate func(*types.Params)
+	}{
+		{"invalid denom", func(p *types.Params) { p.Denom = "" }},
+		{"zero target gas", func(p *types.Params) { p.TargetGas = 0 }},
+		{"negative adjustment rate", func(p *types.Params) { p.AdjustmentRate = sdk.NewDec(-1) }},
+		{"adjustment rate above one", func(p *types.Params) { p.AdjustmentRate = sdk.NewDec(2) }},
+		{"negative min base fee", func(p *types.Params) { p.MinBaseFee = sdk.NewDec(-1) }},
+		{"max base fee below min base fee", func(p *types.Params) {
+			p.MinBaseFee = sdk.NewDec(2)
+			p.MaxBaseFee = sdk.NewDec(1)
+		}},
+		{"negative gas refund ratio", func(p *types.Params) { p.GasRefundRatio = sdk.NewDec(-1) }},
+		{"gas refund ratio above max", func(p *types.Params) { p.GasRefundRatio = sdk.NewDecWithPrec(6, 1) }},
+		{"invalid fee denom", func(p *types.Params) { p.FeeDenoms = []types.FeeDenom{types.NewFeeDenom("", sdk.OneDec())} }},
+		{"zero fee denom price", func(p *types.Params) { p.FeeDenoms = []types.FeeDenom{types.NewFeeDenom("uusdc", sdk.ZeroDec())} }},
+		{"base fee denom as fee denom", func(p *types.Params) { p.FeeDenoms = []types.FeeDenom{types.NewFeeDenom(p.Denom, sdk.OneDec())} }},
+		{"duplicate fee denom", func(p *types.Params) {
+			p.FeeDenoms = []types.FeeDenom{types.NewFeeDenom("uusdc", sdk.OneDec()), types.NewFeeDenom("uusdc", sdk.NewDec(2))}
+		}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.malleate(&params)
+			require.Error(t, params.Validate())
+		})
+	}
+}
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.DefaultParams()
+	params.TargetGas = 1000
+	params.MinBaseFee = sdk.NewDecWithPrec(5, 1)
+	params.MaxBaseFee = sdk.NewDec(20)
+	baseFee := sdk.NewDec(10)
+
+	testCases := []struct {
+		name    string
+		baseFee sdk.Dec
+		gasUsed uint64
+		exp     sdk.Dec
+	}{
+		{"target gas", baseFee, 1000, baseFee},
+		{"twice the target gas", baseFee, 2000, sdk.MustNewDecFromStr("11.25")},
+		{"more than twice the target gas", baseFee, 5000, sdk.MustNewDecFromStr("11.25")},
+		{"half the target gas", baseFee, 500, sdk.MustNewDecFromStr("9.375")},
+		{"no gas", baseFee, 0, sdk.MustNewDecFromStr("8.75")},
+		{"min base fee", sdk.NewDecWithPrec(5, 1), 0, sdk.NewDecWithPrec(5, 1)},
+		{"max base fee", sdk.NewDec(20), 2000, sdk.NewDec(20)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, params.NextBaseFee(tc.baseFee, tc.gasUsed))
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/gasschedule/types"
+)
+
+// GetQueryCmd returns the cli query commands for the gasschedule module.
+func GetQueryCmd() *cobra.Command {
+	gasScheduleQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the gasschedule module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	gasScheduleQueryCmd.AddCommand(
+		GetCmdQueryGasSchedule(),
+	)
+
+	return gasScheduleQueryCmd
+}
+
+// GetCmdQueryGasSchedule implements a command to return the gas schedule.
+func GetCmdQueryGasSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "gas-schedule",
+		Short:   "Query the gas schedule",
+		Example: fmt.Sprintf("%s query %s gas-schedule", version.AppName, types.ModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GasSchedule(cmd.Context(), &types.QueryGasScheduleRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.GasSchedule)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
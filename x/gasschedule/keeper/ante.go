@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/gasschedule/types"
+)
+
+var (
+	_ ante.ContextSignatureVerificationGasConsumer = Keeper{}.SigVerificationGasConsumer
+	_ ante.MsgGasConsumer                          = Keeper{}.MsgGasConsumer
+	_ ante.TxPriorityBooster                       = Keeper{}.TxPriorityBooster
+)
+
+// SigVerificationGasConsumer implements ante.ContextSignatureVerificationGasConsumer with
+// the signature verification costs of the gas schedule stored in the state. The
+// signatures of public key types without a cost in the gas schedule are charged the
+// x/auth params costs by ante.DefaultSigVerificationGasConsumer, which also accepts or
+// rejects the public key types.
+func (k Keeper) SigVerificationGasConsumer(ctx sdk.Context, meter sdk.GasMeter, sig signing.SignatureV2, params authtypes.Params) error {
+	return consumeSigVerificationGas(k.gasSchedule(ctx), meter, sig, params)
+}
+
+// consumeSigVerificationGas consumes the gas of a signature with the costs of the gas
+// schedule.
+func consumeSigVerificationGas(schedule types.GasSchedule, meter sdk.GasMeter, sig signing.SignatureV2, params authtypes.Params) error {
+	if pubkey, ok := sig.PubKey.(multisig.PubKey); ok {
+		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
+		if !ok {
+			return fmt.Errorf("expected %T, got, %T", &signing.MultiSignatureData{}, sig.Data)
+		}
+		return consumeMultisignatureVerificationGas(schedule, meter, multisignature, pubkey, params, sig.Sequence)
+	}
+
+	typeURL := "/" + proto.MessageName(sig.PubKey)
+	cost, ok := schedule.SigVerifyCost(typeURL)
+	if !ok {
+		return ante.DefaultSigVerificationGasConsumer(meter, sig, params)
+	}
+
+	meter.ConsumeGas(cost, "ante verify: "+typeURL)
+	return ante.DefaultSigVerificationGasConsumer(sdk.NewInfiniteGasMeter(), sig, params)
+}
+
+// consumeMultisignatureVerificationGas consumes the gas of the signatures of the keys
+// of a multisig public key.
+func consumeMultisignatureVerificationGas(
+	schedule types.GasSchedule, meter sdk.GasMeter, sig *signing.MultiSignatureData,
+	pubkey multisig.PubKey, params authtypes.Params, accSeq uint64,
+) error {
+	size := sig.BitArray.Count()
+	sigIndex := 0
+
+	for i := 0; i < size; i++ {
+		if !sig.BitArray.GetIndex(i) {
+			continue
+		}
+		sigV2 := signing.SignatureV2{
+			PubKey:   pubkey.GetPubKeys()[i],
+			Data:     sig.Signatures[sigIndex],
+			Sequence: accSeq,
+		}
+		if err := consumeSigVerificationGas(schedule, meter, sigV2, params); err != nil {
+			return err
+		}
+		sigIndex++
+	}
+
+	return nil
+}
+
+// MsgGasConsumer implements ante.MsgGasConsumer with the Msg costs of the gas schedule
+// stored in the state. Only the Msgs of the tx are charged, not the Msgs they may
+// execute.
+func (k Keeper) MsgGasConsumer(ctx sdk.Context, msg sdk.Msg) error {
+	typeURL := sdk.MsgTypeURL(msg)
+	if cost := k.gasSchedule(ctx).MsgCost(typeURL); cost > 0 {
+		ctx.GasMeter().ConsumeGas(cost, "msg: "+typeURL)
+	}
+
+	return nil
+}
+
+// TxPriorityBooster implements ante.TxPriorityBooster with the priority boost of the
+// priority lane of the tx in the gas schedule stored in the state.
+func (k Keeper) TxPriorityBooster(ctx sdk.Context, tx sdk.Tx) int64 {
+	lane, ok := k.gasSchedule(ctx).PriorityLaneOf(tx)
+	if !ok {
+		return 0
+	}
+
+	return lane.PriorityBoost
+}
+
+// gasSchedule returns the gas schedule stored in the state of the tx, without
+// consuming the gas of the tx.
+func (k Keeper) gasSchedule(ctx sdk.Context) types.GasSchedule {
+	return k.GetGasSchedule(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+}
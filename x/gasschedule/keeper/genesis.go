@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gasschedule/types"
+)
+
+// InitGenesis sets the gas schedule from the genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := k.SetGasSchedule(ctx, genState.GasSchedule); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis returns the gasschedule module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetGasSchedule(ctx))
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gasschedule/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// GasSchedule implements the Query/GasSchedule gRPC method
+func (k Keeper) GasSchedule(c context.Context, req *types.QueryGasScheduleRequest) (*types.QueryGasScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryGasScheduleResponse{GasSchedule: k.GetGasSchedule(sdk.UnwrapSDKContext(c))}, nil
+}
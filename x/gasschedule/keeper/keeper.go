@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gasschedule/types"
+)
+
+// Keeper - gasschedule keeper
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	// the address capable of updating the gas schedule. Typically, this should be
+	// the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new Keeper object
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, authority string) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		storeKey:  storeKey,
+		cdc:       cdc,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the x/gasschedule module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetGasSchedule returns the gas schedule stored in the state, or the default gas
+// schedule if none is stored.
+func (k Keeper) GetGasSchedule(ctx sdk.Context) types.GasSchedule {
+	bz := ctx.KVStore(k.storeKey).Get(types.GasScheduleKey)
+	if bz == nil {
+		return types.DefaultGasSchedule()
+	}
+
+	var schedule types.GasSchedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return schedule
+}
+
+// SetGasSchedule stores the gas schedule in the state. Its store gas configs and
+// priority lanes are loaded at the start of the next block, while its other costs
+// apply to the txs executed on the state. The priority lanes cannot reserve more than
+// MaxReservedGasShare of the block max gas of the context consensus params.
+func (k Keeper) SetGasSchedule(ctx sdk.Context, schedule types.GasSchedule) error {
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+	if err := schedule.ValidateReservedGas(ctx.ConsensusParams().GetBlock().GetMaxGas()); err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GasScheduleKey, k.cdc.MustMarshal(&schedule))
+	return nil
+}
+
+var _ baseapp.GasConfigLoader = Keeper{}.LoadGasConfigs
+
+// LoadGasConfigs implements baseapp.GasConfigLoader. It returns the store gas configs
+// of the gas schedule stored in the state.
+func (k Keeper) LoadGasConfigs(ctx sdk.Context) (kv, transient storetypes.GasConfig) {
+	schedule := k.GetGasSchedule(ctx)
+	return schedule.KVStore.StoreGasConfig(), schedule.TransientStore.StoreGasConfig()
+}
+
+var _ baseapp.PriorityLaneLoader = Keeper{}.LoadPriorityLanes
+
+// LoadPriorityLanes implements baseapp.PriorityLaneLoader. It returns the priority
+// lanes of the gas schedule stored in the state.
+func (k Keeper) LoadPriorityLanes(ctx sdk.Context) []baseapp.PriorityLane {
+	schedule := k.GetGasSchedule(ctx)
+
+	lanes := make([]baseapp.PriorityLane, len(schedule.PriorityLanes))
+	for i, lane := range schedule.PriorityLanes {
+		lanes[i] = baseapp.PriorityLane{
+			Name:        lane.Name,
+			MsgTypeURLs: lane.MsgTypeURLs,
+			ReservedGas: lane.ReservedGas,
+		}
+	}
+
+	return lanes
+}
@@ -0,0 +1,194 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gasschedule/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gasschedule/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	secp256k1TypeURL = "/cosmos.crypto.secp256k1.PubKey"
+	msgSendTypeURL   = sdk.MsgTypeURL(&banktypes.MsgSend{})
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app       *simapp.SimApp
+	ctx       sdk.Context
+	msgServer types.MsgServer
+	authority string
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.app = simapp.Setup(s.T(), false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{})
+	s.msgServer = keeper.NewMsgServerImpl(s.app.GasScheduleKeeper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) customSchedule() types.GasSchedule {
+	schedule := types.DefaultGasSchedule()
+	schedule.KVStore.WriteCostFlat = 1000
+	schedule.TransientStore.ReadCostFlat = 50
+	schedule.SigVerifyCosts = []types.GasCost{{TypeUrl: secp256k1TypeURL, Cost: 1500}}
+	schedule.MsgCosts = []types.GasCost{{TypeUrl: msgSendTypeURL, Cost: 300}}
+	return schedule
+}
+
+func (s *KeeperTestSuite) TestUpdateGasSchedule() {
+	k := s.app.GasScheduleKeeper
+	s.Require().Equal(types.DefaultGasSchedule(), k.GetGasSchedule(s.ctx))
+
+	schedule := s.customSchedule()
+	_, err := s.msgServer.UpdateGasSchedule(sdk.WrapSDKContext(s.ctx), &types.MsgUpdateGasSchedule{Authority: s.authority, GasSchedule: schedule})
+	s.Require().NoError(err)
+	s.Require().Equal(schedule, k.GetGasSchedule(s.ctx))
+
+	// only the authority updates the gas schedule
+	_, err = s.msgServer.UpdateGasSchedule(sdk.WrapSDKContext(s.ctx), &types.MsgUpdateGasSchedule{Authority: s.authority[:len(s.authority)-1], GasSchedule: types.DefaultGasSchedule()})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	invalid := s.customSchedule()
+	invalid.MsgCosts = append(invalid.MsgCosts, types.GasCost{TypeUrl: msgSendTypeURL, Cost: 1})
+	_, err = s.msgServer.UpdateGasSchedule(sdk.WrapSDKContext(s.ctx), &types.MsgUpdateGasSchedule{Authority: s.authority, GasSchedule: invalid})
+	s.Require().ErrorIs(err, types.ErrInvalidGasSchedule)
+	s.Require().Equal(schedule, k.GetGasSchedule(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestLoadGasConfigs() {
+	k := s.app.GasScheduleKeeper
+	schedule := s.customSchedule()
+	s.Require().NoError(k.SetGasSchedule(s.ctx, schedule))
+
+	kv, transient := k.LoadGasConfigs(s.ctx)
+	s.Require().Equal(uint64(1000), kv.WriteCostFlat)
+	s.Require().Equal(storetypes.KVGasConfig().ReadCostFlat, kv.ReadCostFlat)
+	s.Require().Equal(uint64(50), transient.ReadCostFlat)
+
+	res, err := k.GasSchedule(sdk.WrapSDKContext(s.ctx), &types.QueryGasScheduleRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(schedule, res.GasSchedule)
+}
+
+func (s *KeeperTestSuite) TestSigVerificationGasConsumer() {
+	k := s.app.GasScheduleKeeper
+	params := s.app.AccountKeeper.GetParams(s.ctx)
+	s.Require().NoError(k.SetGasSchedule(s.ctx, s.customSchedule()))
+
+	r1Key, err := secp256r1.GenPrivKey()
+	s.Require().NoError(err)
+	pubKeys := []cryptotypes.PubKey{secp256k1.GenPrivKey().PubKey(), r1Key.PubKey()}
+	multisigKey := multisig.NewLegacyAminoPubKey(2, pubKeys)
+	multisignature := multisigtypes.NewMultisig(len(pubKeys))
+	for _, pubKey := range pubKeys {
+		s.Require().NoError(multisigtypes.AddSignatureV2(multisignature, signing.SignatureV2{PubKey: pubKey, Data: &signing.SingleSignatureData{}}, pubKeys))
+	}
+
+	testCases := []struct {
+		name string
+		sig  signing.SignatureV2
+		gas  uint64
+	}{
+		{"secp256k1 cost of the gas schedule", signing.SignatureV2{PubKey: pubKeys[0], Data: &signing.SingleSignatureData{}}, 1500},
+		{"secp256r1 cost of the params", signing.SignatureV2{PubKey: pubKeys[1], Data: &signing.SingleSignatureData{}}, params.SigVerifyCostSecp256r1()},
+		{"multisig", signing.SignatureV2{PubKey: multisigKey, Data: multisignature}, 1500 + params.SigVerifyCostSecp256r1()},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			meter := sdk.NewInfiniteGasMeter()
+			s.Require().NoError(k.SigVerificationGasConsumer(s.ctx, meter, tc.sig, params))
+			s.Require().Equal(tc.gas, meter.GasConsumed())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgGasConsumer() {
+	k := s.app.GasScheduleKeeper
+	meter := sdk.NewInfiniteGasMeter()
+	ctx := s.ctx.WithGasMeter(meter)
+	s.Require().NoError(k.MsgGasConsumer(ctx, &banktypes.MsgSend{}))
+	s.Require().Zero(meter.GasConsumed())
+
+	// the costs of the gas schedule stored in the state apply, and reading it does not
+	// consume the gas of the tx
+	s.Require().NoError(k.SetGasSchedule(s.ctx, s.customSchedule()))
+	s.Require().NoError(k.MsgGasConsumer(ctx, &banktypes.MsgSend{}))
+	s.Require().Equal(uint64(300), meter.GasConsumed())
+	s.Require().NoError(k.MsgGasConsumer(ctx, &banktypes.MsgMultiSend{}))
+	s.Require().Equal(uint64(300), meter.GasConsumed())
+}
+
+func (s *KeeperTestSuite) TestPriorityLanes() {
+	k := s.app.GasScheduleKeeper
+	schedule := s.customSchedule()
+	schedule.PriorityLanes = []types.PriorityLane{{
+		Name:          "send",
+		MsgTypeURLs:   []string{msgSendTypeURL},
+		PriorityBoost: 1000,
+		ReservedGas:   50000,
+	}}
+	txBuilder := simapp.MakeTestEncodingConfig().TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(&banktypes.MsgSend{}))
+	tx := txBuilder.GetTx()
+	s.Require().Zero(k.TxPriorityBooster(s.ctx, tx))
+	s.Require().NoError(k.SetGasSchedule(s.ctx, schedule))
+
+	// the lanes are loaded from the state
+	s.Require().Equal([]baseapp.PriorityLane{{
+		Name:        "send",
+		MsgTypeURLs: []string{msgSendTypeURL},
+		ReservedGas: 50000,
+	}}, k.LoadPriorityLanes(s.ctx))
+
+	// and so is the priority boost
+	s.Require().Equal(int64(1000), k.TxPriorityBooster(s.ctx, tx))
+
+	s.Require().NoError(txBuilder.SetMsgs(&banktypes.MsgMultiSend{}))
+	s.Require().Zero(k.TxPriorityBooster(s.ctx, txBuilder.GetTx()))
+}
+
+func (s *KeeperTestSuite) TestPriorityLanesReservedGasLimit() {
+	k := s.app.GasScheduleKeeper
+	schedule := s.customSchedule()
+	schedule.PriorityLanes = []types.PriorityLane{{
+		Name:        "send",
+		MsgTypeURLs: []string{msgSendTypeURL},
+		ReservedGas: 50000,
+	}}
+
+	// the lanes can reserve half of the block max gas
+	ctx := s.ctx.WithConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxBytes: 200000, MaxGas: 100000}})
+	s.Require().NoError(k.SetGasSchedule(ctx, schedule))
+
+	// but not more
+	schedule.PriorityLanes[0].ReservedGas = 50001
+	s.Require().ErrorIs(k.SetGasSchedule(ctx, schedule), types.ErrInvalidGasSchedule)
+	s.Require().Equal(uint64(50000), k.GetGasSchedule(ctx).TotalReservedGas())
+
+	// without a block gas limit, no gas is reserved
+	ctx = s.ctx.WithConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxBytes: 200000, MaxGas: -1}})
+	s.Require().NoError(k.SetGasSchedule(ctx, schedule))
+}
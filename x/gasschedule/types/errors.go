@@ -0,0 +1,8 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/gasschedule module sentinel errors
+var ErrInvalidGasSchedule = sdkerrors.Register(ModuleName, 2, "invalid gas schedule")
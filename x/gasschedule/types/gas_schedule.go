@@ -0,0 +1,183 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// MaxReservedGasShare is the inverse of the maximum share of the block max gas that
+// the priority lanes can reserve in total, so that the txs of no lane can still use
+// half of every block.
+const MaxReservedGasShare = 2
+
+// DefaultGasSchedule returns the gas schedule matching the default gas costs of the
+// SDK: the default KVStore and transient store gas configs, the x/auth params costs
+// for every signature and no Msg costs.
+func DefaultGasSchedule() GasSchedule {
+	return GasSchedule{
+		KVStore:        NewGasConfig(storetypes.KVGasConfig()),
+		TransientStore: NewGasConfig(storetypes.TransientGasConfig()),
+	}
+}
+
+// Validate returns an error if the gas schedule is invalid.
+func (s GasSchedule) Validate() error {
+	if err := validateGasCosts(s.SigVerifyCosts); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidGasSchedule, "signature verification costs: %s", err)
+	}
+	if err := validateGasCosts(s.MsgCosts); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidGasSchedule, "msg costs: %s", err)
+	}
+	if err := validatePriorityLanes(s.PriorityLanes); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidGasSchedule, "priority lanes: %s", err)
+	}
+
+	return nil
+}
+
+// ValidateReservedGas returns an error if the total gas reserved by the priority
+// lanes exceeds MaxReservedGasShare of the block max gas. A non-positive block max
+// gas, i.e. no block gas limit, reserves no gas.
+func (s GasSchedule) ValidateReservedGas(blockMaxGas int64) error {
+	if blockMaxGas <= 0 {
+		return nil
+	}
+
+	maxReserved := uint64(blockMaxGas) / MaxReservedGasShare
+	if reserved := s.TotalReservedGas(); reserved > maxReserved {
+		return sdkerrors.Wrapf(
+			ErrInvalidGasSchedule, "total reserved gas %d exceeds 1/%d of the block max gas %d", reserved, MaxReservedGasShare, blockMaxGas,
+		)
+	}
+
+	return nil
+}
+
+// TotalReservedGas returns the total gas reserved by the priority lanes.
+func (s GasSchedule) TotalReservedGas() uint64 {
+	var total uint64
+	for _, lane := range s.PriorityLanes {
+		total += lane.ReservedGas
+	}
+	return total
+}
+
+// SigVerifyCost returns the cost of verifying a signature of a public key type, and
+// false if the gas schedule has no cost for the type.
+func (s GasSchedule) SigVerifyCost(pubKeyTypeURL string) (uint64, bool) {
+	return findGasCost(s.SigVerifyCosts, pubKeyTypeURL)
+}
+
+// MsgCost returns the flat cost charged for a Msg type, or zero if the gas schedule
+// has no cost for the type.
+func (s GasSchedule) MsgCost(msgTypeURL string) uint64 {
+	cost, _ := findGasCost(s.MsgCosts, msgTypeURL)
+	return cost
+}
+
+// PriorityLaneOf returns the first priority lane of the tx, i.e. whose type URLs
+// include the type URLs of all the Msgs of the tx, and false if the tx has none.
+func (s GasSchedule) PriorityLaneOf(tx sdk.Tx) (PriorityLane, bool) {
+	for _, lane := range s.PriorityLanes {
+		if mempool.MatchMsgTypeURLs(lane.MsgTypeURLs...)(tx) {
+			return lane, true
+		}
+	}
+
+	return PriorityLane{}, false
+}
+
+// NewGasConfig returns the GasConfig of a store gas config.
+func NewGasConfig(config storetypes.GasConfig) GasConfig {
+	return GasConfig{
+		HasCost:          config.HasCost,
+		DeleteCost:       config.DeleteCost,
+		ReadCostFlat:     config.ReadCostFlat,
+		ReadCostPerByte:  config.ReadCostPerByte,
+		WriteCostFlat:    config.WriteCostFlat,
+		WriteCostPerByte: config.WriteCostPerByte,
+		IterNextCostFlat: config.IterNextCostFlat,
+	}
+}
+
+// StoreGasConfig returns the store gas config of the GasConfig.
+func (c GasConfig) StoreGasConfig() storetypes.GasConfig {
+	return storetypes.GasConfig{
+		HasCost:          c.HasCost,
+		DeleteCost:       c.DeleteCost,
+		ReadCostFlat:     c.ReadCostFlat,
+		ReadCostPerByte:  c.ReadCostPerByte,
+		WriteCostFlat:    c.WriteCostFlat,
+		WriteCostPerByte: c.WriteCostPerByte,
+		IterNextCostFlat: c.IterNextCostFlat,
+	}
+}
+
+func validateGasCosts(costs []GasCost) error {
+	seen := make(map[string]bool, len(costs))
+	for _, c := range costs {
+		if !strings.HasPrefix(c.TypeUrl, "/") {
+			return fmt.Errorf("invalid type URL %q", c.TypeUrl)
+		}
+		if seen[c.TypeUrl] {
+			return fmt.Errorf("duplicate cost for type URL %s", c.TypeUrl)
+		}
+		seen[c.TypeUrl] = true
+	}
+
+	return nil
+}
+
+func validatePriorityLanes(lanes []PriorityLane) error {
+	names := make(map[string]bool, len(lanes))
+	typeURLs := make(map[string]bool)
+	var reserved uint64
+	for _, lane := range lanes {
+		if lane.Name == "" {
+			return fmt.Errorf("empty lane name")
+		}
+		if names[lane.Name] {
+			return fmt.Errorf("duplicate lane %s", lane.Name)
+		}
+		names[lane.Name] = true
+
+		if len(lane.MsgTypeURLs) == 0 {
+			return fmt.Errorf("lane %s has no msg type URLs", lane.Name)
+		}
+		for _, typeURL := range lane.MsgTypeURLs {
+			if !strings.HasPrefix(typeURL, "/") {
+				return fmt.Errorf("invalid type URL %q of lane %s", typeURL, lane.Name)
+			}
+			if typeURLs[typeURL] {
+				return fmt.Errorf("type URL %s is in several lanes", typeURL)
+			}
+			typeURLs[typeURL] = true
+		}
+
+		if lane.PriorityBoost < 0 {
+			return fmt.Errorf("negative priority boost of lane %s: %d", lane.Name, lane.PriorityBoost)
+		}
+
+		if reserved+lane.ReservedGas < reserved {
+			return fmt.Errorf("total reserved gas overflows at lane %s", lane.Name)
+		}
+		reserved += lane.ReservedGas
+	}
+
+	return nil
+}
+
+func findGasCost(costs []GasCost, typeURL string) (uint64, bool) {
+	for _, c := range costs {
+		if c.TypeUrl == typeURL {
+			return c.Cost, true
+		}
+	}
+
+	return 0, false
+}
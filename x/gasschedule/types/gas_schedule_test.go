@@ -0,0 +1,137 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gasschedule/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestGasScheduleValidate(t *testing.T) {
+	require.NoError(t, types.DefaultGasSchedule().Validate())
+
+	schedule := types.DefaultGasSchedule()
+	schedule.SigVerifyCosts = []types.GasCost{{TypeUrl: "/cosmos.crypto.secp256k1.PubKey", Cost: 1000}}
+	schedule.MsgCosts = []types.GasCost{{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Cost: 100}}
+	require.NoError(t, schedule.Validate())
+
+	schedule.MsgCosts = append(schedule.MsgCosts, types.GasCost{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Cost: 200})
+	require.ErrorIs(t, schedule.Validate(), types.ErrInvalidGasSchedule)
+
+	schedule.MsgCosts = nil
+	schedule.SigVerifyCosts = []types.GasCost{{TypeUrl: "cosmos.crypto.secp256k1.PubKey", Cost: 1000}}
+	require.ErrorIs(t, schedule.Validate(), types.ErrInvalidGasSchedule)
+}
+
+func TestGasSchedulePriorityLanes(t *testing.T) {
+	voteTypeURL := "/cosmos.gov.v1.MsgVote"
+	updateClientTypeURL := "/ibc.core.client.v1.MsgUpdateClient"
+
+	schedule := types.DefaultGasSchedule()
+	schedule.PriorityLanes = []types.PriorityLane{
+		{Name: "gov", MsgTypeURLs: []string{voteTypeURL}, PriorityBoost: 1000},
+		{Name: "ibc", MsgTypeURLs: []string{updateClientTypeURL}, ReservedGas: 1000000},
+	}
+	require.NoError(t, schedule.Validate())
+
+	lane, ok := schedule.PriorityLaneOf(newTx(&govv1.MsgVote{}, &govv1.MsgVote{}))
+	require.True(t, ok)
+	require.Equal(t, "gov", lane.Name)
+	_, ok = schedule.PriorityLaneOf(newTx(&govv1.MsgVote{}, &banktypes.MsgSend{}))
+	require.False(t, ok)
+
+	invalid := []types.PriorityLane{
+		{Name: "", MsgTypeURLs: []string{voteTypeURL}},
+		{Name: "gov", MsgTypeURLs: nil},
+		{Name: "gov", MsgTypeURLs: []string{"cosmos.gov.v1.MsgVote"}},
+		{Name: "gov", MsgTypeURLs: []string{voteTypeURL}, PriorityBoost: -1},
+	}
+	for _, lane := range invalid {
+		schedule.PriorityLanes = []types.PriorityLane{lane}
+		require.ErrorIs(t, schedule.Validate(), types.ErrInvalidGasSchedule)
+	}
+
+	schedule.PriorityLanes = []types.PriorityLane{
+		{Name: "gov", MsgTypeURLs: []string{voteTypeURL}},
+		{Name: "gov", MsgTypeURLs: []string{updateClientTypeURL}},
+	}
+	require.ErrorIs(t, schedule.Validate(), types.ErrInvalidGasSchedule)
+
+	schedule.PriorityLanes = []types.PriorityLane{
+		{Name: "gov", MsgTypeURLs: []string{voteTypeURL}},
+		{Name: "ibc", MsgTypeURLs: []string{updateClientTypeURL, voteTypeURL}},
+	}
+	require.ErrorIs(t, schedule.Validate(), types.ErrInvalidGasSchedule)
+}
+
+// msgsTx is a sdk.Tx of the given Msgs.
+type msgsTx []sdk.Msg
+
+func (tx msgsTx) GetMsgs() []sdk.Msg   { return tx }
+func (tx msgsTx) ValidateBasic() error { return nil }
+
+func newTx(msgs ...sdk.Msg) sdk.Tx {
+	return msgsTx(msgs)
+}
+
+func TestGasScheduleReservedGas(t *testing.T) {
+	voteTypeURL := "/cosmos.gov.v1.MsgVote"
+	updateClientTypeURL := "/ibc.core.client.v1.MsgUpdateClient"
+
+	schedule := types.DefaultGasSchedule()
+	schedule.PriorityLanes = []types.PriorityLane{
+		{Name: "gov", MsgTypeURLs: []string{voteTypeURL}, ReservedGas: 300000},
+		{Name: "ibc", MsgTypeURLs: []string{updateClientTypeURL}, ReservedGas: 700000},
+	}
+	require.Equal(t, uint64(1000000), schedule.TotalReservedGas())
+
+	testCases := []struct {
+		name        string
+		blockMaxGas int64
+		expErr      bool
+	}{
+		{"no block gas limit", -1, false},
+		{"zero block gas limit", 0, false},
+		{"half of the block max gas", 2000000, false},
+		{"above half of the block max gas", 1999999, true},
+		{"above the block max gas", 500000, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := schedule.ValidateReservedGas(tc.blockMaxGas)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidGasSchedule)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// the total reserved gas cannot overflow
+	schedule.PriorityLanes[1].ReservedGas = math.MaxUint64
+	require.ErrorIs(t, schedule.Validate(), types.ErrInvalidGasSchedule)
+}
+
+func TestGasScheduleCosts(t *testing.T) {
+	schedule := types.DefaultGasSchedule()
+	require.Equal(t, storetypes.KVGasConfig(), schedule.KVStore.StoreGasConfig())
+	require.Equal(t, storetypes.TransientGasConfig(), schedule.TransientStore.StoreGasConfig())
+
+	schedule.SigVerifyCosts = []types.GasCost{{TypeUrl: "/cosmos.crypto.secp256k1.PubKey", Cost: 0}}
+	schedule.MsgCosts = []types.GasCost{{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Cost: 100}}
+
+	cost, ok := schedule.SigVerifyCost("/cosmos.crypto.secp256k1.PubKey")
+	require.True(t, ok)
+	require.Zero(t, cost)
+	_, ok = schedule.SigVerifyCost("/cosmos.crypto.ed25519.PubKey")
+	require.False(t, ok)
+
+	require.Equal(t, uint64(100), schedule.MsgCost("/cosmos.bank.v1beta1.MsgSend"))
+	require.Zero(t, schedule.MsgCost("/cosmos.bank.v1beta1.MsgMultiSend"))
+}
@@ -0,0 +1,1624 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/gasschedule/v1beta1/gasschedule.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GasSchedule defines the gas costs of the state machine that governance can tune.
+type GasSchedule struct {
+	// kv_store is the gas config of the KVStores.
+	KVStore GasConfig `protobuf:"bytes,1,opt,name=kv_store,json=kvStore,proto3" json:"kv_store"`
+	// transient_store is the gas config of the transient stores.
+	TransientStore GasConfig `protobuf:"bytes,2,opt,name=transient_store,json=transientStore,proto3" json:"transient_store"`
+	// sig_verify_costs are the costs of verifying a signature by public key type.
+	// Public key types without a cost are charged the x/auth params costs.
+	SigVerifyCosts []GasCost `protobuf:"bytes,3,rep,name=sig_verify_costs,json=sigVerifyCosts,proto3" json:"sig_verify_costs"`
+	// msg_costs are the flat costs charged for every Msg of a given type in a tx.
+	MsgCosts []GasCost `protobuf:"bytes,4,rep,name=msg_costs,json=msgCosts,proto3" json:"msg_costs"`
+	// priority_lanes are the lanes of txs prioritized over the fee competition of
+	// the other txs, e.g. IBC client updates or governance votes.
+	PriorityLanes []PriorityLane `protobuf:"bytes,5,rep,name=priority_lanes,json=priorityLanes,proto3" json:"priority_lanes"`
+}
+
+func (m *GasSchedule) Reset()         { *m = GasSchedule{} }
+func (m *GasSchedule) String() string { return proto.CompactTextString(m) }
+func (*GasSchedule) ProtoMessage()    {}
+func (*GasSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5975298a267feada, []int{0}
+}
+func (m *GasSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasSchedule.Merge(m, src)
+}
+func (m *GasSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasSchedule proto.InternalMessageInfo
+
+func (m *GasSchedule) GetKVStore() GasConfig {
+	if m != nil {
+		return m.KVStore
+	}
+	return GasConfig{}
+}
+
+func (m *GasSchedule) GetTransientStore() GasConfig {
+	if m != nil {
+		return m.TransientStore
+	}
+	return GasConfig{}
+}
+
+func (m *GasSchedule) GetSigVerifyCosts() []GasCost {
+	if m != nil {
+		return m.SigVerifyCosts
+	}
+	return nil
+}
+
+func (m *GasSchedule) GetMsgCosts() []GasCost {
+	if m != nil {
+		return m.MsgCosts
+	}
+	return nil
+}
+
+func (m *GasSchedule) GetPriorityLanes() []PriorityLane {
+	if m != nil {
+		return m.PriorityLanes
+	}
+	return nil
+}
+
+// GasConfig defines the gas costs of the store operations.
+type GasConfig struct {
+	HasCost          uint64 `protobuf:"varint,1,opt,name=has_cost,json=hasCost,proto3" json:"has_cost,omitempty"`
+	DeleteCost       uint64 `protobuf:"varint,2,opt,name=delete_cost,json=deleteCost,proto3" json:"delete_cost,omitempty"`
+	ReadCostFlat     uint64 `protobuf:"varint,3,opt,name=read_cost_flat,json=readCostFlat,proto3" json:"read_cost_flat,omitempty"`
+	ReadCostPerByte  uint64 `protobuf:"varint,4,opt,name=read_cost_per_byte,json=readCostPerByte,proto3" json:"read_cost_per_byte,omitempty"`
+	WriteCostFlat    uint64 `protobuf:"varint,5,opt,name=write_cost_flat,json=writeCostFlat,proto3" json:"write_cost_flat,omitempty"`
+	WriteCostPerByte uint64 `protobuf:"varint,6,opt,name=write_cost_per_byte,json=writeCostPerByte,proto3" json:"write_cost_per_byte,omitempty"`
+	IterNextCostFlat uint64 `protobuf:"varint,7,opt,name=iter_next_cost_flat,json=iterNextCostFlat,proto3" json:"iter_next_cost_flat,omitempty"`
+}
+
+func (m *GasConfig) Reset()         { *m = GasConfig{} }
+func (m *GasConfig) String() string { return proto.CompactTextString(m) }
+func (*GasConfig) ProtoMessage()    {}
+func (*GasConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5975298a267feada, []int{1}
+}
+func (m *GasConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasConfig.Merge(m, src)
+}
+func (m *GasConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasConfig proto.InternalMessageInfo
+
+func (m *GasConfig) GetHasCost() uint64 {
+	if m != nil {
+		return m.HasCost
+	}
+	return 0
+}
+
+func (m *GasConfig) GetDeleteCost() uint64 {
+	if m != nil {
+		return m.DeleteCost
+	}
+	return 0
+}
+
+func (m *GasConfig) GetReadCostFlat() uint64 {
+	if m != nil {
+		return m.ReadCostFlat
+	}
+	return 0
+}
+
+func (m *GasConfig) GetReadCostPerByte() uint64 {
+	if m != nil {
+		return m.ReadCostPerByte
+	}
+	return 0
+}
+
+func (m *GasConfig) GetWriteCostFlat() uint64 {
+	if m != nil {
+		return m.WriteCostFlat
+	}
+	return 0
+}
+
+func (m *GasConfig) GetWriteCostPerByte() uint64 {
+	if m != nil {
+		return m.WriteCostPerByte
+	}
+	return 0
+}
+
+func (m *GasConfig) GetIterNextCostFlat() uint64 {
+	if m != nil {
+		return m.IterNextCostFlat
+	}
+	return 0
+}
+
+// GasCost defines the gas cost of a type, identified by its type URL.
+type GasCost struct {
+	// type_url is the type URL of the public key or Msg.
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	Cost    uint64 `protobuf:"varint,2,opt,name=cost,proto3" json:"cost,omitempty"`
+}
+
+func (m *GasCost) Reset()         { *m = GasCost{} }
+func (m *GasCost) String() string { return proto.CompactTextString(m) }
+func (*GasCost) ProtoMessage()    {}
+func (*GasCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5975298a267feada, []int{2}
+}
+func (m *GasCost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasCost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasCost.Merge(m, src)
+}
+func (m *GasCost) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasCost proto.InternalMessageInfo
+
+func (m *GasCost) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *GasCost) GetCost() uint64 {
+	if m != nil {
+		return m.Cost
+	}
+	return 0
+}
+
+// PriorityLane defines a lane of the txs whose Msgs all have one of its type URLs.
+type PriorityLane struct {
+	// name is the name of the lane.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// msg_type_urls are the type URLs of the Msgs of the lane. A type URL belongs to
+	// at most one lane.
+	MsgTypeURLs []string `protobuf:"bytes,2,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// priority_boost is added to the priority of the txs of the lane in CheckTx.
+	PriorityBoost int64 `protobuf:"varint,3,opt,name=priority_boost,json=priorityBoost,proto3" json:"priority_boost,omitempty"`
+	// reserved_gas is the gas of every block that only the txs of the lane can use.
+	ReservedGas uint64 `protobuf:"varint,4,opt,name=reserved_gas,json=reservedGas,proto3" json:"reserved_gas,omitempty"`
+}
+
+func (m *PriorityLane) Reset()         { *m = PriorityLane{} }
+func (m *PriorityLane) String() string { return proto.CompactTextString(m) }
+func (*PriorityLane) ProtoMessage()    {}
+func (*PriorityLane) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5975298a267feada, []int{3}
+}
+func (m *PriorityLane) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriorityLane) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriorityLane.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriorityLane) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriorityLane.Merge(m, src)
+}
+func (m *PriorityLane) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriorityLane) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriorityLane.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriorityLane proto.InternalMessageInfo
+
+func (m *PriorityLane) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PriorityLane) GetMsgTypeURLs() []string {
+	if m != nil {
+		return m.MsgTypeURLs
+	}
+	return nil
+}
+
+func (m *PriorityLane) GetPriorityBoost() int64 {
+	if m != nil {
+		return m.PriorityBoost
+	}
+	return 0
+}
+
+func (m *PriorityLane) GetReservedGas() uint64 {
+	if m != nil {
+		return m.ReservedGas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GasSchedule)(nil), "cosmos.gasschedule.v1beta1.GasSchedule")
+	proto.RegisterType((*GasConfig)(nil), "cosmos.gasschedule.v1beta1.GasConfig")
+	proto.RegisterType((*GasCost)(nil), "cosmos.gasschedule.v1beta1.GasCost")
+	proto.RegisterType((*PriorityLane)(nil), "cosmos.gasschedule.v1beta1.PriorityLane")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/gasschedule/v1beta1/gasschedule.proto", fileDescriptor_5975298a267feada)
+}
+
+var fileDescriptor_5975298a267feada = []byte{
+	// 590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0x87, 0xe3, 0xc4, 0xad, 0x9b, 0x73, 0xdb, 0x54, 0x57, 0x06, 0xd3, 0xc1, 0x29, 0x85, 0xa2,
+	0x48, 0xd0, 0x84, 0xb6, 0x1b, 0x03, 0x43, 0x90, 0x5a, 0x09, 0x0a, 0x2a, 0xee, 0x9f, 0x81, 0xc5,
+	0xba, 0x24, 0x6f, 0x1d, 0xab, 0xb6, 0x2f, 0xba, 0xf7, 0x1a, 0x9a, 0x6f, 0xc1, 0x47, 0x60, 0x82,
+	0x95, 0x8f, 0xd1, 0xb1, 0x23, 0x53, 0x85, 0xd2, 0x85, 0x8f, 0x81, 0xee, 0x7c, 0x4e, 0xcd, 0x00,
+	0xa8, 0x53, 0xce, 0xbf, 0xf7, 0xb9, 0x27, 0xf6, 0xfb, 0x9e, 0x8e, 0x3c, 0xef, 0x73, 0x4c, 0x39,
+	0x76, 0x22, 0x86, 0xd8, 0x1f, 0xc2, 0xe0, 0x22, 0x81, 0xce, 0x78, 0xbb, 0x07, 0x92, 0x6d, 0x97,
+	0xb3, 0xf6, 0x48, 0x70, 0xc9, 0xe9, 0x5a, 0x4e, 0xb7, 0xcb, 0x15, 0x43, 0xaf, 0x3d, 0x88, 0x78,
+	0xc4, 0x35, 0xd6, 0x51, 0xab, 0x7c, 0xc7, 0xc6, 0xf7, 0x1a, 0x71, 0xf7, 0x19, 0x1e, 0x19, 0x9a,
+	0x7e, 0x20, 0x0b, 0xe7, 0xe3, 0x10, 0x25, 0x17, 0xe0, 0x59, 0xeb, 0x56, 0xcb, 0xdd, 0xd9, 0x6c,
+	0xff, 0x5d, 0xda, 0xde, 0x67, 0xf8, 0x9a, 0x67, 0x67, 0x71, 0xd4, 0x6d, 0x5c, 0xdd, 0x34, 0x2b,
+	0xd3, 0x9b, 0xa6, 0xf3, 0xf6, 0xf4, 0x48, 0xed, 0x0e, 0x9c, 0xf3, 0xb1, 0x5e, 0xd0, 0x63, 0xd2,
+	0x90, 0x82, 0x65, 0x18, 0x43, 0x26, 0x8d, 0xb9, 0x7a, 0x1f, 0xb3, 0xad, 0xcc, 0xc1, 0xf2, 0xcc,
+	0x91, 0x5b, 0x8f, 0xc8, 0x0a, 0xc6, 0x51, 0x38, 0x06, 0x11, 0x9f, 0x4d, 0xc2, 0x3e, 0x47, 0x89,
+	0x5e, 0x6d, 0xbd, 0xd6, 0x72, 0x77, 0x1e, 0xff, 0x57, 0x8b, 0xb2, 0x90, 0x62, 0x1c, 0x9d, 0x6a,
+	0x83, 0x0a, 0x91, 0xee, 0x91, 0x7a, 0x8a, 0x91, 0xb1, 0xd9, 0xf7, 0xb5, 0x2d, 0xa4, 0x18, 0xe5,
+	0x9e, 0x13, 0xb2, 0x3c, 0x12, 0x31, 0x17, 0xb1, 0x9c, 0x84, 0x09, 0xcb, 0x00, 0xbd, 0x39, 0x2d,
+	0x6b, 0xfd, 0x4b, 0x76, 0x68, 0x76, 0x1c, 0xb0, 0x0c, 0x8c, 0x71, 0x69, 0x54, 0xca, 0xf0, 0xa5,
+	0xfd, 0xeb, 0x4b, 0xd3, 0xda, 0xf8, 0x5a, 0x25, 0xf5, 0x59, 0x77, 0xe8, 0x43, 0xb2, 0x30, 0x64,
+	0xa8, 0x5f, 0x59, 0x0f, 0xcc, 0x0e, 0x9c, 0x61, 0xfe, 0x56, 0xb4, 0x49, 0xdc, 0x01, 0x24, 0x20,
+	0x21, 0xaf, 0x56, 0x75, 0x95, 0xe4, 0x91, 0x06, 0x9e, 0x90, 0x65, 0x01, 0x6c, 0xa0, 0xcb, 0xe1,
+	0x59, 0xc2, 0xa4, 0x57, 0xd3, 0xcc, 0xa2, 0x4a, 0x15, 0xb1, 0x97, 0x30, 0x49, 0x9f, 0x11, 0x7a,
+	0x47, 0x8d, 0x40, 0x84, 0xbd, 0x89, 0x04, 0xcf, 0xd6, 0x64, 0xa3, 0x20, 0x0f, 0x41, 0x74, 0x27,
+	0x12, 0xe8, 0x53, 0xd2, 0xf8, 0x24, 0x62, 0x09, 0x25, 0xe7, 0x9c, 0x26, 0x97, 0x74, 0x3c, 0x93,
+	0x6e, 0x91, 0xd5, 0x12, 0x37, 0xb3, 0xce, 0x6b, 0x76, 0x65, 0xc6, 0x16, 0xda, 0x2d, 0xb2, 0x1a,
+	0x4b, 0x10, 0x61, 0x06, 0x97, 0xb2, 0xa4, 0x76, 0x72, 0x5c, 0x95, 0xde, 0xc3, 0xa5, 0x2c, 0xec,
+	0xa6, 0x51, 0xaf, 0x88, 0x63, 0x06, 0xa4, 0xba, 0x24, 0x27, 0x23, 0x08, 0x2f, 0x44, 0xa2, 0xbb,
+	0x54, 0x0f, 0x1c, 0xf5, 0x7c, 0x22, 0x12, 0x4a, 0x89, 0x5d, 0x6a, 0x8f, 0x5e, 0x9b, 0xfd, 0xdf,
+	0x2c, 0xb2, 0x58, 0x1e, 0x8a, 0x42, 0x33, 0x96, 0x82, 0x31, 0xe8, 0x35, 0xdd, 0x25, 0x4b, 0xea,
+	0xc8, 0x14, 0x76, 0xf4, 0xaa, 0xeb, 0xb5, 0x56, 0xbd, 0xdb, 0x98, 0xde, 0x34, 0xdd, 0x77, 0x18,
+	0x1d, 0xab, 0x7f, 0x09, 0x0e, 0x30, 0x70, 0x53, 0xf3, 0x20, 0x12, 0xa4, 0x9b, 0xa5, 0xf3, 0xd1,
+	0xe3, 0x1c, 0xf3, 0xc6, 0xd7, 0xee, 0xe6, 0xdd, 0x55, 0x21, 0x7d, 0x44, 0x16, 0x05, 0x20, 0x88,
+	0x31, 0x0c, 0xc2, 0x88, 0xa1, 0xe9, 0xb9, 0x5b, 0x64, 0xfb, 0xcc, 0x1c, 0x89, 0xee, 0x9b, 0xab,
+	0xa9, 0x6f, 0x5d, 0x4f, 0x7d, 0xeb, 0xe7, 0xd4, 0xb7, 0x3e, 0xdf, 0xfa, 0x95, 0xeb, 0x5b, 0xbf,
+	0xf2, 0xe3, 0xd6, 0xaf, 0x7c, 0x7c, 0x11, 0xc5, 0x72, 0x78, 0xd1, 0x6b, 0xf7, 0x79, 0xda, 0x31,
+	0x57, 0x49, 0xfe, 0xb3, 0x85, 0x83, 0xf3, 0xce, 0xe5, 0x1f, 0xf7, 0x8a, 0xfa, 0x06, 0xec, 0xcd,
+	0xeb, 0x8b, 0x61, 0xf7, 0xf7, 0x00, 0x45, 0xd2, 0xf2, 0x86, 0x7a, 0x04, 0x00, 0x00,
+}
+
+func (this *GasSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GasSchedule)
+	if !ok {
+		that2, ok := that.(GasSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.KVStore.Equal(&that1.KVStore) {
+		return false
+	}
+	if !this.TransientStore.Equal(&that1.TransientStore) {
+		return false
+	}
+	if len(this.SigVerifyCosts) != len(that1.SigVerifyCosts) {
+		return false
+	}
+	for i := range this.SigVerifyCosts {
+		if !this.SigVerifyCosts[i].Equal(&that1.SigVerifyCosts[i]) {
+			return false
+		}
+	}
+	if len(this.MsgCosts) != len(that1.MsgCosts) {
+		return false
+	}
+	for i := range this.MsgCosts {
+		if !this.MsgCosts[i].Equal(&that1.MsgCosts[i]) {
+			return false
+		}
+	}
+	if len(this.PriorityLanes) != len(that1.PriorityLanes) {
+		return false
+	}
+	for i := range this.PriorityLanes {
+		if !this.PriorityLanes[i].Equal(&that1.PriorityLanes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GasConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GasConfig)
+	if !ok {
+		that2, ok := that.(GasConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HasCost != that1.HasCost {
+		return false
+	}
+	if this.DeleteCost != that1.DeleteCost {
+		return false
+	}
+	if this.ReadCostFlat != that1.ReadCostFlat {
+		return false
+	}
+	if this.ReadCostPerByte != that1.ReadCostPerByte {
+		return false
+	}
+	if this.WriteCostFlat != that1.WriteCostFlat {
+		return false
+	}
+	if this.WriteCostPerByte != that1.WriteCostPerByte {
+		return false
+	}
+	if this.IterNextCostFlat != that1.IterNextCostFlat {
+		return false
+	}
+	return true
+}
+func (this *GasCost) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GasCost)
+	if !ok {
+		that2, ok := that.(GasCost)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TypeUrl != that1.TypeUrl {
+		return false
+	}
+	if this.Cost != that1.Cost {
+		return false
+	}
+	return true
+}
+func (this *PriorityLane) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PriorityLane)
+	if !ok {
+		that2, ok := that.(PriorityLane)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if len(this.MsgTypeURLs) != len(that1.MsgTypeURLs) {
+		return false
+	}
+	for i := range this.MsgTypeURLs {
+		if this.MsgTypeURLs[i] != that1.MsgTypeURLs[i] {
+			return false
+		}
+	}
+	if this.PriorityBoost != that1.PriorityBoost {
+		return false
+	}
+	if this.ReservedGas != that1.ReservedGas {
+		return false
+	}
+	return true
+}
+func (m *GasSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriorityLanes) > 0 {
+		for iNdEx := len(m.PriorityLanes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriorityLanes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGasschedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MsgCosts) > 0 {
+		for iNdEx := len(m.MsgCosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgCosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGasschedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SigVerifyCosts) > 0 {
+		for iNdEx := len(m.SigVerifyCosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigVerifyCosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGasschedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.TransientStore.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGasschedule(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.KVStore.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGasschedule(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GasConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IterNextCostFlat != 0 {
+		i = encodeVarintGasschedule(dAtA, i, uint64(m.IterNextCostFlat))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.WriteCostPerByte != 0 {
+		i = encodeVarintGasschedule(dAtA, i, uint64(m.WriteCostPerByte))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WriteCostFlat != 0 {
+		i = encodeVarintGasschedule(dAtA, i, uint64(m.WriteCostFlat))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReadCostPerByte != 0 {
+		i = encodeVarintGasschedule(dAtA, i, uint64(m.ReadCostPerByte))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadCostFlat != 0 {
+		i = encodeVarintGasschedule(dAtA, i, uint64(m.ReadCostFlat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DeleteCost != 0 {
+		i = encodeVarintGasschedule(dAtA, i, uint64(m.DeleteCost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HasCost != 0 {
+		i = encodeVarintGasschedule(dAtA, i, uint64(m.HasCost))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasCost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasCost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasCost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cost != 0 {
+		i = encodeVarintGasschedule(dAtA, i, uint64(m.Cost))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintGasschedule(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriorityLane) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriorityLane) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriorityLane) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReservedGas != 0 {
+		i = encodeVarintGasschedule(dAtA, i, uint64(m.ReservedGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PriorityBoost != 0 {
+		i = encodeVarintGasschedule(dAtA, i, uint64(m.PriorityBoost))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MsgTypeURLs) > 0 {
+		for iNdEx := len(m.MsgTypeURLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeURLs[iNdEx])
+			copy(dAtA[i:], m.MsgTypeURLs[iNdEx])
+			i = encodeVarintGasschedule(dAtA, i, uint64(len(m.MsgTypeURLs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGasschedule(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGasschedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGasschedule(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GasSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.KVStore.Size()
+	n += 1 + l + sovGasschedule(uint64(l))
+	l = m.TransientStore.Size()
+	n += 1 + l + sovGasschedule(uint64(l))
+	if len(m.SigVerifyCosts) > 0 {
+		for _, e := range m.SigVerifyCosts {
+			l = e.Size()
+			n += 1 + l + sovGasschedule(uint64(l))
+		}
+	}
+	if len(m.MsgCosts) > 0 {
+		for _, e := range m.MsgCosts {
+			l = e.Size()
+			n += 1 + l + sovGasschedule(uint64(l))
+		}
+	}
+	if len(m.PriorityLanes) > 0 {
+		for _, e := range m.PriorityLanes {
+			l = e.Size()
+			n += 1 + l + sovGasschedule(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasCost != 0 {
+		n += 1 + sovGasschedule(uint64(m.HasCost))
+	}
+	if m.DeleteCost != 0 {
+		n += 1 + sovGasschedule(uint64(m.DeleteCost))
+	}
+	if m.ReadCostFlat != 0 {
+		n += 1 + sovGasschedule(uint64(m.ReadCostFlat))
+	}
+	if m.ReadCostPerByte != 0 {
+		n += 1 + sovGasschedule(uint64(m.ReadCostPerByte))
+	}
+	if m.WriteCostFlat != 0 {
+		n += 1 + sovGasschedule(uint64(m.WriteCostFlat))
+	}
+	if m.WriteCostPerByte != 0 {
+		n += 1 + sovGasschedule(uint64(m.WriteCostPerByte))
+	}
+	if m.IterNextCostFlat != 0 {
+		n += 1 + sovGasschedule(uint64(m.IterNextCostFlat))
+	}
+	return n
+}
+
+func (m *GasCost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGasschedule(uint64(l))
+	}
+	if m.Cost != 0 {
+		n += 1 + sovGasschedule(uint64(m.Cost))
+	}
+	return n
+}
+
+func (m *PriorityLane) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGasschedule(uint64(l))
+	}
+	if len(m.MsgTypeURLs) > 0 {
+		for _, s := range m.MsgTypeURLs {
+			l = len(s)
+			n += 1 + l + sovGasschedule(uint64(l))
+		}
+	}
+	if m.PriorityBoost != 0 {
+		n += 1 + sovGasschedule(uint64(m.PriorityBoost))
+	}
+	if m.ReservedGas != 0 {
+		n += 1 + sovGasschedule(uint64(m.ReservedGas))
+	}
+	return n
+}
+
+func sovGasschedule(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGasschedule(x uint64) (n int) {
+	return sovGasschedule(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GasSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasschedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KVStore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KVStore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransientStore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransientStore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigVerifyCosts = append(m.SigVerifyCosts, GasCost{})
+			if err := m.SigVerifyCosts[len(m.SigVerifyCosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgCosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgCosts = append(m.MsgCosts, GasCost{})
+			if err := m.MsgCosts[len(m.MsgCosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityLanes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityLanes = append(m.PriorityLanes, PriorityLane{})
+			if err := m.PriorityLanes[len(m.PriorityLanes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasschedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasschedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCost", wireType)
+			}
+			m.HasCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HasCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteCost", wireType)
+			}
+			m.DeleteCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadCostFlat", wireType)
+			}
+			m.ReadCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadCostPerByte", wireType)
+			}
+			m.ReadCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteCostFlat", wireType)
+			}
+			m.WriteCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteCostPerByte", wireType)
+			}
+			m.WriteCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WriteCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IterNextCostFlat", wireType)
+			}
+			m.IterNextCostFlat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IterNextCostFlat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasschedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasCost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasschedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasCost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasCost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			m.Cost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasschedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriorityLane) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasschedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriorityLane: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriorityLane: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeURLs = append(m.MsgTypeURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityBoost", wireType)
+			}
+			m.PriorityBoost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriorityBoost |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedGas", wireType)
+			}
+			m.ReservedGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReservedGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasschedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasschedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGasschedule(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGasschedule
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGasschedule
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGasschedule
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGasschedule
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGasschedule
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGasschedule        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGasschedule          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGasschedule = fmt.Errorf("proto: unexpected end of group")
+)
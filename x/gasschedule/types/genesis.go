@@ -0,0 +1,16 @@
+package types
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(schedule GasSchedule) *GenesisState {
+	return &GenesisState{GasSchedule: schedule}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultGasSchedule())
+}
+
+// ValidateGenesis validates the gasschedule genesis data
+func ValidateGenesis(data *GenesisState) error {
+	return data.GasSchedule.Validate()
+}
@@ -0,0 +1,12 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "gasschedule"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+// GasScheduleKey is the store key of the gas schedule.
+var GasScheduleKey = []byte{0x01}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var _ legacytx.LegacyMsg = &MsgUpdateGasSchedule{}
+
+// NewMsgUpdateGasSchedule creates a new MsgUpdateGasSchedule instance
+func NewMsgUpdateGasSchedule(authority sdk.AccAddress, schedule GasSchedule) *MsgUpdateGasSchedule {
+	return &MsgUpdateGasSchedule{
+		Authority:   authority.String(),
+		GasSchedule: schedule,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgUpdateGasSchedule) Route() string { return sdk.MsgTypeURL(&msg) }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgUpdateGasSchedule) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateGasSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateGasSchedule message.
+func (msg MsgUpdateGasSchedule) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg MsgUpdateGasSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	return msg.GasSchedule.Validate()
+}
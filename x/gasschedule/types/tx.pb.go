@@ -0,0 +1,596 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/gasschedule/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateGasSchedule is the Msg/UpdateGasSchedule request type.
+type MsgUpdateGasSchedule struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// gas_schedule is the new gas schedule. All the fields must be supplied.
+	GasSchedule GasSchedule `protobuf:"bytes,2,opt,name=gas_schedule,json=gasSchedule,proto3" json:"gas_schedule"`
+}
+
+func (m *MsgUpdateGasSchedule) Reset()         { *m = MsgUpdateGasSchedule{} }
+func (m *MsgUpdateGasSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGasSchedule) ProtoMessage()    {}
+func (*MsgUpdateGasSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7190c2e2e98ca32a, []int{0}
+}
+func (m *MsgUpdateGasSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGasSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGasSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGasSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGasSchedule.Merge(m, src)
+}
+func (m *MsgUpdateGasSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGasSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGasSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGasSchedule proto.InternalMessageInfo
+
+func (m *MsgUpdateGasSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateGasSchedule) GetGasSchedule() GasSchedule {
+	if m != nil {
+		return m.GasSchedule
+	}
+	return GasSchedule{}
+}
+
+// MsgUpdateGasScheduleResponse defines the response structure for executing a
+// MsgUpdateGasSchedule message.
+type MsgUpdateGasScheduleResponse struct {
+}
+
+func (m *MsgUpdateGasScheduleResponse) Reset()         { *m = MsgUpdateGasScheduleResponse{} }
+func (m *MsgUpdateGasScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGasScheduleResponse) ProtoMessage()    {}
+func (*MsgUpdateGasScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7190c2e2e98ca32a, []int{1}
+}
+func (m *MsgUpdateGasScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGasScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGasScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGasScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGasScheduleResponse.Merge(m, src)
+}
+func (m *MsgUpdateGasScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGasScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGasScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGasScheduleResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateGasSchedule)(nil), "cosmos.gasschedule.v1beta1.MsgUpdateGasSchedule")
+	proto.RegisterType((*MsgUpdateGasScheduleResponse)(nil), "cosmos.gasschedule.v1beta1.MsgUpdateGasScheduleResponse")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/gasschedule/v1beta1/tx.proto", fileDescriptor_7190c2e2e98ca32a)
+}
+
+var fileDescriptor_7190c2e2e98ca32a = []byte{
+	// 333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xc1, 0x4b, 0x02, 0x41,
+	0x14, 0xc6, 0x77, 0x2a, 0x02, 0xc7, 0x08, 0x5a, 0x84, 0x6c, 0x89, 0x49, 0xec, 0x90, 0x44, 0xce,
+	0xa8, 0x41, 0x44, 0xb7, 0xbc, 0x04, 0x81, 0x10, 0x4a, 0x97, 0x2e, 0x32, 0xba, 0xc3, 0x28, 0xa5,
+	0xb3, 0xec, 0x1b, 0x45, 0x4f, 0x41, 0x87, 0xce, 0xfd, 0x29, 0x1e, 0xfa, 0x23, 0x3c, 0x4a, 0xa7,
+	0x4e, 0x11, 0x7a, 0xe8, 0xdf, 0x08, 0x77, 0x47, 0x76, 0x23, 0x13, 0x3a, 0xbd, 0x65, 0xbf, 0xdf,
+	0x37, 0xdf, 0xf7, 0x78, 0xf8, 0xb0, 0xa9, 0xa0, 0xa3, 0x80, 0x49, 0x0e, 0xd0, 0x6c, 0x09, 0xb7,
+	0xf7, 0x20, 0x58, 0xbf, 0xd8, 0x10, 0x9a, 0x17, 0x99, 0x1e, 0x50, 0xcf, 0x57, 0x5a, 0xd9, 0x4e,
+	0x08, 0xd1, 0x18, 0x44, 0x0d, 0xe4, 0xa4, 0xa4, 0x92, 0x2a, 0xc0, 0xd8, 0xfc, 0x2b, 0x74, 0x38,
+	0x7b, 0xa1, 0xa3, 0x1e, 0x0a, 0xc6, 0x1e, 0x4a, 0xbb, 0x26, 0xb1, 0x03, 0x92, 0xf5, 0x8b, 0xf3,
+	0x61, 0x84, 0x93, 0x15, 0x55, 0xe2, 0xc9, 0x01, 0x9d, 0x1d, 0x21, 0x9c, 0xaa, 0x80, 0xbc, 0xf5,
+	0x5c, 0xae, 0xc5, 0x15, 0x87, 0x9a, 0x91, 0xed, 0x33, 0x9c, 0xe0, 0x3d, 0xdd, 0x52, 0x7e, 0x5b,
+	0x0f, 0xd3, 0x28, 0x83, 0x72, 0x89, 0x72, 0xfa, 0xed, 0x35, 0x9f, 0x32, 0x25, 0x2e, 0x5d, 0xd7,
+	0x17, 0x00, 0x35, 0xed, 0xb7, 0xbb, 0xb2, 0x1a, 0xa1, 0xf6, 0x0d, 0xde, 0x92, 0x1c, 0xea, 0x8b,
+	0x98, 0xf4, 0x5a, 0x06, 0xe5, 0x92, 0xa5, 0x23, 0xfa, 0xf7, 0xee, 0x34, 0x16, 0x5b, 0xde, 0x18,
+	0x7f, 0x1c, 0x58, 0xd5, 0xa4, 0x8c, 0x7e, 0x5d, 0x6c, 0x3f, 0x7d, 0x8d, 0x8e, 0xa3, 0x84, 0x2c,
+	0xc1, 0xfb, 0xcb, 0x1a, 0x57, 0x05, 0x78, 0xaa, 0x0b, 0xa2, 0xf4, 0x8c, 0xf0, 0x7a, 0x05, 0xa4,
+	0xfd, 0x88, 0x77, 0x7e, 0xaf, 0x55, 0x58, 0x55, 0x64, 0xd9, 0xb3, 0xce, 0xf9, 0x7f, 0x1d, 0x8b,
+	0x22, 0xe5, 0xeb, 0xf1, 0x94, 0xa0, 0xc9, 0x94, 0xa0, 0xcf, 0x29, 0x41, 0x2f, 0x33, 0x62, 0x4d,
+	0x66, 0xc4, 0x7a, 0x9f, 0x11, 0xeb, 0xae, 0x20, 0xdb, 0xba, 0xd5, 0x6b, 0xd0, 0xa6, 0xea, 0x98,
+	0xab, 0x9a, 0x91, 0x07, 0xf7, 0x9e, 0x0d, 0x7e, 0xdc, 0x4e, 0x0f, 0x3d, 0x01, 0x8d, 0xcd, 0xe0,
+	0x5c, 0xa7, 0xdf, 0x03, 0x00, 0xf9, 0x44, 0xfb, 0xb6, 0x69, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateGasSchedule defines a governance operation for updating the gas
+	// schedule. The new gas schedule applies from the next block.
+	UpdateGasSchedule(ctx context.Context, in *MsgUpdateGasSchedule, opts ...grpc.CallOption) (*MsgUpdateGasScheduleResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateGasSchedule(ctx context.Context, in *MsgUpdateGasSchedule, opts ...grpc.CallOption) (*MsgUpdateGasScheduleResponse, error) {
+	out := new(MsgUpdateGasScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gasschedule.v1beta1.Msg/UpdateGasSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateGasSchedule defines a governance operation for updating the gas
+	// schedule. The new gas schedule applies from the next block.
+	UpdateGasSchedule(context.Context, *MsgUpdateGasSchedule) (*MsgUpdateGasScheduleResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateGasSchedule(ctx context.Context, req *MsgUpdateGasSchedule) (*MsgUpdateGasScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGasSchedule not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateGasSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGasSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGasSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gasschedule.v1beta1.Msg/UpdateGasSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGasSchedule(ctx, req.(*MsgUpdateGasSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.gasschedule.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateGasSchedule",
+			Handler:    _Msg_UpdateGasSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/gasschedule/v1beta1/tx.proto",
+}
+
+func (m *MsgUpdateGasSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGasSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGasSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GasSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGasScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGasScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGasScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateGasSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.GasSchedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateGasScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateGasSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGasSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGasSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGasScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGasScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGasScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -12,9 +12,10 @@ import (
 // InitGenesis - store genesis parameters
 func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, data *v1.GenesisState) {
 	k.SetProposalID(ctx, data.StartingProposalId)
-	k.SetDepositParams(ctx, *data.DepositParams)
-	k.SetVotingParams(ctx, *data.VotingParams)
-	k.SetTallyParams(ctx, *data.TallyParams)
+	params := v1.NewParams(*data.VotingParams, *data.TallyParams, *data.DepositParams)
+	if err := k.SetParams(ctx, params); err != nil {
+		panic(err)
+	}
 
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
@@ -106,6 +106,21 @@ func (k msgServer) ExecLegacyContent(goCtx context.Context, msg *v1.MsgExecLegac
 	return &v1.MsgExecLegacyContentResponse{}, nil
 }
 
+// UpdateParams updates the x/gov module parameters. The request must be
+// signed by the module authority.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *v1.MsgUpdateParams) (*v1.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSigner, "expected %s got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, msg.GetParams()); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUpdateParamsResponse{}, nil
+}
+
 func (k msgServer) Vote(goCtx context.Context, msg *v1.MsgVote) (*v1.MsgVoteResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	accAddr, err := sdk.AccAddressFromBech32(msg.Voter)
@@ -2,41 +2,72 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
-// GetDepositParams returns the current DepositParams from the global param store
-func (keeper Keeper) GetDepositParams(ctx sdk.Context) v1.DepositParams {
-	var depositParams v1.DepositParams
-	keeper.paramSpace.Get(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
+// GetParams returns the current x/gov module parameters.
+func (keeper Keeper) GetParams(ctx sdk.Context) v1.Params {
+	return v1.NewParams(keeper.GetVotingParams(ctx), keeper.GetTallyParams(ctx), keeper.GetDepositParams(ctx))
+}
+
+// SetParams validates and sets the x/gov module parameters.
+func (keeper Keeper) SetParams(ctx sdk.Context, params v1.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	keeper.SetDepositParams(ctx, params.DepositParams)
+	keeper.SetVotingParams(ctx, params.VotingParams)
+	keeper.SetTallyParams(ctx, params.TallyParams)
+
+	return nil
+}
+
+// GetDepositParams returns the current DepositParams from the gov store
+func (keeper Keeper) GetDepositParams(ctx sdk.Context) (depositParams v1.DepositParams) {
+	bz := ctx.KVStore(keeper.storeKey).Get(types.DepositParamsKey)
+	if bz == nil {
+		return depositParams
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &depositParams)
 	return depositParams
 }
 
-// GetVotingParams returns the current VotingParams from the global param store
-func (keeper Keeper) GetVotingParams(ctx sdk.Context) v1.VotingParams {
-	var votingParams v1.VotingParams
-	keeper.paramSpace.Get(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
+// GetVotingParams returns the current VotingParams from the gov store
+func (keeper Keeper) GetVotingParams(ctx sdk.Context) (votingParams v1.VotingParams) {
+	bz := ctx.KVStore(keeper.storeKey).Get(types.VotingParamsKey)
+	if bz == nil {
+		return votingParams
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &votingParams)
 	return votingParams
 }
 
-// GetTallyParams returns the current TallyParam from the global param store
-func (keeper Keeper) GetTallyParams(ctx sdk.Context) v1.TallyParams {
-	var tallyParams v1.TallyParams
-	keeper.paramSpace.Get(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+// GetTallyParams returns the current TallyParam from the gov store
+func (keeper Keeper) GetTallyParams(ctx sdk.Context) (tallyParams v1.TallyParams) {
+	bz := ctx.KVStore(keeper.storeKey).Get(types.TallyParamsKey)
+	if bz == nil {
+		return tallyParams
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &tallyParams)
 	return tallyParams
 }
 
-// SetDepositParams sets DepositParams to the global param store
+// SetDepositParams sets DepositParams to the gov store
 func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams v1.DepositParams) {
-	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
+	ctx.KVStore(keeper.storeKey).Set(types.DepositParamsKey, keeper.cdc.MustMarshal(&depositParams))
 }
 
-// SetVotingParams sets VotingParams to the global param store
+// SetVotingParams sets VotingParams to the gov store
 func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams v1.VotingParams) {
-	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
+	ctx.KVStore(keeper.storeKey).Set(types.VotingParamsKey, keeper.cdc.MustMarshal(&votingParams))
 }
 
-// SetTallyParams sets TallyParams to the global param store
+// SetTallyParams sets TallyParams to the gov store
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams v1.TallyParams) {
-	keeper.paramSpace.Set(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+	ctx.KVStore(keeper.storeKey).Set(types.TallyParamsKey, keeper.cdc.MustMarshal(&tallyParams))
 }
@@ -0,0 +1,36 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/exported"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// Migrate migrates the x/gov module state from the consensus version 3 to
+// version 4. Specifically, it takes the deposit, voting and tally parameters
+// that are currently stored and managed by the x/params modules and stores
+// them directly into the x/gov module state.
+func Migrate(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace exported.Subspace, cdc codec.BinaryCodec) error {
+	var (
+		depositParams v1.DepositParams
+		votingParams  v1.VotingParams
+		tallyParams   v1.TallyParams
+	)
+	legacySubspace.Get(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
+	legacySubspace.Get(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
+	legacySubspace.Get(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+
+	if err := v1.NewParams(votingParams, tallyParams, depositParams).Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.DepositParamsKey, cdc.MustMarshal(&depositParams))
+	store.Set(types.VotingParamsKey, cdc.MustMarshal(&votingParams))
+	store.Set(types.TallyParamsKey, cdc.MustMarshal(&tallyParams))
+
+	return nil
+}
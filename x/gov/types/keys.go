@@ -37,6 +37,12 @@ const (
 // - 0x10<proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
+//
+// - 0x30: DepositParams
+//
+// - 0x31: VotingParams
+//
+// - 0x32: TallyParams
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
@@ -46,6 +52,10 @@ var (
 	DepositsKeyPrefix = []byte{0x10}
 
 	VotesKeyPrefix = []byte{0x20}
+
+	DepositParamsKey = []byte{0x30}
+	VotingParamsKey  = []byte{0x31}
+	TallyParamsKey   = []byte{0x32}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
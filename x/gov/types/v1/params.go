@@ -195,3 +195,15 @@ func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
 func DefaultParams() Params {
 	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams())
 }
+
+// Validate performs basic validation on governance parameters.
+func (gp Params) Validate() error {
+	if err := validateDepositParams(gp.DepositParams); err != nil {
+		return err
+	}
+	if err := validateVotingParams(gp.VotingParams); err != nil {
+		return err
+	}
+
+	return validateTallyParams(gp.TallyParams)
+}
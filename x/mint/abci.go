@@ -20,7 +20,11 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, ic types.InflationCalculatio
 	// recalculate inflation rate
 	totalStakingSupply := k.StakingTokenSupply(ctx)
 	bondedRatio := k.BondedRatio(ctx)
-	minter.Inflation = ic(ctx, minter, params, bondedRatio)
+	if params.InflationSchedule == types.InflationScheduleBondedRatio {
+		minter.Inflation = ic(ctx, minter, params, bondedRatio)
+	} else {
+		minter.Inflation = params.ScheduledInflationRate(ctx.BlockHeight(), totalStakingSupply)
+	}
 	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
 	k.SetMinter(ctx, minter)
 
@@ -0,0 +1,49 @@
+package mint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestBeginBlockerInflationSchedule(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.InflationSchedule = types.InflationSchedulePiecewise
+	params.InflationSteps = []types.InflationStep{
+		{StartHeight: 0, Inflation: sdk.NewDecWithPrec(10, 2)},
+		{StartHeight: 11, Inflation: sdk.NewDecWithPrec(3, 2)},
+	}
+	app.MintKeeper.SetParams(ctx, params)
+
+	calls := 0
+	ic := func(ctx sdk.Context, minter types.Minter, params types.Params, bondedRatio sdk.Dec) sdk.Dec {
+		calls++
+		return types.DefaultInflationCalculationFn(ctx, minter, params, bondedRatio)
+	}
+
+	// the inflation calculation function is only used by the bonded ratio schedule
+	mint.BeginBlocker(ctx, app.MintKeeper, ic)
+	require.Equal(t, sdk.NewDecWithPrec(10, 2), app.MintKeeper.GetMinter(ctx).Inflation)
+
+	ctx = ctx.WithBlockHeight(11)
+	supply := app.MintKeeper.StakingTokenSupply(ctx)
+	mint.BeginBlocker(ctx, app.MintKeeper, ic)
+	minter := app.MintKeeper.GetMinter(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(3, 2), minter.Inflation)
+	require.Equal(t, sdk.NewDecWithPrec(3, 2).MulInt(supply), minter.AnnualProvisions)
+	require.Equal(t, 0, calls)
+
+	params.InflationSchedule = types.InflationScheduleBondedRatio
+	app.MintKeeper.SetParams(ctx, params)
+	mint.BeginBlocker(ctx, app.MintKeeper, ic)
+	require.Equal(t, 1, calls)
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -24,6 +25,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryInflation(),
 		GetCmdQueryAnnualProvisions(),
+		GetCmdQuerySupplyProjection(),
 	)
 
 	return mintingQueryCmd
@@ -115,3 +117,38 @@ func GetCmdQueryAnnualProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQuerySupplyProjection implements a command to return the projected
+// supply of the mint denom at a future height.
+func GetCmdQuerySupplyProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-projection [height]",
+		Short: "Query the projected supply of the mint denom at a future height",
+		Long: `Query the projected supply of the mint denom and the inflation rate at a
+future height, assuming the inflation schedule and the bonded ratio do not change.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("height %s not a valid int, please input a valid height", args[0])
+			}
+
+			res, err := queryClient.SupplyProjection(cmd.Context(), &types.QuerySupplyProjectionRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
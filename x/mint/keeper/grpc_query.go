@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
@@ -32,3 +35,27 @@ func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisio
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// SupplyProjection returns the projected supply of the mint denom at a future
+// height.
+func (k Keeper) SupplyProjection(c context.Context, req *types.QuerySupplyProjectionRequest) (*types.QuerySupplyProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	if req.Height <= ctx.BlockHeight() {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d is not after the current height %d", req.Height, ctx.BlockHeight())
+	}
+	if uint64(req.Height-ctx.BlockHeight()) > types.MaxProjectionYears*params.BlocksPerYear {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d is more than %d years ahead", req.Height, types.MaxProjectionYears)
+	}
+
+	supply, inflation := types.ProjectSupply(
+		k.GetMinter(ctx), params, k.BondedRatio(ctx), k.StakingTokenSupply(ctx), ctx.BlockHeight(), req.Height,
+	)
+
+	return &types.QuerySupplyProjectionResponse{Supply: supply, Inflation: inflation}, nil
+}
@@ -51,6 +51,32 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(annualProvisions.AnnualProvisions, app.MintKeeper.GetMinter(ctx).AnnualProvisions)
 }
 
+func (suite *MintTestSuite) TestGRPCSupplyProjection() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.InflationSchedule = types.InflationScheduleFixed
+	params.FixedInflation = sdk.NewDecWithPrec(10, 2)
+	app.MintKeeper.SetParams(ctx, params)
+
+	supply := app.MintKeeper.StakingTokenSupply(ctx)
+	res, err := queryClient.SupplyProjection(gocontext.Background(), &types.QuerySupplyProjectionRequest{Height: int64(params.BlocksPerYear)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.FixedInflation, res.Inflation)
+
+	// a year of 10% inflation compounded every block
+	expected := sdk.NewDecWithPrec(110517, 5).MulInt(supply).TruncateInt()
+	suite.Require().True(res.Supply.Sub(expected).Abs().LTE(supply.QuoRaw(100_000)), "expected %s, got %s", expected, res.Supply)
+
+	_, err = queryClient.SupplyProjection(gocontext.Background(), &types.QuerySupplyProjectionRequest{Height: ctx.BlockHeight()})
+	suite.Require().Error(err)
+
+	_, err = queryClient.SupplyProjection(gocontext.Background(), &types.QuerySupplyProjectionRequest{
+		Height: int64(params.BlocksPerYear)*types.MaxProjectionYears + 1,
+	})
+	suite.Require().Error(err)
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
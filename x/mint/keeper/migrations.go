@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/exported"
+	v047 "github.com/cosmos/cosmos-sdk/x/mint/migrations/v047"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper         Keeper
+	legacySubspace exported.Subspace
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper, legacySubspace exported.Subspace) Migrator {
+	return Migrator{keeper: keeper, legacySubspace: legacySubspace}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v047.MigrateStore(ctx, m.legacySubspace)
+}
+
+// Migrate2to3 migrates from version 2 to 3. Specifically, it moves the module
+// parameters out of the x/params subspace and into the x/mint store.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v047.Migrate(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc)
+}
@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func (suite *MintTestSuite) TestUpdateParams() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.MintKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	newParams := types.DefaultParams()
+	newParams.BlocksPerYear = 1000
+
+	invalidParams := types.DefaultParams()
+	invalidParams.MintDenom = ""
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name:      "invalid authority",
+			request:   &types.MsgUpdateParams{Authority: sdk.AccAddress("invalid").String(), Params: newParams},
+			expectErr: true,
+		},
+		{
+			name:      "invalid params",
+			request:   &types.MsgUpdateParams{Authority: authority, Params: invalidParams},
+			expectErr: true,
+		},
+		{
+			name:      "set params",
+			request:   &types.MsgUpdateParams{Authority: authority, Params: newParams},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.request.Params.BlocksPerYear, app.MintKeeper.GetParams(ctx).BlocksPerYear)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package v047
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/exported"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47.
+// The migration includes:
+//
+// - Setting the inflation schedule params in the paramstore
+func MigrateStore(ctx sdk.Context, paramstore exported.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	paramstore.Set(ctx, types.KeyInflationSchedule, types.DefaultInflationSchedule)
+	paramstore.Set(ctx, types.KeyFixedInflation, types.DefaultFixedInflation)
+	paramstore.Set(ctx, types.KeyHalvingInterval, types.DefaultHalvingInterval)
+	paramstore.Set(ctx, types.KeyInitialAnnualProvisions, types.DefaultInitialAnnualProvisions)
+	paramstore.Set(ctx, types.KeyMaxSupply, types.DefaultMaxSupply)
+	paramstore.Set(ctx, types.KeySupplyDecayRate, types.DefaultSupplyDecayRate)
+	paramstore.Set(ctx, types.KeyInflationSteps, types.DefaultInflationSteps)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047mint "github.com/cosmos/cosmos-sdk/x/mint/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	mintKey := sdk.NewKVStoreKey("mint")
+	tMintKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(mintKey, tMintKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, mintKey, tMintKey, "mint")
+
+	// Check no params
+	require.False(t, paramstore.Has(ctx, types.KeyInflationSchedule))
+	require.False(t, paramstore.Has(ctx, types.KeyInflationSteps))
+
+	// Run migrations.
+	err := v047mint.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	var schedule types.InflationSchedule
+	paramstore.Get(ctx, types.KeyInflationSchedule, &schedule)
+	require.Equal(t, types.InflationScheduleBondedRatio, schedule)
+	require.True(t, paramstore.Has(ctx, types.KeyFixedInflation))
+	require.True(t, paramstore.Has(ctx, types.KeyHalvingInterval))
+	require.True(t, paramstore.Has(ctx, types.KeyInitialAnnualProvisions))
+	require.True(t, paramstore.Has(ctx, types.KeyMaxSupply))
+	require.True(t, paramstore.Has(ctx, types.KeySupplyDecayRate))
+	require.True(t, paramstore.Has(ctx, types.KeyInflationSteps))
+}
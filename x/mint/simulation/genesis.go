@@ -7,8 +7,11 @@ import (
 	"fmt"
 	"math/rand"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -19,6 +22,14 @@ const (
 	InflationMax        = "inflation_max"
 	InflationMin        = "inflation_min"
 	GoalBonded          = "goal_bonded"
+
+	InflationSchedule       = "inflation_schedule"
+	FixedInflation          = "fixed_inflation"
+	HalvingInterval         = "halving_interval"
+	InitialAnnualProvisions = "initial_annual_provisions"
+	MaxSupply               = "max_supply"
+	SupplyDecayRate         = "supply_decay_rate"
+	InflationSteps          = "inflation_steps"
 )
 
 // GenInflation randomized Inflation
@@ -46,6 +57,47 @@ func GenGoalBonded(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(67, 2)
 }
 
+// GenInflationSchedule randomized InflationSchedule
+func GenInflationSchedule(r *rand.Rand) types.InflationSchedule {
+	return types.InflationSchedule(r.Intn(len(types.InflationSchedule_name)))
+}
+
+// GenFixedInflation randomized FixedInflation
+func GenFixedInflation(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(21)), 2)
+}
+
+// GenHalvingInterval randomized HalvingInterval
+func GenHalvingInterval(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 1000))
+}
+
+// GenInitialAnnualProvisions randomized InitialAnnualProvisions
+func GenInitialAnnualProvisions(r *rand.Rand) sdk.Dec {
+	return sdk.NewDec(r.Int63n(1_000_000_000_000))
+}
+
+// GenMaxSupply randomized MaxSupply
+func GenMaxSupply(r *rand.Rand) math.Int {
+	return sdk.NewInt(r.Int63n(1_000_000_000_000_000))
+}
+
+// GenSupplyDecayRate randomized SupplyDecayRate
+func GenSupplyDecayRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 2)
+}
+
+// GenInflationSteps randomized InflationSteps
+func GenInflationSteps(r *rand.Rand) []types.InflationStep {
+	steps := make([]types.InflationStep, r.Intn(5))
+	height := int64(0)
+	for i := range steps {
+		steps[i] = types.InflationStep{StartHeight: height, Inflation: GenFixedInflation(r)}
+		height += int64(simtypes.RandIntBetween(r, 1, 100))
+	}
+	return steps
+}
+
 // RandomizedGenState generates a random GenesisState for mint
 func RandomizedGenState(simState *module.SimulationState) {
 	// minter
@@ -80,9 +132,58 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { goalBonded = GenGoalBonded(r) },
 	)
 
+	var inflationSchedule types.InflationSchedule
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InflationSchedule, &inflationSchedule, simState.Rand,
+		func(r *rand.Rand) { inflationSchedule = GenInflationSchedule(r) },
+	)
+
+	var fixedInflation sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FixedInflation, &fixedInflation, simState.Rand,
+		func(r *rand.Rand) { fixedInflation = GenFixedInflation(r) },
+	)
+
+	var halvingInterval uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, HalvingInterval, &halvingInterval, simState.Rand,
+		func(r *rand.Rand) { halvingInterval = GenHalvingInterval(r) },
+	)
+
+	var initialAnnualProvisions sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InitialAnnualProvisions, &initialAnnualProvisions, simState.Rand,
+		func(r *rand.Rand) { initialAnnualProvisions = GenInitialAnnualProvisions(r) },
+	)
+
+	var maxSupply math.Int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxSupply, &maxSupply, simState.Rand,
+		func(r *rand.Rand) { maxSupply = GenMaxSupply(r) },
+	)
+
+	var supplyDecayRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SupplyDecayRate, &supplyDecayRate, simState.Rand,
+		func(r *rand.Rand) { supplyDecayRate = GenSupplyDecayRate(r) },
+	)
+
+	var inflationSteps []types.InflationStep
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InflationSteps, &inflationSteps, simState.Rand,
+		func(r *rand.Rand) { inflationSteps = GenInflationSteps(r) },
+	)
+
 	mintDenom := sdk.DefaultBondDenom
 	blocksPerYear := uint64(60 * 60 * 8766 / 5)
 	params := types.NewParams(mintDenom, inflationRateChange, inflationMax, inflationMin, goalBonded, blocksPerYear)
+	params.InflationSchedule = inflationSchedule
+	params.FixedInflation = fixedInflation
+	params.HalvingInterval = halvingInterval
+	params.InitialAnnualProvisions = initialAnnualProvisions
+	params.MaxSupply = maxSupply
+	params.SupplyDecayRate = supplyDecayRate
+	params.InflationSteps = inflationSteps
 
 	mintGenesis := types.NewGenesisState(types.InitialMinter(inflation), params)
 
@@ -45,6 +45,7 @@ func TestRandomizedGenState(t *testing.T) {
 	dec2, _ := sdk.NewDecFromStr("0.200000000000000000")
 	dec3, _ := sdk.NewDecFromStr("0.070000000000000000")
 
+	require.NoError(t, mintGenesis.Params.Validate())
 	require.Equal(t, uint64(6311520), mintGenesis.Params.BlocksPerYear)
 	require.Equal(t, dec1, mintGenesis.Params.GoalBonded)
 	require.Equal(t, dec2, mintGenesis.Params.InflationMax)
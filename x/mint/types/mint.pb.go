@@ -25,6 +25,51 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InflationSchedule enumerates the schedules used to calculate the inflation
+// rate.
+type InflationSchedule int32
+
+const (
+	// INFLATION_SCHEDULE_BONDED_RATIO adjusts the inflation rate towards the goal
+	// bonded ratio, using the app's InflationCalculationFn.
+	InflationScheduleBondedRatio InflationSchedule = 0
+	// INFLATION_SCHEDULE_FIXED uses a fixed annual inflation rate.
+	InflationScheduleFixed InflationSchedule = 1
+	// INFLATION_SCHEDULE_HALVING mints fixed annual provisions which are halved
+	// every halving interval.
+	InflationScheduleHalving InflationSchedule = 2
+	// INFLATION_SCHEDULE_CAPPED_SUPPLY mints a fraction of the supply still to be
+	// minted before the maximum supply is reached.
+	InflationScheduleCappedSupply InflationSchedule = 3
+	// INFLATION_SCHEDULE_PIECEWISE uses the inflation rate of the last inflation
+	// step started.
+	InflationSchedulePiecewise InflationSchedule = 4
+)
+
+var InflationSchedule_name = map[int32]string{
+	0: "INFLATION_SCHEDULE_BONDED_RATIO",
+	1: "INFLATION_SCHEDULE_FIXED",
+	2: "INFLATION_SCHEDULE_HALVING",
+	3: "INFLATION_SCHEDULE_CAPPED_SUPPLY",
+	4: "INFLATION_SCHEDULE_PIECEWISE",
+}
+
+var InflationSchedule_value = map[string]int32{
+	"INFLATION_SCHEDULE_BONDED_RATIO":  0,
+	"INFLATION_SCHEDULE_FIXED":         1,
+	"INFLATION_SCHEDULE_HALVING":       2,
+	"INFLATION_SCHEDULE_CAPPED_SUPPLY": 3,
+	"INFLATION_SCHEDULE_PIECEWISE":     4,
+}
+
+func (x InflationSchedule) String() string {
+	return proto.EnumName(InflationSchedule_name, int32(x))
+}
+
+func (InflationSchedule) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{0}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// current annual inflation rate
@@ -80,6 +125,21 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// schedule used to calculate the inflation rate
+	InflationSchedule InflationSchedule `protobuf:"varint,7,opt,name=inflation_schedule,json=inflationSchedule,proto3,enum=cosmos.mint.v1beta1.InflationSchedule" json:"inflation_schedule,omitempty"`
+	// annual inflation rate of the fixed schedule
+	FixedInflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=fixed_inflation,json=fixedInflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fixed_inflation"`
+	// number of blocks between two halvings of the halving schedule
+	HalvingInterval uint64 `protobuf:"varint,9,opt,name=halving_interval,json=halvingInterval,proto3" json:"halving_interval,omitempty"`
+	// annual provisions of the halving schedule before the first halving
+	InitialAnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=initial_annual_provisions,json=initialAnnualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"initial_annual_provisions"`
+	// maximum supply of the capped supply schedule
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
+	// fraction of the supply still to be minted that the capped supply schedule
+	// mints per year
+	SupplyDecayRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=supply_decay_rate,json=supplyDecayRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"supply_decay_rate"`
+	// annual inflation rates of the piecewise schedule, ordered by height
+	InflationSteps []InflationStep `protobuf:"bytes,13,rep,name=inflation_steps,json=inflationSteps,proto3" json:"inflation_steps"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -128,40 +188,137 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetInflationSchedule() InflationSchedule {
+	if m != nil {
+		return m.InflationSchedule
+	}
+	return InflationScheduleBondedRatio
+}
+
+func (m *Params) GetHalvingInterval() uint64 {
+	if m != nil {
+		return m.HalvingInterval
+	}
+	return 0
+}
+
+func (m *Params) GetInflationSteps() []InflationStep {
+	if m != nil {
+		return m.InflationSteps
+	}
+	return nil
+}
+
+// InflationStep defines the annual inflation rate of the piecewise schedule
+// from a given height onwards.
+type InflationStep struct {
+	// height from which the inflation rate applies
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// annual inflation rate
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+}
+
+func (m *InflationStep) Reset()         { *m = InflationStep{} }
+func (m *InflationStep) String() string { return proto.CompactTextString(m) }
+func (*InflationStep) ProtoMessage()    {}
+func (*InflationStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *InflationStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationStep.Merge(m, src)
+}
+func (m *InflationStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationStep proto.InternalMessageInfo
+
+func (m *InflationStep) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.mint.v1beta1.InflationSchedule", InflationSchedule_name, InflationSchedule_value)
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*InflationStep)(nil), "cosmos.mint.v1beta1.InflationStep")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0xaa, 0xda, 0x40,
-	0x18, 0x85, 0x93, 0x6a, 0x03, 0x4e, 0x2b, 0x6d, 0xc7, 0x16, 0x52, 0xa1, 0x51, 0x5c, 0x88, 0x5d,
-	0x98, 0x20, 0xdd, 0x95, 0xae, 0xd4, 0xad, 0x20, 0xd9, 0x55, 0x28, 0x61, 0x92, 0x4c, 0xe3, 0x60,
-	0x32, 0x13, 0x66, 0x46, 0xd1, 0xb7, 0xe8, 0xb2, 0xcb, 0x3e, 0x44, 0x1f, 0xc2, 0x5d, 0xa5, 0xab,
-	0xd2, 0x85, 0x5c, 0x74, 0x7b, 0x1f, 0xe2, 0x92, 0x99, 0x10, 0xe1, 0x2e, 0xee, 0x2a, 0xab, 0x64,
-	0xce, 0x99, 0xff, 0x3b, 0x27, 0x81, 0x1f, 0x38, 0x11, 0x13, 0x19, 0x13, 0x5e, 0x46, 0xa8, 0xf4,
-	0x76, 0x93, 0x10, 0x4b, 0x34, 0x51, 0x07, 0x37, 0xe7, 0x4c, 0x32, 0xd8, 0xd1, 0xbe, 0xab, 0xa4,
-	0xd2, 0xef, 0xbe, 0x4d, 0x58, 0xc2, 0x94, 0xef, 0x15, 0x6f, 0xfa, 0x6a, 0xf7, 0xbd, 0xbe, 0x1a,
-	0x68, 0xa3, 0x9c, 0x53, 0x87, 0xc1, 0x1f, 0x13, 0x58, 0x0b, 0x42, 0x25, 0xe6, 0x70, 0x05, 0x5a,
-	0x84, 0x7e, 0x4f, 0x91, 0x24, 0x8c, 0xda, 0x66, 0xdf, 0x1c, 0xb5, 0xa6, 0x5f, 0x8e, 0xe7, 0x9e,
-	0xf1, 0xff, 0xdc, 0x1b, 0x26, 0x44, 0xae, 0xb7, 0xa1, 0x1b, 0xb1, 0xac, 0x1c, 0x2f, 0x1f, 0x63,
-	0x11, 0x6f, 0x3c, 0x79, 0xc8, 0xb1, 0x70, 0xe7, 0x38, 0xfa, 0xfb, 0x7b, 0x0c, 0x4a, 0xfa, 0x1c,
-	0x47, 0xfe, 0x0d, 0x07, 0x09, 0x78, 0x83, 0x28, 0xdd, 0xa2, 0xb4, 0xe8, 0xb0, 0x23, 0x82, 0x30,
-	0x2a, 0xec, 0x67, 0x35, 0x64, 0xbc, 0xd6, 0xd8, 0x65, 0x45, 0x1d, 0xdc, 0x37, 0x80, 0xb5, 0x44,
-	0x1c, 0x65, 0x02, 0x7e, 0x00, 0xa0, 0xf8, 0x3b, 0x41, 0x8c, 0x29, 0xcb, 0xf4, 0x27, 0xf9, 0xad,
-	0x42, 0x99, 0x17, 0x02, 0xcc, 0xc1, 0xbb, 0xaa, 0x61, 0xc0, 0x91, 0xc4, 0x41, 0xb4, 0x46, 0x34,
-	0xc1, 0xb5, 0x14, 0xeb, 0x54, 0x68, 0x1f, 0x49, 0x3c, 0x53, 0x60, 0x88, 0x40, 0xfb, 0x96, 0x98,
-	0xa1, 0xbd, 0xdd, 0xa8, 0x21, 0xe9, 0x65, 0x85, 0x5c, 0xa0, 0xfd, 0xa3, 0x08, 0x42, 0xed, 0x66,
-	0xbd, 0x11, 0x84, 0xc2, 0x6f, 0xe0, 0x45, 0xc2, 0x50, 0x1a, 0x84, 0x8c, 0xc6, 0x38, 0xb6, 0x9f,
-	0xd7, 0x10, 0x00, 0x0a, 0xe0, 0x54, 0xf1, 0xe0, 0x10, 0xbc, 0x0a, 0x53, 0x16, 0x6d, 0x44, 0x90,
-	0x63, 0x1e, 0x1c, 0x30, 0xe2, 0xb6, 0xd5, 0x37, 0x47, 0x4d, 0xbf, 0xad, 0xe5, 0x25, 0xe6, 0x5f,
-	0x31, 0xe2, 0x9f, 0x9b, 0x3f, 0x7f, 0xf5, 0x8c, 0xe9, 0xec, 0x78, 0x71, 0xcc, 0xd3, 0xc5, 0x31,
-	0xef, 0x2e, 0x8e, 0xf9, 0xe3, 0xea, 0x18, 0xa7, 0xab, 0x63, 0xfc, 0xbb, 0x3a, 0xc6, 0xea, 0xe3,
-	0x93, 0x4d, 0xf6, 0x7a, 0xb1, 0x54, 0xa1, 0xd0, 0x52, 0xcb, 0xf0, 0xe9, 0x21, 0x00, 0x00, 0xff,
-	0xff, 0x83, 0xde, 0x9f, 0x75, 0x74, 0x03, 0x00, 0x00,
+	// 792 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0xc7, 0xe3, 0xdd, 0xb0, 0x34, 0xb3, 0x3f, 0x92, 0x9d, 0xf2, 0xc3, 0xb5, 0xb6, 0x5e, 0x37,
+	0x87, 0x55, 0x8a, 0xd4, 0x44, 0x2d, 0x17, 0x84, 0x7a, 0x20, 0x89, 0xbd, 0x8d, 0xa5, 0x34, 0x35,
+	0x0e, 0x0b, 0xb4, 0x08, 0x8d, 0x26, 0xf6, 0x34, 0x19, 0xd5, 0x1e, 0x5b, 0xf6, 0x24, 0x24, 0xff,
+	0x01, 0xca, 0x01, 0x71, 0xe4, 0x12, 0x09, 0x89, 0x7f, 0x81, 0x3f, 0xa2, 0x37, 0x2a, 0x4e, 0x88,
+	0x43, 0x85, 0x36, 0x27, 0xfe, 0x0b, 0xe4, 0xb1, 0x49, 0xb6, 0x9b, 0x08, 0x54, 0xc9, 0xa7, 0x5d,
+	0x7f, 0xe7, 0xbd, 0xcf, 0x7b, 0x2f, 0x7e, 0xdf, 0x31, 0x50, 0x9d, 0x20, 0xf6, 0x83, 0xb8, 0xe1,
+	0x53, 0xc6, 0x1b, 0x93, 0xfb, 0x03, 0xc2, 0xf1, 0x7d, 0xf1, 0x50, 0x0f, 0xa3, 0x80, 0x07, 0xf0,
+	0x66, 0x7a, 0x5e, 0x17, 0x52, 0x76, 0xae, 0xbc, 0x37, 0x0c, 0x86, 0x81, 0x38, 0x6f, 0x24, 0xff,
+	0xa5, 0xa1, 0xca, 0xad, 0x34, 0x14, 0xa5, 0x07, 0x59, 0x9e, 0x78, 0xa8, 0xfe, 0x26, 0x81, 0xbd,
+	0xc7, 0x94, 0x71, 0x12, 0xc1, 0x67, 0xa0, 0x44, 0xd9, 0x73, 0x0f, 0x73, 0x1a, 0x30, 0x59, 0xd2,
+	0xa4, 0x5a, 0xa9, 0xf5, 0xf0, 0xe5, 0xeb, 0xd3, 0xc2, 0x9f, 0xaf, 0x4f, 0xcf, 0x86, 0x94, 0x8f,
+	0xc6, 0x83, 0xba, 0x13, 0xf8, 0x59, 0x7a, 0xf6, 0xe7, 0x5e, 0xec, 0xbe, 0x68, 0xf0, 0x59, 0x48,
+	0xe2, 0xba, 0x4e, 0x9c, 0xdf, 0x7f, 0xbd, 0x07, 0x32, 0xba, 0x4e, 0x1c, 0x7b, 0x8d, 0x83, 0x14,
+	0x1c, 0x63, 0xc6, 0xc6, 0xd8, 0x4b, 0x7a, 0x98, 0xd0, 0x98, 0x06, 0x2c, 0x96, 0x77, 0x72, 0xa8,
+	0x51, 0x49, 0xb1, 0xd6, 0x8a, 0x5a, 0xfd, 0xfb, 0x06, 0xd8, 0xb3, 0x70, 0x84, 0xfd, 0x18, 0xde,
+	0x06, 0x20, 0xf9, 0x75, 0x90, 0x4b, 0x58, 0xe0, 0xa7, 0x23, 0xd9, 0xa5, 0x44, 0xd1, 0x13, 0x01,
+	0x86, 0xe0, 0xfd, 0x55, 0x87, 0x28, 0xc2, 0x9c, 0x20, 0x67, 0x84, 0xd9, 0x90, 0xe4, 0xd2, 0xd8,
+	0xcd, 0x15, 0xda, 0xc6, 0x9c, 0xb4, 0x05, 0x18, 0x62, 0x70, 0xb8, 0xae, 0xe8, 0xe3, 0xa9, 0xbc,
+	0x9b, 0x43, 0xa5, 0x83, 0x15, 0xf2, 0x31, 0x9e, 0x5e, 0x2b, 0x41, 0x99, 0x5c, 0xcc, 0xb7, 0x04,
+	0x65, 0xf0, 0x5b, 0xb0, 0x3f, 0x0c, 0xb0, 0x87, 0x06, 0x01, 0x73, 0x89, 0x2b, 0xbf, 0x93, 0x43,
+	0x01, 0x90, 0x00, 0x5b, 0x82, 0x07, 0xcf, 0x40, 0x79, 0xe0, 0x05, 0xce, 0x8b, 0x18, 0x85, 0x24,
+	0x42, 0x33, 0x82, 0x23, 0x79, 0x4f, 0x93, 0x6a, 0x45, 0xfb, 0x30, 0x95, 0x2d, 0x12, 0x3d, 0x25,
+	0x38, 0x82, 0x17, 0x00, 0xae, 0x27, 0x8d, 0x9d, 0x11, 0x71, 0xc7, 0x1e, 0x91, 0xdf, 0xd5, 0xa4,
+	0xda, 0xd1, 0x83, 0xb3, 0xfa, 0x16, 0x77, 0xd4, 0xcd, 0x7f, 0xc3, 0xfb, 0x59, 0xb4, 0x7d, 0x4c,
+	0xaf, 0x4b, 0x90, 0x80, 0xf2, 0x73, 0x3a, 0x25, 0x2e, 0x5a, 0x9b, 0xe1, 0x46, 0x0e, 0x13, 0x1e,
+	0x09, 0xe8, 0xaa, 0x03, 0x78, 0x17, 0x54, 0x46, 0xd8, 0x9b, 0x50, 0x36, 0x44, 0xc2, 0x7e, 0x13,
+	0xec, 0xc9, 0x25, 0x31, 0x66, 0x39, 0xd3, 0xcd, 0x4c, 0x86, 0x53, 0x70, 0x8b, 0x32, 0xca, 0x29,
+	0xf6, 0xd0, 0xa6, 0x89, 0x40, 0x0e, 0xbd, 0x7d, 0x98, 0xe1, 0x9b, 0xd7, 0xbc, 0x04, 0xbf, 0x01,
+	0xc0, 0xc7, 0x53, 0x14, 0x8f, 0xc3, 0xd0, 0x9b, 0xc9, 0xfb, 0x6f, 0x5d, 0xca, 0x64, 0xfc, 0x4a,
+	0x29, 0x93, 0x71, 0xbb, 0xe4, 0xe3, 0x69, 0x5f, 0xe0, 0xe0, 0x08, 0x1c, 0xa7, 0x60, 0xe4, 0x12,
+	0x07, 0xcf, 0x84, 0x03, 0xe5, 0x83, 0x1c, 0xc6, 0x29, 0xa7, 0x58, 0x3d, 0xa1, 0x26, 0xe6, 0x83,
+	0x9f, 0x83, 0xf2, 0x95, 0x4d, 0xe1, 0x24, 0x8c, 0xe5, 0x43, 0x6d, 0xb7, 0xb6, 0xff, 0xa0, 0xfa,
+	0x3f, 0x6b, 0xc2, 0x49, 0xd8, 0x2a, 0x26, 0xbd, 0xd8, 0x47, 0xf4, 0xaa, 0x18, 0x7f, 0x5a, 0xfc,
+	0xe9, 0xe7, 0xd3, 0x42, 0xf5, 0x07, 0x09, 0x1c, 0xbe, 0x11, 0x0d, 0xef, 0x80, 0x83, 0x98, 0xe3,
+	0x88, 0xa3, 0x11, 0xa1, 0xc3, 0x11, 0x17, 0x97, 0xce, 0xae, 0xbd, 0x2f, 0xb4, 0x8e, 0x90, 0xde,
+	0xbc, 0x67, 0x77, 0x72, 0xbd, 0x67, 0x3f, 0x5a, 0xee, 0x80, 0xe3, 0x8d, 0x2d, 0x87, 0x06, 0x38,
+	0x35, 0x7b, 0xe7, 0xdd, 0xe6, 0x17, 0xe6, 0x93, 0x1e, 0xea, 0xb7, 0x3b, 0x86, 0x7e, 0xd1, 0x35,
+	0x50, 0xeb, 0x49, 0x4f, 0x37, 0x74, 0x64, 0x27, 0x72, 0xa5, 0xa0, 0x68, 0xf3, 0x85, 0x76, 0xb2,
+	0x91, 0x9b, 0x7a, 0xd2, 0x4e, 0x34, 0xf8, 0x09, 0x90, 0xb7, 0x60, 0xce, 0xcd, 0xaf, 0x0d, 0xbd,
+	0x22, 0x29, 0xca, 0x7c, 0xa1, 0x7d, 0xb0, 0x91, 0x7f, 0x9e, 0x6c, 0x3d, 0x7c, 0x08, 0x94, 0x2d,
+	0x99, 0x9d, 0x66, 0xf7, 0x4b, 0xb3, 0xf7, 0xa8, 0xb2, 0xa3, 0x9c, 0xcc, 0x17, 0x9a, 0xbc, 0x91,
+	0xdb, 0x49, 0x7d, 0x00, 0x1f, 0x01, 0x6d, 0x4b, 0x76, 0xbb, 0x69, 0x59, 0x86, 0x8e, 0xfa, 0x17,
+	0x96, 0xd5, 0x7d, 0x5a, 0xd9, 0x55, 0xee, 0xcc, 0x17, 0xda, 0xed, 0x0d, 0x46, 0x1b, 0x87, 0x21,
+	0x71, 0xb3, 0x8d, 0xfb, 0x0c, 0x9c, 0x6c, 0x01, 0x59, 0xa6, 0xd1, 0x36, 0xbe, 0x32, 0xfb, 0x46,
+	0xa5, 0xa8, 0xa8, 0xf3, 0x85, 0xa6, 0x6c, 0x40, 0x2c, 0x4a, 0x1c, 0xf2, 0x1d, 0x8d, 0x89, 0x52,
+	0xfc, 0xfe, 0x17, 0xb5, 0xd0, 0x6a, 0xbf, 0xbc, 0x54, 0xa5, 0x57, 0x97, 0xaa, 0xf4, 0xd7, 0xa5,
+	0x2a, 0xfd, 0xb8, 0x54, 0x0b, 0xaf, 0x96, 0x6a, 0xe1, 0x8f, 0xa5, 0x5a, 0x78, 0x76, 0xf7, 0x3f,
+	0x5f, 0xe0, 0x34, 0xfd, 0x98, 0x8b, 0xf7, 0x38, 0xd8, 0x13, 0x1f, 0xe0, 0x8f, 0xff, 0x19, 0x00,
+	0x83, 0xce, 0x79, 0x15, 0xe8, 0x07, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -227,6 +384,70 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InflationSteps) > 0 {
+		for iNdEx := len(m.InflationSteps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InflationSteps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size := m.SupplyDecayRate.Size()
+		i -= size
+		if _, err := m.SupplyDecayRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.InitialAnnualProvisions.Size()
+		i -= size
+		if _, err := m.InitialAnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.HalvingInterval != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.HalvingInterval))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.FixedInflation.Size()
+		i -= size
+		if _, err := m.FixedInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.InflationSchedule != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.InflationSchedule))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -282,6 +503,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InflationStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -327,6 +586,40 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	if m.InflationSchedule != 0 {
+		n += 1 + sovMint(uint64(m.InflationSchedule))
+	}
+	l = m.FixedInflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.HalvingInterval != 0 {
+		n += 1 + sovMint(uint64(m.HalvingInterval))
+	}
+	l = m.InitialAnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.SupplyDecayRate.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.InflationSteps) > 0 {
+		for _, e := range m.InflationSteps {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InflationStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -670,6 +963,317 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSchedule", wireType)
+			}
+			m.InflationSchedule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationSchedule |= InflationSchedule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedInflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FixedInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingInterval", wireType)
+			}
+			m.HalvingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialAnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyDecayRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyDecayRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSteps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationSteps = append(m.InflationSteps, InflationStep{})
+			if err := m.InflationSteps[len(m.InflationSteps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
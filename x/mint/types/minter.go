@@ -43,6 +43,12 @@ func ValidateMinter(minter Minter) error {
 
 // NextInflationRate returns the new inflation rate for the next hour.
 func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec) sdk.Dec {
+	return m.nextInflationRate(params, bondedRatio, 1)
+}
+
+// nextInflationRate returns the inflation rate after adjusting it towards the
+// goal bonded ratio for the given number of blocks.
+func (m Minter) nextInflationRate(params Params, bondedRatio sdk.Dec, blocks int64) sdk.Dec {
 	// The target annual inflation rate is recalculated for each previsions cycle. The
 	// inflation is also subject to a rate change (positive or negative) depending on
 	// the distance from the desired ratio (67%). The maximum rate change possible is
@@ -54,6 +60,9 @@ func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec) sdk.Dec {
 		Sub(bondedRatio.Quo(params.GoalBonded)).
 		Mul(params.InflationRateChange)
 	inflationRateChange := inflationRateChangePerYear.Quo(sdk.NewDec(int64(params.BlocksPerYear)))
+	if blocks != 1 {
+		inflationRateChange = inflationRateChange.MulInt64(blocks)
+	}
 
 	// adjust the new annual inflation for this next cycle
 	inflation := m.Inflation.Add(inflationRateChange) // note inflationRateChange may be negative
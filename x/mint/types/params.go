@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/math"
 	"sigs.k8s.io/yaml"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,6 +20,25 @@ var (
 	KeyInflationMin        = []byte("InflationMin")
 	KeyGoalBonded          = []byte("GoalBonded")
 	KeyBlocksPerYear       = []byte("BlocksPerYear")
+
+	KeyInflationSchedule       = []byte("InflationSchedule")
+	KeyFixedInflation          = []byte("FixedInflation")
+	KeyHalvingInterval         = []byte("HalvingInterval")
+	KeyInitialAnnualProvisions = []byte("InitialAnnualProvisions")
+	KeyMaxSupply               = []byte("MaxSupply")
+	KeySupplyDecayRate         = []byte("SupplyDecayRate")
+	KeyInflationSteps          = []byte("InflationSteps")
+)
+
+// Default values of the inflation schedule parameters
+var (
+	DefaultInflationSchedule       = InflationScheduleBondedRatio
+	DefaultFixedInflation          = sdk.ZeroDec()
+	DefaultHalvingInterval         = uint64(60 * 60 * 8766 / 5 * 4) // four years of 5 second blocks
+	DefaultInitialAnnualProvisions = sdk.ZeroDec()
+	DefaultMaxSupply               = sdk.ZeroInt()
+	DefaultSupplyDecayRate         = sdk.ZeroDec()
+	DefaultInflationSteps          = []InflationStep{}
 )
 
 // ParamTable for minting module.
@@ -36,6 +56,14 @@ func NewParams(
 		InflationMin:        inflationMin,
 		GoalBonded:          goalBonded,
 		BlocksPerYear:       blocksPerYear,
+
+		InflationSchedule:       DefaultInflationSchedule,
+		FixedInflation:          DefaultFixedInflation,
+		HalvingInterval:         DefaultHalvingInterval,
+		InitialAnnualProvisions: DefaultInitialAnnualProvisions,
+		MaxSupply:               DefaultMaxSupply,
+		SupplyDecayRate:         DefaultSupplyDecayRate,
+		InflationSteps:          DefaultInflationSteps,
 	}
 }
 
@@ -48,6 +76,14 @@ func DefaultParams() Params {
 		InflationMin:        sdk.NewDecWithPrec(7, 2),
 		GoalBonded:          sdk.NewDecWithPrec(67, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+
+		InflationSchedule:       DefaultInflationSchedule,
+		FixedInflation:          DefaultFixedInflation,
+		HalvingInterval:         DefaultHalvingInterval,
+		InitialAnnualProvisions: DefaultInitialAnnualProvisions,
+		MaxSupply:               DefaultMaxSupply,
+		SupplyDecayRate:         DefaultSupplyDecayRate,
+		InflationSteps:          DefaultInflationSteps,
 	}
 }
 
@@ -71,6 +107,27 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateInflationSchedule(p.InflationSchedule); err != nil {
+		return err
+	}
+	if err := validateFixedInflation(p.FixedInflation); err != nil {
+		return err
+	}
+	if err := validateHalvingInterval(p.HalvingInterval); err != nil {
+		return err
+	}
+	if err := validateInitialAnnualProvisions(p.InitialAnnualProvisions); err != nil {
+		return err
+	}
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
+	if err := validateSupplyDecayRate(p.SupplyDecayRate); err != nil {
+		return err
+	}
+	if err := validateInflationSteps(p.InflationSteps); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -96,6 +153,13 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflationMin),
 		paramtypes.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
 		paramtypes.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		paramtypes.NewParamSetPair(KeyInflationSchedule, &p.InflationSchedule, validateInflationSchedule),
+		paramtypes.NewParamSetPair(KeyFixedInflation, &p.FixedInflation, validateFixedInflation),
+		paramtypes.NewParamSetPair(KeyHalvingInterval, &p.HalvingInterval, validateHalvingInterval),
+		paramtypes.NewParamSetPair(KeyInitialAnnualProvisions, &p.InitialAnnualProvisions, validateInitialAnnualProvisions),
+		paramtypes.NewParamSetPair(KeyMaxSupply, &p.MaxSupply, validateMaxSupply),
+		paramtypes.NewParamSetPair(KeySupplyDecayRate, &p.SupplyDecayRate, validateSupplyDecayRate),
+		paramtypes.NewParamSetPair(KeyInflationSteps, &p.InflationSteps, validateInflationSteps),
 	}
 }
 
@@ -191,3 +255,111 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateInflationSchedule(i interface{}) error {
+	v, ok := i.(InflationSchedule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := InflationSchedule_name[int32(v)]; !ok {
+		return fmt.Errorf("unknown inflation schedule: %d", v)
+	}
+
+	return nil
+}
+
+func validateFixedInflation(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("fixed inflation cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fixed inflation too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateHalvingInterval(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("halving interval must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateInitialAnnualProvisions(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("initial annual provisions cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxSupply(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("max supply cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateSupplyDecayRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("supply decay rate cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("supply decay rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateInflationSteps(i interface{}) error {
+	v, ok := i.([]InflationStep)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for j, step := range v {
+		if step.StartHeight < 0 {
+			return fmt.Errorf("inflation step start height cannot be negative: %d", step.StartHeight)
+		}
+		if j > 0 && step.StartHeight <= v[j-1].StartHeight {
+			return fmt.Errorf("inflation steps must be ordered by strictly increasing start height: %d after %d", step.StartHeight, v[j-1].StartHeight)
+		}
+		if step.Inflation.IsNil() || step.Inflation.IsNegative() {
+			return fmt.Errorf("inflation step inflation cannot be negative: %s", step.Inflation)
+		}
+		if step.Inflation.GT(sdk.OneDec()) {
+			return fmt.Errorf("inflation step inflation too large: %s", step.Inflation)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package types
+
+import (
+	"sort"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// projectionStepsPerYear is the number of steps per year of a supply
+// projection. The inflation rate is recalculated at the start of each step.
+const projectionStepsPerYear = 365
+
+// MaxProjectionYears is the maximum number of years a supply projection can
+// cover.
+const MaxProjectionYears = 100
+
+// ScheduledInflationRate returns the annual inflation rate of the inflation
+// schedule selected by the params at the given height and supply. It must not
+// be called for the bonded ratio schedule, whose rate is calculated by the
+// app's InflationCalculationFn.
+func (p Params) ScheduledInflationRate(height int64, supply math.Int) sdk.Dec {
+	switch p.InflationSchedule {
+	case InflationScheduleFixed:
+		return p.FixedInflation
+
+	case InflationScheduleHalving:
+		if !supply.IsPositive() {
+			return sdk.ZeroDec()
+		}
+		provisions := p.InitialAnnualProvisions
+		if height > 0 && p.HalvingInterval > 0 {
+			// stop halving once the provisions are zero, so that the loop is
+			// bounded by the precision of sdk.Dec
+			for i := uint64(height) / p.HalvingInterval; i > 0 && provisions.IsPositive(); i-- {
+				provisions = provisions.QuoInt64(2)
+			}
+		}
+		return provisions.QuoInt(supply)
+
+	case InflationScheduleCappedSupply:
+		if !supply.IsPositive() || supply.GTE(p.MaxSupply) {
+			return sdk.ZeroDec()
+		}
+		// truncate so that the provisions never exceed the remaining supply
+		return p.SupplyDecayRate.MulInt(p.MaxSupply.Sub(supply)).QuoInt(supply)
+
+	case InflationSchedulePiecewise:
+		i := sort.Search(len(p.InflationSteps), func(i int) bool {
+			return p.InflationSteps[i].StartHeight > height
+		})
+		if i == 0 {
+			return sdk.ZeroDec()
+		}
+		return p.InflationSteps[i-1].Inflation
+
+	default:
+		panic("inflation rate of the bonded ratio schedule is calculated by the InflationCalculationFn")
+	}
+}
+
+// nextScheduleChange returns the first height after the given height at which
+// the inflation schedule selected by the params changes the inflation rate
+// independently of the supply, or zero if there is no such height.
+func (p Params) nextScheduleChange(height int64) int64 {
+	switch p.InflationSchedule {
+	case InflationScheduleHalving:
+		if p.HalvingInterval == 0 || height < 0 {
+			return 0
+		}
+		interval := int64(p.HalvingInterval)
+		return (height/interval + 1) * interval
+
+	case InflationSchedulePiecewise:
+		i := sort.Search(len(p.InflationSteps), func(i int) bool {
+			return p.InflationSteps[i].StartHeight > height
+		})
+		if i == len(p.InflationSteps) {
+			return 0
+		}
+		return p.InflationSteps[i].StartHeight
+
+	default:
+		return 0
+	}
+}
+
+// ProjectSupply projects the supply and the inflation rate at the target height,
+// starting from the minter and supply at the given height. The inflation
+// schedule, bonded ratio and blocks per year are assumed not to change, and
+// the bonded ratio schedule is assumed to use NextInflationRate.
+//
+// The projection does not mint block by block. Instead, the inflation rate is
+// recalculated at the start of each step and applied to all the blocks of the
+// step, where steps are roughly a day long and also end at every change of the
+// schedule.
+func ProjectSupply(minter Minter, params Params, bondedRatio sdk.Dec, supply math.Int, height, target int64) (math.Int, sdk.Dec) {
+	blocksPerYear := int64(params.BlocksPerYear)
+	stepBlocks := blocksPerYear / projectionStepsPerYear
+	if stepBlocks == 0 {
+		stepBlocks = 1
+	}
+
+	for height < target {
+		// the first block of the step is minted at height+1
+		blocks := target - height
+		if blocks > stepBlocks {
+			blocks = stepBlocks
+		}
+		if next := params.nextScheduleChange(height + 1); next > 0 && next-height-1 < blocks {
+			blocks = next - height - 1
+		}
+
+		var inflation sdk.Dec
+		if params.InflationSchedule == InflationScheduleBondedRatio {
+			inflation = minter.nextInflationRate(params, bondedRatio, 1)
+			minter.Inflation = minter.nextInflationRate(params, bondedRatio, blocks)
+		} else {
+			inflation = params.ScheduledInflationRate(height+1, supply)
+		}
+
+		var next math.Int
+		switch params.InflationSchedule {
+		case InflationScheduleHalving:
+			// the provisions are the same for every block until the next halving
+			provision := inflation.MulInt(supply).QuoInt64(blocksPerYear).TruncateInt()
+			next = supply.Add(provision.MulRaw(blocks))
+
+		case InflationScheduleCappedSupply:
+			// the supply still to be minted decays by the same fraction every block
+			if supply.GTE(params.MaxSupply) {
+				next = supply
+				break
+			}
+			decay := sdk.OneDec().Sub(params.SupplyDecayRate.QuoInt64(blocksPerYear)).Power(uint64(blocks))
+			next = params.MaxSupply.Sub(decay.MulInt(params.MaxSupply.Sub(supply)).Ceil().TruncateInt())
+
+		default:
+			growth := sdk.OneDec().Add(inflation.QuoInt64(blocksPerYear)).Power(uint64(blocks))
+			next = growth.MulInt(supply).TruncateInt()
+		}
+
+		supply = next
+		height += blocks
+	}
+
+	if params.InflationSchedule == InflationScheduleBondedRatio {
+		return supply, minter.Inflation
+	}
+	return supply, params.ScheduledInflationRate(target, supply)
+}
@@ -0,0 +1,173 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestScheduledInflationRate(t *testing.T) {
+	supply := sdk.NewInt(1_000_000)
+
+	fixed := DefaultParams()
+	fixed.InflationSchedule = InflationScheduleFixed
+	fixed.FixedInflation = sdk.NewDecWithPrec(5, 2)
+
+	halving := DefaultParams()
+	halving.InflationSchedule = InflationScheduleHalving
+	halving.HalvingInterval = 100
+	halving.InitialAnnualProvisions = sdk.NewDec(80_000)
+
+	capped := DefaultParams()
+	capped.InflationSchedule = InflationScheduleCappedSupply
+	capped.MaxSupply = sdk.NewInt(2_000_000)
+	capped.SupplyDecayRate = sdk.NewDecWithPrec(1, 1)
+
+	piecewise := DefaultParams()
+	piecewise.InflationSchedule = InflationSchedulePiecewise
+	piecewise.InflationSteps = []InflationStep{
+		{StartHeight: 10, Inflation: sdk.NewDecWithPrec(10, 2)},
+		{StartHeight: 20, Inflation: sdk.NewDecWithPrec(5, 2)},
+	}
+
+	tests := []struct {
+		name   string
+		params Params
+		height int64
+		supply sdk.Int
+		exp    sdk.Dec
+	}{
+		{"fixed", fixed, 1, supply, sdk.NewDecWithPrec(5, 2)},
+		{"fixed ignores height", fixed, 1_000_000, supply, sdk.NewDecWithPrec(5, 2)},
+		{"halving before first halving", halving, 99, supply, sdk.NewDecWithPrec(8, 2)},
+		{"halving after first halving", halving, 100, supply, sdk.NewDecWithPrec(4, 2)},
+		{"halving after second halving", halving, 250, supply, sdk.NewDecWithPrec(2, 2)},
+		{"halving after provisions reach zero", halving, 1_000_000, supply, sdk.ZeroDec()},
+		{"halving without supply", halving, 1, sdk.ZeroInt(), sdk.ZeroDec()},
+		{"capped supply", capped, 1, supply, sdk.NewDecWithPrec(1, 1)},
+		{"capped supply half minted", capped, 1, sdk.NewInt(1_600_000), sdk.NewDecWithPrec(25, 3)},
+		{"capped supply reached", capped, 1, sdk.NewInt(2_000_000), sdk.ZeroDec()},
+		{"capped supply exceeded", capped, 1, sdk.NewInt(3_000_000), sdk.ZeroDec()},
+		{"piecewise before first step", piecewise, 9, supply, sdk.ZeroDec()},
+		{"piecewise first step", piecewise, 10, supply, sdk.NewDecWithPrec(10, 2)},
+		{"piecewise between steps", piecewise, 19, supply, sdk.NewDecWithPrec(10, 2)},
+		{"piecewise last step", piecewise, 1_000, supply, sdk.NewDecWithPrec(5, 2)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.params.Validate())
+			rate := tc.params.ScheduledInflationRate(tc.height, tc.supply)
+			require.True(t, tc.exp.Equal(rate), "expected %s, got %s", tc.exp, rate)
+		})
+	}
+
+	require.Panics(t, func() { DefaultParams().ScheduledInflationRate(1, supply) })
+}
+
+func TestValidateInflationScheduleParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		malleate func(p *Params)
+	}{
+		{"unknown schedule", func(p *Params) { p.InflationSchedule = 5 }},
+		{"negative fixed inflation", func(p *Params) { p.FixedInflation = sdk.NewDec(-1) }},
+		{"fixed inflation too large", func(p *Params) { p.FixedInflation = sdk.NewDec(2) }},
+		{"zero halving interval", func(p *Params) { p.HalvingInterval = 0 }},
+		{"negative initial annual provisions", func(p *Params) { p.InitialAnnualProvisions = sdk.NewDec(-1) }},
+		{"negative max supply", func(p *Params) { p.MaxSupply = sdk.NewInt(-1) }},
+		{"supply decay rate too large", func(p *Params) { p.SupplyDecayRate = sdk.NewDec(2) }},
+		{"negative start height", func(p *Params) {
+			p.InflationSteps = []InflationStep{{StartHeight: -1, Inflation: sdk.ZeroDec()}}
+		}},
+		{"unordered steps", func(p *Params) {
+			p.InflationSteps = []InflationStep{
+				{StartHeight: 10, Inflation: sdk.ZeroDec()},
+				{StartHeight: 10, Inflation: sdk.ZeroDec()},
+			}
+		}},
+		{"step inflation too large", func(p *Params) {
+			p.InflationSteps = []InflationStep{{StartHeight: 1, Inflation: sdk.NewDec(2)}}
+		}},
+	}
+
+	require.NoError(t, DefaultParams().Validate())
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			tc.malleate(&params)
+			require.Error(t, params.Validate())
+		})
+	}
+}
+
+// mintBlocks mints block by block as BeginBlocker does, for a schedule other
+// than the bonded ratio schedule.
+func mintBlocks(params Params, supply sdk.Int, height, target int64) sdk.Int {
+	minter := DefaultInitialMinter()
+	for height < target {
+		height++
+		minter.Inflation = params.ScheduledInflationRate(height, supply)
+		minter.AnnualProvisions = minter.NextAnnualProvisions(params, supply)
+		supply = supply.Add(minter.BlockProvision(params).Amount)
+	}
+	return supply
+}
+
+func TestProjectSupply(t *testing.T) {
+	supply := sdk.NewInt(1_000_000_000_000)
+	minter := DefaultInitialMinter()
+
+	fixed := DefaultParams()
+	fixed.InflationSchedule = InflationScheduleFixed
+	fixed.FixedInflation = sdk.NewDecWithPrec(10, 2)
+
+	halving := DefaultParams()
+	halving.InflationSchedule = InflationScheduleHalving
+	halving.HalvingInterval = 50_000
+	halving.InitialAnnualProvisions = sdk.NewDec(100_000_000_000)
+
+	capped := DefaultParams()
+	capped.InflationSchedule = InflationScheduleCappedSupply
+	capped.MaxSupply = sdk.NewInt(1_100_000_000_000)
+	capped.SupplyDecayRate = sdk.NewDecWithPrec(5, 1)
+
+	piecewise := DefaultParams()
+	piecewise.InflationSchedule = InflationSchedulePiecewise
+	piecewise.InflationSteps = []InflationStep{
+		{StartHeight: 0, Inflation: sdk.NewDecWithPrec(20, 2)},
+		{StartHeight: 40_000, Inflation: sdk.NewDecWithPrec(5, 2)},
+	}
+
+	// the projection matches minting block by block up to rounding
+	for _, params := range []Params{fixed, halving, capped, piecewise} {
+		t.Run(params.InflationSchedule.String(), func(t *testing.T) {
+			expected := mintBlocks(params, supply, 10, 100_010)
+			projected, inflation := ProjectSupply(minter, params, sdk.ZeroDec(), supply, 10, 100_010)
+
+			diff := projected.Sub(expected).Abs()
+			require.True(t, diff.LT(sdk.NewInt(100_000)), "expected %s, got %s", expected, projected)
+			require.True(t, params.ScheduledInflationRate(100_010, projected).Sub(inflation).Abs().LT(sdk.NewDecWithPrec(1, 6)))
+		})
+	}
+
+	// the capped supply is never exceeded
+	params := capped
+	params.SupplyDecayRate = sdk.OneDec()
+	projected, _ := ProjectSupply(minter, params, sdk.ZeroDec(), supply, 0, int64(params.BlocksPerYear)*MaxProjectionYears)
+	require.True(t, projected.LTE(params.MaxSupply))
+	require.True(t, projected.GT(params.MaxSupply.Sub(sdk.NewInt(1_000))))
+
+	// the bonded ratio schedule moves the inflation rate towards the maximum
+	params = DefaultParams()
+	projected, inflation := ProjectSupply(minter, params, sdk.ZeroDec(), supply, 0, int64(params.BlocksPerYear))
+	require.Equal(t, params.InflationMax, inflation)
+	require.True(t, projected.GT(supply))
+
+	// nothing is minted at the current height
+	projected, inflation = ProjectSupply(minter, fixed, sdk.ZeroDec(), supply, 10, 10)
+	require.Equal(t, supply, projected)
+	require.Equal(t, fixed.FixedInflation, inflation)
+}
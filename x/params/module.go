@@ -116,6 +116,12 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 // ProposalContents returns all the params content functions used to
 // simulate governance proposals.
 func (am AppModule) ProposalContents(simState module.SimulationState) []simtypes.WeightedProposalContent {
+	// modules that manage their own parameters no longer expose them through
+	// x/params, so there may be nothing left to propose changes for
+	if len(simState.ParamChanges) == 0 {
+		return nil
+	}
+
 	return simulation.ProposalContents(simState.ParamChanges)
 }
 
@@ -29,6 +29,11 @@ func (suite *HandlerTestSuite) SetupTest() {
 	suite.app = simapp.Setup(suite.T(), false)
 	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{})
 	suite.govHandler = params.NewParamChangeProposalHandler(suite.app.ParamsKeeper)
+
+	// x/staking no longer registers its key table on the legacy subspace, so
+	// register it here to exercise the proposal handler.
+	ss, _ := suite.app.ParamsKeeper.GetSubspace(stakingtypes.ModuleName)
+	ss.WithKeyTable(stakingtypes.ParamKeyTable())
 }
 
 func TestHandlerTestSuite(t *testing.T) {
@@ -50,7 +55,9 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 			"all fields",
 			testProposal(proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "1")),
 			func() {
-				maxVals := suite.app.StakingKeeper.MaxValidators(suite.ctx)
+				var maxVals uint32
+				ss, _ := suite.app.ParamsKeeper.GetSubspace(stakingtypes.ModuleName)
+				ss.Get(suite.ctx, stakingtypes.KeyMaxValidators, &maxVals)
 				suite.Require().Equal(uint32(1), maxVals)
 			},
 			false,
@@ -69,7 +76,9 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 				Value:    `{"min_deposit": [{"denom": "uatom","amount": "64000000"}], "max_deposit_period": "172800000000000"}`,
 			}),
 			func() {
-				depositParams := suite.app.GovKeeper.GetDepositParams(suite.ctx)
+				var depositParams govv1.DepositParams
+				ss, _ := suite.app.ParamsKeeper.GetSubspace(govtypes.ModuleName)
+				ss.Get(suite.ctx, govv1.ParamStoreKeyDepositParams, &depositParams)
 				defaultPeriod := govv1.DefaultPeriod
 				suite.Require().Equal(govv1.DepositParams{
 					MinDeposit:       sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(64000000))),
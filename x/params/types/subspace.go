@@ -71,16 +71,20 @@ func (s Subspace) WithKeyTable(table KeyTable) Subspace {
 
 // Returns a KVStore identical with ctx.KVStore(s.key).Prefix()
 func (s Subspace) kvStore(ctx sdk.Context) sdk.KVStore {
-	// append here is safe, appends within a function won't cause
-	// weird side effects when its singlethreaded
-	return prefix.NewStore(ctx.KVStore(s.key), append(s.name, '/'))
+	return prefix.NewStore(ctx.KVStore(s.key), s.prefix())
 }
 
 // Returns a transient store for modification
 func (s Subspace) transientStore(ctx sdk.Context) sdk.KVStore {
-	// append here is safe, appends within a function won't cause
-	// weird side effects when its singlethreaded
-	return prefix.NewStore(ctx.TransientStore(s.tkey), append(s.name, '/'))
+	return prefix.NewStore(ctx.TransientStore(s.tkey), s.prefix())
+}
+
+// prefix returns the store prefix of the subspace. The name is copied since the
+// subspace may be used concurrently, e.g. by the txs executed by DeliverTxBatch.
+func (s Subspace) prefix() []byte {
+	prefix := make([]byte, len(s.name), len(s.name)+1)
+	copy(prefix, s.name)
+	return append(prefix, '/')
 }
 
 // Validate attempts to validate a parameter value by its key. If the key is not
@@ -27,6 +27,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQueryDowntimeOffences(),
 	)
 
 	return slashingQueryCmd
@@ -108,6 +109,45 @@ $ <appd> query slashing signing-infos
 	return cmd
 }
 
+// GetCmdQueryDowntimeOffences implements the command to query the downtime
+// offence history of a validator.
+func GetCmdQueryDowntimeOffences() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "downtime-offences [validator-conspub]",
+		Short: "Query a validator's downtime offences within the offence window",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to find the downtime offences of that validator:
+
+$ <appd> query slashing downtime-offences '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"OauFcTKbN5Lx3fJL689cikXBqe+hcp6Y+x0rYUdR9Jk="}'
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			consAddr := sdk.ConsAddress(pk.Address())
+			params := &types.QueryDowntimeOffencesRequest{ConsAddress: consAddr.String()}
+			res, err := queryClient.DowntimeOffences(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.DowntimeOffences)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
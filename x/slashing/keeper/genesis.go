@@ -38,7 +38,17 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, stakingKeeper types.StakingKee
 		}
 	}
 
-	keeper.SetParams(ctx, data.Params)
+	for _, offences := range data.DowntimeOffences {
+		address, err := sdk.ConsAddressFromBech32(offences.Address)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetValidatorDowntimeOffences(ctx, address, offences)
+	}
+
+	if err := keeper.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis writes the current store values
@@ -65,5 +75,11 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	downtimeOffences := make([]types.ValidatorDowntimeOffences, 0)
+	keeper.IterateValidatorDowntimeOffences(ctx, func(_ sdk.ConsAddress, offences types.ValidatorDowntimeOffences) (stop bool) {
+		downtimeOffences = append(downtimeOffences, offences)
+		return false
+	})
+
+	return types.NewGenesisState(params, signingInfos, missedBlocks, downtimeOffences)
 }
@@ -72,3 +72,23 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+func (k Keeper) DowntimeOffences(c context.Context, req *types.QueryDowntimeOffencesRequest) (*types.QueryDowntimeOffencesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	offences := k.GetValidatorDowntimeOffences(ctx, consAddr)
+
+	return &types.QueryDowntimeOffencesResponse{DowntimeOffences: offences}, nil
+}
@@ -84,7 +84,16 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-			coinsBurned := k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
+			// Escalate the penalty by the number of downtime offences the validator committed within the
+			// offence window, and tombstone it once it reaches the configured maximum.
+			params := k.GetParams(ctx)
+			blockTime := ctx.BlockHeader().Time
+			offences := k.GetValidatorDowntimeOffences(ctx, consAddr).PruneBefore(blockTime.Add(-params.DowntimeOffenceWindow))
+			jailDuration, slashFraction := params.EscalatedDowntimePenalty(len(offences.Offences), types.TombstoneJailEndTime.Sub(blockTime))
+			offences.Offences = append(offences.Offences, types.NewDowntimeOffence(height, blockTime, jailDuration, slashFraction))
+			tombstone := params.MaxDowntimeOffences > 0 && len(offences.Offences) >= int(params.MaxDowntimeOffences)
+
+			coinsBurned := k.sk.Slash(ctx, consAddr, distributionHeight, power, slashFraction)
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
@@ -93,11 +102,29 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
 					sdk.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
+					sdk.NewAttribute(types.AttributeKeyOffences, fmt.Sprintf("%d", len(offences.Offences))),
 				),
 			)
 			k.sk.Jail(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			signInfo.JailedUntil = blockTime.Add(jailDuration)
+
+			if tombstone {
+				// The validator exhausted its downtime offences: it can never be unjailed again.
+				signInfo.Tombstoned = true
+				signInfo.JailedUntil = types.TombstoneJailEndTime
+
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeSlash,
+						sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+						sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueRepeatedDowntime),
+						sdk.NewAttribute(types.AttributeKeyTombstoned, consAddr.String()),
+					),
+				)
+			}
+
+			k.SetValidatorDowntimeOffences(ctx, consAddr, offences)
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
@@ -110,8 +137,10 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 				"validator", consAddr.String(),
 				"min_height", minHeight,
 				"threshold", minSignedPerWindow,
-				"slashed", k.SlashFractionDowntime(ctx).String(),
+				"slashed", slashFraction.String(),
 				"jailed_until", signInfo.JailedUntil,
+				"downtime_offences", len(offences.Offences),
+				"tombstoned", tombstone,
 			)
 		} else {
 			// validator was (a) not found or (b) already jailed so we do not slash
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/testslashing"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -283,3 +284,71 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	staking.EndBlocker(ctx, app.StakingKeeper)
 	tstaking.CheckValidator(valAddr, stakingtypes.Unbonding, true)
 }
+
+// Test that repeated downtime within the offence window escalates the jail
+// duration and slash fraction, and tombstones the validator after the maximum
+// number of offences.
+func TestHandleRepeatedDowntime(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0)})
+
+	params := testslashing.TestParams()
+	params.DowntimeJailMultiplier = sdk.NewDec(2)
+	params.DowntimeSlashMultiplier = sdk.NewDec(3)
+	params.MaxDowntimeOffences = 2
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	power := int64(100)
+	pks := simapp.CreateTestPubKeys(1)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+
+	val := pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	valAddr := sdk.ValAddress(val.Address())
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(valAddr, val, power, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	tstaking.CheckValidator(valAddr, stakingtypes.Bonded, false)
+
+	// missDowntime makes the validator miss enough blocks to be jailed for downtime
+	height := int64(0)
+	missDowntime := func() {
+		latest := height + app.SlashingKeeper.SignedBlocksWindow(ctx) + app.SlashingKeeper.MinSignedPerWindow(ctx) + 1
+		for ; height < latest; height++ {
+			ctx = ctx.WithBlockHeight(height)
+			app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+		}
+		staking.EndBlocker(ctx, app.StakingKeeper)
+		tstaking.CheckValidator(valAddr, stakingtypes.Unbonding, true)
+	}
+
+	// first offence applies the base penalty
+	missDowntime()
+	offences := app.SlashingKeeper.GetValidatorDowntimeOffences(ctx, consAddr)
+	require.Len(t, offences.Offences, 1)
+	require.Equal(t, params.DowntimeJailDuration, offences.Offences[0].JailDuration)
+	require.Equal(t, params.SlashFractionDowntime, offences.Offences[0].SlashFraction)
+	signInfo, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(params.DowntimeJailDuration), signInfo.JailedUntil)
+	require.False(t, signInfo.Tombstoned)
+
+	// validator rejoins after its jail period
+	ctx = ctx.WithBlockTime(signInfo.JailedUntil.Add(time.Second))
+	require.NoError(t, app.SlashingKeeper.Unjail(ctx, valAddr))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	tstaking.CheckValidator(valAddr, stakingtypes.Bonded, false)
+
+	// second offence within the window escalates the penalty and tombstones the validator
+	missDowntime()
+	offences = app.SlashingKeeper.GetValidatorDowntimeOffences(ctx, consAddr)
+	require.Len(t, offences.Offences, 2)
+	require.Equal(t, 2*params.DowntimeJailDuration, offences.Offences[1].JailDuration)
+	require.Equal(t, params.SlashFractionDowntime.MulInt64(3), offences.Offences[1].SlashFraction)
+	signInfo, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.True(t, signInfo.Tombstoned)
+	require.True(t, types.TombstoneJailEndTime.Equal(signInfo.JailedUntil))
+	require.Error(t, app.SlashingKeeper.Unjail(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour*24*365)), valAddr))
+}
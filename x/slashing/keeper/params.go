@@ -8,47 +8,75 @@ import (
 )
 
 // SignedBlocksWindow - sliding window for downtime slashing
-func (k Keeper) SignedBlocksWindow(ctx sdk.Context) (res int64) {
-	k.paramspace.Get(ctx, types.KeySignedBlocksWindow, &res)
-	return
+func (k Keeper) SignedBlocksWindow(ctx sdk.Context) int64 {
+	return k.GetParams(ctx).SignedBlocksWindow
 }
 
 // MinSignedPerWindow - minimum blocks signed per window
 func (k Keeper) MinSignedPerWindow(ctx sdk.Context) int64 {
-	var minSignedPerWindow sdk.Dec
-	k.paramspace.Get(ctx, types.KeyMinSignedPerWindow, &minSignedPerWindow)
-	signedBlocksWindow := k.SignedBlocksWindow(ctx)
+	params := k.GetParams(ctx)
 
 	// NOTE: RoundInt64 will never panic as minSignedPerWindow is
 	//       less than 1.
-	return minSignedPerWindow.MulInt64(signedBlocksWindow).RoundInt64()
+	return params.MinSignedPerWindow.MulInt64(params.SignedBlocksWindow).RoundInt64()
 }
 
 // DowntimeJailDuration - Downtime unbond duration
-func (k Keeper) DowntimeJailDuration(ctx sdk.Context) (res time.Duration) {
-	k.paramspace.Get(ctx, types.KeyDowntimeJailDuration, &res)
-	return
+func (k Keeper) DowntimeJailDuration(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).DowntimeJailDuration
 }
 
 // SlashFractionDoubleSign - fraction of power slashed in case of double sign
-func (k Keeper) SlashFractionDoubleSign(ctx sdk.Context) (res sdk.Dec) {
-	k.paramspace.Get(ctx, types.KeySlashFractionDoubleSign, &res)
-	return
+func (k Keeper) SlashFractionDoubleSign(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).SlashFractionDoubleSign
 }
 
 // SlashFractionDowntime - fraction of power slashed for downtime
-func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
-	k.paramspace.Get(ctx, types.KeySlashFractionDowntime, &res)
-	return
+func (k Keeper) SlashFractionDowntime(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).SlashFractionDowntime
 }
 
-// GetParams returns the total set of slashing parameters.
+// DowntimeOffenceWindow - lookback window for downtime penalty escalation
+func (k Keeper) DowntimeOffenceWindow(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).DowntimeOffenceWindow
+}
+
+// DowntimeJailMultiplier - jail duration factor per previous downtime offence
+func (k Keeper) DowntimeJailMultiplier(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).DowntimeJailMultiplier
+}
+
+// DowntimeSlashMultiplier - slash fraction factor per previous downtime offence
+func (k Keeper) DowntimeSlashMultiplier(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).DowntimeSlashMultiplier
+}
+
+// MaxDowntimeOffences - number of downtime offences within the window after which a validator is tombstoned
+func (k Keeper) MaxDowntimeOffences(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxDowntimeOffences
+}
+
+// GetParams returns the current x/slashing module parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramspace.GetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
-// SetParams sets the slashing parameters to the param space.
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramspace.SetParamSet(ctx, &params)
+// SetParams sets the x/slashing module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
 }
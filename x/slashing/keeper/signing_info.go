@@ -156,3 +156,48 @@ func (k Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.C
 		store.Delete(iter.Key())
 	}
 }
+
+// GetValidatorDowntimeOffences returns the downtime offence history of a
+// validator. An empty history is returned if none was recorded.
+func (k Keeper) GetValidatorDowntimeOffences(ctx sdk.Context, address sdk.ConsAddress) types.ValidatorDowntimeOffences {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ValidatorDowntimeOffencesKey(address))
+	if bz == nil {
+		return types.NewValidatorDowntimeOffences(address, []types.DowntimeOffence{})
+	}
+
+	var offences types.ValidatorDowntimeOffences
+	k.cdc.MustUnmarshal(bz, &offences)
+	return offences
+}
+
+// SetValidatorDowntimeOffences sets the downtime offence history of a
+// validator. An empty history is removed from the store.
+func (k Keeper) SetValidatorDowntimeOffences(ctx sdk.Context, address sdk.ConsAddress, offences types.ValidatorDowntimeOffences) {
+	store := ctx.KVStore(k.storeKey)
+	if len(offences.Offences) == 0 {
+		store.Delete(types.ValidatorDowntimeOffencesKey(address))
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&offences)
+	store.Set(types.ValidatorDowntimeOffencesKey(address), bz)
+}
+
+// IterateValidatorDowntimeOffences iterates over the stored downtime offence
+// histories
+func (k Keeper) IterateValidatorDowntimeOffences(ctx sdk.Context,
+	handler func(address sdk.ConsAddress, offences types.ValidatorDowntimeOffences) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorDowntimeOffencesKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		address := types.ValidatorDowntimeOffencesAddress(iter.Key())
+		var offences types.ValidatorDowntimeOffences
+		k.cdc.MustUnmarshal(iter.Value(), &offences)
+		if handler(address, offences) {
+			break
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package v047
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/exported"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47.
+// The migration includes:
+//
+// - Setting the downtime escalation params in the paramstore
+func MigrateStore(ctx sdk.Context, paramstore exported.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	paramstore.Set(ctx, types.KeyDowntimeOffenceWindow, types.DefaultDowntimeOffenceWindow)
+	paramstore.Set(ctx, types.KeyDowntimeJailMultiplier, types.DefaultDowntimeJailMultiplier)
+	paramstore.Set(ctx, types.KeyDowntimeSlashMultiplier, types.DefaultDowntimeSlashMultiplier)
+	paramstore.Set(ctx, types.KeyMaxDowntimeOffences, types.DefaultMaxDowntimeOffences)
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v047slashing "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	slashingKey := sdk.NewKVStoreKey("slashing")
+	tSlashingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(slashingKey, tSlashingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, slashingKey, tSlashingKey, "slashing")
+
+	// Check no params
+	require.False(t, paramstore.Has(ctx, types.KeyDowntimeOffenceWindow))
+	require.False(t, paramstore.Has(ctx, types.KeyMaxDowntimeOffences))
+
+	// Run migrations.
+	err := v047slashing.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	require.True(t, paramstore.Has(ctx, types.KeyDowntimeOffenceWindow))
+	require.True(t, paramstore.Has(ctx, types.KeyDowntimeJailMultiplier))
+	require.True(t, paramstore.Has(ctx, types.KeyDowntimeSlashMultiplier))
+	require.True(t, paramstore.Has(ctx, types.KeyMaxDowntimeOffences))
+}
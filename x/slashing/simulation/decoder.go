@@ -39,6 +39,12 @@ func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
 			}
 			return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", pubKeyA, pubKeyB)
 
+		case bytes.Equal(kvA.Key[:1], types.ValidatorDowntimeOffencesKeyPrefix):
+			var offencesA, offencesB types.ValidatorDowntimeOffences
+			cdc.MustUnmarshal(kvA.Value, &offencesA)
+			cdc.MustUnmarshal(kvB.Value, &offencesB)
+			return fmt.Sprintf("%v\n%v", offencesA, offencesB)
+
 		default:
 			panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 		}
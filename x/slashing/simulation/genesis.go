@@ -21,6 +21,10 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+	DowntimeOffenceWindow   = "downtime_offence_window"
+	DowntimeJailMultiplier  = "downtime_jail_multiplier"
+	DowntimeSlashMultiplier = "downtime_slash_multiplier"
+	MaxDowntimeOffences     = "max_downtime_offences"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -48,6 +52,26 @@ func GenSlashFractionDowntime(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenDowntimeOffenceWindow randomized DowntimeOffenceWindow
+func GenDowntimeOffenceWindow(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 0, 60*60*24*30)) * time.Second
+}
+
+// GenDowntimeJailMultiplier randomized DowntimeJailMultiplier
+func GenDowntimeJailMultiplier(r *rand.Rand) sdk.Dec {
+	return sdk.OneDec().Add(sdk.NewDecWithPrec(int64(r.Intn(20)), 1))
+}
+
+// GenDowntimeSlashMultiplier randomized DowntimeSlashMultiplier
+func GenDowntimeSlashMultiplier(r *rand.Rand) sdk.Dec {
+	return sdk.OneDec().Add(sdk.NewDecWithPrec(int64(r.Intn(20)), 1))
+}
+
+// GenMaxDowntimeOffences randomized MaxDowntimeOffences
+func GenMaxDowntimeOffences(r *rand.Rand) uint32 {
+	return uint32(r.Intn(10))
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -80,12 +104,37 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var downtimeOffenceWindow time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeOffenceWindow, &downtimeOffenceWindow, simState.Rand,
+		func(r *rand.Rand) { downtimeOffenceWindow = GenDowntimeOffenceWindow(r) },
+	)
+
+	var downtimeJailMultiplier sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeJailMultiplier, &downtimeJailMultiplier, simState.Rand,
+		func(r *rand.Rand) { downtimeJailMultiplier = GenDowntimeJailMultiplier(r) },
+	)
+
+	var downtimeSlashMultiplier sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeSlashMultiplier, &downtimeSlashMultiplier, simState.Rand,
+		func(r *rand.Rand) { downtimeSlashMultiplier = GenDowntimeSlashMultiplier(r) },
+	)
+
+	var maxDowntimeOffences uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxDowntimeOffences, &maxDowntimeOffences, simState.Rand,
+		func(r *rand.Rand) { maxDowntimeOffences = GenMaxDowntimeOffences(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
-		slashFractionDoubleSign, slashFractionDowntime,
+		slashFractionDoubleSign, slashFractionDowntime, downtimeOffenceWindow,
+		downtimeJailMultiplier, downtimeSlashMultiplier, maxDowntimeOffences,
 	)
 
-	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
+	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{}, []types.ValidatorDowntimeOffences{})
 
 	bz, err := json.MarshalIndent(&slashingGenesis, "", " ")
 	if err != nil {
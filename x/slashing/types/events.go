@@ -12,8 +12,11 @@ const (
 	AttributeKeyJailed       = "jailed"
 	AttributeKeyMissedBlocks = "missed_blocks"
 	AttributeKeyBurnedCoins  = "burned_coins"
+	AttributeKeyOffences     = "downtime_offences"
+	AttributeKeyTombstoned   = "tombstoned"
 
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
+	AttributeValueRepeatedDowntime = "repeated_downtime"
 	AttributeValueCategory         = ModuleName
 )
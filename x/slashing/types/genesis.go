@@ -10,11 +10,13 @@ import (
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
 	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks,
+	downtimeOffences []ValidatorDowntimeOffences,
 ) *GenesisState {
 	return &GenesisState{
-		Params:       params,
-		SigningInfos: signingInfos,
-		MissedBlocks: missedBlocks,
+		Params:           params,
+		SigningInfos:     signingInfos,
+		MissedBlocks:     missedBlocks,
+		DowntimeOffences: downtimeOffences,
 	}
 }
 
@@ -29,9 +31,10 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: []SigningInfo{},
-		MissedBlocks: []ValidatorMissedBlocks{},
+		Params:           DefaultParams(),
+		SigningInfos:     []SigningInfo{},
+		MissedBlocks:     []ValidatorMissedBlocks{},
+		DowntimeOffences: []ValidatorDowntimeOffences{},
 	}
 }
 
@@ -62,5 +65,53 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := validateDowntimeOffenceWindow(data.Params.DowntimeOffenceWindow); err != nil {
+		return err
+	}
+
+	if err := validateDowntimeJailMultiplier(data.Params.DowntimeJailMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateDowntimeSlashMultiplier(data.Params.DowntimeSlashMultiplier); err != nil {
+		return err
+	}
+
+	return validateDowntimeOffences(data.DowntimeOffences)
+}
+
+// validateDowntimeOffences checks that the downtime offences are recorded once per
+// validator, and that the offences of a validator are valid and ordered by height.
+func validateDowntimeOffences(downtimeOffences []ValidatorDowntimeOffences) error {
+	seen := make(map[string]bool, len(downtimeOffences))
+	for _, offences := range downtimeOffences {
+		consAddr, err := sdk.ConsAddressFromBech32(offences.Address)
+		if err != nil {
+			return fmt.Errorf("invalid downtime offences address %s: %w", offences.Address, err)
+		}
+		if seen[consAddr.String()] {
+			return fmt.Errorf("duplicate downtime offences for address %s", offences.Address)
+		}
+		seen[consAddr.String()] = true
+
+		var lastHeight int64
+		for _, offence := range offences.Offences {
+			if offence.Height <= 0 {
+				return fmt.Errorf("downtime offence height of %s must be positive, is %d", offences.Address, offence.Height)
+			}
+			if offence.Height <= lastHeight {
+				return fmt.Errorf("downtime offences of %s must be ordered by height without duplicates, got height %d after %d", offences.Address, offence.Height, lastHeight)
+			}
+			lastHeight = offence.Height
+
+			if offence.JailDuration < 0 {
+				return fmt.Errorf("downtime offence jail duration of %s at height %d cannot be negative, is %s", offences.Address, offence.Height, offence.JailDuration)
+			}
+			if offence.SlashFraction.IsNil() || offence.SlashFraction.IsNegative() || offence.SlashFraction.GT(sdk.OneDec()) {
+				return fmt.Errorf("downtime offence slash fraction of %s at height %d should be between zero and one, is %s", offences.Address, offence.Height, offence.SlashFraction)
+			}
+		}
+	}
+
 	return nil
 }
@@ -34,6 +34,8 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// downtime_offences represents the downtime offence history of validators.
+	DowntimeOffences []ValidatorDowntimeOffences `protobuf:"bytes,4,rep,name=downtime_offences,json=downtimeOffences,proto3" json:"downtime_offences"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -90,6 +92,13 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetDowntimeOffences() []ValidatorDowntimeOffences {
+	if m != nil {
+		return m.DowntimeOffences
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -268,34 +277,36 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 420 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x6e, 0xd4, 0x30,
-	0x10, 0x86, 0xe3, 0x2e, 0x2c, 0xe0, 0xb4, 0x17, 0x2b, 0x94, 0xd0, 0x43, 0x5a, 0xad, 0x00, 0xf5,
-	0x92, 0x44, 0x5d, 0x8e, 0x88, 0x03, 0xb9, 0x54, 0x1c, 0x50, 0x51, 0x56, 0x42, 0x82, 0x4b, 0xe4,
-	0x6c, 0x5c, 0xd7, 0xea, 0xc6, 0x5e, 0x65, 0xcc, 0xaa, 0xbc, 0x05, 0x0f, 0xc0, 0x23, 0x70, 0xe4,
-	0x21, 0x7a, 0xac, 0x38, 0x71, 0x42, 0x68, 0xf7, 0x29, 0xb8, 0x21, 0x6c, 0x87, 0x8d, 0x60, 0xa3,
-	0x95, 0x38, 0x25, 0x63, 0x7f, 0xf3, 0xcf, 0xcc, 0xef, 0xc1, 0x8f, 0xa7, 0x0a, 0x6a, 0x05, 0x29,
-	0xcc, 0x28, 0x5c, 0x08, 0xc9, 0xd3, 0xc5, 0x49, 0xc9, 0x34, 0x3d, 0x49, 0x39, 0x93, 0x0c, 0x04,
-	0x24, 0xf3, 0x46, 0x69, 0x45, 0x1e, 0x58, 0x2c, 0x69, 0xb1, 0xc4, 0x61, 0x07, 0x01, 0x57, 0x5c,
-	0x19, 0x26, 0xfd, 0xfd, 0x67, 0xf1, 0x83, 0x27, 0x7d, 0xaa, 0x7f, 0xf2, 0x2d, 0xf7, 0xd0, 0x72,
-	0x85, 0x15, 0x70, 0x35, 0x4c, 0x30, 0xfa, 0x89, 0xf0, 0xee, 0xa9, 0xed, 0x61, 0xa2, 0xa9, 0x66,
-	0xe4, 0x39, 0x1e, 0xce, 0x69, 0x43, 0x6b, 0x08, 0xd1, 0x11, 0x3a, 0xf6, 0xc7, 0x87, 0x49, 0x4f,
-	0x4f, 0xc9, 0x6b, 0x83, 0x65, 0xb7, 0xae, 0xbf, 0x1f, 0x7a, 0xb9, 0x4b, 0x22, 0x67, 0x78, 0x0f,
-	0x04, 0x97, 0x42, 0xf2, 0x42, 0xc8, 0x73, 0x05, 0xe1, 0xce, 0xd1, 0xe0, 0xd8, 0x1f, 0x3f, 0xea,
-	0x55, 0x99, 0x58, 0xfa, 0xa5, 0x3c, 0x57, 0x4e, 0x6a, 0x17, 0xd6, 0x47, 0x40, 0xde, 0xe2, 0xbd,
-	0x5a, 0x00, 0xb0, 0xaa, 0x28, 0x67, 0x6a, 0x7a, 0x09, 0xe1, 0xc0, 0x08, 0x26, 0xbd, 0x82, 0x6f,
-	0xe8, 0x4c, 0x54, 0x54, 0xab, 0xe6, 0x95, 0x49, 0xcb, 0x4c, 0x56, 0x2b, 0x5d, 0x77, 0xce, 0x46,
-	0x9f, 0x11, 0xf6, 0x3b, 0xe5, 0xc9, 0x18, 0xdf, 0xa1, 0x55, 0xd5, 0x30, 0xb0, 0xb3, 0xdf, 0xcb,
-	0xc2, 0xaf, 0x5f, 0xe2, 0xc0, 0xd5, 0x79, 0x61, 0x6f, 0x26, 0xba, 0x11, 0x92, 0xe7, 0x2d, 0x48,
-	0x04, 0xde, 0x5f, 0xb4, 0x05, 0x8b, 0xee, 0xe4, 0xe1, 0x8e, 0xb1, 0x2f, 0xde, 0xde, 0xe7, 0xbf,
-	0x0e, 0x04, 0x8b, 0x0d, 0x77, 0xa3, 0x4f, 0x08, 0xdf, 0xdf, 0x38, 0xdc, 0x7f, 0x35, 0x7e, 0xf6,
-	0xb7, 0xaf, 0xdb, 0x1e, 0xaa, 0x53, 0x71, 0xa3, 0x9b, 0xcf, 0xb0, 0xdf, 0x41, 0x48, 0x80, 0x6f,
-	0x0b, 0x59, 0xb1, 0x2b, 0xd3, 0xd1, 0x20, 0xb7, 0x01, 0xd9, 0xc7, 0x43, 0x9b, 0x64, 0xec, 0xb9,
-	0x9b, 0xbb, 0x28, 0x3b, 0xbd, 0x5e, 0x46, 0xe8, 0x66, 0x19, 0xa1, 0x1f, 0xcb, 0x08, 0x7d, 0x5c,
-	0x45, 0xde, 0xcd, 0x2a, 0xf2, 0xbe, 0xad, 0x22, 0xef, 0x5d, 0xcc, 0x85, 0xbe, 0x78, 0x5f, 0x26,
-	0x53, 0x55, 0xbb, 0xcd, 0x75, 0x9f, 0x18, 0xaa, 0xcb, 0xf4, 0x6a, 0xbd, 0xfb, 0xfa, 0xc3, 0x9c,
-	0x41, 0x39, 0x34, 0x6b, 0xfd, 0xf4, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xac, 0xc1, 0x45, 0xfe,
-	0x71, 0x03, 0x00, 0x00,
+	// 451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0x76, 0x14, 0x70, 0x37, 0x09, 0xac, 0x32, 0xc2, 0x0e, 0xd9, 0x54, 0x01, 0xda,
+	0xa5, 0x89, 0x56, 0x8e, 0x88, 0x03, 0x15, 0xd2, 0xc4, 0x01, 0x15, 0xa5, 0x12, 0x12, 0x5c, 0x22,
+	0x27, 0x76, 0x3c, 0x6b, 0x8d, 0x5d, 0xe5, 0x99, 0x32, 0xbe, 0x05, 0x1f, 0x80, 0x8f, 0xc0, 0x91,
+	0x0f, 0xb1, 0xe3, 0xc4, 0x89, 0x13, 0x9a, 0xda, 0x2f, 0x82, 0xb0, 0x1d, 0x16, 0x95, 0x46, 0x95,
+	0x38, 0x25, 0x7e, 0xfe, 0xfd, 0xff, 0xef, 0xe5, 0xbd, 0x3c, 0xf4, 0x24, 0x53, 0x50, 0x28, 0x88,
+	0x60, 0x46, 0xe0, 0x4c, 0x48, 0x1e, 0x2d, 0x4e, 0x52, 0xa6, 0xc9, 0x49, 0xc4, 0x99, 0x64, 0x20,
+	0x20, 0x9c, 0x97, 0x4a, 0x2b, 0xfc, 0xd0, 0x62, 0x61, 0x85, 0x85, 0x0e, 0x3b, 0xe8, 0x73, 0xc5,
+	0x95, 0x61, 0xa2, 0x3f, 0x6f, 0x16, 0x3f, 0x78, 0xda, 0xe4, 0xfa, 0x57, 0x6f, 0xb9, 0x47, 0x96,
+	0x4b, 0xac, 0x81, 0xcb, 0x61, 0x0e, 0x83, 0xeb, 0x36, 0xda, 0x3d, 0xb5, 0x35, 0x4c, 0x35, 0xd1,
+	0x0c, 0xbf, 0x40, 0xdd, 0x39, 0x29, 0x49, 0x01, 0xbe, 0x77, 0xe4, 0x1d, 0xf7, 0x46, 0x87, 0x61,
+	0x43, 0x4d, 0xe1, 0x5b, 0x83, 0x8d, 0x77, 0x2e, 0x7f, 0x1d, 0xb6, 0x62, 0x27, 0xc2, 0x13, 0xb4,
+	0x07, 0x82, 0x4b, 0x21, 0x79, 0x22, 0x64, 0xae, 0xc0, 0x6f, 0x1f, 0x75, 0x8e, 0x7b, 0xa3, 0xc7,
+	0x8d, 0x2e, 0x53, 0x4b, 0xbf, 0x96, 0xb9, 0x72, 0x56, 0xbb, 0x70, 0x13, 0x02, 0xfc, 0x1e, 0xed,
+	0x15, 0x02, 0x80, 0xd1, 0x24, 0x9d, 0xa9, 0xec, 0x1c, 0xfc, 0x8e, 0x31, 0x0c, 0x1b, 0x0d, 0xdf,
+	0x91, 0x99, 0xa0, 0x44, 0xab, 0xf2, 0x8d, 0x91, 0x8d, 0x8d, 0xaa, 0xb2, 0x2e, 0x6a, 0x31, 0xcc,
+	0xd0, 0x7d, 0xaa, 0x3e, 0x49, 0x2d, 0x0a, 0x96, 0xa8, 0x3c, 0x67, 0x32, 0x63, 0xe0, 0xef, 0x18,
+	0xfb, 0xd1, 0x76, 0xfb, 0x57, 0x4e, 0x3a, 0x71, 0x4a, 0x97, 0xe2, 0x1e, 0x5d, 0x8b, 0x0f, 0xbe,
+	0x79, 0xa8, 0x57, 0xfb, 0x4a, 0x3c, 0x42, 0xb7, 0x09, 0xa5, 0x25, 0x03, 0xdb, 0xe2, 0xbb, 0x63,
+	0xff, 0xc7, 0xf7, 0x61, 0xdf, 0xe5, 0x7b, 0x69, 0x6f, 0xa6, 0xba, 0x14, 0x92, 0xc7, 0x15, 0x88,
+	0x05, 0xda, 0x5f, 0x54, 0x89, 0x93, 0x7a, 0x83, 0xfd, 0xb6, 0x99, 0xd2, 0x70, 0x7b, 0xbd, 0xff,
+	0x36, 0xba, 0xbf, 0xd8, 0x70, 0x37, 0xf8, 0xea, 0xa1, 0x07, 0x1b, 0x7b, 0xf8, 0x5f, 0x85, 0x4f,
+	0xd6, 0xc7, 0xb7, 0xed, 0x7f, 0xa8, 0x65, 0xdc, 0x34, 0xb4, 0xc1, 0x73, 0xd4, 0xab, 0x21, 0xb8,
+	0x8f, 0x6e, 0x09, 0x49, 0xd9, 0x85, 0xa9, 0xa8, 0x13, 0xdb, 0x03, 0xde, 0x47, 0x5d, 0x2b, 0x32,
+	0xed, 0xb9, 0x13, 0xbb, 0xd3, 0xf8, 0xf4, 0x72, 0x19, 0x78, 0x57, 0xcb, 0xc0, 0xbb, 0x5e, 0x06,
+	0xde, 0x97, 0x55, 0xd0, 0xba, 0x5a, 0x05, 0xad, 0x9f, 0xab, 0xa0, 0xf5, 0x61, 0xc8, 0x85, 0x3e,
+	0xfb, 0x98, 0x86, 0x99, 0x2a, 0xdc, 0x82, 0xb8, 0xc7, 0x10, 0xe8, 0x79, 0x74, 0x71, 0xb3, 0x62,
+	0xfa, 0xf3, 0x9c, 0x41, 0xda, 0x35, 0xdb, 0xf3, 0xec, 0xf7, 0x00, 0xfb, 0xa0, 0x54, 0x94, 0xd8,
+	0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -318,6 +329,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DowntimeOffences) > 0 {
+		for iNdEx := len(m.DowntimeOffences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DowntimeOffences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -512,6 +537,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DowntimeOffences) > 0 {
+		for _, e := range m.DowntimeOffences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -700,6 +731,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DowntimeOffences = append(m.DowntimeOffences, ValidatorDowntimeOffences{})
+			if err := m.DowntimeOffences[len(m.DowntimeOffences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
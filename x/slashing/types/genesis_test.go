@@ -0,0 +1,108 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestValidateGenesisDowntimeOffences(t *testing.T) {
+	addr1 := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	addr2 := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	now := time.Unix(1700000000, 0).UTC()
+	offence := func(height int64) types.DowntimeOffence {
+		return types.NewDowntimeOffence(height, now, time.Hour, sdk.NewDecWithPrec(1, 2))
+	}
+
+	tests := []struct {
+		name     string
+		offences []types.ValidatorDowntimeOffences
+		expErr   bool
+	}{
+		{"no offences", nil, false},
+		{
+			"valid offences",
+			[]types.ValidatorDowntimeOffences{
+				{Address: addr1, Offences: []types.DowntimeOffence{offence(10), offence(20)}},
+				{Address: addr2, Offences: []types.DowntimeOffence{offence(10)}},
+			},
+			false,
+		},
+		{
+			"invalid address",
+			[]types.ValidatorDowntimeOffences{{Address: "invalid", Offences: []types.DowntimeOffence{offence(10)}}},
+			true,
+		},
+		{
+			"duplicate address",
+			[]types.ValidatorDowntimeOffences{
+				{Address: addr1, Offences: []types.DowntimeOffence{offence(10)}},
+				{Address: addr1, Offences: []types.DowntimeOffence{offence(20)}},
+			},
+			true,
+		},
+		{
+			"zero height",
+			[]types.ValidatorDowntimeOffences{{Address: addr1, Offences: []types.DowntimeOffence{offence(0)}}},
+			true,
+		},
+		{
+			"negative height",
+			[]types.ValidatorDowntimeOffences{{Address: addr1, Offences: []types.DowntimeOffence{offence(-1)}}},
+			true,
+		},
+		{
+			"duplicate height",
+			[]types.ValidatorDowntimeOffences{{Address: addr1, Offences: []types.DowntimeOffence{offence(10), offence(10)}}},
+			true,
+		},
+		{
+			"unordered heights",
+			[]types.ValidatorDowntimeOffences{{Address: addr1, Offences: []types.DowntimeOffence{offence(20), offence(10)}}},
+			true,
+		},
+		{
+			"negative jail duration",
+			[]types.ValidatorDowntimeOffences{{
+				Address:  addr1,
+				Offences: []types.DowntimeOffence{types.NewDowntimeOffence(10, now, -time.Hour, sdk.NewDecWithPrec(1, 2))},
+			}},
+			true,
+		},
+		{
+			"slash fraction above one",
+			[]types.ValidatorDowntimeOffences{{
+				Address:  addr1,
+				Offences: []types.DowntimeOffence{types.NewDowntimeOffence(10, now, time.Hour, sdk.NewDec(2))},
+			}},
+			true,
+		},
+		{
+			"nil slash fraction",
+			[]types.ValidatorDowntimeOffences{{
+				Address:  addr1,
+				Offences: []types.DowntimeOffence{{Height: 10, Time: now, JailDuration: time.Hour}},
+			}},
+			true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			genState.DowntimeOffences = tc.offences
+
+			err := types.ValidateGenesis(*genState)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -30,10 +30,16 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><period_Bytes>: bool
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<consAddrLen (1 Byte)><consAddress_Bytes>: ValidatorDowntimeOffences
+//
+// - 0x00: Params
 var (
+	ParamsKey                             = []byte{0x00} // Prefix for params key
 	ValidatorSigningInfoKeyPrefix         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKeyPrefix           = []byte{0x03} // Prefix for address-pubkey relation
+	ValidatorDowntimeOffencesKeyPrefix    = []byte{0x04} // Prefix for downtime offence history
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -50,6 +56,20 @@ func ValidatorSigningInfoAddress(key []byte) (v sdk.ConsAddress) {
 	return sdk.ConsAddress(addr)
 }
 
+// ValidatorDowntimeOffencesKey - stored by *Consensus* address (not operator address)
+func ValidatorDowntimeOffencesKey(v sdk.ConsAddress) []byte {
+	return append(ValidatorDowntimeOffencesKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// ValidatorDowntimeOffencesAddress - extract the address from a validator downtime offences key
+func ValidatorDowntimeOffencesAddress(key []byte) (v sdk.ConsAddress) {
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+
+	return sdk.ConsAddress(addr)
+}
+
 // ValidatorMissedBlockBitArrayPrefixKey - stored by *Consensus* address (not operator address)
 func ValidatorMissedBlockBitArrayPrefixKey(v sdk.ConsAddress) []byte {
 	return append(ValidatorMissedBlockBitArrayKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
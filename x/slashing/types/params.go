@@ -10,14 +10,18 @@ import (
 
 // Default parameter namespace
 const (
-	DefaultSignedBlocksWindow   = int64(100)
-	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultSignedBlocksWindow    = int64(100)
+	DefaultDowntimeJailDuration  = 60 * 10 * time.Second
+	DefaultDowntimeOffenceWindow = 60 * 60 * 24 * 30 * time.Second
+	DefaultMaxDowntimeOffences   = uint32(0)
 )
 
 var (
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
 	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+	DefaultDowntimeJailMultiplier  = sdk.OneDec()
+	DefaultDowntimeSlashMultiplier = sdk.OneDec()
 )
 
 // Parameter store keys
@@ -27,6 +31,10 @@ var (
 	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+	KeyDowntimeOffenceWindow   = []byte("DowntimeOffenceWindow")
+	KeyDowntimeJailMultiplier  = []byte("DowntimeJailMultiplier")
+	KeyDowntimeSlashMultiplier = []byte("DowntimeSlashMultiplier")
+	KeyMaxDowntimeOffences     = []byte("MaxDowntimeOffences")
 )
 
 // ParamKeyTable for slashing module
@@ -37,7 +45,8 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	slashFractionDoubleSign, slashFractionDowntime sdk.Dec, downtimeOffenceWindow time.Duration,
+	downtimeJailMultiplier, downtimeSlashMultiplier sdk.Dec, maxDowntimeOffences uint32,
 ) Params {
 	return Params{
 		SignedBlocksWindow:      signedBlocksWindow,
@@ -45,6 +54,10 @@ func NewParams(
 		DowntimeJailDuration:    downtimeJailDuration,
 		SlashFractionDoubleSign: slashFractionDoubleSign,
 		SlashFractionDowntime:   slashFractionDowntime,
+		DowntimeOffenceWindow:   downtimeOffenceWindow,
+		DowntimeJailMultiplier:  downtimeJailMultiplier,
+		DowntimeSlashMultiplier: downtimeSlashMultiplier,
+		MaxDowntimeOffences:     maxDowntimeOffences,
 	}
 }
 
@@ -56,6 +69,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 		paramtypes.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
 		paramtypes.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		paramtypes.NewParamSetPair(KeyDowntimeOffenceWindow, &p.DowntimeOffenceWindow, validateDowntimeOffenceWindow),
+		paramtypes.NewParamSetPair(KeyDowntimeJailMultiplier, &p.DowntimeJailMultiplier, validateDowntimeJailMultiplier),
+		paramtypes.NewParamSetPair(KeyDowntimeSlashMultiplier, &p.DowntimeSlashMultiplier, validateDowntimeSlashMultiplier),
+		paramtypes.NewParamSetPair(KeyMaxDowntimeOffences, &p.MaxDowntimeOffences, validateMaxDowntimeOffences),
 	}
 }
 
@@ -63,10 +80,60 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
-		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime, DefaultDowntimeOffenceWindow,
+		DefaultDowntimeJailMultiplier, DefaultDowntimeSlashMultiplier, DefaultMaxDowntimeOffences,
 	)
 }
 
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if err := validateSignedBlocksWindow(p.SignedBlocksWindow); err != nil {
+		return err
+	}
+	if err := validateMinSignedPerWindow(p.MinSignedPerWindow); err != nil {
+		return err
+	}
+	if err := validateDowntimeJailDuration(p.DowntimeJailDuration); err != nil {
+		return err
+	}
+	if err := validateSlashFractionDoubleSign(p.SlashFractionDoubleSign); err != nil {
+		return err
+	}
+	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
+		return err
+	}
+	if err := validateDowntimeOffenceWindow(p.DowntimeOffenceWindow); err != nil {
+		return err
+	}
+	if err := validateDowntimeJailMultiplier(p.DowntimeJailMultiplier); err != nil {
+		return err
+	}
+	if err := validateDowntimeSlashMultiplier(p.DowntimeSlashMultiplier); err != nil {
+		return err
+	}
+
+	return validateMaxDowntimeOffences(p.MaxDowntimeOffences)
+}
+
+// EscalatedDowntimePenalty returns the jail duration and slash fraction for a
+// downtime offence given the number of previous downtime offences of the
+// validator within the offence window. Each previous offence multiplies the
+// base jail duration and slash fraction by their respective multipliers. The
+// slash fraction is capped at one and the jail duration at maxJailDuration.
+func (p Params) EscalatedDowntimePenalty(previousOffences int, maxJailDuration time.Duration) (time.Duration, sdk.Dec) {
+	maxJail := sdk.NewDec(int64(maxJailDuration))
+	jail := sdk.MinDec(sdk.NewDec(int64(p.DowntimeJailDuration)), maxJail)
+	slashFraction := p.SlashFractionDowntime
+
+	// stop early once both penalties are capped so large multipliers can never overflow
+	for i := 0; i < previousOffences && (jail.LT(maxJail) || slashFraction.LT(sdk.OneDec())); i++ {
+		jail = sdk.MinDec(jail.Mul(p.DowntimeJailMultiplier), maxJail)
+		slashFraction = sdk.MinDec(slashFraction.Mul(p.DowntimeSlashMultiplier), sdk.OneDec())
+	}
+
+	return time.Duration(jail.TruncateInt64()), slashFraction
+}
+
 func validateSignedBlocksWindow(i interface{}) error {
 	v, ok := i.(int64)
 	if !ok {
@@ -140,3 +207,50 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateDowntimeOffenceWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime offence window cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeJailMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.LT(sdk.OneDec()) {
+		return fmt.Errorf("downtime jail multiplier must be at least one: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeSlashMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.LT(sdk.OneDec()) {
+		return fmt.Errorf("downtime slash multiplier must be at least one: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxDowntimeOffences(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
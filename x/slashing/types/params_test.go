@@ -0,0 +1,41 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestEscalatedDowntimePenalty(t *testing.T) {
+	params := types.DefaultParams()
+	params.DowntimeJailDuration = time.Hour
+	params.SlashFractionDowntime = sdk.NewDecWithPrec(1, 2)
+	params.DowntimeJailMultiplier = sdk.NewDec(2)
+	params.DowntimeSlashMultiplier = sdk.NewDec(10)
+
+	tests := []struct {
+		name             string
+		previousOffences int
+		maxJail          time.Duration
+		expJail          time.Duration
+		expSlash         sdk.Dec
+	}{
+		{"first offence", 0, 24 * time.Hour, time.Hour, sdk.NewDecWithPrec(1, 2)},
+		{"second offence", 1, 24 * time.Hour, 2 * time.Hour, sdk.NewDecWithPrec(1, 1)},
+		{"third offence", 2, 24 * time.Hour, 4 * time.Hour, sdk.OneDec()},
+		{"capped", 5, 24 * time.Hour, 24 * time.Hour, sdk.OneDec()},
+		{"many offences", 10000, 24 * time.Hour, 24 * time.Hour, sdk.OneDec()},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			jail, slash := params.EscalatedDowntimePenalty(tc.previousOffences, tc.maxJail)
+			require.Equal(t, tc.expJail, jail)
+			require.Equal(t, tc.expSlash, slash)
+		})
+	}
+}
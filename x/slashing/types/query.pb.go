@@ -308,6 +308,101 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryDowntimeOffencesRequest is the request type for the
+// Query/DowntimeOffences RPC method
+type QueryDowntimeOffencesRequest struct {
+	// cons_address is the address to query the downtime offences of
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+}
+
+func (m *QueryDowntimeOffencesRequest) Reset()         { *m = QueryDowntimeOffencesRequest{} }
+func (m *QueryDowntimeOffencesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimeOffencesRequest) ProtoMessage()    {}
+func (*QueryDowntimeOffencesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QueryDowntimeOffencesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimeOffencesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimeOffencesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimeOffencesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimeOffencesRequest.Merge(m, src)
+}
+func (m *QueryDowntimeOffencesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimeOffencesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimeOffencesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimeOffencesRequest proto.InternalMessageInfo
+
+func (m *QueryDowntimeOffencesRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+// QueryDowntimeOffencesResponse is the response type for the
+// Query/DowntimeOffences RPC method
+type QueryDowntimeOffencesResponse struct {
+	// downtime_offences is the downtime offence history of the requested val
+	// cons address
+	DowntimeOffences ValidatorDowntimeOffences `protobuf:"bytes,1,opt,name=downtime_offences,json=downtimeOffences,proto3" json:"downtime_offences"`
+}
+
+func (m *QueryDowntimeOffencesResponse) Reset()         { *m = QueryDowntimeOffencesResponse{} }
+func (m *QueryDowntimeOffencesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDowntimeOffencesResponse) ProtoMessage()    {}
+func (*QueryDowntimeOffencesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QueryDowntimeOffencesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDowntimeOffencesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDowntimeOffencesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDowntimeOffencesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDowntimeOffencesResponse.Merge(m, src)
+}
+func (m *QueryDowntimeOffencesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDowntimeOffencesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDowntimeOffencesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDowntimeOffencesResponse proto.InternalMessageInfo
+
+func (m *QueryDowntimeOffencesResponse) GetDowntimeOffences() ValidatorDowntimeOffences {
+	if m != nil {
+		return m.DowntimeOffences
+	}
+	return ValidatorDowntimeOffences{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -315,6 +410,8 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryDowntimeOffencesRequest)(nil), "cosmos.slashing.v1beta1.QueryDowntimeOffencesRequest")
+	proto.RegisterType((*QueryDowntimeOffencesResponse)(nil), "cosmos.slashing.v1beta1.QueryDowntimeOffencesResponse")
 }
 
 func init() {
@@ -322,42 +419,47 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 552 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6b, 0x13, 0x4f,
-	0x14, 0xc7, 0xb3, 0x6d, 0x7f, 0x81, 0xdf, 0xa4, 0x88, 0x8c, 0x81, 0xa6, 0x41, 0x36, 0xba, 0x42,
-	0x5a, 0xd4, 0xec, 0x9a, 0x88, 0x78, 0x90, 0x1e, 0xcc, 0xc1, 0xe0, 0x4d, 0x53, 0xe9, 0x41, 0x90,
-	0x30, 0x9b, 0x4c, 0xa7, 0x83, 0x9b, 0x99, 0xed, 0xce, 0x24, 0x18, 0xc4, 0x8b, 0x67, 0x0f, 0x82,
-	0x7f, 0x83, 0x47, 0x0f, 0x82, 0x7f, 0x44, 0x8f, 0x45, 0x2f, 0x9e, 0x44, 0x12, 0xff, 0x02, 0xff,
-	0x02, 0xc9, 0xcc, 0x4b, 0xb2, 0x25, 0xae, 0xa6, 0x9e, 0x32, 0x79, 0xf3, 0xbe, 0xdf, 0xf7, 0x79,
-	0x33, 0x6f, 0x16, 0x5d, 0xeb, 0x4a, 0xd5, 0x97, 0x2a, 0x50, 0x11, 0x51, 0x47, 0x5c, 0xb0, 0x60,
-	0x58, 0x0f, 0xa9, 0x26, 0xf5, 0xe0, 0x78, 0x40, 0x93, 0x91, 0x1f, 0x27, 0x52, 0x4b, 0xbc, 0x65,
-	0x93, 0xfc, 0x59, 0x92, 0x0f, 0x49, 0xe5, 0xeb, 0xa0, 0x0e, 0x89, 0xa2, 0x56, 0x31, 0xd7, 0xc7,
-	0x84, 0x71, 0x41, 0x34, 0x97, 0xc2, 0x9a, 0x94, 0x8b, 0x4c, 0x32, 0x69, 0x96, 0xc1, 0x74, 0x05,
-	0xd1, 0xcb, 0x4c, 0x4a, 0x16, 0xd1, 0x80, 0xc4, 0x3c, 0x20, 0x42, 0x48, 0x6d, 0x24, 0x0a, 0x76,
-	0xab, 0x59, 0x74, 0x73, 0x12, 0x9b, 0xb7, 0x6d, 0xf3, 0x3a, 0xd6, 0x1e, 0x68, 0xcd, 0x1f, 0xaf,
-	0x88, 0xf0, 0xe3, 0x29, 0xd8, 0x23, 0x92, 0x90, 0xbe, 0x6a, 0xd3, 0xe3, 0x01, 0x55, 0xda, 0x7b,
-	0x82, 0x2e, 0x9d, 0x89, 0xaa, 0x58, 0x0a, 0x45, 0xf1, 0x1e, 0xca, 0xc7, 0x26, 0x52, 0x72, 0xae,
-	0x38, 0xbb, 0x85, 0x46, 0xc5, 0xcf, 0xe8, 0xdc, 0xb7, 0xc2, 0xe6, 0xc6, 0xc9, 0xb7, 0x4a, 0xae,
-	0x0d, 0x22, 0xef, 0x00, 0x6d, 0x19, 0xd7, 0x7d, 0xce, 0x04, 0x17, 0xec, 0xa1, 0x38, 0x94, 0x50,
-	0x10, 0xdf, 0x43, 0x9b, 0x5d, 0x29, 0x54, 0x87, 0xf4, 0x7a, 0x09, 0x55, 0xd6, 0xff, 0xff, 0x66,
-	0xe9, 0xf3, 0xa7, 0x5a, 0x11, 0x4a, 0xdc, 0xb7, 0x3b, 0xfb, 0x3a, 0xe1, 0x82, 0xb5, 0x0b, 0xd3,
-	0x6c, 0x08, 0x79, 0x23, 0x54, 0x5a, 0xf6, 0x05, 0xe4, 0x67, 0xe8, 0xe2, 0x90, 0x44, 0x1d, 0x65,
-	0xb7, 0x3a, 0x5c, 0x1c, 0x4a, 0x80, 0xaf, 0x65, 0xc2, 0x1f, 0x90, 0x88, 0xf7, 0x88, 0x96, 0x49,
-	0xca, 0x10, 0x5a, 0xb9, 0x30, 0x24, 0x51, 0x2a, 0xea, 0x85, 0xcb, 0xa5, 0x67, 0x87, 0x88, 0x1f,
-	0x20, 0xb4, 0xb8, 0x65, 0x28, 0x5a, 0x9d, 0x15, 0x9d, 0x8e, 0x84, 0x6f, 0x87, 0x68, 0x71, 0x66,
-	0x8c, 0x82, 0xb6, 0x9d, 0x52, 0x7a, 0x1f, 0x1c, 0xb4, 0xfd, 0x9b, 0x22, 0xd0, 0x60, 0x0b, 0x6d,
-	0x40, 0x53, 0xeb, 0xff, 0xda, 0x94, 0x31, 0xc0, 0xad, 0x33, 0xb8, 0x6b, 0x06, 0x77, 0xe7, 0xaf,
-	0xb8, 0x96, 0x22, 0xcd, 0xdb, 0xf8, 0xb9, 0x8e, 0xfe, 0x33, 0xbc, 0xf8, 0x8d, 0x83, 0xf2, 0x76,
-	0x12, 0xf0, 0x8d, 0x4c, 0xb0, 0xe5, 0xf1, 0x2b, 0xdf, 0x5c, 0x2d, 0xd9, 0xd6, 0xf6, 0x76, 0x5e,
-	0x7f, 0xf9, 0xf1, 0x6e, 0xed, 0x2a, 0xae, 0x04, 0x59, 0xcf, 0xc1, 0xce, 0x1f, 0xfe, 0xe8, 0xa0,
-	0x42, 0xaa, 0x7b, 0x7c, 0xeb, 0xcf, 0x65, 0x96, 0xc7, 0xb4, 0x5c, 0x3f, 0x87, 0x02, 0xe8, 0xf6,
-	0x0c, 0xdd, 0x5d, 0x7c, 0x27, 0x93, 0x2e, 0x3d, 0x9b, 0x2a, 0x78, 0x99, 0x7e, 0x07, 0xaf, 0xf0,
-	0x7b, 0x07, 0x6d, 0xa6, 0xef, 0x1d, 0xaf, 0x8e, 0x30, 0x3f, 0xce, 0xc6, 0x79, 0x24, 0x80, 0xed,
-	0x1b, 0xec, 0x5d, 0x5c, 0x5d, 0x0d, 0xbb, 0xd9, 0x3a, 0x19, 0xbb, 0xce, 0xe9, 0xd8, 0x75, 0xbe,
-	0x8f, 0x5d, 0xe7, 0xed, 0xc4, 0xcd, 0x9d, 0x4e, 0xdc, 0xdc, 0xd7, 0x89, 0x9b, 0x7b, 0x5a, 0x63,
-	0x5c, 0x1f, 0x0d, 0x42, 0xbf, 0x2b, 0xfb, 0x33, 0x2f, 0xfb, 0x53, 0x53, 0xbd, 0xe7, 0xc1, 0x8b,
-	0x85, 0xb1, 0x1e, 0xc5, 0x54, 0x85, 0x79, 0xf3, 0x5d, 0xba, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff,
-	0xb9, 0x03, 0x61, 0xef, 0x7a, 0x05, 0x00, 0x00,
+	// 635 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xc1, 0x6f, 0x12, 0x4f,
+	0x14, 0xc7, 0xd9, 0xfe, 0xda, 0x26, 0xbf, 0xa1, 0x31, 0x38, 0x92, 0x94, 0x92, 0xba, 0xe8, 0x9a,
+	0xd0, 0x46, 0x65, 0x57, 0x30, 0xd5, 0x98, 0xa6, 0x87, 0x12, 0x23, 0xf1, 0xa4, 0x52, 0xd3, 0x83,
+	0xc6, 0x90, 0x81, 0x1d, 0xb6, 0x1b, 0x61, 0x66, 0xbb, 0xb3, 0xa0, 0xc4, 0x78, 0xf1, 0xe0, 0xc9,
+	0x83, 0x89, 0x7f, 0x83, 0x47, 0x0f, 0x26, 0x5e, 0xbc, 0x7b, 0xe8, 0xb1, 0xd1, 0x8b, 0x27, 0x63,
+	0xc0, 0x3f, 0xc4, 0x30, 0xf3, 0x80, 0x05, 0xdc, 0x16, 0x8c, 0xa7, 0x6e, 0x67, 0xde, 0xf7, 0xfb,
+	0x3e, 0xef, 0xcd, 0x7b, 0x01, 0x5d, 0xaa, 0x71, 0xd1, 0xe4, 0xc2, 0x12, 0x0d, 0x22, 0x0e, 0x5c,
+	0xe6, 0x58, 0xed, 0x7c, 0x95, 0x06, 0x24, 0x6f, 0x1d, 0xb6, 0xa8, 0xdf, 0x31, 0x3d, 0x9f, 0x07,
+	0x1c, 0xaf, 0xaa, 0x20, 0x73, 0x10, 0x64, 0x42, 0x50, 0xfa, 0x32, 0xa8, 0xab, 0x44, 0x50, 0xa5,
+	0x18, 0xea, 0x3d, 0xe2, 0xb8, 0x8c, 0x04, 0x2e, 0x67, 0xca, 0x24, 0x9d, 0x74, 0xb8, 0xc3, 0xe5,
+	0xa7, 0xd5, 0xff, 0x82, 0xd3, 0x75, 0x87, 0x73, 0xa7, 0x41, 0x2d, 0xe2, 0xb9, 0x16, 0x61, 0x8c,
+	0x07, 0x52, 0x22, 0xe0, 0x36, 0x1b, 0x45, 0x37, 0x24, 0x51, 0x71, 0x6b, 0x2a, 0xae, 0xa2, 0xec,
+	0x81, 0x56, 0xfe, 0x63, 0x24, 0x11, 0x7e, 0xd0, 0x07, 0xbb, 0x4f, 0x7c, 0xd2, 0x14, 0x65, 0x7a,
+	0xd8, 0xa2, 0x22, 0x30, 0x1e, 0xa2, 0x73, 0x63, 0xa7, 0xc2, 0xe3, 0x4c, 0x50, 0xbc, 0x83, 0x96,
+	0x3d, 0x79, 0x92, 0xd2, 0x2e, 0x68, 0x9b, 0xf1, 0x42, 0xc6, 0x8c, 0xa8, 0xdc, 0x54, 0xc2, 0xe2,
+	0xe2, 0xd1, 0x8f, 0x4c, 0xac, 0x0c, 0x22, 0x63, 0x1f, 0xad, 0x4a, 0xd7, 0x3d, 0xd7, 0x61, 0x2e,
+	0x73, 0xee, 0xb2, 0x3a, 0x87, 0x84, 0x78, 0x1b, 0xad, 0xd4, 0x38, 0x13, 0x15, 0x62, 0xdb, 0x3e,
+	0x15, 0xca, 0xff, 0xff, 0x62, 0xea, 0xeb, 0xa7, 0x5c, 0x12, 0x52, 0xec, 0xaa, 0x9b, 0xbd, 0xc0,
+	0x77, 0x99, 0x53, 0x8e, 0xf7, 0xa3, 0xe1, 0xc8, 0xe8, 0xa0, 0xd4, 0xb4, 0x2f, 0x20, 0x3f, 0x41,
+	0x89, 0x36, 0x69, 0x54, 0x84, 0xba, 0xaa, 0xb8, 0xac, 0xce, 0x01, 0x3e, 0x17, 0x09, 0xbf, 0x4f,
+	0x1a, 0xae, 0x4d, 0x02, 0xee, 0x87, 0x0c, 0xa1, 0x94, 0x33, 0x6d, 0xd2, 0x08, 0x9d, 0x1a, 0xd5,
+	0xe9, 0xd4, 0x83, 0x26, 0xe2, 0x3b, 0x08, 0x8d, 0x5e, 0x19, 0x92, 0x66, 0x07, 0x49, 0xfb, 0x23,
+	0x61, 0xaa, 0x21, 0x1a, 0xf5, 0xcc, 0xa1, 0xa0, 0x2d, 0x87, 0x94, 0xc6, 0x07, 0x0d, 0xad, 0xfd,
+	0x21, 0x09, 0x14, 0x58, 0x42, 0x8b, 0x50, 0xd4, 0x7f, 0x7f, 0x5b, 0x94, 0x34, 0xc0, 0xa5, 0x31,
+	0xdc, 0x05, 0x89, 0xbb, 0x71, 0x2a, 0xae, 0xa2, 0x18, 0xe3, 0x7d, 0x8c, 0xd6, 0x25, 0xee, 0x6d,
+	0xfe, 0x8c, 0x05, 0x6e, 0x93, 0xde, 0xab, 0xd7, 0x29, 0xab, 0x51, 0xf1, 0x4f, 0xde, 0xfa, 0xb5,
+	0x86, 0xce, 0x47, 0xb8, 0x43, 0x43, 0x28, 0x3a, 0x6b, 0xc3, 0x5d, 0x85, 0xc3, 0x25, 0x74, 0xbf,
+	0x70, 0x7a, 0x77, 0x26, 0x6d, 0xa1, 0x45, 0x09, 0x7b, 0xe2, 0xbc, 0xf0, 0x79, 0x09, 0x2d, 0x49,
+	0x10, 0xfc, 0x46, 0x43, 0xcb, 0x6a, 0xde, 0xf1, 0x95, 0xc8, 0x04, 0xd3, 0x4b, 0x96, 0xbe, 0x3a,
+	0x5b, 0xb0, 0x2a, 0xcb, 0xd8, 0x78, 0xf5, 0xed, 0xd7, 0xbb, 0x85, 0x8b, 0x38, 0x63, 0x45, 0x2d,
+	0xbd, 0xda, 0x32, 0xfc, 0x51, 0x43, 0xf1, 0xd0, 0x1b, 0xe3, 0x6b, 0x27, 0xa7, 0x99, 0x5e, 0xc6,
+	0x74, 0x7e, 0x0e, 0x05, 0xd0, 0xed, 0x48, 0xba, 0x9b, 0x78, 0x2b, 0x92, 0x2e, 0xbc, 0x81, 0xc2,
+	0x7a, 0x11, 0x9e, 0x80, 0x97, 0xf8, 0xbd, 0x86, 0x56, 0x42, 0xb6, 0x02, 0xcf, 0x8e, 0x30, 0x6c,
+	0x67, 0x61, 0x1e, 0x09, 0x60, 0x9b, 0x12, 0x7b, 0x13, 0x67, 0x67, 0xc3, 0xc6, 0x5f, 0x34, 0x94,
+	0x98, 0x9c, 0x10, 0xbc, 0x75, 0x72, 0xe2, 0x88, 0x35, 0x48, 0xdf, 0x98, 0x57, 0x06, 0xcc, 0xbb,
+	0x92, 0x79, 0x1b, 0xdf, 0x8a, 0x64, 0x9e, 0x1a, 0xff, 0x89, 0x76, 0x17, 0x4b, 0x47, 0x5d, 0x5d,
+	0x3b, 0xee, 0xea, 0xda, 0xcf, 0xae, 0xae, 0xbd, 0xed, 0xe9, 0xb1, 0xe3, 0x9e, 0x1e, 0xfb, 0xde,
+	0xd3, 0x63, 0x8f, 0x72, 0x8e, 0x1b, 0x1c, 0xb4, 0xaa, 0x66, 0x8d, 0x37, 0x07, 0xf6, 0xea, 0x4f,
+	0x4e, 0xd8, 0x4f, 0xad, 0xe7, 0xa3, 0x5c, 0x41, 0xc7, 0xa3, 0xa2, 0xba, 0x2c, 0x7f, 0x44, 0xae,
+	0xff, 0x1e, 0x00, 0x35, 0x23, 0x5e, 0xfa, 0x27, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -378,6 +480,9 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// DowntimeOffences queries the downtime offence history of given cons
+	// address
+	DowntimeOffences(ctx context.Context, in *QueryDowntimeOffencesRequest, opts ...grpc.CallOption) (*QueryDowntimeOffencesResponse, error)
 }
 
 type queryClient struct {
@@ -415,6 +520,15 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) DowntimeOffences(ctx context.Context, in *QueryDowntimeOffencesRequest, opts ...grpc.CallOption) (*QueryDowntimeOffencesResponse, error) {
+	out := new(QueryDowntimeOffencesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/DowntimeOffences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -423,6 +537,9 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// DowntimeOffences queries the downtime offence history of given cons
+	// address
+	DowntimeOffences(context.Context, *QueryDowntimeOffencesRequest) (*QueryDowntimeOffencesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -438,6 +555,9 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) DowntimeOffences(ctx context.Context, req *QueryDowntimeOffencesRequest) (*QueryDowntimeOffencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DowntimeOffences not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -497,6 +617,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DowntimeOffences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDowntimeOffencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DowntimeOffences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/DowntimeOffences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DowntimeOffences(ctx, req.(*QueryDowntimeOffencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -513,6 +651,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "DowntimeOffences",
+			Handler:    _Query_DowntimeOffences_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -721,6 +863,69 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDowntimeOffencesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimeOffencesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimeOffencesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDowntimeOffencesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDowntimeOffencesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDowntimeOffencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DowntimeOffences.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -808,6 +1013,30 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDowntimeOffencesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDowntimeOffencesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DowntimeOffences.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1318,6 +1547,171 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDowntimeOffencesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimeOffencesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimeOffencesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDowntimeOffencesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDowntimeOffencesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDowntimeOffencesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimeOffences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8,6 +8,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// TombstoneJailEndTime is the time until which a validator that has been
+// tombstoned for repeated downtime is jailed. It matches the maximum time
+// supported by Amino.
+var TombstoneJailEndTime = time.Unix(253402300799, 0)
+
 // NewValidatorSigningInfo creates a new ValidatorSigningInfo instance
 //
 //nolint:interfacer
@@ -38,6 +43,39 @@ func (i ValidatorSigningInfo) String() string {
 		i.Tombstoned, i.MissedBlocksCounter)
 }
 
+// NewValidatorDowntimeOffences creates a new ValidatorDowntimeOffences instance
+//
+//nolint:interfacer
+func NewValidatorDowntimeOffences(consAddr sdk.ConsAddress, offences []DowntimeOffence) ValidatorDowntimeOffences {
+	return ValidatorDowntimeOffences{
+		Address:  consAddr.String(),
+		Offences: offences,
+	}
+}
+
+// NewDowntimeOffence creates a new DowntimeOffence instance
+func NewDowntimeOffence(height int64, t time.Time, jailDuration time.Duration, slashFraction sdk.Dec) DowntimeOffence {
+	return DowntimeOffence{
+		Height:        height,
+		Time:          t,
+		JailDuration:  jailDuration,
+		SlashFraction: slashFraction,
+	}
+}
+
+// PruneBefore returns the offences that happened at or after the given time.
+func (o ValidatorDowntimeOffences) PruneBefore(t time.Time) ValidatorDowntimeOffences {
+	offences := make([]DowntimeOffence, 0, len(o.Offences))
+	for _, offence := range o.Offences {
+		if !offence.Time.Before(t) {
+			offences = append(offences, offence)
+		}
+	}
+
+	o.Offences = offences
+	return o
+}
+
 // unmarshal a validator signing info from a store value
 func UnmarshalValSigningInfo(cdc codec.Codec, value []byte) (signingInfo ValidatorSigningInfo, err error) {
 	err = cdc.Unmarshal(value, &signingInfo)
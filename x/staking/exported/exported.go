@@ -0,0 +1,21 @@
+package exported
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+type (
+	ParamSet = paramtypes.ParamSet
+
+	// Subspace defines an interface that implements the legacy x/params Subspace
+	// type.
+	//
+	// NOTE: This is used solely for migration of x/params managed parameters.
+	Subspace interface {
+		GetParamSet(ctx sdk.Context, ps ParamSet)
+		HasKeyTable() bool
+		WithKeyTable(table paramtypes.KeyTable) paramtypes.Subspace
+		Set(ctx sdk.Context, key []byte, value interface{})
+	}
+)
@@ -1011,3 +1011,211 @@ func (k Keeper) ValidateUnbondAmount(
 
 	return shares, nil
 }
+
+// TransferUnbonding moves up to wantAmt of unbonding tokens from the unbonding
+// delegation of fromAddr at valAddr to toAddr, preserving the creation height
+// and completion time of every moved entry, and splitting the initial balance
+// of a partially moved entry in proportion to the moved balance, so that
+// slashing both entries burns what slashing the original entry would have.
+// The most recently created entries are moved first. It returns the amount
+// actually transferred.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt math.Int,
+) math.Int {
+	transferred := sdk.ZeroInt()
+
+	ubd, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	for i := len(ubd.Entries) - 1; i >= 0 && wantAmt.IsPositive(); i-- {
+		entry := ubd.Entries[i]
+
+		toXfer := sdk.MinInt(entry.Balance, wantAmt)
+		if !toXfer.IsPositive() {
+			continue
+		}
+
+		initialXfer := entry.InitialBalance
+		if toXfer.Equal(entry.Balance) {
+			ubd.RemoveEntry(int64(i))
+		} else {
+			// split the entry, keeping the initial balance proportional to the
+			// remaining balance
+			initialXfer = entry.InitialBalance.Mul(toXfer).Quo(entry.Balance)
+			ubd.Entries[i].Balance = entry.Balance.Sub(toXfer)
+			ubd.Entries[i].InitialBalance = entry.InitialBalance.Sub(initialXfer)
+		}
+
+		toUbd, found := k.GetUnbondingDelegation(ctx, toAddr, valAddr)
+		if !found {
+			toUbd = types.UnbondingDelegation{DelegatorAddress: toAddr.String(), ValidatorAddress: valAddr.String()}
+		}
+		toUbd.Entries = append(toUbd.Entries, types.UnbondingDelegationEntry{
+			CreationHeight: entry.CreationHeight,
+			CompletionTime: entry.CompletionTime,
+			InitialBalance: initialXfer,
+			Balance:        toXfer,
+		})
+		k.SetUnbondingDelegation(ctx, toUbd)
+		k.InsertUBDQueue(ctx, toUbd, entry.CompletionTime)
+
+		transferred = transferred.Add(toXfer)
+		wantAmt = wantAmt.Sub(toXfer)
+	}
+
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return transferred
+}
+
+// TransferDelegation moves up to wantShares of the delegation of fromAddr at
+// valAddr to toAddr without unbonding the underlying tokens. Redelegation
+// entries of fromAddr whose destination is valAddr are moved along with the
+// shares whenever the remaining delegation can no longer cover them, so that
+// slashing for infractions at the source validator still reaches the stake.
+// It returns the amount of shares actually transferred.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantShares sdk.Dec,
+) (sdk.Dec, error) {
+	transferred := sdk.ZeroDec()
+
+	if !wantShares.IsPositive() || fromAddr.Equals(toAddr) {
+		return transferred, nil
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return transferred, types.ErrNoValidatorFound
+	}
+
+	fromDelegation, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred, nil
+	}
+
+	transferred = sdk.MinDec(fromDelegation.Shares, wantShares)
+	remaining := fromDelegation.Shares.Sub(transferred)
+
+	if err := k.transferRedelegationsTo(ctx, fromAddr, toAddr, valAddr, remaining); err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	// remove the shares from the source delegation
+	if err := k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr); err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	fromDelegation.Shares = remaining
+
+	// If the source is the operator of the validator and the transfer will
+	// decrease the validator's self-delegation below their minimum, we jail
+	// the validator.
+	if fromAddr.Equals(validator.GetOperator()) && !validator.Jailed &&
+		validator.TokensFromShares(fromDelegation.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+		k.jailValidator(ctx, validator)
+	}
+
+	if fromDelegation.Shares.IsZero() {
+		if err := k.RemoveDelegation(ctx, fromDelegation); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	} else {
+		k.SetDelegation(ctx, fromDelegation)
+		if err := k.AfterDelegationModified(ctx, fromAddr, valAddr); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	}
+
+	// add the shares to the destination delegation
+	toDelegation, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		if err := k.BeforeDelegationSharesModified(ctx, toAddr, valAddr); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	} else {
+		toDelegation = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+		if err := k.BeforeDelegationCreated(ctx, toAddr, valAddr); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	}
+
+	toDelegation.Shares = toDelegation.Shares.Add(transferred)
+	k.SetDelegation(ctx, toDelegation)
+	if err := k.AfterDelegationModified(ctx, toAddr, valAddr); err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	return transferred, nil
+}
+
+// transferRedelegationsTo moves the redelegation entries of fromAddr ending at
+// valDstAddr to toAddr until the destination shares still held by fromAddr do
+// not exceed remainingShares. The most recently created entries are moved
+// first.
+func (k Keeper) transferRedelegationsTo(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valDstAddr sdk.ValAddress, remainingShares sdk.Dec,
+) error {
+	var reds []types.Redelegation
+	protected := sdk.ZeroDec()
+
+	k.IterateDelegatorRedelegations(ctx, fromAddr, func(red types.Redelegation) bool {
+		if red.ValidatorDstAddress != valDstAddr.String() {
+			return false
+		}
+
+		reds = append(reds, red)
+		for _, entry := range red.Entries {
+			protected = protected.Add(entry.SharesDst)
+		}
+
+		return false
+	})
+
+	excess := protected.Sub(remainingShares)
+	for _, red := range reds {
+		if !excess.IsPositive() {
+			break
+		}
+
+		valSrcAddr, err := sdk.ValAddressFromBech32(red.ValidatorSrcAddress)
+		if err != nil {
+			return err
+		}
+
+		for i := len(red.Entries) - 1; i >= 0 && excess.IsPositive(); i-- {
+			entry := red.Entries[i]
+
+			sharesXfer := sdk.MinDec(entry.SharesDst, excess)
+			balanceXfer := entry.InitialBalance
+			if sharesXfer.Equal(entry.SharesDst) {
+				red.RemoveEntry(int64(i))
+			} else {
+				balanceXfer = sdk.NewDecFromInt(entry.InitialBalance).Mul(sharesXfer).Quo(entry.SharesDst).TruncateInt()
+				red.Entries[i].SharesDst = entry.SharesDst.Sub(sharesXfer)
+				red.Entries[i].InitialBalance = entry.InitialBalance.Sub(balanceXfer)
+			}
+
+			toRed := k.SetRedelegationEntry(
+				ctx, toAddr, valSrcAddr, valDstAddr,
+				entry.CreationHeight, entry.CompletionTime, balanceXfer, sdk.ZeroDec(), sharesXfer,
+			)
+			k.InsertRedelegationQueue(ctx, toRed, entry.CompletionTime)
+
+			excess = excess.Sub(sharesXfer)
+		}
+
+		if len(red.Entries) == 0 {
+			k.RemoveRedelegation(ctx, red)
+		} else {
+			k.SetRedelegation(ctx, red)
+		}
+	}
+
+	return nil
+}
@@ -707,6 +707,141 @@ func TestRedelegation(t *testing.T) {
 	require.Equal(t, 0, len(redelegations))
 }
 
+func TestTransferDelegation(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(0))
+	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
+
+	validator := teststaking.NewValidator(t, addrVals[0], PKs[0])
+	validator, _ = validator.AddTokensFromDel(sdk.NewInt(100))
+	keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrDels[0], addrVals[0], sdk.NewDec(100)))
+
+	// 60 of the shares were redelegated from another validator
+	completionTime := ctx.BlockTime().Add(time.Hour)
+	rd := types.NewRedelegation(addrDels[0], addrVals[1], addrVals[0], 0, completionTime, sdk.NewInt(60), sdk.NewDec(60))
+	app.StakingKeeper.SetRedelegation(ctx, rd)
+
+	transferred, err := app.StakingKeeper.TransferDelegation(ctx, addrDels[0], addrDels[1], addrVals[0], sdk.NewDec(70))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(70), transferred)
+
+	fromDel, found := app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(30), fromDel.Shares)
+	toDel, found := app.StakingKeeper.GetDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(70), toDel.Shares)
+
+	// the redelegation liability is split so each delegation can cover it
+	fromRed, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(30), fromRed.Entries[0].SharesDst)
+	require.Equal(t, sdk.NewInt(30), fromRed.Entries[0].InitialBalance)
+	toRed, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[1], addrVals[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(30), toRed.Entries[0].SharesDst)
+	require.Equal(t, sdk.NewInt(30), toRed.Entries[0].InitialBalance)
+	require.Len(t, app.StakingKeeper.GetRedelegationQueueTimeSlice(ctx, completionTime), 1)
+
+	// transferring more shares than delegated moves the whole delegation
+	transferred, err = app.StakingKeeper.TransferDelegation(ctx, addrDels[0], addrDels[1], addrVals[0], sdk.NewDec(1000))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(30), transferred)
+	_, found = app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[1], addrVals[0])
+	require.False(t, found)
+}
+
+func TestTransferUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(0))
+	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
+
+	completionTime := ctx.BlockTime().Add(time.Hour)
+	app.StakingKeeper.SetUnbondingDelegationEntry(ctx, addrDels[0], addrVals[0], 0, completionTime, sdk.NewInt(10))
+	app.StakingKeeper.SetUnbondingDelegationEntry(ctx, addrDels[0], addrVals[0], 1, completionTime.Add(time.Hour), sdk.NewInt(20))
+
+	// the most recent entry is transferred first
+	transferred := app.StakingKeeper.TransferUnbonding(ctx, addrDels[0], addrDels[1], addrVals[0], sdk.NewInt(25))
+	require.Equal(t, sdk.NewInt(25), transferred)
+
+	fromUbd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Len(t, fromUbd.Entries, 1)
+	require.Equal(t, sdk.NewInt(5), fromUbd.Entries[0].Balance)
+
+	toUbd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Len(t, toUbd.Entries, 2)
+	require.Equal(t, sdk.NewInt(20), toUbd.Entries[0].Balance)
+	require.Equal(t, completionTime.Add(time.Hour), toUbd.Entries[0].CompletionTime)
+	require.Equal(t, sdk.NewInt(5), toUbd.Entries[1].Balance)
+	require.Equal(t, completionTime, toUbd.Entries[1].CompletionTime)
+
+	// transferring more than available moves the remainder
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, addrDels[0], addrDels[1], addrVals[0], sdk.NewInt(25))
+	require.Equal(t, sdk.NewInt(5), transferred)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+
+	// the moved entries keep their initial balances
+	toUbd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Len(t, toUbd.Entries, 3)
+	require.Equal(t, sdk.NewInt(20), toUbd.Entries[0].InitialBalance)
+	require.Equal(t, sdk.NewInt(5), toUbd.Entries[1].InitialBalance)
+	require.Equal(t, sdk.NewInt(5), toUbd.Entries[2].InitialBalance)
+}
+
+func TestTransferUnbondingSlash(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(0))
+	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
+
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, notBondedPool.GetName(), sdk.NewCoins(sdk.NewInt64Coin(app.StakingKeeper.BondDenom(ctx), 50))))
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	// an entry of 100 tokens already slashed down to 50
+	completionTime := ctx.BlockTime().Add(time.Hour)
+	ubd := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 10, completionTime, sdk.NewInt(100))
+	ubd.Entries[0].Balance = sdk.NewInt(50)
+	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+
+	// the initial balance is split in proportion to the moved balance
+	transferred := app.StakingKeeper.TransferUnbonding(ctx, addrDels[0], addrDels[1], addrVals[0], sdk.NewInt(20))
+	require.Equal(t, sdk.NewInt(20), transferred)
+	fromUbd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(60), fromUbd.Entries[0].InitialBalance)
+	require.Equal(t, sdk.NewInt(30), fromUbd.Entries[0].Balance)
+	toUbd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(40), toUbd.Entries[0].InitialBalance)
+	require.Equal(t, sdk.NewInt(20), toUbd.Entries[0].Balance)
+
+	// slashing half of both entries burns half of the original initial balance,
+	// like slashing the original entry
+	fraction := sdk.NewDecWithPrec(5, 1)
+	slashed := app.StakingKeeper.SlashUnbondingDelegation(ctx, fromUbd, 10, fraction)
+	slashed = slashed.Add(app.StakingKeeper.SlashUnbondingDelegation(ctx, toUbd, 10, fraction))
+	require.Equal(t, sdk.NewInt(50), slashed)
+
+	fromUbd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.True(t, fromUbd.Entries[0].Balance.IsZero())
+	toUbd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.True(t, toUbd.Entries[0].Balance.IsZero())
+	require.True(t, app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), app.StakingKeeper.BondDenom(ctx)).IsZero())
+}
+
 func TestRedelegateToSameValidator(t *testing.T) {
 	_, app, ctx := createTestInput(t)
 
@@ -633,6 +633,101 @@ func (m *MsgCancelUnbondingDelegationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelUnbondingDelegationResponse proto.InternalMessageInfo
 
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/staking parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{12}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{13}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.staking.v1beta1.MsgCreateValidator")
 	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "cosmos.staking.v1beta1.MsgCreateValidatorResponse")
@@ -646,71 +741,77 @@ func init() {
 	proto.RegisterType((*MsgUndelegateResponse)(nil), "cosmos.staking.v1beta1.MsgUndelegateResponse")
 	proto.RegisterType((*MsgCancelUnbondingDelegation)(nil), "cosmos.staking.v1beta1.MsgCancelUnbondingDelegation")
 	proto.RegisterType((*MsgCancelUnbondingDelegationResponse)(nil), "cosmos.staking.v1beta1.MsgCancelUnbondingDelegationResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.staking.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.staking.v1beta1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 936 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x5e, 0x67, 0x37, 0xa1, 0x4c, 0xd4, 0xa6, 0x75, 0x12, 0xd8, 0x58, 0xd5, 0x6e, 0xb5, 0x2d,
-	0x6d, 0x54, 0x88, 0x97, 0x86, 0x22, 0x50, 0xd4, 0x4b, 0xb7, 0xdb, 0x8a, 0xaa, 0xac, 0x84, 0x1c,
-	0xca, 0x01, 0x21, 0xad, 0xc6, 0xf6, 0x64, 0x32, 0x5a, 0x7b, 0xc6, 0xf5, 0xcc, 0x46, 0xdd, 0x2b,
-	0x27, 0x6e, 0x54, 0xe2, 0x1f, 0xe8, 0x19, 0x71, 0xe0, 0xd0, 0x3f, 0xa2, 0x42, 0x1c, 0xaa, 0x9e,
-	0x10, 0x87, 0x02, 0xc9, 0x01, 0x8e, 0x88, 0xbf, 0x00, 0x79, 0x3c, 0x9e, 0xfd, 0xed, 0x3a, 0x28,
-	0x3d, 0xa0, 0x9e, 0xd6, 0x9a, 0xf9, 0xde, 0xf7, 0xc6, 0xdf, 0xfb, 0xe6, 0x3d, 0x2f, 0xa8, 0x7b,
-	0x8c, 0x87, 0x8c, 0x37, 0xb9, 0x80, 0x3d, 0x42, 0x71, 0xf3, 0xe0, 0x9a, 0x8b, 0x04, 0xbc, 0xd6,
-	0x14, 0x0f, 0xed, 0x28, 0x66, 0x82, 0x99, 0x6f, 0xa5, 0x00, 0x5b, 0x01, 0x6c, 0x05, 0xb0, 0x36,
-	0x30, 0x63, 0x38, 0x40, 0x4d, 0x89, 0x72, 0xfb, 0x7b, 0x4d, 0x48, 0x07, 0x69, 0x88, 0x55, 0x9f,
-	0xdc, 0x12, 0x24, 0x44, 0x5c, 0xc0, 0x30, 0x52, 0x80, 0x35, 0xcc, 0x30, 0x93, 0x8f, 0xcd, 0xe4,
-	0x49, 0xad, 0x6e, 0xa4, 0x99, 0xba, 0xe9, 0x86, 0x4a, 0x9b, 0x6e, 0xd5, 0xd4, 0x29, 0x5d, 0xc8,
-	0x91, 0x3e, 0xa2, 0xc7, 0x08, 0x55, 0xfb, 0x97, 0xe6, 0xbc, 0x45, 0x76, 0xe8, 0x14, 0xf5, 0xb6,
-	0x42, 0x85, 0x3c, 0x41, 0x24, 0x3f, 0xe9, 0x46, 0xe3, 0x8f, 0x0a, 0x30, 0x3b, 0x1c, 0xdf, 0x8a,
-	0x11, 0x14, 0xe8, 0x0b, 0x18, 0x10, 0x1f, 0x0a, 0x16, 0x9b, 0xf7, 0xc0, 0xb2, 0x8f, 0xb8, 0x17,
-	0x93, 0x48, 0x10, 0x46, 0xab, 0xc6, 0x05, 0x63, 0x73, 0x79, 0xfb, 0xa2, 0x3d, 0x5b, 0x10, 0xbb,
-	0x3d, 0x84, 0xb6, 0x2a, 0x4f, 0x5f, 0xd4, 0x4b, 0xce, 0x68, 0xb4, 0xd9, 0x01, 0xc0, 0x63, 0x61,
-	0x48, 0x38, 0x4f, 0xb8, 0x16, 0x24, 0xd7, 0x95, 0x79, 0x5c, 0xb7, 0x34, 0xd2, 0x81, 0x02, 0x71,
-	0xc5, 0x37, 0x42, 0x60, 0x06, 0x60, 0x35, 0x24, 0xb4, 0xcb, 0x51, 0xb0, 0xd7, 0xf5, 0x51, 0x80,
-	0x30, 0x94, 0x67, 0x2c, 0x5f, 0x30, 0x36, 0xdf, 0x6c, 0xdd, 0x48, 0xe0, 0xbf, 0xbe, 0xa8, 0x5f,
-	0xc6, 0x44, 0xec, 0xf7, 0x5d, 0xdb, 0x63, 0xa1, 0xd2, 0x53, 0xfd, 0x6c, 0x71, 0xbf, 0xd7, 0x14,
-	0x83, 0x08, 0x71, 0xfb, 0x2e, 0x15, 0xcf, 0x9f, 0x6c, 0x01, 0x75, 0x90, 0xbb, 0x54, 0x38, 0xe7,
-	0x42, 0x42, 0x77, 0x51, 0xb0, 0xd7, 0xd6, 0xb4, 0xe6, 0x6d, 0x70, 0x4e, 0x25, 0x61, 0x71, 0x17,
-	0xfa, 0x7e, 0x8c, 0x38, 0xaf, 0x56, 0x64, 0xae, 0xea, 0xf3, 0x27, 0x5b, 0x6b, 0x2a, 0xfa, 0x66,
-	0xba, 0xb3, 0x2b, 0x62, 0x42, 0xb1, 0x73, 0x56, 0x87, 0xa8, 0xf5, 0x84, 0xe6, 0x20, 0x53, 0x57,
-	0xd3, 0x2c, 0xbe, 0x8c, 0x46, 0x87, 0x64, 0x34, 0x77, 0xc0, 0x52, 0xd4, 0x77, 0x7b, 0x68, 0x50,
-	0x5d, 0x92, 0x32, 0xae, 0xd9, 0xa9, 0xe1, 0xec, 0xcc, 0x70, 0xf6, 0x4d, 0x3a, 0x68, 0x55, 0x7f,
-	0x1a, 0x32, 0x7a, 0xf1, 0x20, 0x12, 0xcc, 0xfe, 0xac, 0xef, 0xde, 0x43, 0x03, 0x47, 0x45, 0x9b,
-	0x1f, 0x82, 0xc5, 0x03, 0x18, 0xf4, 0x51, 0xf5, 0x0d, 0x49, 0xb3, 0x91, 0x55, 0x23, 0x71, 0xd9,
-	0x48, 0x29, 0x48, 0x56, 0xcf, 0x14, 0xbd, 0x73, 0xfd, 0x9b, 0xc7, 0xf5, 0xd2, 0x5f, 0x8f, 0xeb,
-	0xa5, 0xaf, 0xff, 0xfc, 0xf1, 0xea, 0xb4, 0x2e, 0x72, 0x75, 0xea, 0x35, 0x1b, 0xe7, 0x81, 0x35,
-	0x6d, 0x31, 0x07, 0xf1, 0x88, 0x51, 0x8e, 0x1a, 0xdf, 0x95, 0xc1, 0xd9, 0x0e, 0xc7, 0xb7, 0x7d,
-	0x22, 0x5e, 0x91, 0xff, 0x66, 0x6a, 0xbf, 0x70, 0x6c, 0xed, 0x21, 0x58, 0x19, 0xba, 0xb0, 0x1b,
-	0x43, 0x81, 0x94, 0xe7, 0x3e, 0x2e, 0xe8, 0xb7, 0x36, 0xf2, 0x46, 0xfc, 0xd6, 0x46, 0x9e, 0x73,
-	0xc6, 0x1b, 0x73, 0xbb, 0xb9, 0x3f, 0xdb, 0xda, 0x95, 0x63, 0xa5, 0x29, 0x62, 0xeb, 0x9d, 0xda,
-	0x58, 0x25, 0xa7, 0x6b, 0x66, 0x81, 0xea, 0x64, 0x51, 0x74, 0xc5, 0xfe, 0x36, 0xc0, 0x72, 0x87,
-	0x63, 0xc5, 0x86, 0x66, 0x5f, 0x11, 0xe3, 0x64, 0xae, 0xc8, 0xf1, 0xcb, 0xf4, 0x11, 0x58, 0x82,
-	0x21, 0xeb, 0x53, 0x21, 0xab, 0x53, 0xc0, 0xdb, 0x0a, 0xbe, 0x63, 0xcd, 0x37, 0x76, 0x63, 0x1d,
-	0xac, 0x8e, 0xbc, 0xb1, 0x56, 0xe2, 0xe7, 0x05, 0xd9, 0x3d, 0x5b, 0x08, 0x13, 0xea, 0x20, 0xff,
-	0x84, 0x05, 0xf9, 0x14, 0xac, 0x0f, 0x05, 0xe1, 0xb1, 0x57, 0x58, 0x94, 0x55, 0x1d, 0xb6, 0x1b,
-	0x7b, 0x33, 0xd9, 0x7c, 0x2e, 0x34, 0x5b, 0xb9, 0x30, 0x5b, 0x9b, 0x8b, 0x69, 0x95, 0x2b, 0x27,
-	0xa7, 0x72, 0x4f, 0x36, 0x8a, 0x09, 0x35, 0x33, 0xb1, 0xcd, 0x8e, 0xbc, 0x7f, 0x51, 0x80, 0x12,
-	0x03, 0x77, 0x93, 0xc1, 0xaa, 0xfa, 0x82, 0x35, 0xd5, 0x04, 0x3f, 0xcf, 0xa6, 0x6e, 0xeb, 0x54,
-	0x92, 0xfc, 0xd1, 0x6f, 0x75, 0x43, 0xde, 0x35, 0x15, 0x9c, 0x6c, 0x37, 0xfe, 0x31, 0xc0, 0xe9,
-	0x0e, 0xc7, 0xf7, 0xa9, 0xff, 0x1a, 0xf9, 0x78, 0x0f, 0xac, 0x8f, 0xbd, 0xf3, 0xab, 0x12, 0xf7,
-	0xfb, 0x05, 0x70, 0x3e, 0xe9, 0xf9, 0x90, 0x7a, 0x28, 0xb8, 0x4f, 0x5d, 0x46, 0x7d, 0x42, 0xf1,
-	0xcb, 0xc6, 0xea, 0xff, 0x4e, 0x6b, 0xf3, 0x0a, 0x58, 0xf1, 0x92, 0xb9, 0x96, 0x88, 0xb6, 0x8f,
-	0x08, 0xde, 0x4f, 0xef, 0x43, 0xd9, 0x39, 0x93, 0x2d, 0x7f, 0x22, 0x57, 0x73, 0x8b, 0x72, 0x19,
-	0x5c, 0xca, 0xd3, 0x2a, 0xab, 0xd1, 0xf6, 0x0f, 0x8b, 0xa0, 0xdc, 0xe1, 0xd8, 0x7c, 0x00, 0x56,
-	0x26, 0xbf, 0xd7, 0xae, 0xce, 0x1b, 0x8d, 0xd3, 0x83, 0xd7, 0xda, 0x2e, 0x8e, 0xd5, 0xf6, 0xe8,
-	0x81, 0xd3, 0xe3, 0x03, 0x7a, 0x33, 0x87, 0x64, 0x0c, 0x69, 0xbd, 0x5f, 0x14, 0xa9, 0x93, 0x7d,
-	0x05, 0x4e, 0xe9, 0xd9, 0x72, 0x31, 0x27, 0x3a, 0x03, 0x59, 0xef, 0x16, 0x00, 0x69, 0xf6, 0x07,
-	0x60, 0x65, 0xb2, 0x5f, 0xe7, 0xa9, 0x37, 0x81, 0xcd, 0x55, 0x6f, 0x5e, 0xe7, 0x72, 0x01, 0x18,
-	0x69, 0x33, 0xef, 0xe4, 0x30, 0x0c, 0x61, 0xd6, 0x56, 0x21, 0x98, 0xce, 0xf1, 0xad, 0x01, 0x36,
-	0xe6, 0x5f, 0xb7, 0xeb, 0x79, 0x35, 0x9f, 0x17, 0x65, 0xdd, 0xf8, 0x2f, 0x51, 0xd9, 0x89, 0x5a,
-	0x77, 0x9e, 0x1e, 0xd6, 0x8c, 0x67, 0x87, 0x35, 0xe3, 0xf7, 0xc3, 0x9a, 0xf1, 0xe8, 0xa8, 0x56,
-	0x7a, 0x76, 0x54, 0x2b, 0xfd, 0x72, 0x54, 0x2b, 0x7d, 0xf9, 0x5e, 0xee, 0x57, 0xcc, 0x43, 0xfd,
-	0x5f, 0x46, 0x7e, 0xcf, 0xb8, 0x4b, 0xb2, 0xf3, 0x7c, 0xf0, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x2b, 0xfb, 0x94, 0x91, 0xb0, 0x0d, 0x00, 0x00,
+	// 1007 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xc6, 0xa9, 0x69, 0x5f, 0x68, 0xdc, 0x6e, 0x12, 0x6a, 0xaf, 0x2a, 0xbb, 0x72, 0x4b,
+	0x13, 0x15, 0xb2, 0xa6, 0xa1, 0xfc, 0x50, 0x94, 0x4b, 0x5d, 0xb7, 0xa2, 0x2a, 0x96, 0xaa, 0x0d,
+	0xe5, 0x80, 0x90, 0xac, 0xf1, 0xee, 0x64, 0xbd, 0xf2, 0xee, 0xcc, 0x76, 0x67, 0x1c, 0xd5, 0x57,
+	0x4e, 0xdc, 0xa8, 0x84, 0x04, 0xd7, 0x9e, 0x39, 0x71, 0xe8, 0x1f, 0x51, 0x21, 0x0e, 0x55, 0x4f,
+	0x88, 0x43, 0x81, 0xe4, 0x00, 0x47, 0xc4, 0x5f, 0x80, 0x76, 0x77, 0x76, 0xfc, 0x7b, 0xb3, 0x41,
+	0xe9, 0x01, 0xf5, 0xe4, 0xd5, 0xcc, 0xf7, 0xbe, 0x37, 0xf3, 0xbd, 0x6f, 0xde, 0x8c, 0xa1, 0x6a,
+	0x52, 0xe6, 0x51, 0x56, 0x67, 0x1c, 0xf5, 0x1c, 0x62, 0xd7, 0xf7, 0xaf, 0x77, 0x30, 0x47, 0xd7,
+	0xeb, 0xfc, 0x91, 0xee, 0x07, 0x94, 0x53, 0xf5, 0xad, 0x18, 0xa0, 0x0b, 0x80, 0x2e, 0x00, 0x5a,
+	0xd9, 0xa6, 0xd4, 0x76, 0x71, 0x3d, 0x42, 0x75, 0xfa, 0x7b, 0x75, 0x44, 0x06, 0x71, 0x88, 0x56,
+	0x9d, 0x9c, 0xe2, 0x8e, 0x87, 0x19, 0x47, 0x9e, 0x2f, 0x00, 0xab, 0x36, 0xb5, 0x69, 0xf4, 0x59,
+	0x0f, 0xbf, 0xc4, 0x68, 0x39, 0xce, 0xd4, 0x8e, 0x27, 0x44, 0xda, 0x78, 0xaa, 0x22, 0x56, 0xd9,
+	0x41, 0x0c, 0xcb, 0x25, 0x9a, 0xd4, 0x21, 0x62, 0xfe, 0xca, 0x9c, 0x5d, 0x24, 0x8b, 0x8e, 0x51,
+	0x17, 0x04, 0xca, 0x63, 0x21, 0x22, 0xfc, 0x89, 0x27, 0x6a, 0x7f, 0x2c, 0x82, 0xda, 0x62, 0xf6,
+	0xad, 0x00, 0x23, 0x8e, 0x3f, 0x47, 0xae, 0x63, 0x21, 0x4e, 0x03, 0xf5, 0x1e, 0x2c, 0x59, 0x98,
+	0x99, 0x81, 0xe3, 0x73, 0x87, 0x92, 0x92, 0x72, 0x49, 0xd9, 0x58, 0xda, 0xba, 0xac, 0xcf, 0x16,
+	0x44, 0x6f, 0x0e, 0xa1, 0x8d, 0xc5, 0x67, 0x2f, 0xab, 0x39, 0x63, 0x34, 0x5a, 0x6d, 0x01, 0x98,
+	0xd4, 0xf3, 0x1c, 0xc6, 0x42, 0xae, 0x85, 0x88, 0x6b, 0x7d, 0x1e, 0xd7, 0x2d, 0x89, 0x34, 0x10,
+	0xc7, 0x4c, 0xf0, 0x8d, 0x10, 0xa8, 0x2e, 0xac, 0x78, 0x0e, 0x69, 0x33, 0xec, 0xee, 0xb5, 0x2d,
+	0xec, 0x62, 0x1b, 0x45, 0x6b, 0xcc, 0x5f, 0x52, 0x36, 0xce, 0x34, 0x76, 0x42, 0xf8, 0xaf, 0x2f,
+	0xab, 0x57, 0x6d, 0x87, 0x77, 0xfb, 0x1d, 0xdd, 0xa4, 0x9e, 0xd0, 0x53, 0xfc, 0x6c, 0x32, 0xab,
+	0x57, 0xe7, 0x03, 0x1f, 0x33, 0xfd, 0x2e, 0xe1, 0x2f, 0x9e, 0x6e, 0x82, 0x58, 0xc8, 0x5d, 0xc2,
+	0x8d, 0xf3, 0x9e, 0x43, 0x76, 0xb1, 0xbb, 0xd7, 0x94, 0xb4, 0xea, 0x6d, 0x38, 0x2f, 0x92, 0xd0,
+	0xa0, 0x8d, 0x2c, 0x2b, 0xc0, 0x8c, 0x95, 0x16, 0xa3, 0x5c, 0xa5, 0x17, 0x4f, 0x37, 0x57, 0x45,
+	0xf4, 0xcd, 0x78, 0x66, 0x97, 0x07, 0x0e, 0xb1, 0x8d, 0x73, 0x32, 0x44, 0x8c, 0x87, 0x34, 0xfb,
+	0x89, 0xba, 0x92, 0xe6, 0xd4, 0x51, 0x34, 0x32, 0x24, 0xa1, 0xb9, 0x03, 0x05, 0xbf, 0xdf, 0xe9,
+	0xe1, 0x41, 0xa9, 0x10, 0xc9, 0xb8, 0xaa, 0xc7, 0x86, 0xd3, 0x13, 0xc3, 0xe9, 0x37, 0xc9, 0xa0,
+	0x51, 0xfa, 0x69, 0xc8, 0x68, 0x06, 0x03, 0x9f, 0x53, 0xfd, 0x7e, 0xbf, 0x73, 0x0f, 0x0f, 0x0c,
+	0x11, 0xad, 0x7e, 0x00, 0xa7, 0xf6, 0x91, 0xdb, 0xc7, 0xa5, 0x37, 0x22, 0x9a, 0x72, 0x52, 0x8d,
+	0xd0, 0x65, 0x23, 0xa5, 0x70, 0x92, 0x7a, 0xc6, 0xe8, 0xed, 0x1b, 0x5f, 0x3f, 0xa9, 0xe6, 0xfe,
+	0x7a, 0x52, 0xcd, 0x7d, 0xf5, 0xe7, 0x8f, 0xd7, 0xa6, 0x75, 0x89, 0x46, 0xa7, 0xb6, 0x59, 0xbb,
+	0x08, 0xda, 0xb4, 0xc5, 0x0c, 0xcc, 0x7c, 0x4a, 0x18, 0xae, 0x7d, 0x9b, 0x87, 0x73, 0x2d, 0x66,
+	0xdf, 0xb6, 0x1c, 0xfe, 0x8a, 0xfc, 0x37, 0x53, 0xfb, 0x85, 0x63, 0x6b, 0x8f, 0xa0, 0x38, 0x74,
+	0x61, 0x3b, 0x40, 0x1c, 0x0b, 0xcf, 0x7d, 0x9c, 0xd1, 0x6f, 0x4d, 0x6c, 0x8e, 0xf8, 0xad, 0x89,
+	0x4d, 0x63, 0xd9, 0x1c, 0x73, 0xbb, 0xda, 0x9d, 0x6d, 0xed, 0xc5, 0x63, 0xa5, 0xc9, 0x62, 0xeb,
+	0xed, 0xca, 0x58, 0x25, 0xa7, 0x6b, 0xa6, 0x41, 0x69, 0xb2, 0x28, 0xb2, 0x62, 0x7f, 0x2b, 0xb0,
+	0xd4, 0x62, 0xb6, 0x60, 0xc3, 0xb3, 0x8f, 0x88, 0x72, 0x32, 0x47, 0xe4, 0xf8, 0x65, 0xfa, 0x08,
+	0x0a, 0xc8, 0xa3, 0x7d, 0xc2, 0x4b, 0xf9, 0x6c, 0xde, 0x16, 0xf0, 0x6d, 0x6d, 0xbe, 0xb1, 0x6b,
+	0x6b, 0xb0, 0x32, 0xb2, 0x63, 0xa9, 0xc4, 0xcf, 0x0b, 0x51, 0xf7, 0x6c, 0x60, 0xdb, 0x21, 0x06,
+	0xb6, 0x4e, 0x58, 0x90, 0x4f, 0x61, 0x6d, 0x28, 0x08, 0x0b, 0xcc, 0xcc, 0xa2, 0xac, 0xc8, 0xb0,
+	0xdd, 0xc0, 0x9c, 0xc9, 0x66, 0x31, 0x2e, 0xd9, 0xf2, 0x99, 0xd9, 0x9a, 0x8c, 0x4f, 0xab, 0xbc,
+	0x78, 0x72, 0x2a, 0xf7, 0x40, 0x9b, 0x56, 0x33, 0x11, 0x5b, 0x6d, 0x45, 0xe7, 0xcf, 0x77, 0x71,
+	0x68, 0xe0, 0x76, 0x78, 0xb1, 0x8a, 0xbe, 0xa0, 0x4d, 0x35, 0xc1, 0xcf, 0x92, 0x5b, 0xb7, 0x71,
+	0x3a, 0x4c, 0xfe, 0xf8, 0xb7, 0xaa, 0x62, 0x2c, 0x0f, 0x83, 0xc3, 0xe9, 0xda, 0x3f, 0x0a, 0x9c,
+	0x6d, 0x31, 0xfb, 0x01, 0xb1, 0x5e, 0x23, 0x1f, 0xef, 0xc1, 0xda, 0xd8, 0x9e, 0x5f, 0x95, 0xb8,
+	0x3f, 0x2c, 0xc0, 0xc5, 0xb0, 0xe7, 0x23, 0x62, 0x62, 0xf7, 0x01, 0xe9, 0x50, 0x62, 0x39, 0xc4,
+	0x3e, 0xea, 0x5a, 0xfd, 0xdf, 0x69, 0xad, 0xae, 0x43, 0xd1, 0x0c, 0xef, 0xb5, 0x50, 0xb4, 0x2e,
+	0x76, 0xec, 0x6e, 0x7c, 0x1e, 0xf2, 0xc6, 0x72, 0x32, 0xfc, 0x49, 0x34, 0x9a, 0x5a, 0x94, 0xab,
+	0x70, 0x25, 0x4d, 0x2b, 0xd9, 0x6d, 0xbe, 0x57, 0xa0, 0x18, 0x56, 0xcf, 0xb7, 0x10, 0xc7, 0xf7,
+	0x51, 0x80, 0x3c, 0xa6, 0x7e, 0x08, 0x67, 0x50, 0x9f, 0x77, 0x69, 0xe0, 0xf0, 0xc1, 0x91, 0xfa,
+	0x0d, 0xa1, 0xea, 0x0e, 0x14, 0xfc, 0x88, 0x41, 0xbc, 0xc7, 0x2a, 0xf3, 0xee, 0xd6, 0x38, 0x4f,
+	0xb2, 0xed, 0x38, 0x66, 0x7b, 0x39, 0xdc, 0xc9, 0x90, 0xad, 0x56, 0x86, 0x0b, 0x13, 0x0b, 0x4b,
+	0x16, 0xbd, 0xf5, 0x5d, 0x01, 0xf2, 0x2d, 0x66, 0xab, 0x0f, 0xa1, 0x38, 0xf9, 0xc8, 0xbc, 0x36,
+	0x2f, 0xe7, 0xf4, 0x6b, 0x41, 0xdb, 0xca, 0x8e, 0x95, 0x9e, 0xee, 0xc1, 0xd9, 0xf1, 0x57, 0xc5,
+	0x46, 0x0a, 0xc9, 0x18, 0x52, 0x7b, 0x2f, 0x2b, 0x52, 0x26, 0xfb, 0x12, 0x4e, 0xcb, 0x0b, 0xf1,
+	0x72, 0x4a, 0x74, 0x02, 0xd2, 0xde, 0xc9, 0x00, 0x92, 0xec, 0x0f, 0xa1, 0x38, 0x79, 0xc9, 0xa4,
+	0xa9, 0x37, 0x81, 0xd5, 0xb6, 0xb2, 0x63, 0x65, 0xca, 0x0e, 0xc0, 0x48, 0x6f, 0x7c, 0x3b, 0x85,
+	0x61, 0x08, 0xd3, 0x36, 0x33, 0xc1, 0x64, 0x8e, 0x6f, 0x14, 0x28, 0xcf, 0xef, 0x11, 0x37, 0xd2,
+	0x6a, 0x3e, 0x2f, 0x4a, 0xdb, 0xf9, 0x2f, 0x51, 0x72, 0x45, 0x5d, 0x78, 0x73, 0xec, 0x7c, 0xad,
+	0xa7, 0x6d, 0x68, 0x04, 0xa8, 0xd5, 0x33, 0x02, 0x93, 0x4c, 0x8d, 0x3b, 0xcf, 0x0e, 0x2a, 0xca,
+	0xf3, 0x83, 0x8a, 0xf2, 0xfb, 0x41, 0x45, 0x79, 0x7c, 0x58, 0xc9, 0x3d, 0x3f, 0xac, 0xe4, 0x7e,
+	0x39, 0xac, 0xe4, 0xbe, 0x78, 0x37, 0xf5, 0x91, 0xf7, 0x48, 0xfe, 0xd5, 0x8b, 0x9e, 0x7b, 0x9d,
+	0x42, 0xd4, 0x98, 0xdf, 0xff, 0x77, 0x00, 0x70, 0xbd, 0x5e, 0x83, 0xcf, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -743,6 +844,9 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUnbondingDelegation(ctx context.Context, in *MsgCancelUnbondingDelegation, opts ...grpc.CallOption) (*MsgCancelUnbondingDelegationResponse, error)
+	// UpdateParams defines a governance operation for updating the x/staking module
+	// parameters. The authority is defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -807,6 +911,15 @@ func (c *msgClient) CancelUnbondingDelegation(ctx context.Context, in *MsgCancel
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateValidator defines a method for creating a new validator.
@@ -827,6 +940,9 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUnbondingDelegation(context.Context, *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error)
+	// UpdateParams defines a governance operation for updating the x/staking module
+	// parameters. The authority is defined in the keeper.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -851,6 +967,9 @@ func (*UnimplementedMsgServer) Undelegate(ctx context.Context, req *MsgUndelegat
 func (*UnimplementedMsgServer) CancelUnbondingDelegation(ctx context.Context, req *MsgCancelUnbondingDelegation) (*MsgCancelUnbondingDelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUnbondingDelegation not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -964,6 +1083,24 @@ func _Msg_CancelUnbondingDelegation_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -992,6 +1129,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUnbondingDelegation",
 			Handler:    _Msg_CancelUnbondingDelegation_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
@@ -1504,6 +1645,69 @@ func (m *MsgCancelUnbondingDelegationResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1708,6 +1912,30 @@ func (m *MsgCancelUnbondingDelegationResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3189,6 +3417,171 @@ func (m *MsgCancelUnbondingDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
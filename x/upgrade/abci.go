@@ -12,7 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
-// BeginBlock will check if there is a scheduled plan and if it is ready to be executed.
+// BeginBlock will check if the next scheduled plan is ready to be executed.
 // If the current height is in the provided set of heights to skip, it will skip and clear the upgrade plan.
 // If it is ready, it will execute it if the handler is installed, and panic/abort otherwise.
 // If the plan is not ready, it will ensure the handler is not registered too early (and abort otherwise).
@@ -37,8 +37,10 @@ func BeginBlocker(k keeper.Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 			skipUpgradeMsg := fmt.Sprintf("UPGRADE \"%s\" SKIPPED at %d: %s", plan.Name, plan.Height, plan.Info)
 			logger.Info(skipUpgradeMsg)
 
-			// Clear the upgrade plan at current height
-			k.ClearUpgradePlan(ctx)
+			// Clear the upgrade plan at current height, keeping the plans queued after it
+			if err := k.CancelUpgradePlan(ctx, plan.Name); err != nil {
+				panic(err)
+			}
 			return
 		}
 
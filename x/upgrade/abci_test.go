@@ -81,7 +81,7 @@ func TestDoHeightUpgrade(t *testing.T) {
 func TestCanOverwriteScheduleUpgrade(t *testing.T) {
 	s := setupTest(t, 10, map[int64]bool{})
 	t.Log("Can overwrite plan")
-	err := s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "bad_test", Height: s.ctx.BlockHeight() + 10}})
+	err := s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "test", Height: s.ctx.BlockHeight() + 10}})
 	require.NoError(t, err)
 	err = s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "test", Height: s.ctx.BlockHeight() + 1}})
 	require.NoError(t, err)
@@ -89,6 +89,43 @@ func TestCanOverwriteScheduleUpgrade(t *testing.T) {
 	VerifyDoUpgrade(t)
 }
 
+func TestQueueScheduleUpgrades(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	t.Log("Can queue plans at different heights")
+	err := s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "later", Height: s.ctx.BlockHeight() + 10}})
+	require.NoError(t, err)
+	err = s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "test", Height: s.ctx.BlockHeight() + 1}})
+	require.NoError(t, err)
+
+	t.Log("Cannot queue another plan at an occupied height")
+	err = s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "conflict", Height: s.ctx.BlockHeight() + 10}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	plans := s.keeper.GetUpgradePlans(s.ctx)
+	require.Len(t, plans, 2)
+	require.Equal(t, "test", plans[0].Name)
+	require.Equal(t, "later", plans[1].Name)
+
+	t.Log("Verify that the earliest plan is applied and the later one stays queued")
+	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(time.Now())
+	req := abci.RequestBeginBlock{Header: newCtx.BlockHeader()}
+	require.Panics(t, func() {
+		s.module.BeginBlock(newCtx, req)
+	})
+
+	s.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(newCtx, req)
+	})
+	VerifyDone(t, newCtx, "test")
+
+	plan, found := s.keeper.GetUpgradePlan(newCtx)
+	require.True(t, found)
+	require.Equal(t, "later", plan.Name)
+}
+
 func VerifyDoUpgrade(t *testing.T) {
 	t.Log("Verify that a panic happens at the upgrade height")
 	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(time.Now())
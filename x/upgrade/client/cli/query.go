@@ -20,6 +20,8 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetCurrentPlanCmd(),
+		GetUpgradePlansCmd(),
+		GetUpgradeReadinessCmd(),
 		GetAppliedPlanCmd(),
 		GetModuleVersionsCmd(),
 	)
@@ -60,6 +62,63 @@ func GetCurrentPlanCmd() *cobra.Command {
 	return cmd
 }
 
+// GetUpgradePlansCmd returns the query upgrade plans command.
+func GetUpgradePlansCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plans",
+		Short: "get all scheduled upgrade plans",
+		Long:  "Gets all scheduled upgrade plans, ordered by height",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UpgradePlans(cmd.Context(), &types.QueryUpgradePlansRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetUpgradeReadinessCmd returns the query upgrade readiness command.
+func GetUpgradeReadinessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "readiness [upgrade-name]",
+		Short: "get the validators ready for a scheduled upgrade",
+		Long: "Gets the readiness signals sent by validators for a scheduled upgrade plan, " +
+			"along with the share of the bonded voting power that has signalled",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UpgradeReadiness(cmd.Context(), &types.QueryUpgradeReadinessRequest{Name: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetAppliedPlanCmd returns information about the block at which a completed
 // upgrade was applied.
 func GetAppliedPlanCmd() *cobra.Command {
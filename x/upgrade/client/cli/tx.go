@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
@@ -31,6 +32,39 @@ func GetTxCmd() *cobra.Command {
 		Short: "Upgrade transaction subcommands",
 	}
 
+	cmd.AddCommand(
+		NewCmdSignalReadiness(),
+	)
+
+	return cmd
+}
+
+// NewCmdSignalReadiness implements a command handler for a validator operator
+// to signal that the binary for a scheduled upgrade has been installed.
+func NewCmdSignalReadiness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signal-readiness [name] [binary-checksum]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Signal that the binary for a scheduled upgrade is installed",
+		Long: "Signal that the validator operated by the --from account has installed the binary for the\n" +
+			"scheduled upgrade with the given name. The checksum of the installed binary is recorded with the signal.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSignalReadiness(sdk.ValAddress(clientCtx.GetFromAddress()), args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
 	return cmd
 }
 
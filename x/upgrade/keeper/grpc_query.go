@@ -22,6 +22,40 @@ func (k Keeper) CurrentPlan(c context.Context, req *types.QueryCurrentPlanReques
 	return &types.QueryCurrentPlanResponse{Plan: &plan}, nil
 }
 
+// UpgradePlans implements the Query/UpgradePlans gRPC method
+func (k Keeper) UpgradePlans(c context.Context, req *types.QueryUpgradePlansRequest) (*types.QueryUpgradePlansResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryUpgradePlansResponse{Plans: k.GetUpgradePlans(ctx)}, nil
+}
+
+// UpgradeReadiness implements the Query/UpgradeReadiness gRPC method
+func (k Keeper) UpgradeReadiness(c context.Context, req *types.QueryUpgradeReadinessRequest) (*types.QueryUpgradeReadinessResponse, error) {
+	if req == nil || len(req.Name) == 0 {
+		return nil, errors.Wrap(errors.ErrInvalidRequest, "plan name cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetUpgradePlanByName(ctx, req.Name); !found {
+		return nil, errors.Wrapf(errors.ErrNotFound, "no upgrade named %s is scheduled", req.Name)
+	}
+
+	signalled, total := k.GetReadinessPower(ctx, req.Name)
+
+	share := sdk.ZeroDec()
+	if total.IsPositive() {
+		share = sdk.NewDec(signalled).QuoInt(total)
+	}
+
+	return &types.QueryUpgradeReadinessResponse{
+		Signals:        k.GetReadinessSignals(ctx, req.Name),
+		SignalledPower: signalled,
+		TotalPower:     total.Int64(),
+		SignalledShare: share,
+	}, nil
+}
+
 // AppliedPlan implements the Query/AppliedPlan gRPC method
 func (k Keeper) AppliedPlan(c context.Context, req *types.QueryAppliedPlanRequest) (*types.QueryAppliedPlanResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -211,6 +211,41 @@ func (suite *UpgradeTestSuite) TestAuthority() {
 	suite.Require().Equal(authtypes.NewModuleAddress(govtypes.ModuleName).String(), res.Address)
 }
 
+func (suite *UpgradeTestSuite) TestUpgradePlans() {
+	first := types.Plan{Name: "first", Height: 100}
+	second := types.Plan{Name: "second", Height: 200}
+	suite.Require().NoError(suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, second))
+	suite.Require().NoError(suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, first))
+
+	res, err := suite.queryClient.UpgradePlans(gocontext.Background(), &types.QueryUpgradePlansRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Plan{first, second}, res.Plans)
+}
+
+func (suite *UpgradeTestSuite) TestUpgradeReadiness() {
+	plan := types.Plan{Name: "test", Height: 100}
+	suite.Require().NoError(suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, plan))
+
+	_, err := suite.queryClient.UpgradeReadiness(gocontext.Background(), &types.QueryUpgradeReadinessRequest{Name: "unknown"})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.UpgradeReadiness(gocontext.Background(), &types.QueryUpgradeReadinessRequest{Name: plan.Name})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Signals)
+	suite.Require().Equal(int64(0), res.SignalledPower)
+	suite.Require().True(res.SignalledShare.IsZero())
+
+	validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+	suite.Require().NoError(suite.app.UpgradeKeeper.RecordReadiness(suite.ctx, validator.GetOperator(), plan.Name, "sha256:abcd"))
+
+	res, err = suite.queryClient.UpgradeReadiness(gocontext.Background(), &types.QueryUpgradeReadinessRequest{Name: plan.Name})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Signals, 1)
+	suite.Require().Positive(res.TotalPower)
+	suite.Require().Equal(res.TotalPower, res.SignalledPower)
+	suite.Require().Equal(sdk.OneDec(), res.SignalledShare)
+}
+
 func TestUpgradeTestSuite(t *testing.T) {
 	suite.Run(t, new(UpgradeTestSuite))
 }
@@ -36,6 +36,7 @@ type Keeper struct {
 	versionSetter      xp.ProtocolVersionSetter        // implements setting the protocol version field on BaseApp
 	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
 	authority          string                          // the address capable of executing and cancelling an upgrade. Usually the gov module account
+	stakingKeeper      types.StakingKeeper             // used to weigh readiness signals by voting power
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -44,7 +45,9 @@ type Keeper struct {
 // cdc - the app-wide binary codec
 // homePath - root directory of the application's config
 // vs - the interface implemented by baseapp which allows setting baseapp's protocol version field
-func NewKeeper(skipUpgradeHeights map[int64]bool, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, homePath string, vs xp.ProtocolVersionSetter, authority string) Keeper {
+// authority - the address capable of executing and cancelling an upgrade
+// sk - the staking keeper used to weigh validator readiness signals
+func NewKeeper(skipUpgradeHeights map[int64]bool, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, homePath string, vs xp.ProtocolVersionSetter, authority string, sk types.StakingKeeper) Keeper {
 	return Keeper{
 		homePath:           homePath,
 		skipUpgradeHeights: skipUpgradeHeights,
@@ -53,6 +56,7 @@ func NewKeeper(skipUpgradeHeights map[int64]bool, storeKey storetypes.StoreKey,
 		upgradeHandlers:    map[string]types.UpgradeHandler{},
 		versionSetter:      vs,
 		authority:          authority,
+		stakingKeeper:      sk,
 	}
 }
 
@@ -164,10 +168,10 @@ func (k Keeper) getModuleVersion(ctx sdk.Context, name string) (uint64, bool) {
 	return 0, false
 }
 
-// ScheduleUpgrade schedules an upgrade based on the specified plan.
-// If there is another Plan already scheduled, it will cancel and overwrite it.
-// ScheduleUpgrade will also write the upgraded IBC ClientState to the upgraded client
-// path if it is specified in the plan.
+// ScheduleUpgrade adds an upgrade based on the specified plan to the queue of
+// scheduled upgrades. If a Plan with the same name is already scheduled, it
+// will be rescheduled and its readiness signals kept. Scheduling fails if
+// another Plan is already scheduled at the same height.
 func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
 	if err := plan.ValidateBasic(); err != nil {
 		return err
@@ -183,16 +187,21 @@ func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade with name %s has already been completed", plan.Name)
 	}
 
+	if other, found := k.GetUpgradePlanAtHeight(ctx, plan.Height); found && other.Name != plan.Name {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade %s is already scheduled at height %d", other.Name, plan.Height)
+	}
+
 	store := ctx.KVStore(k.storeKey)
 
-	// clear any old IBC state stored by previous plan
-	oldPlan, found := k.GetUpgradePlan(ctx)
+	// clear any old IBC state stored by the plan being rescheduled
+	oldPlan, found := k.GetUpgradePlanByName(ctx, plan.Name)
 	if found {
 		k.ClearIBCState(ctx, oldPlan.Height)
+		store.Delete(types.PlanQueueKey(oldPlan.Height))
 	}
 
 	bz := k.cdc.MustMarshal(&plan)
-	store.Set(types.PlanKey(), bz)
+	store.Set(types.PlanQueueKey(plan.Height), bz)
 
 	return nil
 }
@@ -285,16 +294,36 @@ func (k Keeper) ClearIBCState(ctx sdk.Context, lastHeight int64) {
 	store.Delete(types.UpgradedConsStateKey(lastHeight))
 }
 
-// ClearUpgradePlan clears any schedule upgrade and associated IBC states.
+// ClearUpgradePlan clears all scheduled upgrades and their associated IBC
+// states and readiness signals.
 func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
-	// clear IBC states everytime upgrade plan is removed
-	oldPlan, found := k.GetUpgradePlan(ctx)
-	if found {
-		k.ClearIBCState(ctx, oldPlan.Height)
+	for _, plan := range k.GetUpgradePlans(ctx) {
+		k.removeUpgradePlan(ctx, plan)
 	}
+}
 
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.PlanKey())
+// CancelUpgradePlan removes the scheduled upgrade with the given name and its
+// associated IBC state and readiness signals.
+func (k Keeper) CancelUpgradePlan(ctx sdk.Context, name string) error {
+	plan, found := k.GetUpgradePlanByName(ctx, name)
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no upgrade named %s is scheduled", name)
+	}
+
+	k.removeUpgradePlan(ctx, plan)
+	return nil
+}
+
+// removeUpgradePlan removes a scheduled plan from the queue. IBC states are
+// cleared everytime an upgrade plan is removed.
+func (k Keeper) removeUpgradePlan(ctx sdk.Context, plan types.Plan) {
+	k.ClearIBCState(ctx, plan.Height)
+	k.deleteReadinessSignals(ctx, plan.Name)
+
+	if queued, found := k.GetUpgradePlanAtHeight(ctx, plan.Height); found && queued.Name == plan.Name {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.PlanQueueKey(plan.Height))
+	}
 }
 
 // Logger returns a module-specific logger.
@@ -302,11 +331,39 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
-// GetUpgradePlan returns the currently scheduled Plan if any, setting havePlan to true if there is a scheduled
+// GetUpgradePlan returns the next scheduled Plan if any, setting havePlan to true if there is a scheduled
 // upgrade or false if there is none
 func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan types.Plan, havePlan bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.PlanQueueByte})
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return plan, false
+	}
+
+	k.cdc.MustUnmarshal(iter.Value(), &plan)
+	return plan, true
+}
+
+// GetUpgradePlans returns all scheduled Plans ordered by height.
+func (k Keeper) GetUpgradePlans(ctx sdk.Context) []types.Plan {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.PlanQueueByte})
+	defer iter.Close()
+
+	var plans []types.Plan
+	for ; iter.Valid(); iter.Next() {
+		var plan types.Plan
+		k.cdc.MustUnmarshal(iter.Value(), &plan)
+		plans = append(plans, plan)
+	}
+
+	return plans
+}
+
+// GetUpgradePlanAtHeight returns the Plan scheduled at the given height if any.
+func (k Keeper) GetUpgradePlanAtHeight(ctx sdk.Context, height int64) (plan types.Plan, found bool) {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.PlanKey())
+	bz := store.Get(types.PlanQueueKey(height))
 	if bz == nil {
 		return plan, false
 	}
@@ -315,6 +372,17 @@ func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan types.Plan, havePlan bool)
 	return plan, true
 }
 
+// GetUpgradePlanByName returns the scheduled Plan with the given name if any.
+func (k Keeper) GetUpgradePlanByName(ctx sdk.Context, name string) (types.Plan, bool) {
+	for _, plan := range k.GetUpgradePlans(ctx) {
+		if plan.Name == name {
+			return plan, true
+		}
+	}
+
+	return types.Plan{}, false
+}
+
 // setDone marks this upgrade name as being done so the name can't be reused accidentally
 func (k Keeper) setDone(ctx sdk.Context, name string) {
 	store := ctx.KVStore(k.storeKey)
@@ -351,8 +419,7 @@ func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
 
 	// Must clear IBC state after upgrade is applied as it is stored separately from the upgrade plan.
 	// This will prevent resubmission of upgrade msg after upgrade is already completed.
-	k.ClearIBCState(ctx, plan.Height)
-	k.ClearUpgradePlan(ctx)
+	k.removeUpgradePlan(ctx, plan)
 	k.setDone(ctx, plan.Name)
 }
 
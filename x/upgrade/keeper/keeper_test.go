@@ -32,7 +32,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	homeDir := filepath.Join(s.T().TempDir(), "x_upgrade_keeper_test")
 	app.UpgradeKeeper = keeper.NewKeeper( // recreate keeper in order to use a custom home path
 		make(map[int64]bool), app.GetKey(types.StoreKey), app.AppCodec(), homeDir, app.BaseApp,
-		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(), app.StakingKeeper,
 	)
 	s.T().Log("home dir:", homeDir)
 	s.homeDir = homeDir
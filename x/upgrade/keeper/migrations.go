@@ -24,6 +24,29 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return migrateDoneUpgradeKeys(ctx, m.keeper.storeKey)
 }
 
+// Migrate2to3 migrates from version 2 to 3. It moves the single scheduled
+// upgrade plan into the queue of scheduled plans.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return migrateUpgradePlan(ctx, m.keeper.storeKey)
+}
+
+func migrateUpgradePlan(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+	bz := store.Get(types.PlanKey())
+	if bz == nil {
+		return nil
+	}
+
+	var plan types.Plan
+	if err := plan.Unmarshal(bz); err != nil {
+		return err
+	}
+
+	store.Set(types.PlanQueueKey(plan.Height), bz)
+	store.Delete(types.PlanKey())
+	return nil
+}
+
 func migrateDoneUpgradeKeys(ctx sdk.Context, storeKey storetypes.StoreKey) error {
 	store := ctx.KVStore(storeKey)
 	oldDoneStore := prefix.NewStore(store, []byte{types.DoneByte})
@@ -57,3 +57,22 @@ func TestMigrateDoneUpgradeKeys(t *testing.T) {
 		}
 	}
 }
+
+func TestMigrateUpgradePlan(t *testing.T) {
+	upgradeKey := sdk.NewKVStoreKey("upgrade")
+	ctx := testutil.DefaultContext(upgradeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(upgradeKey)
+
+	// nothing to migrate without a scheduled plan
+	require.NoError(t, migrateUpgradePlan(ctx, upgradeKey))
+	require.False(t, store.Has(types.PlanKey()))
+
+	plan := types.Plan{Name: "test", Height: 100}
+	bz, err := plan.Marshal()
+	require.NoError(t, err)
+	store.Set(types.PlanKey(), bz)
+
+	require.NoError(t, migrateUpgradePlan(ctx, upgradeKey))
+	require.False(t, store.Has(types.PlanKey()))
+	require.Equal(t, bz, store.Get(types.PlanQueueKey(100)))
+}
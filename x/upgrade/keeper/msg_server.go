@@ -45,7 +45,38 @@ func (k msgServer) CancelUpgrade(goCtx context.Context, req *types.MsgCancelUpgr
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	k.ClearUpgradePlan(ctx)
+	if len(req.Name) == 0 {
+		k.ClearUpgradePlan(ctx)
+		return &types.MsgCancelUpgradeResponse{}, nil
+	}
+
+	if err := k.CancelUpgradePlan(ctx, req.Name); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgCancelUpgradeResponse{}, nil
 }
+
+// SignalReadiness implements the Msg/SignalReadiness Msg service.
+func (k msgServer) SignalReadiness(goCtx context.Context, req *types.MsgSignalReadiness) (*types.MsgSignalReadinessResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.RecordReadiness(ctx, valAddr, req.PlanName, req.BinaryChecksum); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSignalReadiness,
+			sdk.NewAttribute(types.AttributeKeyPlanName, req.PlanName),
+			sdk.NewAttribute(types.AttributeKeyValidator, req.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyBinaryChecksum, req.BinaryChecksum),
+		),
+	)
+
+	return &types.MsgSignalReadinessResponse{}, nil
+}
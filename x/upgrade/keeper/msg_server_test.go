@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -113,3 +114,71 @@ func (s *KeeperTestSuite) TestCancelUpgrade() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestCancelUpgradeByName() {
+	govAccAddr := s.app.GovKeeper.GetGovernanceAccount(s.ctx).GetAddress().String()
+	s.Require().NoError(s.app.UpgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "first", Height: 123450000}))
+	s.Require().NoError(s.app.UpgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "second", Height: 123460000}))
+
+	_, err := s.msgSrvr.CancelUpgrade(s.ctx, &types.MsgCancelUpgrade{Authority: govAccAddr, Name: "unknown"})
+	s.Require().Error(err)
+
+	_, err = s.msgSrvr.CancelUpgrade(s.ctx, &types.MsgCancelUpgrade{Authority: govAccAddr, Name: "first"})
+	s.Require().NoError(err)
+
+	plans := s.app.UpgradeKeeper.GetUpgradePlans(s.ctx)
+	s.Require().Len(plans, 1)
+	s.Require().Equal("second", plans[0].Name)
+}
+
+func (s *KeeperTestSuite) TestSignalReadiness() {
+	validator := s.app.StakingKeeper.GetAllValidators(s.ctx)[0]
+	s.Require().NoError(s.app.UpgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "all-good", Height: 123450000}))
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgSignalReadiness
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"unknown plan",
+			types.NewMsgSignalReadiness(validator.GetOperator(), "unknown", "sha256:abcd"),
+			true,
+			"no upgrade named unknown is scheduled",
+		},
+		{
+			"unknown validator",
+			types.NewMsgSignalReadiness(sdk.ValAddress(s.addrs[0]), "all-good", "sha256:abcd"),
+			true,
+			"does not exist",
+		},
+		{
+			"readiness signalled",
+			types.NewMsgSignalReadiness(validator.GetOperator(), "all-good", "sha256:abcd"),
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgSrvr.SignalReadiness(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+				signals := s.app.UpgradeKeeper.GetReadinessSignals(s.ctx, "all-good")
+				s.Require().Equal([]types.ReadinessSignal{{
+					ValidatorAddress: tc.req.ValidatorAddress,
+					BinaryChecksum:   tc.req.BinaryChecksum,
+					Height:           s.ctx.BlockHeight(),
+				}}, signals)
+			}
+		})
+	}
+
+	// signals are removed along with the plan
+	s.app.UpgradeKeeper.ClearUpgradePlan(s.ctx)
+	s.Require().Empty(s.app.UpgradeKeeper.GetReadinessSignals(s.ctx, "all-good"))
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// RecordReadiness records that the given validator has installed the binary
+// for the scheduled plan with the given name. A validator signalling again
+// for the same plan overwrites its previous signal.
+func (k Keeper) RecordReadiness(ctx sdk.Context, valAddr sdk.ValAddress, planName, binaryChecksum string) error {
+	if _, found := k.GetUpgradePlanByName(ctx, planName); !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no upgrade named %s is scheduled", planName)
+	}
+
+	if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "validator %s does not exist", valAddr)
+	}
+
+	signal := types.ReadinessSignal{
+		ValidatorAddress: valAddr.String(),
+		BinaryChecksum:   binaryChecksum,
+		Height:           ctx.BlockHeight(),
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ReadinessKey(planName, valAddr), k.cdc.MustMarshal(&signal))
+
+	return nil
+}
+
+// GetReadinessSignals returns the readiness signals recorded for the plan with
+// the given name.
+func (k Keeper) GetReadinessSignals(ctx sdk.Context, planName string) []types.ReadinessSignal {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ReadinessPrefix(planName))
+	defer iter.Close()
+
+	var signals []types.ReadinessSignal
+	for ; iter.Valid(); iter.Next() {
+		var signal types.ReadinessSignal
+		k.cdc.MustUnmarshal(iter.Value(), &signal)
+		signals = append(signals, signal)
+	}
+
+	return signals
+}
+
+// GetReadinessPower returns the consensus power of the bonded validators that
+// have signalled readiness for the plan with the given name, along with the
+// total consensus power of the bonded validators.
+func (k Keeper) GetReadinessPower(ctx sdk.Context, planName string) (signalled int64, total math.Int) {
+	for _, signal := range k.GetReadinessSignals(ctx, planName) {
+		valAddr, err := sdk.ValAddressFromBech32(signal.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		signalled += k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+	}
+
+	return signalled, k.stakingKeeper.GetLastTotalPower(ctx)
+}
+
+// deleteReadinessSignals removes the readiness signals recorded for the plan
+// with the given name.
+func (k Keeper) deleteReadinessSignals(ctx sdk.Context, planName string) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ReadinessPrefix(planName))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,10 @@
+package types
+
+// upgrade module event types
+const (
+	EventTypeSignalReadiness = "signal_readiness"
+
+	AttributeKeyPlanName       = "plan_name"
+	AttributeKeyValidator      = "validator"
+	AttributeKeyBinaryChecksum = "binary_checksum"
+)
@@ -0,0 +1,16 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingKeeper defines the expected staking keeper used to weigh validator
+// readiness signals by voting power.
+type StakingKeeper interface {
+	Validator(ctx sdk.Context, address sdk.ValAddress) stakingtypes.ValidatorI
+	GetLastValidatorPower(ctx sdk.Context, operator sdk.ValAddress) int64
+	GetLastTotalPower(ctx sdk.Context) math.Int
+}
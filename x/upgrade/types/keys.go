@@ -1,6 +1,12 @@
 package types
 
-import "fmt"
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName is the name of this module
@@ -17,7 +23,8 @@ const (
 )
 
 const (
-	// PlanByte specifies the Byte under which a pending upgrade plan is stored in the store
+	// PlanByte specifies the Byte under which a pending upgrade plan was stored
+	// before upgrade plans were queued. It is only used by store migrations.
 	PlanByte = 0x0
 	// DoneByte is a prefix for to look up completed upgrade plan by name
 	DoneByte = 0x1
@@ -28,6 +35,12 @@ const (
 	// ProtocolVersionByte is a prefix to look up Protocol Version
 	ProtocolVersionByte = 0x3
 
+	// PlanQueueByte is a prefix to look up scheduled upgrade plans by height
+	PlanQueueByte = 0x4
+
+	// ReadinessByte is a prefix to look up validator readiness signals by plan name
+	ReadinessByte = 0x5
+
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
 
@@ -38,12 +51,35 @@ const (
 	KeyUpgradedConsState = "upgradedConsState"
 )
 
-// PlanKey is the key under which the current plan is saved
+// PlanKey is the key under which the current plan was saved before upgrade
+// plans were queued.
 // We store PlanByte as a const to keep it immutable (unlike a []byte)
 func PlanKey() []byte {
 	return []byte{PlanByte}
 }
 
+// PlanQueueKey is the key under which the plan scheduled at the given height
+// is saved. Keys sort by height, so iterating the queue yields plans in the
+// order they will be executed.
+func PlanQueueKey(height int64) []byte {
+	key := make([]byte, 9)
+	key[0] = PlanQueueByte
+	binary.BigEndian.PutUint64(key[1:], uint64(height))
+	return key
+}
+
+// ReadinessPrefix is the prefix under which the readiness signals for the
+// given plan are saved.
+func ReadinessPrefix(planName string) []byte {
+	return append([]byte{ReadinessByte}, address.MustLengthPrefix([]byte(planName))...)
+}
+
+// ReadinessKey is the key under which the readiness signal of a validator for
+// the given plan is saved.
+func ReadinessKey(planName string, valAddr sdk.ValAddress) []byte {
+	return append(ReadinessPrefix(planName), address.MustLengthPrefix(valAddr)...)
+}
+
 // UpgradedClientKey is the key under which the upgraded client state is saved
 // Connecting IBC chains can verify against the upgraded client in this path before
 // upgrading their clients
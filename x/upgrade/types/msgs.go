@@ -6,9 +6,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 )
 
+// MaxBinaryChecksumLength is the maximum length of the binary checksum sent
+// with MsgSignalReadiness.
+const MaxBinaryChecksumLength = 256
+
 var (
-	_, _ sdk.Msg            = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}
-	_, _ legacytx.LegacyMsg = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}
+	_, _, _ sdk.Msg            = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}, &MsgSignalReadiness{}
+	_, _, _ legacytx.LegacyMsg = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}, &MsgSignalReadiness{}
 )
 
 // Route implements the LegacyMsg interface.
@@ -58,6 +62,10 @@ func (m *MsgCancelUpgrade) ValidateBasic() error {
 		return sdkerrors.Wrap(err, "authority")
 	}
 
+	if len(m.Name) > MaxPlanNameLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "name cannot be longer than %d characters", MaxPlanNameLength)
+	}
+
 	return nil
 }
 
@@ -66,3 +74,52 @@ func (m *MsgCancelUpgrade) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgSignalReadiness creates a new MsgSignalReadiness instance.
+func NewMsgSignalReadiness(valAddr sdk.ValAddress, planName, binaryChecksum string) *MsgSignalReadiness {
+	return &MsgSignalReadiness{
+		ValidatorAddress: valAddr.String(),
+		PlanName:         planName,
+		BinaryChecksum:   binaryChecksum,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgSignalReadiness) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgSignalReadiness) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSignalReadiness) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSignalReadiness) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(m.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(err, "validator address")
+	}
+
+	if len(m.PlanName) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "plan name cannot be empty")
+	}
+	if len(m.PlanName) > MaxPlanNameLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "plan name cannot be longer than %d characters", MaxPlanNameLength)
+	}
+
+	if len(m.BinaryChecksum) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "binary checksum cannot be empty")
+	}
+	if len(m.BinaryChecksum) > MaxBinaryChecksumLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "binary checksum cannot be longer than %d characters", MaxBinaryChecksumLength)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgSignalReadiness.
+func (m *MsgSignalReadiness) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(m.ValidatorAddress)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
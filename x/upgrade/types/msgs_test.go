@@ -107,3 +107,56 @@ func TestMsgCancelUpgrade(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSignalReadiness(t *testing.T) {
+	valAddr := sdk.ValAddress("validator")
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSignalReadiness
+		expErr bool
+		errMsg string
+	}{
+		{
+			"invalid validator address",
+			&types.MsgSignalReadiness{
+				ValidatorAddress: "validator",
+				PlanName:         "all-good",
+				BinaryChecksum:   "sha256:abcd",
+			},
+			true,
+			"validator address: decoding bech32 failed",
+		},
+		{
+			"empty plan name",
+			types.NewMsgSignalReadiness(valAddr, "", "sha256:abcd"),
+			true,
+			"plan name cannot be empty",
+		},
+		{
+			"empty binary checksum",
+			types.NewMsgSignalReadiness(valAddr, "all-good", ""),
+			true,
+			"binary checksum cannot be empty",
+		},
+		{
+			"all good",
+			types.NewMsgSignalReadiness(valAddr, "all-good", "sha256:abcd"),
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{sdk.AccAddress(valAddr)}, tc.msg.GetSigners())
+			}
+		})
+	}
+}
@@ -10,6 +10,10 @@ import (
 // UpgradeInfoFileName file to store upgrade information
 const UpgradeInfoFilename = "upgrade-info.json"
 
+// MaxPlanNameLength is the maximum length of a plan name. Plan names are
+// length-prefixed in the readiness signal store keys.
+const MaxPlanNameLength = 255
+
 func (p Plan) String() string {
 	due := p.DueAt()
 	return fmt.Sprintf(`Upgrade Plan
@@ -29,6 +33,9 @@ func (p Plan) ValidateBasic() error {
 	if len(p.Name) == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name cannot be empty")
 	}
+	if len(p.Name) > MaxPlanNameLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "name cannot be longer than %d characters", MaxPlanNameLength)
+	}
 	if p.Height <= 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "height must be greater than 0")
 	}
@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -113,6 +116,206 @@ func (m *QueryCurrentPlanResponse) GetPlan() *Plan {
 	return nil
 }
 
+// QueryUpgradePlansRequest is the request type for the Query/UpgradePlans RPC
+// method.
+type QueryUpgradePlansRequest struct {
+}
+
+func (m *QueryUpgradePlansRequest) Reset()         { *m = QueryUpgradePlansRequest{} }
+func (m *QueryUpgradePlansRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradePlansRequest) ProtoMessage()    {}
+func (*QueryUpgradePlansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{2}
+}
+func (m *QueryUpgradePlansRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradePlansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradePlansRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradePlansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradePlansRequest.Merge(m, src)
+}
+func (m *QueryUpgradePlansRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradePlansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradePlansRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradePlansRequest proto.InternalMessageInfo
+
+// QueryUpgradePlansResponse is the response type for the Query/UpgradePlans
+// RPC method.
+type QueryUpgradePlansResponse struct {
+	// plans are the scheduled upgrade plans ordered by height.
+	Plans []Plan `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans"`
+}
+
+func (m *QueryUpgradePlansResponse) Reset()         { *m = QueryUpgradePlansResponse{} }
+func (m *QueryUpgradePlansResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradePlansResponse) ProtoMessage()    {}
+func (*QueryUpgradePlansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{3}
+}
+func (m *QueryUpgradePlansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradePlansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradePlansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradePlansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradePlansResponse.Merge(m, src)
+}
+func (m *QueryUpgradePlansResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradePlansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradePlansResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradePlansResponse proto.InternalMessageInfo
+
+func (m *QueryUpgradePlansResponse) GetPlans() []Plan {
+	if m != nil {
+		return m.Plans
+	}
+	return nil
+}
+
+// QueryUpgradeReadinessRequest is the request type for the
+// Query/UpgradeReadiness RPC method.
+type QueryUpgradeReadinessRequest struct {
+	// name is the name of the scheduled plan.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryUpgradeReadinessRequest) Reset()         { *m = QueryUpgradeReadinessRequest{} }
+func (m *QueryUpgradeReadinessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeReadinessRequest) ProtoMessage()    {}
+func (*QueryUpgradeReadinessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{4}
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeReadinessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeReadinessRequest.Merge(m, src)
+}
+func (m *QueryUpgradeReadinessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeReadinessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeReadinessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeReadinessRequest proto.InternalMessageInfo
+
+func (m *QueryUpgradeReadinessRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// QueryUpgradeReadinessResponse is the response type for the
+// Query/UpgradeReadiness RPC method.
+type QueryUpgradeReadinessResponse struct {
+	// signals are the readiness signals sent for the plan.
+	Signals []ReadinessSignal `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals"`
+	// signalled_power is the consensus power of the bonded validators that have
+	// signalled readiness.
+	SignalledPower int64 `protobuf:"varint,2,opt,name=signalled_power,json=signalledPower,proto3" json:"signalled_power,omitempty"`
+	// total_power is the total consensus power of the bonded validators.
+	TotalPower int64 `protobuf:"varint,3,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// signalled_share is signalled_power divided by total_power.
+	SignalledShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=signalled_share,json=signalledShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"signalled_share"`
+}
+
+func (m *QueryUpgradeReadinessResponse) Reset()         { *m = QueryUpgradeReadinessResponse{} }
+func (m *QueryUpgradeReadinessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeReadinessResponse) ProtoMessage()    {}
+func (*QueryUpgradeReadinessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{5}
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeReadinessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeReadinessResponse.Merge(m, src)
+}
+func (m *QueryUpgradeReadinessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeReadinessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeReadinessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeReadinessResponse proto.InternalMessageInfo
+
+func (m *QueryUpgradeReadinessResponse) GetSignals() []ReadinessSignal {
+	if m != nil {
+		return m.Signals
+	}
+	return nil
+}
+
+func (m *QueryUpgradeReadinessResponse) GetSignalledPower() int64 {
+	if m != nil {
+		return m.SignalledPower
+	}
+	return 0
+}
+
+func (m *QueryUpgradeReadinessResponse) GetTotalPower() int64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
 // QueryCurrentPlanRequest is the request type for the Query/AppliedPlan RPC
 // method.
 type QueryAppliedPlanRequest struct {
@@ -124,7 +327,7 @@ func (m *QueryAppliedPlanRequest) Reset()         { *m = QueryAppliedPlanRequest
 func (m *QueryAppliedPlanRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAppliedPlanRequest) ProtoMessage()    {}
 func (*QueryAppliedPlanRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a334d07ad8374f0, []int{2}
+	return fileDescriptor_4a334d07ad8374f0, []int{6}
 }
 func (m *QueryAppliedPlanRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -171,7 +374,7 @@ func (m *QueryAppliedPlanResponse) Reset()         { *m = QueryAppliedPlanRespon
 func (m *QueryAppliedPlanResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAppliedPlanResponse) ProtoMessage()    {}
 func (*QueryAppliedPlanResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a334d07ad8374f0, []int{3}
+	return fileDescriptor_4a334d07ad8374f0, []int{7}
 }
 func (m *QueryAppliedPlanResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -221,7 +424,7 @@ func (m *QueryUpgradedConsensusStateRequest) Reset()         { *m = QueryUpgrade
 func (m *QueryUpgradedConsensusStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedConsensusStateRequest) ProtoMessage()    {}
 func (*QueryUpgradedConsensusStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a334d07ad8374f0, []int{4}
+	return fileDescriptor_4a334d07ad8374f0, []int{8}
 }
 func (m *QueryUpgradedConsensusStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,7 +473,7 @@ func (m *QueryUpgradedConsensusStateResponse) Reset()         { *m = QueryUpgrad
 func (m *QueryUpgradedConsensusStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradedConsensusStateResponse) ProtoMessage()    {}
 func (*QueryUpgradedConsensusStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a334d07ad8374f0, []int{5}
+	return fileDescriptor_4a334d07ad8374f0, []int{9}
 }
 func (m *QueryUpgradedConsensusStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -321,7 +524,7 @@ func (m *QueryModuleVersionsRequest) Reset()         { *m = QueryModuleVersionsR
 func (m *QueryModuleVersionsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleVersionsRequest) ProtoMessage()    {}
 func (*QueryModuleVersionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a334d07ad8374f0, []int{6}
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
 }
 func (m *QueryModuleVersionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -370,7 +573,7 @@ func (m *QueryModuleVersionsResponse) Reset()         { *m = QueryModuleVersions
 func (m *QueryModuleVersionsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleVersionsResponse) ProtoMessage()    {}
 func (*QueryModuleVersionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a334d07ad8374f0, []int{7}
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
 }
 func (m *QueryModuleVersionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -416,7 +619,7 @@ func (m *QueryAuthorityRequest) Reset()         { *m = QueryAuthorityRequest{} }
 func (m *QueryAuthorityRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAuthorityRequest) ProtoMessage()    {}
 func (*QueryAuthorityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a334d07ad8374f0, []int{8}
+	return fileDescriptor_4a334d07ad8374f0, []int{12}
 }
 func (m *QueryAuthorityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,7 +659,7 @@ func (m *QueryAuthorityResponse) Reset()         { *m = QueryAuthorityResponse{}
 func (m *QueryAuthorityResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAuthorityResponse) ProtoMessage()    {}
 func (*QueryAuthorityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a334d07ad8374f0, []int{9}
+	return fileDescriptor_4a334d07ad8374f0, []int{13}
 }
 func (m *QueryAuthorityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,6 +698,10 @@ func (m *QueryAuthorityResponse) GetAddress() string {
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
+	proto.RegisterType((*QueryUpgradePlansRequest)(nil), "cosmos.upgrade.v1beta1.QueryUpgradePlansRequest")
+	proto.RegisterType((*QueryUpgradePlansResponse)(nil), "cosmos.upgrade.v1beta1.QueryUpgradePlansResponse")
+	proto.RegisterType((*QueryUpgradeReadinessRequest)(nil), "cosmos.upgrade.v1beta1.QueryUpgradeReadinessRequest")
+	proto.RegisterType((*QueryUpgradeReadinessResponse)(nil), "cosmos.upgrade.v1beta1.QueryUpgradeReadinessResponse")
 	proto.RegisterType((*QueryAppliedPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryAppliedPlanRequest")
 	proto.RegisterType((*QueryAppliedPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryAppliedPlanResponse")
 	proto.RegisterType((*QueryUpgradedConsensusStateRequest)(nil), "cosmos.upgrade.v1beta1.QueryUpgradedConsensusStateRequest")
@@ -510,48 +717,63 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 644 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xc1, 0x4f, 0x13, 0x4f,
-	0x14, 0x66, 0x0a, 0x3f, 0x7e, 0xf2, 0x6a, 0xd0, 0x4c, 0x62, 0x59, 0x57, 0x52, 0x71, 0x40, 0x85,
-	0x48, 0x77, 0xa0, 0x5c, 0x0c, 0x46, 0xa3, 0x92, 0x18, 0x31, 0x4a, 0xb4, 0x46, 0x0f, 0x5e, 0x9a,
-	0xa1, 0x3b, 0x69, 0x37, 0xb6, 0x3b, 0xcb, 0xce, 0x2c, 0x91, 0x10, 0x2e, 0x9e, 0x3c, 0x9a, 0x18,
-	0xaf, 0xde, 0xbc, 0xf8, 0x97, 0x78, 0x24, 0xf1, 0xe2, 0xc1, 0x83, 0x01, 0xff, 0x04, 0xff, 0x00,
-	0xb3, 0xb3, 0xb3, 0xa4, 0xa5, 0xbb, 0x0b, 0x7a, 0x6a, 0x77, 0xde, 0xf7, 0x7d, 0xef, 0x7b, 0x3b,
-	0xdf, 0x6b, 0x81, 0xb4, 0x84, 0xec, 0x09, 0x49, 0xa3, 0xa0, 0x1d, 0x32, 0x97, 0xd3, 0xed, 0xe5,
-	0x4d, 0xae, 0xd8, 0x32, 0xdd, 0x8a, 0x78, 0xb8, 0xe3, 0x04, 0xa1, 0x50, 0x02, 0x57, 0x12, 0x8c,
-	0x63, 0x30, 0x8e, 0xc1, 0xd8, 0xd3, 0x6d, 0x21, 0xda, 0x5d, 0x4e, 0x59, 0xe0, 0x51, 0xe6, 0xfb,
-	0x42, 0x31, 0xe5, 0x09, 0x5f, 0x26, 0x2c, 0x7b, 0x2e, 0x47, 0x39, 0x55, 0xd1, 0x28, 0x72, 0x11,
-	0xa6, 0x9e, 0xc5, 0xad, 0xd6, 0xa2, 0x30, 0xe4, 0xbe, 0x7a, 0xda, 0x65, 0x7e, 0x83, 0x6f, 0x45,
-	0x5c, 0x2a, 0xf2, 0x18, 0xac, 0xe1, 0x92, 0x0c, 0x84, 0x2f, 0x39, 0x5e, 0x82, 0xb1, 0xa0, 0xcb,
-	0x7c, 0x0b, 0xcd, 0xa0, 0xf9, 0x72, 0x7d, 0xda, 0xc9, 0x76, 0xe8, 0x68, 0x8e, 0x46, 0x92, 0x9a,
-	0x69, 0x74, 0x2f, 0x08, 0xba, 0x1e, 0x77, 0xfb, 0x1a, 0x61, 0x0c, 0x63, 0x3e, 0xeb, 0x71, 0x2d,
-	0x36, 0xd1, 0xd0, 0xdf, 0x49, 0xdd, 0x34, 0x1f, 0x80, 0x9b, 0xe6, 0x15, 0x18, 0xef, 0x70, 0xaf,
-	0xdd, 0x51, 0x9a, 0x31, 0xda, 0x30, 0x4f, 0x64, 0x1d, 0x88, 0xe6, 0xbc, 0x48, 0x5c, 0xb8, 0x6b,
-	0x31, 0xda, 0x97, 0x91, 0x7c, 0xae, 0x98, 0xe2, 0x69, 0xb7, 0xcb, 0x50, 0xee, 0x32, 0xa9, 0x9a,
-	0x03, 0x12, 0x10, 0x1f, 0x3d, 0xd4, 0x27, 0xab, 0x25, 0x0b, 0x11, 0x0f, 0x66, 0x0b, 0xa5, 0x8c,
-	0x93, 0x9b, 0x60, 0x99, 0x91, 0xdd, 0x66, 0x2b, 0x85, 0x34, 0x65, 0x8c, 0xb1, 0x4a, 0x33, 0x68,
-	0xfe, 0x6c, 0xa3, 0x12, 0x65, 0x2a, 0xc4, 0x4d, 0x1e, 0x8d, 0x9d, 0x41, 0xe7, 0x4b, 0xe4, 0x36,
-	0xd8, 0xba, 0xd5, 0x13, 0xe1, 0x46, 0x5d, 0xfe, 0x92, 0x87, 0x32, 0xbe, 0xc4, 0x3e, 0xb7, 0x3d,
-	0x5d, 0x68, 0xf6, 0xbd, 0x22, 0x48, 0x8e, 0x36, 0xe2, 0x17, 0xd5, 0x83, 0x4b, 0x99, 0x74, 0xe3,
-	0x70, 0x03, 0xce, 0x19, 0xfe, 0xb6, 0x29, 0x59, 0x68, 0x66, 0x74, 0xbe, 0x5c, 0xbf, 0x9a, 0x77,
-	0x67, 0x03, 0x42, 0x8d, 0xc9, 0xde, 0x80, 0x2e, 0x99, 0x82, 0x0b, 0xc9, 0xbd, 0x44, 0xaa, 0x23,
-	0x42, 0x4f, 0xed, 0xa4, 0x69, 0xa9, 0x43, 0xe5, 0x78, 0xc1, 0x58, 0xb0, 0xe0, 0x7f, 0xe6, 0xba,
-	0x21, 0x97, 0xd2, 0xd8, 0x4f, 0x1f, 0xeb, 0xbf, 0xc7, 0xe1, 0x3f, 0x4d, 0xc2, 0x9f, 0x10, 0x94,
-	0xfb, 0x72, 0x86, 0x69, 0x9e, 0xbb, 0x9c, 0xb0, 0xda, 0x4b, 0xa7, 0x27, 0x24, 0xb6, 0xc8, 0xe2,
-	0xdb, 0x6f, 0xbf, 0x3e, 0x94, 0xae, 0xe1, 0x39, 0x9a, 0xb3, 0x28, 0xad, 0x84, 0xd4, 0x8c, 0xe3,
-	0x8b, 0x3f, 0x23, 0x28, 0xf7, 0x65, 0xf1, 0x04, 0x83, 0xc3, 0x21, 0x3f, 0xc1, 0x60, 0x46, 0xcc,
-	0xc9, 0x8a, 0x36, 0x58, 0xc3, 0x37, 0xf2, 0x0c, 0xb2, 0x84, 0xa4, 0x0d, 0xd2, 0xdd, 0x38, 0x1f,
-	0x7b, 0xf8, 0x07, 0x82, 0x4a, 0x76, 0x68, 0xf1, 0x6a, 0xa1, 0x83, 0xc2, 0xa5, 0xb1, 0x6f, 0xfd,
-	0x13, 0xd7, 0x0c, 0xb2, 0xae, 0x07, 0xb9, 0x8b, 0xef, 0xd0, 0xe2, 0x9f, 0xa4, 0xa1, 0x1d, 0xa2,
-	0xbb, 0x7d, 0x9b, 0xba, 0xf7, 0xae, 0x84, 0xf0, 0x17, 0x04, 0x93, 0x83, 0x49, 0xc7, 0xf5, 0x42,
-	0x6b, 0x99, 0x5b, 0x65, 0xaf, 0xfc, 0x15, 0xc7, 0x8c, 0x41, 0xf5, 0x18, 0x0b, 0xf8, 0x7a, 0xde,
-	0x18, 0xc7, 0x16, 0x0d, 0x7f, 0x44, 0x30, 0x71, 0xb4, 0x0e, 0xb8, 0x56, 0x1c, 0x80, 0x63, 0xfb,
-	0x64, 0x3b, 0xa7, 0x85, 0x1b, 0x77, 0x0b, 0xda, 0xdd, 0x2c, 0xbe, 0x92, 0x9b, 0x96, 0x94, 0x72,
-	0xff, 0xc1, 0xd7, 0x83, 0x2a, 0xda, 0x3f, 0xa8, 0xa2, 0x9f, 0x07, 0x55, 0xf4, 0xfe, 0xb0, 0x3a,
-	0xb2, 0x7f, 0x58, 0x1d, 0xf9, 0x7e, 0x58, 0x1d, 0x79, 0xb5, 0xd8, 0xf6, 0x54, 0x27, 0xda, 0x74,
-	0x5a, 0xa2, 0x97, 0xca, 0x24, 0x1f, 0x35, 0xe9, 0xbe, 0xa6, 0x6f, 0x8e, 0x34, 0xd5, 0x4e, 0xc0,
-	0xe5, 0xe6, 0xb8, 0xfe, 0x0b, 0x59, 0xf9, 0x13, 0x00, 0x00, 0xff, 0xff, 0x18, 0x7f, 0x99, 0xff,
-	0xc4, 0x06, 0x00, 0x00,
+	// 882 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0xde, 0x49, 0xd2, 0x96, 0x7d, 0xa9, 0xb6, 0xd5, 0x08, 0x52, 0xaf, 0xd9, 0x26, 0x8b, 0xfb,
+	0x63, 0xb7, 0xd0, 0xd8, 0xd9, 0x2c, 0x48, 0xa8, 0xfc, 0x10, 0x6c, 0x2b, 0xa0, 0x08, 0xaa, 0xe2,
+	0x55, 0x39, 0x70, 0x89, 0x66, 0xe3, 0x91, 0x63, 0xe1, 0x78, 0x5c, 0xcf, 0xb8, 0xb0, 0xaa, 0x7a,
+	0xe1, 0xc4, 0x11, 0x09, 0x71, 0x80, 0x03, 0x37, 0x2e, 0xdc, 0x90, 0xf8, 0x17, 0x90, 0x7a, 0xac,
+	0xe0, 0x82, 0x10, 0xaa, 0xd0, 0x2e, 0x7f, 0x08, 0x9a, 0xf1, 0x38, 0x72, 0x12, 0x3b, 0xcd, 0x72,
+	0xca, 0x78, 0xde, 0xf7, 0xbd, 0xf7, 0xbd, 0x99, 0xe7, 0xcf, 0x01, 0x6b, 0xc8, 0xf8, 0x98, 0x71,
+	0x27, 0x8d, 0xfd, 0x84, 0x78, 0xd4, 0x79, 0xb0, 0x73, 0x40, 0x05, 0xd9, 0x71, 0xee, 0xa7, 0x34,
+	0x39, 0xb4, 0xe3, 0x84, 0x09, 0x86, 0x5b, 0x19, 0xc6, 0xd6, 0x18, 0x5b, 0x63, 0xcc, 0x0d, 0x9f,
+	0x31, 0x3f, 0xa4, 0x0e, 0x89, 0x03, 0x87, 0x44, 0x11, 0x13, 0x44, 0x04, 0x2c, 0xe2, 0x19, 0xcb,
+	0x7c, 0xde, 0x67, 0x3e, 0x53, 0x4b, 0x47, 0xae, 0xf4, 0xee, 0x7a, 0x96, 0x6b, 0x90, 0x05, 0x74,
+	0xe2, 0x2c, 0x74, 0xb9, 0x42, 0x4a, 0x5e, 0x56, 0xa1, 0xac, 0x75, 0xb8, 0xf0, 0x89, 0xd4, 0x76,
+	0x33, 0x4d, 0x12, 0x1a, 0x89, 0xbb, 0x21, 0x89, 0x5c, 0x7a, 0x3f, 0xa5, 0x5c, 0x58, 0x1f, 0x81,
+	0x31, 0x1f, 0xe2, 0x31, 0x8b, 0x38, 0xc5, 0x3d, 0x68, 0xc4, 0x21, 0x89, 0x0c, 0xb4, 0x89, 0xb6,
+	0x9b, 0xfd, 0x0d, 0xbb, 0xbc, 0x25, 0x5b, 0x71, 0x14, 0xd2, 0x32, 0x75, 0xb6, 0x7b, 0x19, 0x44,
+	0x46, 0x78, 0x5e, 0xe9, 0x1e, 0xac, 0x97, 0xc4, 0x74, 0xa9, 0xd7, 0xe1, 0x94, 0x4c, 0xc0, 0x0d,
+	0xb4, 0x59, 0x7f, 0x56, 0xad, 0xbd, 0xc6, 0xe3, 0xa7, 0x9d, 0x15, 0x37, 0x23, 0x58, 0x7d, 0xd8,
+	0x28, 0xa6, 0x75, 0x29, 0xf1, 0x82, 0x88, 0xf2, 0xbc, 0x2c, 0xc6, 0xd0, 0x88, 0xc8, 0x98, 0xaa,
+	0x26, 0x56, 0x5d, 0xb5, 0xb6, 0xbe, 0xaf, 0xc1, 0xc5, 0x0a, 0x92, 0xd6, 0xf3, 0x3e, 0x9c, 0xe1,
+	0x81, 0x1f, 0x91, 0x30, 0x57, 0xb4, 0x55, 0xa5, 0x68, 0xc2, 0xdd, 0x57, 0x78, 0x2d, 0x2e, 0x67,
+	0xe3, 0x2d, 0x38, 0x97, 0x2d, 0x43, 0xea, 0x0d, 0x62, 0xf6, 0x05, 0x4d, 0x8c, 0xda, 0x26, 0xda,
+	0xae, 0xbb, 0x6b, 0x93, 0xed, 0xbb, 0x72, 0x17, 0x77, 0xa0, 0x29, 0x98, 0x20, 0xa1, 0x06, 0xd5,
+	0x15, 0x08, 0xd4, 0x56, 0x06, 0xa0, 0xc5, 0x4c, 0x7c, 0x44, 0x12, 0x6a, 0x34, 0x64, 0x4f, 0x7b,
+	0x6f, 0xca, 0x8a, 0x7f, 0x3d, 0xed, 0x5c, 0xf5, 0x03, 0x31, 0x4a, 0x0f, 0xec, 0x21, 0x1b, 0xeb,
+	0x21, 0xd1, 0x3f, 0x5d, 0xee, 0x7d, 0xee, 0x88, 0xc3, 0x98, 0x72, 0xfb, 0x16, 0x1d, 0xfe, 0xfe,
+	0x6b, 0x17, 0x74, 0x2f, 0xb7, 0xe8, 0xb0, 0xa0, 0x63, 0x5f, 0xe6, 0xb4, 0xba, 0x7a, 0x56, 0xde,
+	0x8d, 0xe3, 0x30, 0xa0, 0x5e, 0x61, 0x56, 0x4a, 0x8f, 0xb2, 0x0f, 0xc6, 0x3c, 0x5c, 0x1f, 0x62,
+	0x0b, 0x4e, 0x8f, 0x68, 0xe0, 0x8f, 0x84, 0x62, 0xd4, 0x5d, 0xfd, 0x64, 0xdd, 0x06, 0xab, 0x78,
+	0xfa, 0xde, 0x4d, 0x89, 0x8e, 0x78, 0xca, 0xf7, 0x05, 0x11, 0x34, 0xaf, 0xd6, 0x81, 0x66, 0x48,
+	0xb8, 0x18, 0x4c, 0xa5, 0x00, 0xb9, 0xf5, 0x81, 0xda, 0xb9, 0x51, 0x33, 0x90, 0x15, 0xc0, 0xa5,
+	0x85, 0xa9, 0x26, 0xe3, 0x65, 0xe8, 0x7b, 0xf3, 0x06, 0xc3, 0x1c, 0x32, 0xe0, 0x12, 0xa3, 0xae,
+	0xe3, 0xac, 0xdb, 0x4a, 0x4b, 0x33, 0xc8, 0x22, 0x1f, 0x36, 0x9e, 0x43, 0xe7, 0x6b, 0xd6, 0x5b,
+	0x60, 0xaa, 0x52, 0x1f, 0x33, 0x2f, 0x0d, 0xe9, 0xa7, 0x34, 0xe1, 0xf2, 0xc5, 0x2d, 0xa8, 0x1d,
+	0xab, 0xc0, 0xa0, 0x70, 0x44, 0x90, 0x6d, 0xdd, 0x91, 0x07, 0x35, 0x86, 0x17, 0x4b, 0xe9, 0x5a,
+	0xe1, 0x1d, 0x38, 0xa7, 0xf9, 0x0f, 0x74, 0x48, 0x0f, 0xde, 0x95, 0xaa, 0xc1, 0x9b, 0x4a, 0xe4,
+	0xae, 0x8d, 0xa7, 0xf2, 0x5a, 0x17, 0xe0, 0x85, 0xec, 0x5e, 0x52, 0x31, 0x62, 0x49, 0x20, 0x0e,
+	0xf3, 0xd7, 0xb0, 0x0f, 0xad, 0xd9, 0x80, 0x96, 0x60, 0xc0, 0x19, 0xe2, 0x79, 0x09, 0xe5, 0x5c,
+	0xcb, 0xcf, 0x1f, 0xfb, 0xbf, 0xad, 0xc2, 0x29, 0x45, 0xc2, 0x3f, 0x22, 0x68, 0x16, 0xac, 0x02,
+	0x3b, 0x55, 0xea, 0x2a, 0xfc, 0xc6, 0xec, 0x2d, 0x4f, 0xc8, 0x64, 0x59, 0xd7, 0xbf, 0xfa, 0xe3,
+	0xdf, 0x6f, 0x6b, 0x57, 0xf1, 0x65, 0xa7, 0xc2, 0xeb, 0x86, 0x19, 0x69, 0x20, 0xfd, 0x00, 0xff,
+	0x80, 0xe0, 0x6c, 0xd1, 0x61, 0xf0, 0xe2, 0x82, 0x25, 0x46, 0x65, 0xee, 0x9c, 0x80, 0xa1, 0x35,
+	0x5e, 0x51, 0x1a, 0x3b, 0xf8, 0x62, 0x95, 0x46, 0xe5, 0x55, 0xf8, 0x17, 0x04, 0xe7, 0x67, 0x2d,
+	0x07, 0xbf, 0xba, 0x4c, 0xb9, 0x59, 0x5b, 0x33, 0x5f, 0x3b, 0x21, 0x4b, 0x0b, 0xed, 0x29, 0xa1,
+	0x2f, 0xe3, 0xed, 0x2a, 0xa1, 0x49, 0x4e, 0x71, 0x1e, 0xca, 0x41, 0x7e, 0x84, 0x7f, 0x42, 0xd0,
+	0x2c, 0xbc, 0xdc, 0xcf, 0xb8, 0xf1, 0x79, 0xd7, 0x30, 0x7b, 0xcb, 0x13, 0xb4, 0xc8, 0x5d, 0x25,
+	0xb2, 0x8b, 0x5f, 0xa9, 0x12, 0x49, 0x32, 0x92, 0xba, 0xf1, 0x5c, 0xe7, 0xdf, 0x08, 0x5a, 0xe5,
+	0x2e, 0x80, 0x6f, 0x2c, 0x73, 0x56, 0xe5, 0x2e, 0x64, 0xbe, 0xf1, 0xbf, 0xb8, 0xba, 0x91, 0xdb,
+	0xaa, 0x91, 0x77, 0xf0, 0xdb, 0xce, 0xe2, 0xcf, 0xf4, 0x9c, 0x29, 0x39, 0x0f, 0x0b, 0xd6, 0xf7,
+	0xe8, 0xeb, 0x1a, 0xc2, 0x3f, 0x23, 0x58, 0x9b, 0xb6, 0x0e, 0xdc, 0x5f, 0x28, 0xad, 0xd4, 0xa6,
+	0xcc, 0xdd, 0x13, 0x71, 0x74, 0x1b, 0x8e, 0x6a, 0xe3, 0x1a, 0xde, 0xaa, 0x6a, 0x63, 0xc6, 0xb9,
+	0xf0, 0x77, 0x08, 0x56, 0x27, 0xfe, 0x82, 0xbb, 0x8b, 0x07, 0x60, 0xc6, 0xa0, 0x4c, 0x7b, 0x59,
+	0xb8, 0x56, 0x77, 0x4d, 0xa9, 0xbb, 0x84, 0x5f, 0xaa, 0x9c, 0x96, 0x9c, 0xb2, 0xf7, 0xde, 0xe3,
+	0xa3, 0x36, 0x7a, 0x72, 0xd4, 0x46, 0xff, 0x1c, 0xb5, 0xd1, 0x37, 0xc7, 0xed, 0x95, 0x27, 0xc7,
+	0xed, 0x95, 0x3f, 0x8f, 0xdb, 0x2b, 0x9f, 0x5d, 0x5f, 0xf8, 0xed, 0xfc, 0x72, 0x92, 0x53, 0x7d,
+	0x45, 0x0f, 0x4e, 0xab, 0xbf, 0x55, 0xbb, 0xff, 0x0d, 0x00, 0x4a, 0x42, 0x17, 0x79, 0x09, 0x0a,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -566,8 +788,14 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
-	// CurrentPlan queries the current upgrade plan.
+	// CurrentPlan queries the current upgrade plan, i.e. the scheduled plan
+	// with the lowest height.
 	CurrentPlan(ctx context.Context, in *QueryCurrentPlanRequest, opts ...grpc.CallOption) (*QueryCurrentPlanResponse, error)
+	// UpgradePlans queries all scheduled upgrade plans ordered by height.
+	UpgradePlans(ctx context.Context, in *QueryUpgradePlansRequest, opts ...grpc.CallOption) (*QueryUpgradePlansResponse, error)
+	// UpgradeReadiness queries the readiness signals sent by validators for a
+	// scheduled upgrade plan and the share of voting power they represent.
+	UpgradeReadiness(ctx context.Context, in *QueryUpgradeReadinessRequest, opts ...grpc.CallOption) (*QueryUpgradeReadinessResponse, error)
 	// AppliedPlan queries a previously applied upgrade plan by its name.
 	AppliedPlan(ctx context.Context, in *QueryAppliedPlanRequest, opts ...grpc.CallOption) (*QueryAppliedPlanResponse, error)
 	// UpgradedConsensusState queries the consensus state that will serve
@@ -604,6 +832,24 @@ func (c *queryClient) CurrentPlan(ctx context.Context, in *QueryCurrentPlanReque
 	return out, nil
 }
 
+func (c *queryClient) UpgradePlans(ctx context.Context, in *QueryUpgradePlansRequest, opts ...grpc.CallOption) (*QueryUpgradePlansResponse, error) {
+	out := new(QueryUpgradePlansResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/UpgradePlans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UpgradeReadiness(ctx context.Context, in *QueryUpgradeReadinessRequest, opts ...grpc.CallOption) (*QueryUpgradeReadinessResponse, error) {
+	out := new(QueryUpgradeReadinessResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/UpgradeReadiness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AppliedPlan(ctx context.Context, in *QueryAppliedPlanRequest, opts ...grpc.CallOption) (*QueryAppliedPlanResponse, error) {
 	out := new(QueryAppliedPlanResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/AppliedPlan", in, out, opts...)
@@ -643,8 +889,14 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 
 // QueryServer is the server API for Query service.
 type QueryServer interface {
-	// CurrentPlan queries the current upgrade plan.
+	// CurrentPlan queries the current upgrade plan, i.e. the scheduled plan
+	// with the lowest height.
 	CurrentPlan(context.Context, *QueryCurrentPlanRequest) (*QueryCurrentPlanResponse, error)
+	// UpgradePlans queries all scheduled upgrade plans ordered by height.
+	UpgradePlans(context.Context, *QueryUpgradePlansRequest) (*QueryUpgradePlansResponse, error)
+	// UpgradeReadiness queries the readiness signals sent by validators for a
+	// scheduled upgrade plan and the share of voting power they represent.
+	UpgradeReadiness(context.Context, *QueryUpgradeReadinessRequest) (*QueryUpgradeReadinessResponse, error)
 	// AppliedPlan queries a previously applied upgrade plan by its name.
 	AppliedPlan(context.Context, *QueryAppliedPlanRequest) (*QueryAppliedPlanResponse, error)
 	// UpgradedConsensusState queries the consensus state that will serve
@@ -671,6 +923,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) CurrentPlan(ctx context.Context, req *QueryCurrentPlanRequest) (*QueryCurrentPlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentPlan not implemented")
 }
+func (*UnimplementedQueryServer) UpgradePlans(ctx context.Context, req *QueryUpgradePlansRequest) (*QueryUpgradePlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradePlans not implemented")
+}
+func (*UnimplementedQueryServer) UpgradeReadiness(ctx context.Context, req *QueryUpgradeReadinessRequest) (*QueryUpgradeReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeReadiness not implemented")
+}
 func (*UnimplementedQueryServer) AppliedPlan(ctx context.Context, req *QueryAppliedPlanRequest) (*QueryAppliedPlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AppliedPlan not implemented")
 }
@@ -706,6 +964,42 @@ func _Query_CurrentPlan_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UpgradePlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradePlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradePlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/UpgradePlans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradePlans(ctx, req.(*QueryUpgradePlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UpgradeReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeReadinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/UpgradeReadiness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeReadiness(ctx, req.(*QueryUpgradeReadinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AppliedPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAppliedPlanRequest)
 	if err := dec(in); err != nil {
@@ -786,6 +1080,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentPlan",
 			Handler:    _Query_CurrentPlan_Handler,
 		},
+		{
+			MethodName: "UpgradePlans",
+			Handler:    _Query_UpgradePlans_Handler,
+		},
+		{
+			MethodName: "UpgradeReadiness",
+			Handler:    _Query_UpgradeReadiness_Handler,
+		},
 		{
 			MethodName: "AppliedPlan",
 			Handler:    _Query_AppliedPlan_Handler,
@@ -865,7 +1167,7 @@ func (m *QueryCurrentPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAppliedPlanRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryUpgradePlansRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -875,27 +1177,20 @@ func (m *QueryAppliedPlanRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAppliedPlanRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryUpgradePlansRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAppliedPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryUpgradePlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAppliedPlanResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryUpgradePlansResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -905,25 +1200,34 @@ func (m *QueryAppliedPlanResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAppliedPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryUpgradePlansResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAppliedPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryUpgradePlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Plans) > 0 {
+		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryUpgradedConsensusStateRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryUpgradeReadinessRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -933,25 +1237,27 @@ func (m *QueryUpgradedConsensusStateRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryUpgradedConsensusStateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryUpgradeReadinessRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUpgradedConsensusStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryUpgradeReadinessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LastHeight))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryUpgradedConsensusStateResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryUpgradeReadinessResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -961,27 +1267,54 @@ func (m *QueryUpgradedConsensusStateResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryUpgradedConsensusStateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryUpgradeReadinessResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUpgradedConsensusStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryUpgradeReadinessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UpgradedConsensusState) > 0 {
-		i -= len(m.UpgradedConsensusState)
-		copy(dAtA[i:], m.UpgradedConsensusState)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UpgradedConsensusState)))
+	{
+		size := m.SignalledShare.Size()
+		i -= size
+		if _, err := m.SignalledShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TotalPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPower))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
+	}
+	if m.SignalledPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignalledPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signals) > 0 {
+		for iNdEx := len(m.Signals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryModuleVersionsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAppliedPlanRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -991,27 +1324,27 @@ func (m *QueryModuleVersionsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryModuleVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAppliedPlanRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryModuleVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAppliedPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ModuleName) > 0 {
-		i -= len(m.ModuleName)
-		copy(dAtA[i:], m.ModuleName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryModuleVersionsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAppliedPlanResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1021,20 +1354,136 @@ func (m *QueryModuleVersionsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryModuleVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAppliedPlanResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryModuleVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAppliedPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ModuleVersions) > 0 {
-		for iNdEx := len(m.ModuleVersions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ModuleVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradedConsensusStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradedConsensusStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradedConsensusStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradedConsensusStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradedConsensusStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradedConsensusStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpgradedConsensusState) > 0 {
+		i -= len(m.UpgradedConsensusState)
+		copy(dAtA[i:], m.UpgradedConsensusState)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UpgradedConsensusState)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleVersions) > 0 {
+		for iNdEx := len(m.ModuleVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1134,6 +1583,66 @@ func (m *QueryCurrentPlanResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryUpgradePlansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUpgradePlansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUpgradeReadinessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUpgradeReadinessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signals) > 0 {
+		for _, e := range m.Signals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.SignalledPower != 0 {
+		n += 1 + sovQuery(uint64(m.SignalledPower))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPower))
+	}
+	l = m.SignalledShare.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryAppliedPlanRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1376,6 +1885,378 @@ func (m *QueryCurrentPlanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryUpgradePlansRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradePlansRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradePlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradePlansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradePlansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradePlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plans = append(m.Plans, Plan{})
+			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeReadinessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeReadinessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeReadinessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signals = append(m.Signals, ReadinessSignal{})
+			if err := m.Signals[len(m.Signals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalledPower", wireType)
+			}
+			m.SignalledPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignalledPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalledShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SignalledShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryAppliedPlanRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -132,6 +132,9 @@ var xxx_messageInfo_MsgSoftwareUpgradeResponse proto.InternalMessageInfo
 type MsgCancelUpgrade struct {
 	// authority is the address of the governance account.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// name is the name of the plan to cancel. If empty, all scheduled plans are
+	// cancelled.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
 func (m *MsgCancelUpgrade) Reset()         { *m = MsgCancelUpgrade{} }
@@ -174,6 +177,13 @@ func (m *MsgCancelUpgrade) GetAuthority() string {
 	return ""
 }
 
+func (m *MsgCancelUpgrade) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 // MsgCancelUpgradeResponse is the Msg/CancelUpgrade response type.
 //
 // Since: cosmos-sdk 0.46
@@ -213,40 +223,151 @@ func (m *MsgCancelUpgradeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelUpgradeResponse proto.InternalMessageInfo
 
+// MsgSignalReadiness is the Msg/SignalReadiness request type.
+type MsgSignalReadiness struct {
+	// validator_address is the operator address of the signalling validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// plan_name is the name of the scheduled plan the validator is ready for.
+	PlanName string `protobuf:"bytes,2,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	// binary_checksum is the checksum of the installed binary.
+	BinaryChecksum string `protobuf:"bytes,3,opt,name=binary_checksum,json=binaryChecksum,proto3" json:"binary_checksum,omitempty"`
+}
+
+func (m *MsgSignalReadiness) Reset()         { *m = MsgSignalReadiness{} }
+func (m *MsgSignalReadiness) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalReadiness) ProtoMessage()    {}
+func (*MsgSignalReadiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{4}
+}
+func (m *MsgSignalReadiness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalReadiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalReadiness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignalReadiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalReadiness.Merge(m, src)
+}
+func (m *MsgSignalReadiness) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalReadiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalReadiness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalReadiness proto.InternalMessageInfo
+
+func (m *MsgSignalReadiness) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSignalReadiness) GetPlanName() string {
+	if m != nil {
+		return m.PlanName
+	}
+	return ""
+}
+
+func (m *MsgSignalReadiness) GetBinaryChecksum() string {
+	if m != nil {
+		return m.BinaryChecksum
+	}
+	return ""
+}
+
+// MsgSignalReadinessResponse is the Msg/SignalReadiness response type.
+type MsgSignalReadinessResponse struct {
+}
+
+func (m *MsgSignalReadinessResponse) Reset()         { *m = MsgSignalReadinessResponse{} }
+func (m *MsgSignalReadinessResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalReadinessResponse) ProtoMessage()    {}
+func (*MsgSignalReadinessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{5}
+}
+func (m *MsgSignalReadinessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalReadinessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalReadinessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignalReadinessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalReadinessResponse.Merge(m, src)
+}
+func (m *MsgSignalReadinessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalReadinessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalReadinessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalReadinessResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSoftwareUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgrade")
 	proto.RegisterType((*MsgSoftwareUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgradeResponse")
 	proto.RegisterType((*MsgCancelUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgrade")
 	proto.RegisterType((*MsgCancelUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgradeResponse")
+	proto.RegisterType((*MsgSignalReadiness)(nil), "cosmos.upgrade.v1beta1.MsgSignalReadiness")
+	proto.RegisterType((*MsgSignalReadinessResponse)(nil), "cosmos.upgrade.v1beta1.MsgSignalReadinessResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/upgrade/v1beta1/tx.proto", fileDescriptor_2852c16e3ab79fef) }
 
 var fileDescriptor_2852c16e3ab79fef = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x2d, 0x48, 0x2f, 0x4a, 0x4c, 0x49, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83, 0x28, 0xd0,
-	0x83, 0x2a, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1, 0x07, 0xb1,
-	0x20, 0xaa, 0xa5, 0x24, 0x21, 0xaa, 0xe3, 0x21, 0x12, 0x50, 0xad, 0x10, 0x29, 0x15, 0x1c, 0x36,
-	0xc1, 0x0c, 0x86, 0xa8, 0x12, 0x87, 0xaa, 0xca, 0x2d, 0x4e, 0xd7, 0x2f, 0x33, 0x04, 0x51, 0x10,
-	0x09, 0xa5, 0x29, 0x8c, 0x5c, 0x42, 0xbe, 0xc5, 0xe9, 0xc1, 0xf9, 0x69, 0x25, 0xe5, 0x89, 0x45,
-	0xa9, 0xa1, 0x10, 0x5d, 0x42, 0x66, 0x5c, 0x9c, 0x89, 0xa5, 0x25, 0x19, 0xf9, 0x45, 0x99, 0x25,
-	0x95, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x12, 0x97, 0xb6, 0xe8, 0x8a, 0x40, 0xad, 0x76,
-	0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x0e, 0x2e, 0x29, 0xca, 0xcc, 0x4b, 0x0f, 0x42, 0x28, 0x15,
-	0x32, 0xe3, 0x62, 0x29, 0xc8, 0x49, 0xcc, 0x93, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd1,
-	0xc3, 0xee, 0x4b, 0xbd, 0x80, 0x9c, 0xc4, 0x3c, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xc0,
-	0xea, 0xad, 0xf8, 0x9a, 0x9e, 0x6f, 0xd0, 0x42, 0x98, 0xa3, 0x24, 0xc3, 0x25, 0x85, 0xe9, 0xaa,
-	0xa0, 0xd4, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0xa5, 0x28, 0x2e, 0x01, 0xdf, 0xe2, 0x74, 0xe7,
-	0xc4, 0xbc, 0xe4, 0xd4, 0x1c, 0x0a, 0x5d, 0x8c, 0x61, 0xb3, 0x14, 0x97, 0x04, 0xba, 0xd9, 0x30,
-	0x7b, 0x8d, 0x9e, 0x32, 0x72, 0x31, 0xfb, 0x16, 0xa7, 0x0b, 0x15, 0x72, 0xf1, 0xa3, 0x07, 0x98,
-	0x16, 0x2e, 0xaf, 0x62, 0x7a, 0x43, 0xca, 0x88, 0x78, 0xb5, 0x30, 0xab, 0x85, 0xb2, 0xb9, 0x78,
-	0x51, 0xfd, 0xab, 0x81, 0xc7, 0x10, 0x14, 0x95, 0x52, 0x06, 0xc4, 0xaa, 0x84, 0x59, 0xe6, 0xe4,
-	0x76, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c,
-	0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x3a, 0xe9, 0x99, 0x25, 0x19,
-	0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xd0, 0x64, 0x08, 0xa5, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x2b,
-	0xe0, 0xa9, 0xb0, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x9c, 0xc6, 0x8c, 0x01, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x11, 0x7e, 0xae, 0x0d, 0x0e, 0x03, 0x00, 0x00,
+	// 495 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0xad, 0x42, 0xd4, 0x88, 0x6d, 0x58, 0xd3, 0x08, 0x61, 0x64, 0xa3, 0x42, 0xa2,
+	0x9a, 0x68, 0x42, 0x8b, 0xb4, 0xc3, 0x6e, 0x74, 0x12, 0xb7, 0x4e, 0x28, 0x13, 0x1c, 0xb8, 0x54,
+	0x6e, 0x62, 0xdc, 0xa8, 0x89, 0x1d, 0x6c, 0xb7, 0xac, 0x57, 0x9e, 0x80, 0x03, 0x0f, 0xc2, 0x61,
+	0x4f, 0xc0, 0x69, 0xc7, 0x69, 0xa7, 0x9d, 0x10, 0x6a, 0x0f, 0xbc, 0x06, 0x4a, 0xec, 0x74, 0xa4,
+	0x61, 0x55, 0x25, 0x4e, 0xb1, 0x3e, 0xff, 0xec, 0xff, 0xff, 0xef, 0x2f, 0x1f, 0xd8, 0xf3, 0x99,
+	0x88, 0x99, 0x70, 0x47, 0x09, 0xe1, 0x28, 0xc0, 0xee, 0xb8, 0xd5, 0xc7, 0x12, 0xb5, 0x5c, 0x79,
+	0xe6, 0x24, 0x9c, 0x49, 0x06, 0x77, 0x14, 0xe0, 0x68, 0xc0, 0xd1, 0x80, 0xb5, 0x4d, 0x18, 0x61,
+	0x19, 0xe2, 0xa6, 0x2b, 0x45, 0x5b, 0x8f, 0x14, 0xdd, 0x53, 0x1b, 0xfa, 0xa8, 0xda, 0x7a, 0x76,
+	0x8b, 0x52, 0x7e, 0xb1, 0xa2, 0x1e, 0x6a, 0x2a, 0x16, 0xc4, 0x1d, 0xb7, 0xd2, 0x8f, 0xda, 0xa8,
+	0x7f, 0x33, 0x00, 0xec, 0x0a, 0x72, 0xca, 0x3e, 0xca, 0xcf, 0x88, 0xe3, 0x77, 0xea, 0x14, 0x3c,
+	0x04, 0x35, 0x34, 0x92, 0x03, 0xc6, 0x43, 0x39, 0x31, 0x8d, 0x7d, 0xa3, 0x51, 0xeb, 0x98, 0x57,
+	0xe7, 0xcd, 0x6d, 0x2d, 0xfd, 0x3a, 0x08, 0x38, 0x16, 0xe2, 0x54, 0xf2, 0x90, 0x12, 0xef, 0x06,
+	0x85, 0x87, 0xa0, 0x9a, 0x44, 0x88, 0x9a, 0x6b, 0xfb, 0x46, 0xe3, 0x5e, 0x7b, 0xd7, 0xf9, 0x77,
+	0x4a, 0xe7, 0x6d, 0x84, 0x68, 0xa7, 0x7a, 0xf1, 0x73, 0xaf, 0xe2, 0x65, 0xfc, 0xd1, 0xc6, 0x97,
+	0xdf, 0xdf, 0x0f, 0x6e, 0xee, 0xa9, 0xef, 0x02, 0xab, 0xec, 0xca, 0xc3, 0x22, 0x61, 0x54, 0xe0,
+	0x3a, 0x05, 0x5b, 0x5d, 0x41, 0x8e, 0x11, 0xf5, 0x71, 0xf4, 0xbf, 0x8e, 0x21, 0xa8, 0x52, 0x14,
+	0xe3, 0xcc, 0x71, 0xcd, 0xcb, 0xd6, 0x25, 0x37, 0x16, 0x30, 0x17, 0xf5, 0xe6, 0x5e, 0x7e, 0xe8,
+	0x07, 0x0c, 0x09, 0x45, 0x91, 0x87, 0x51, 0x10, 0x52, 0x2c, 0x04, 0x3c, 0x01, 0x0f, 0xc6, 0x28,
+	0x0a, 0x03, 0x24, 0x19, 0xef, 0x21, 0x25, 0xae, 0x6d, 0x3d, 0xbd, 0x3a, 0x6f, 0x3e, 0xd1, 0xb6,
+	0xde, 0xe7, 0x4c, 0xd1, 0xdf, 0xd6, 0x78, 0xa1, 0x0e, 0x1f, 0x83, 0x5a, 0xfa, 0x50, 0xbd, 0xbf,
+	0xbc, 0xde, 0x4d, 0x0b, 0x27, 0x28, 0xc6, 0xf0, 0x39, 0xd8, 0xec, 0x87, 0x14, 0xf1, 0x49, 0xcf,
+	0x1f, 0x60, 0x7f, 0x28, 0x46, 0xb1, 0xb9, 0x9e, 0x21, 0x1b, 0xaa, 0x7c, 0xac, 0xab, 0x47, 0x3b,
+	0x69, 0xb0, 0xb2, 0xb1, 0xfc, 0xb9, 0x8b, 0x19, 0xf2, 0x88, 0xed, 0xeb, 0x35, 0xb0, 0xde, 0x15,
+	0x04, 0x7e, 0x02, 0x9b, 0x8b, 0xff, 0xc9, 0xc1, 0x6d, 0x1d, 0x2e, 0x77, 0xcf, 0x6a, 0xaf, 0xce,
+	0xe6, 0xd2, 0x70, 0x08, 0xee, 0x17, 0xdb, 0xdc, 0x58, 0x72, 0x49, 0x81, 0xb4, 0x5e, 0xae, 0x4a,
+	0xce, 0xc5, 0xd2, 0x7c, 0x0b, 0x6d, 0x5c, 0x9a, 0xaf, 0xc8, 0x5a, 0xed, 0xd5, 0xd9, 0x5c, 0xb2,
+	0xf3, 0xe6, 0x62, 0x6a, 0x1b, 0x97, 0x53, 0xdb, 0xf8, 0x35, 0xb5, 0x8d, 0xaf, 0x33, 0xbb, 0x72,
+	0x39, 0xb3, 0x2b, 0xd7, 0x33, 0xbb, 0xf2, 0xe1, 0x05, 0x09, 0xe5, 0x60, 0xd4, 0x77, 0x7c, 0x16,
+	0xeb, 0x81, 0xd7, 0x9f, 0xa6, 0x08, 0x86, 0xee, 0xd9, 0x7c, 0xde, 0xe5, 0x24, 0xc1, 0xa2, 0x7f,
+	0x27, 0x9b, 0xe6, 0x57, 0x7f, 0x06, 0x00, 0x13, 0xcc, 0x0f, 0xae, 0x78, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -270,6 +391,9 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
+	// SignalReadiness is used by a validator operator to signal that the binary
+	// for a scheduled upgrade plan has been installed.
+	SignalReadiness(ctx context.Context, in *MsgSignalReadiness, opts ...grpc.CallOption) (*MsgSignalReadinessResponse, error)
 }
 
 type msgClient struct {
@@ -298,6 +422,15 @@ func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opt
 	return out, nil
 }
 
+func (c *msgClient) SignalReadiness(ctx context.Context, in *MsgSignalReadiness, opts ...grpc.CallOption) (*MsgSignalReadinessResponse, error) {
+	out := new(MsgSignalReadinessResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Msg/SignalReadiness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SoftwareUpgrade is a governance operation for initiating a software upgrade.
@@ -309,6 +442,9 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
+	// SignalReadiness is used by a validator operator to signal that the binary
+	// for a scheduled upgrade plan has been installed.
+	SignalReadiness(context.Context, *MsgSignalReadiness) (*MsgSignalReadinessResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -321,6 +457,9 @@ func (*UnimplementedMsgServer) SoftwareUpgrade(ctx context.Context, req *MsgSoft
 func (*UnimplementedMsgServer) CancelUpgrade(ctx context.Context, req *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
 }
+func (*UnimplementedMsgServer) SignalReadiness(ctx context.Context, req *MsgSignalReadiness) (*MsgSignalReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalReadiness not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -362,6 +501,24 @@ func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SignalReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSignalReadiness)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SignalReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Msg/SignalReadiness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SignalReadiness(ctx, req.(*MsgSignalReadiness))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -374,6 +531,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUpgrade",
 			Handler:    _Msg_CancelUpgrade_Handler,
 		},
+		{
+			MethodName: "SignalReadiness",
+			Handler:    _Msg_SignalReadiness_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/tx.proto",
@@ -462,6 +623,13 @@ func (m *MsgCancelUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -495,6 +663,73 @@ func (m *MsgCancelUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSignalReadiness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalReadiness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalReadiness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BinaryChecksum) > 0 {
+		i -= len(m.BinaryChecksum)
+		copy(dAtA[i:], m.BinaryChecksum)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BinaryChecksum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PlanName) > 0 {
+		i -= len(m.PlanName)
+		copy(dAtA[i:], m.PlanName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PlanName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignalReadinessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalReadinessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalReadinessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -540,6 +775,10 @@ func (m *MsgCancelUpgrade) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -552,6 +791,36 @@ func (m *MsgCancelUpgradeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSignalReadiness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PlanName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BinaryChecksum)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSignalReadinessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -784,6 +1053,38 @@ func (m *MsgCancelUpgrade) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -855,6 +1156,202 @@ func (m *MsgCancelUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSignalReadiness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalReadiness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalReadiness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryChecksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryChecksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignalReadinessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalReadinessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalReadinessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -217,11 +217,56 @@ func (m *ModuleVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
+// ReadinessSignal records that a validator operator has installed the binary
+// for a scheduled upgrade plan.
+type ReadinessSignal struct {
+	// validator_address is the operator address of the signalling validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// binary_checksum is the checksum of the binary installed by the validator.
+	BinaryChecksum string `protobuf:"bytes,2,opt,name=binary_checksum,json=binaryChecksum,proto3" json:"binary_checksum,omitempty"`
+	// height is the block height at which the signal was recorded.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ReadinessSignal) Reset()         { *m = ReadinessSignal{} }
+func (m *ReadinessSignal) String() string { return proto.CompactTextString(m) }
+func (*ReadinessSignal) ProtoMessage()    {}
+func (*ReadinessSignal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
+}
+func (m *ReadinessSignal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadinessSignal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadinessSignal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReadinessSignal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadinessSignal.Merge(m, src)
+}
+func (m *ReadinessSignal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadinessSignal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadinessSignal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadinessSignal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
+	proto.RegisterType((*ReadinessSignal)(nil), "cosmos.upgrade.v1beta1.ReadinessSignal")
 }
 
 func init() {
@@ -229,38 +274,43 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xc7, 0x7d, 0xad, 0x5b, 0xe8, 0x45, 0x2c, 0x26, 0x94, 0x6b, 0x04, 0x76, 0x14, 0x31, 0x64,
-	0xa0, 0xb6, 0x5a, 0x24, 0x86, 0x6c, 0x24, 0x03, 0x12, 0x02, 0xa9, 0x72, 0x81, 0x81, 0x25, 0xba,
-	0xd8, 0x17, 0xc7, 0xc2, 0xbe, 0xc7, 0xf2, 0x5d, 0x02, 0x19, 0xf9, 0x06, 0x1d, 0x19, 0xfb, 0x1d,
-	0xe0, 0x43, 0x44, 0x4c, 0x1d, 0x11, 0x03, 0x2f, 0xc9, 0xc2, 0xc7, 0x40, 0xf7, 0xe2, 0x0a, 0x41,
-	0x17, 0x24, 0x26, 0x3f, 0xcf, 0xf9, 0xff, 0xf7, 0xef, 0x79, 0x39, 0xe3, 0x7b, 0x09, 0x88, 0x12,
-	0x44, 0x34, 0xaf, 0xb2, 0x9a, 0xa6, 0x2c, 0x5a, 0x1c, 0x4d, 0x98, 0xa4, 0x47, 0x4d, 0x1e, 0x56,
-	0x35, 0x48, 0xf0, 0xf6, 0x8d, 0x2a, 0x6c, 0x4e, 0xad, 0xaa, 0x73, 0x90, 0x01, 0x64, 0x05, 0x8b,
-	0xb4, 0x6a, 0x32, 0x9f, 0x46, 0x94, 0x2f, 0x8d, 0xa5, 0xd3, 0xce, 0x20, 0x03, 0x1d, 0x46, 0x2a,
-	0xb2, 0xa7, 0xc1, 0x9f, 0x06, 0x99, 0x97, 0x4c, 0x48, 0x5a, 0x56, 0x56, 0x70, 0x60, 0x48, 0x63,
-	0xe3, 0xb4, 0x58, 0x9d, 0xf4, 0xbe, 0x20, 0xec, 0x9e, 0x14, 0x94, 0x7b, 0x1e, 0x76, 0x39, 0x2d,
-	0x19, 0x41, 0x5d, 0xd4, 0xdf, 0x8b, 0x75, 0xec, 0x0d, 0xb0, 0xab, 0x3e, 0x45, 0xb6, 0xba, 0xa8,
-	0xdf, 0x3a, 0xee, 0x84, 0x86, 0x13, 0x36, 0x9c, 0xf0, 0x79, 0xc3, 0x19, 0xe2, 0xd5, 0xd7, 0xc0,
-	0x39, 0xfb, 0x16, 0x20, 0x82, 0x62, 0xed, 0xf1, 0xf6, 0xf1, 0xee, 0x8c, 0xe5, 0xd9, 0x4c, 0x92,
-	0xed, 0x2e, 0xea, 0x6f, 0xc7, 0x36, 0x53, 0x9c, 0x9c, 0x4f, 0x81, 0xb8, 0x86, 0xa3, 0x62, 0xef,
-	0x29, 0xbe, 0x65, 0x87, 0x90, 0x8e, 0x93, 0x22, 0x67, 0x5c, 0x8e, 0x85, 0xa4, 0x92, 0x91, 0x1d,
-	0x0d, 0x6e, 0xff, 0x05, 0x7e, 0xc4, 0x97, 0xc3, 0x2d, 0x82, 0xe2, 0x9b, 0x8d, 0x6d, 0xa4, 0x5d,
-	0xa7, 0xca, 0x34, 0xb8, 0xfe, 0xfe, 0x3c, 0x70, 0x7e, 0x9e, 0x07, 0xa8, 0xf7, 0x01, 0xe1, 0xdb,
-	0xa7, 0x30, 0x95, 0x6f, 0x68, 0xcd, 0x5e, 0x18, 0xe5, 0x49, 0x0d, 0x15, 0x08, 0x5a, 0x78, 0x6d,
-	0xbc, 0x23, 0x73, 0x59, 0x34, 0x0d, 0x9b, 0xc4, 0xeb, 0xe2, 0x56, 0xca, 0x44, 0x52, 0xe7, 0x95,
-	0xcc, 0x81, 0xeb, 0xc6, 0xf7, 0xe2, 0xdf, 0x8f, 0xbc, 0x87, 0xd8, 0xad, 0x0a, 0xca, 0x75, 0x57,
-	0xad, 0xe3, 0x3b, 0xe1, 0xd5, 0x4b, 0x0c, 0xd5, 0x4c, 0x87, 0xae, 0x9a, 0x4a, 0xac, 0xf5, 0x83,
-	0x7e, 0x53, 0xd5, 0xa7, 0x8f, 0x87, 0x1d, 0x6b, 0xca, 0x60, 0x71, 0x69, 0x18, 0x01, 0x97, 0x8c,
-	0x4b, 0x82, 0x7a, 0xef, 0x10, 0xbe, 0x3b, 0xa2, 0x3c, 0x61, 0xc5, 0x7f, 0xae, 0xfd, 0x1f, 0x6a,
-	0x78, 0x8c, 0x6f, 0x3c, 0x83, 0x74, 0x5e, 0xb0, 0x97, 0xac, 0x16, 0xaa, 0xed, 0xab, 0xae, 0x07,
-	0xc1, 0xd7, 0x16, 0xe6, 0xb5, 0x86, 0xb9, 0x71, 0x93, 0xea, 0x15, 0x20, 0x05, 0x1a, 0x3e, 0x59,
-	0xfd, 0xf0, 0x9d, 0xd5, 0xda, 0x47, 0x17, 0x6b, 0x1f, 0x7d, 0x5f, 0xfb, 0xe8, 0x6c, 0xe3, 0x3b,
-	0x17, 0x1b, 0xdf, 0xf9, 0xbc, 0xf1, 0x9d, 0x57, 0xf7, 0xb3, 0x5c, 0xce, 0xe6, 0x93, 0x30, 0x81,
-	0xd2, 0x5e, 0x4b, 0xfb, 0x38, 0x14, 0xe9, 0xeb, 0xe8, 0xed, 0xe5, 0x0f, 0x24, 0x97, 0x15, 0x13,
-	0x93, 0x5d, 0xbd, 0xff, 0x07, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x06, 0xec, 0xeb, 0xea, 0x5f,
-	0x03, 0x00, 0x00,
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xf6, 0xb5, 0x6e, 0x7f, 0xbf, 0x5e, 0x04, 0x85, 0x23, 0x14, 0x37, 0xa2, 0x4e, 0x88, 0x90,
+	0xc8, 0x40, 0x6c, 0xb5, 0x48, 0x0c, 0xd9, 0x9a, 0x0c, 0x48, 0x08, 0x50, 0xe5, 0x40, 0x07, 0x96,
+	0xe8, 0x62, 0x5f, 0x9c, 0x53, 0xed, 0x3b, 0xcb, 0x77, 0x09, 0x64, 0xe4, 0x1b, 0x74, 0x64, 0xec,
+	0xc8, 0x4e, 0x3f, 0x44, 0xc4, 0x54, 0x31, 0x21, 0x06, 0xfe, 0x24, 0x0b, 0x1f, 0x03, 0xf9, 0xee,
+	0x5c, 0xa1, 0x52, 0x06, 0x24, 0x26, 0xbf, 0xef, 0xe3, 0xe7, 0xb9, 0xe7, 0x7d, 0xef, 0x7d, 0x0f,
+	0xde, 0x0d, 0xb9, 0x48, 0xb9, 0xf0, 0x27, 0x59, 0x9c, 0xe3, 0x88, 0xf8, 0xd3, 0xdd, 0x21, 0x91,
+	0x78, 0xb7, 0xcc, 0xbd, 0x2c, 0xe7, 0x92, 0xa3, 0x2d, 0xcd, 0xf2, 0x4a, 0xd4, 0xb0, 0x6a, 0xdb,
+	0x31, 0xe7, 0x71, 0x42, 0x7c, 0xc5, 0x1a, 0x4e, 0x46, 0x3e, 0x66, 0x33, 0x2d, 0xa9, 0x55, 0x63,
+	0x1e, 0x73, 0x15, 0xfa, 0x45, 0x64, 0xd0, 0xfa, 0x45, 0x81, 0xa4, 0x29, 0x11, 0x12, 0xa7, 0x99,
+	0x21, 0x6c, 0x6b, 0xa7, 0x81, 0x56, 0x1a, 0x5b, 0x95, 0x34, 0x3f, 0x03, 0x68, 0x1f, 0x24, 0x98,
+	0x21, 0x04, 0x6d, 0x86, 0x53, 0xe2, 0x80, 0x06, 0x68, 0x6d, 0x04, 0x2a, 0x46, 0x1d, 0x68, 0x17,
+	0x47, 0x39, 0x2b, 0x0d, 0xd0, 0xaa, 0xec, 0xd5, 0x3c, 0xed, 0xe3, 0x95, 0x3e, 0xde, 0xf3, 0xd2,
+	0xa7, 0x0b, 0xe7, 0x5f, 0xea, 0xd6, 0xf1, 0xd7, 0x3a, 0x70, 0x40, 0xa0, 0x34, 0x68, 0x0b, 0xae,
+	0x8f, 0x09, 0x8d, 0xc7, 0xd2, 0x59, 0x6d, 0x80, 0xd6, 0x6a, 0x60, 0xb2, 0xc2, 0x87, 0xb2, 0x11,
+	0x77, 0x6c, 0xed, 0x53, 0xc4, 0xe8, 0x09, 0xbc, 0x69, 0x2e, 0x21, 0x1a, 0x84, 0x09, 0x25, 0x4c,
+	0x0e, 0x84, 0xc4, 0x92, 0x38, 0x6b, 0xca, 0xb8, 0xfa, 0x9b, 0xf1, 0x3e, 0x9b, 0x75, 0x57, 0x1c,
+	0x10, 0xdc, 0x28, 0x65, 0x3d, 0xa5, 0xea, 0x17, 0xa2, 0xce, 0xff, 0x6f, 0x4f, 0xea, 0xd6, 0x8f,
+	0x93, 0x3a, 0x68, 0xbe, 0x07, 0xf0, 0x56, 0x9f, 0x8f, 0xe4, 0x2b, 0x9c, 0x93, 0x17, 0x9a, 0x79,
+	0x90, 0xf3, 0x8c, 0x0b, 0x9c, 0xa0, 0x2a, 0x5c, 0x93, 0x54, 0x26, 0x65, 0xc3, 0x3a, 0x41, 0x0d,
+	0x58, 0x89, 0x88, 0x08, 0x73, 0x9a, 0x49, 0xca, 0x99, 0x6a, 0x7c, 0x23, 0xf8, 0x15, 0x42, 0x0f,
+	0xa1, 0x9d, 0x25, 0x98, 0xa9, 0xae, 0x2a, 0x7b, 0xb7, 0xbd, 0xcb, 0x87, 0xe8, 0x15, 0x77, 0xda,
+	0xb5, 0x8b, 0x5b, 0x09, 0x14, 0xbf, 0xd3, 0x2a, 0xab, 0xfa, 0x70, 0xda, 0xae, 0x19, 0x51, 0xcc,
+	0xa7, 0xe7, 0x82, 0x1e, 0x67, 0x92, 0x30, 0xe9, 0x80, 0xe6, 0x1b, 0x00, 0x77, 0x7a, 0x98, 0x85,
+	0x24, 0xf9, 0xc7, 0xb5, 0xff, 0x45, 0x0d, 0x8f, 0xe0, 0x95, 0xa7, 0x3c, 0x9a, 0x24, 0xe4, 0x90,
+	0xe4, 0x82, 0xf2, 0xcb, 0xd7, 0xc3, 0x81, 0xff, 0x4d, 0xf5, 0x6f, 0x65, 0x66, 0x07, 0x65, 0xaa,
+	0x46, 0x00, 0xd4, 0x08, 0xde, 0x01, 0xb8, 0x19, 0x10, 0x1c, 0x51, 0x46, 0x84, 0xe8, 0xd3, 0x98,
+	0xe1, 0x04, 0x3d, 0x83, 0xd7, 0xa7, 0x38, 0xa1, 0x11, 0x96, 0x3c, 0x1f, 0xe0, 0x28, 0xca, 0x89,
+	0x10, 0xfa, 0xe0, 0xee, 0x9d, 0x8f, 0xa7, 0xed, 0x1d, 0x53, 0xd9, 0x61, 0xc9, 0xd9, 0xd7, 0x94,
+	0xbe, 0xcc, 0x29, 0x8b, 0x83, 0x6b, 0xd3, 0x0b, 0x38, 0xba, 0x07, 0x37, 0x87, 0x94, 0xe1, 0x7c,
+	0x36, 0x08, 0xc7, 0x24, 0x3c, 0x12, 0x93, 0xd4, 0x34, 0x7f, 0x55, 0xc3, 0x3d, 0x83, 0xfe, 0x69,
+	0x27, 0x3b, 0x76, 0x51, 0x6a, 0xf7, 0xf1, 0xfc, 0xbb, 0x6b, 0xcd, 0x17, 0x2e, 0x38, 0x5b, 0xb8,
+	0xe0, 0xdb, 0xc2, 0x05, 0xc7, 0x4b, 0xd7, 0x3a, 0x5b, 0xba, 0xd6, 0xa7, 0xa5, 0x6b, 0xbd, 0xbc,
+	0x1f, 0x53, 0x39, 0x9e, 0x0c, 0xbd, 0x90, 0xa7, 0xe6, 0x05, 0x99, 0x4f, 0x5b, 0x44, 0x47, 0xfe,
+	0xeb, 0xf3, 0xb7, 0x2e, 0x67, 0x19, 0x11, 0xc3, 0x75, 0xb5, 0xaa, 0x0f, 0x7e, 0x0e, 0x00, 0xe9,
+	0x34, 0xef, 0x17, 0x0a, 0x04, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -383,6 +433,36 @@ func (this *ModuleVersion) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ReadinessSignal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReadinessSignal)
+	if !ok {
+		that2, ok := that.(ReadinessSignal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.BinaryChecksum != that1.BinaryChecksum {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
 func (m *Plan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -564,6 +644,48 @@ func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReadinessSignal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadinessSignal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReadinessSignal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BinaryChecksum) > 0 {
+		i -= len(m.BinaryChecksum)
+		copy(dAtA[i:], m.BinaryChecksum)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.BinaryChecksum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -653,6 +775,26 @@ func (m *ModuleVersion) Size() (n int) {
 	return n
 }
 
+func (m *ReadinessSignal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.BinaryChecksum)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovUpgrade(uint64(m.Height))
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1223,6 +1365,139 @@ func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReadinessSignal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadinessSignal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadinessSignal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryChecksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryChecksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0